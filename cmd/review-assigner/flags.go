@@ -25,6 +25,9 @@ const (
 	defaultDbMinConns    = "5"
 	defaultDbMaxConnLife = "1h"
 	defaultDbConnMaxIdle = "30m"
+
+	defaultEscalationInterval = "1m"
+	defaultReminderInterval   = "1m"
 )
 
 var opts = app.Options{}
@@ -62,6 +65,21 @@ func initOpts() {
 	flag.StringVar(&opts.DbConnMaxIdle, "db-conn-max-idle", getEnv("POSTGRES_MAX_CONN_IDLE_TIME", defaultDbConnMaxIdle),
 		fmt.Sprintf("server's database max connection idle, default: %q", defaultDbConnMaxIdle))
 
+	flag.StringVar(&opts.EscalationInterval, "escalation-interval",
+		getEnv("ESCALATION_INTERVAL", defaultEscalationInterval),
+		fmt.Sprintf("how often SLA breaches are escalated, 0 disables the worker, default: %q",
+			defaultEscalationInterval))
+
+	flag.StringVar(&opts.ReminderInterval, "reminder-interval",
+		getEnv("REMINDER_INTERVAL", defaultReminderInterval),
+		fmt.Sprintf("how often reminder schedules are checked, 0 disables the worker, default: %q",
+			defaultReminderInterval))
+
+	flag.StringVar(&opts.GitHubWebhookSecret, "github-webhook-secret", getEnv("GITHUB_WEBHOOK_SECRET", ""),
+		"secret of the GitHub pull_request webhook, empty disables POST /webhooks/github")
+	flag.StringVar(&opts.GitLabWebhookToken, "gitlab-webhook-token", getEnv("GITLAB_WEBHOOK_TOKEN", ""),
+		"secret token of the GitLab merge request webhook, empty disables POST /webhooks/gitlab")
+
 	flag.Parse()
 }
 
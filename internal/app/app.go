@@ -9,17 +9,55 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"time"
 
 	validatorV10 "github.com/go-playground/validator/v10"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 
 	appHttp "github.com/AndrejDubinin/review-assigner/internal/app/http"
 	"github.com/AndrejDubinin/review-assigner/internal/app/http/middleware"
 	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/AndrejDubinin/review-assigner/internal/infra/metrics"
+	"github.com/AndrejDubinin/review-assigner/internal/infra/notify"
 	repo "github.com/AndrejDubinin/review-assigner/internal/repository/db_repo"
+	"github.com/AndrejDubinin/review-assigner/internal/repository/reporting"
+	"github.com/AndrejDubinin/review-assigner/internal/services/escalation"
+	exportReviewsService "github.com/AndrejDubinin/review-assigner/internal/services/export/reviews"
+	createPullRequestService "github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/create"
+	declineReviewService "github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/decline"
+	setDependenciesService "github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/dependencies"
+	getPullRequestService "github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/get"
+	pullRequestLifecycleService "github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/lifecycle"
+	listPullRequestsService "github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/list"
+	mergePullRequestService "github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/merge"
+	mergeabilityService "github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/mergeability"
+	previewAssignmentService "github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/preview"
+	submitReviewService "github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/review"
+	"github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/selection"
+	"github.com/AndrejDubinin/review-assigner/internal/services/reminder"
+	assignmentStatsService "github.com/AndrejDubinin/review-assigner/internal/services/stats/assignments"
+	declineStatsService "github.com/AndrejDubinin/review-assigner/internal/services/stats/declines"
+	loadDistributionService "github.com/AndrejDubinin/review-assigner/internal/services/stats/distribution"
+	reviewGraphService "github.com/AndrejDubinin/review-assigner/internal/services/stats/graph"
+	knowledgeReportService "github.com/AndrejDubinin/review-assigner/internal/services/stats/knowledge"
+	latencyReportService "github.com/AndrejDubinin/review-assigner/internal/services/stats/latency"
 	addTeamService "github.com/AndrejDubinin/review-assigner/internal/services/team/add"
 	getTeamService "github.com/AndrejDubinin/review-assigner/internal/services/team/get"
+	getTeamPolicyService "github.com/AndrejDubinin/review-assigner/internal/services/team/policy/get"
+	rollbackTeamPolicyService "github.com/AndrejDubinin/review-assigner/internal/services/team/policy/rollback"
+	uploadTeamPolicyService "github.com/AndrejDubinin/review-assigner/internal/services/team/policy/upload"
+	listTeamPoliciesService "github.com/AndrejDubinin/review-assigner/internal/services/team/policy/versions"
+	listReminderRunsService "github.com/AndrejDubinin/review-assigner/internal/services/team/reminders"
+	addUserAbsenceService "github.com/AndrejDubinin/review-assigner/internal/services/user/absence"
+	setUserIdentityService "github.com/AndrejDubinin/review-assigner/internal/services/user/identity"
+	getUserReviewsService "github.com/AndrejDubinin/review-assigner/internal/services/user/reviews"
+	"github.com/AndrejDubinin/review-assigner/internal/services/webhook"
+	githubWebhookService "github.com/AndrejDubinin/review-assigner/internal/services/webhook/github"
+	gitlabWebhookService "github.com/AndrejDubinin/review-assigner/internal/services/webhook/gitlab"
 )
 
 type (
@@ -39,18 +77,68 @@ type (
 	validator interface {
 		Struct(s any) error
 	}
-	teamStorage interface {
+	storage interface {
 		AddTeam(ctx context.Context, team domain.TeamDTO) error
 		GetTeam(ctx context.Context, teamName string) (domain.Team, error)
+		FindMissingTeams(ctx context.Context, teamNames []string) ([]string, error)
+		AddTeamPolicy(ctx context.Context, policy domain.TeamPolicyDTO) (domain.TeamPolicy, error)
+		GetTeamPolicy(ctx context.Context, teamName string, version int) (domain.TeamPolicy, error)
+		ListTeamPolicies(ctx context.Context, teamName string) ([]domain.TeamPolicy, error)
+		ActivateTeamPolicy(ctx context.Context, teamName string, version int) (domain.TeamPolicy, error)
+		GetAssignmentPool(ctx context.Context, authorID string) (domain.AssignmentPool, error)
+		GetTeamCandidates(ctx context.Context, teamName string) ([]domain.Candidate, error)
+		GetCandidates(ctx context.Context, userIDs []string) ([]domain.Candidate, error)
+		PullRequestExists(ctx context.Context, pullRequestID string) (bool, error)
+		CreatePullRequest(ctx context.Context, pr domain.PullRequestDTO) (domain.PullRequest, error)
+		SetPullRequestDependencies(ctx context.Context, request domain.SetDependencies) (domain.PullRequest, error)
+		ListDependents(ctx context.Context, pullRequestID string) ([]string, error)
+		GetStackReviewers(ctx context.Context, pullRequestIDs []string) ([]string, error)
+		SubmitReview(ctx context.Context, review domain.SubmitReview) (domain.PullRequest, error)
+		ReRequestReview(ctx context.Context, request domain.ReRequestReview, dismissApprovals bool) (domain.PullRequest, error)
+		DeclineReview(ctx context.Context, decline domain.DeclineReview, replacement []domain.Reviewer) (domain.PullRequest, error)
+		ListDeclinedReviewers(ctx context.Context, pullRequestID string) ([]string, error)
+		GetDeclineStats(ctx context.Context, filter domain.StatsFilter) (domain.DeclineStats, error)
+		GetReviewLoads(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewLoad, error)
+		GetReviewCounts(ctx context.Context, filter domain.KnowledgeFilter) ([]domain.ReviewCount, error)
+		GetAssignmentStats(ctx context.Context, filter domain.StatsFilter, groupBy domain.StatsGroupBy,
+		) (domain.AssignmentStats, error)
+		GetPullRequest(ctx context.Context, pullRequestID string) (domain.PullRequest, error)
+		GetPullRequestTeam(ctx context.Context, pullRequestID string) (string, error)
+		TransitionPullRequest(ctx context.Context, pullRequestID string,
+			decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error),
+		) (domain.PullRequest, error)
+		GetUserReviews(ctx context.Context, userID string) (domain.UserReviews, error)
+		ListPullRequests(ctx context.Context, filter domain.PullRequestFilter) ([]domain.PullRequest, error)
+		ListPullRequestEvents(ctx context.Context, pullRequestID string) ([]domain.PullRequestEvent, error)
+		ListPendingReviews(ctx context.Context, assignedBefore time.Time) ([]domain.PendingReview, error)
+		EscalateReview(ctx context.Context, escalation domain.Escalation) (bool, error)
+		StartReminderRun(ctx context.Context, teamName string, scheduledFor time.Time) (domain.ReminderRun, bool, error)
+		FinishReminderRun(ctx context.Context, runID int64, deliveries []domain.ReminderDelivery) error
+		ListReminderRuns(ctx context.Context, teamName string, limit int) ([]domain.ReminderRun, error)
+		WebhookDeliveryExists(ctx context.Context, delivery domain.WebhookDelivery) (bool, error)
+		RecordWebhookDelivery(ctx context.Context, delivery domain.WebhookDelivery) error
+		SetUserIdentity(ctx context.Context, identity domain.UserIdentity) error
+		GetUserIDByIdentity(ctx context.Context, provider domain.WebhookProvider, externalID string) (string, error)
+		AddUserAbsence(ctx context.Context, absence domain.UserAbsence) error
+	}
+	reports interface {
+		GetLatencyReport(ctx context.Context, filter domain.ReportFilter) (domain.LatencyReport, error)
+		GetReviewGraph(ctx context.Context, filter domain.StatsFilter) (domain.ReviewGraph, error)
+		ExportReviews(ctx context.Context, filter domain.ReportFilter,
+			yield func(record domain.ReviewRecord) error) error
 	}
 
 	App struct {
-		config    config
-		mux       mux
-		server    server
-		logger    logger
-		validator validator
-		storage   teamStorage
+		config        config
+		mux           mux
+		server        server
+		logger        logger
+		validator     validator
+		storage       storage
+		reports       reports
+		metrics       *prometheus.Registry
+		httpMetrics   *metrics.HTTP
+		domainMetrics *metrics.Domain
 	}
 )
 
@@ -78,6 +166,13 @@ func NewApp(config config, logger logger) (*App, error) {
 
 	validator := validatorV10.New(validatorV10.WithRequiredStructEnabled())
 
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		metrics.NewPool(pool),
+	)
+
 	handler := middleware.LoggingMiddleware(logger)(mux)
 	handler = middleware.PanicMiddleware(logger)(handler)
 	handler = middleware.RequestIDMiddleware(handler)
@@ -92,27 +187,243 @@ func NewApp(config config, logger logger) (*App, error) {
 			WriteTimeout: config.web.writeTimeout,
 			IdleTimeout:  config.web.idleTimeout,
 		},
-		logger:    logger,
-		validator: validator,
-		storage:   repo.NewRepo(pool),
+		logger:        logger,
+		validator:     validator,
+		storage:       repo.NewRepo(pool),
+		reports:       reporting.NewRepo(pool),
+		metrics:       registry,
+		httpMetrics:   metrics.NewHTTP(registry),
+		domainMetrics: metrics.NewDomain(registry),
 	}, nil
 }
 
+// handle registers the handler for the pattern and records its requests under the pattern.
+func (a *App) handle(pattern string, handler http.Handler) {
+	a.mux.Handle(pattern, middleware.MetricsMiddleware(a.httpMetrics, pattern)(handler))
+}
+
 func (a *App) ListenAndServe() error {
-	a.mux.Handle(a.config.path.index, appHttp.NewIndexHandler(a.logger))
-	a.mux.Handle(a.config.path.teamAdd, appHttp.NewAddTeamHandler(
+	a.handle(a.config.path.index, appHttp.NewIndexHandler(a.logger))
+	a.handle(a.config.path.metrics, promhttp.HandlerFor(a.metrics, promhttp.HandlerOpts{}))
+	a.handle(a.config.path.teamAdd, appHttp.NewAddTeamHandler(
 		addTeamService.New(a.storage, a.logger),
 		a.config.path.teamAdd,
 		a.logger,
 		a.validator,
 	))
-	a.mux.Handle(a.config.path.teamGet, appHttp.NewGetTeamHandler(
+	a.handle(a.config.path.teamGet, appHttp.NewGetTeamHandler(
 		getTeamService.New(a.storage, a.logger),
 		a.config.path.teamGet,
 		a.logger,
 		a.validator,
 	))
 
+	a.handle(a.config.path.teamPolicyUpload, appHttp.NewUploadTeamPolicyHandler(
+		uploadTeamPolicyService.New(a.storage, a.logger),
+		a.config.path.teamPolicyUpload,
+		a.logger,
+	))
+	a.handle(a.config.path.teamPolicyGet, appHttp.NewGetTeamPolicyHandler(
+		getTeamPolicyService.New(a.storage, a.logger),
+		a.config.path.teamPolicyGet,
+		a.logger,
+	))
+	a.handle(a.config.path.teamPolicyVersions, appHttp.NewListTeamPoliciesHandler(
+		listTeamPoliciesService.New(a.storage, a.logger),
+		a.config.path.teamPolicyVersions,
+		a.logger,
+	))
+	a.handle(a.config.path.teamPolicyRollback, appHttp.NewRollbackTeamPolicyHandler(
+		rollbackTeamPolicyService.New(a.storage, a.logger),
+		a.config.path.teamPolicyRollback,
+		a.logger,
+		a.validator,
+	))
+	a.handle(a.config.path.teamReminderRuns, appHttp.NewListReminderRunsHandler(
+		listReminderRunsService.New(a.storage, a.logger),
+		a.config.path.teamReminderRuns,
+		a.logger,
+	))
+
+	selector := selection.New(a.storage)
+	create := createPullRequestService.New(a.storage, selector, a.domainMetrics, a.logger)
+	a.handle(a.config.path.pullRequestCreate, appHttp.NewCreatePullRequestHandler(
+		create,
+		a.config.path.pullRequestCreate,
+		a.logger,
+		a.validator,
+	))
+	a.handle(a.config.path.pullRequestList, appHttp.NewListPullRequestsHandler(
+		listPullRequestsService.New(a.storage, a.logger),
+		a.config.path.pullRequestList,
+		a.logger,
+	))
+	a.handle(a.config.path.pullRequestGet, appHttp.NewGetPullRequestHandler(
+		getPullRequestService.New(a.storage, a.logger),
+		a.config.path.pullRequestGet,
+		a.logger,
+	))
+	a.handle(a.config.path.pullRequestPreviewAssign, appHttp.NewPreviewAssignmentHandler(
+		previewAssignmentService.New(a.storage, selector, a.logger),
+		a.config.path.pullRequestPreviewAssign,
+		a.logger,
+		a.validator,
+	))
+
+	reviews := submitReviewService.New(a.storage, a.logger)
+	a.handle(a.config.path.pullRequestReview, appHttp.NewSubmitReviewHandler(
+		reviews,
+		a.config.path.pullRequestReview,
+		a.logger,
+		a.validator,
+	))
+	a.handle(a.config.path.pullRequestReRequest, appHttp.NewReRequestReviewHandler(
+		reviews,
+		a.config.path.pullRequestReRequest,
+		a.logger,
+		a.validator,
+	))
+
+	a.handle(a.config.path.pullRequestDecline, appHttp.NewDeclineReviewHandler(
+		declineReviewService.New(a.storage, selector, a.domainMetrics, a.logger),
+		a.config.path.pullRequestDecline,
+		a.logger,
+		a.validator,
+	))
+
+	a.handle(a.config.path.pullRequestDependencies, appHttp.NewSetDependenciesHandler(
+		setDependenciesService.New(a.storage, a.logger),
+		a.config.path.pullRequestDependencies,
+		a.logger,
+		a.validator,
+	))
+
+	mergeability := mergeabilityService.New(a.storage, a.logger)
+	merge := mergePullRequestService.New(a.storage, mergeability, a.domainMetrics, a.logger)
+	a.handle(a.config.path.pullRequestMerge, appHttp.NewMergePullRequestHandler(
+		merge,
+		a.config.path.pullRequestMerge,
+		a.logger,
+		a.validator,
+	))
+	a.handle(a.config.path.pullRequestMergeability, appHttp.NewMergeabilityHandler(
+		mergeability,
+		a.config.path.pullRequestMergeability,
+		a.logger,
+	))
+
+	lifecycle := pullRequestLifecycleService.New(a.storage, selector, a.domainMetrics, a.logger)
+	a.handle(a.config.path.pullRequestClose, appHttp.NewPullRequestActionHandler(
+		lifecycle.ClosePullRequest,
+		"pullRequest.close",
+		a.config.path.pullRequestClose,
+		a.logger,
+		a.validator,
+	))
+	a.handle(a.config.path.pullRequestReopen, appHttp.NewPullRequestActionHandler(
+		lifecycle.ReopenPullRequest,
+		"pullRequest.reopen",
+		a.config.path.pullRequestReopen,
+		a.logger,
+		a.validator,
+	))
+
+	a.handle(a.config.path.pullRequestReady, appHttp.NewReadyForReviewHandler(
+		lifecycle,
+		a.config.path.pullRequestReady,
+		a.logger,
+		a.validator,
+	))
+	a.handle(a.config.path.pullRequestToDraft, appHttp.NewConvertToDraftHandler(
+		lifecycle,
+		a.config.path.pullRequestToDraft,
+		a.logger,
+		a.validator,
+	))
+
+	if a.config.webhooks.githubSecret != "" {
+		router := webhook.NewRouter(a.storage, domain.WebhookProviderGitHub, create, lifecycle, merge, a.logger,
+			a.validator)
+		a.handle(a.config.path.webhookGitHub, appHttp.NewGitHubWebhookHandler(
+			githubWebhookService.New(router, a.config.webhooks.githubSecret),
+			a.config.path.webhookGitHub,
+			a.logger,
+		))
+	}
+	if a.config.webhooks.gitlabToken != "" {
+		router := webhook.NewRouter(a.storage, domain.WebhookProviderGitLab, create, lifecycle, merge, a.logger,
+			a.validator, gitlabWebhookService.ErrNoDraftChange)
+		a.handle(a.config.path.webhookGitLab, appHttp.NewGitLabWebhookHandler(
+			gitlabWebhookService.New(router, a.config.webhooks.gitlabToken),
+			a.config.path.webhookGitLab,
+			a.logger,
+		))
+	}
+
+	a.handle(a.config.path.usersGetReview, appHttp.NewGetUserReviewsHandler(
+		getUserReviewsService.New(a.storage, a.logger),
+		a.config.path.usersGetReview,
+		a.logger,
+	))
+	a.handle(a.config.path.usersSetIdentity, appHttp.NewSetUserIdentityHandler(
+		setUserIdentityService.New(a.storage, a.logger),
+		a.config.path.usersSetIdentity,
+		a.logger,
+		a.validator,
+	))
+	a.handle(a.config.path.usersAddAbsence, appHttp.NewAddUserAbsenceHandler(
+		addUserAbsenceService.New(a.storage, a.logger),
+		a.config.path.usersAddAbsence,
+		a.logger,
+		a.validator,
+	))
+
+	a.handle(a.config.path.stats, appHttp.NewAssignmentStatsHandler(
+		assignmentStatsService.New(a.storage, a.logger),
+		a.config.path.stats,
+		a.logger,
+	))
+	a.handle(a.config.path.statsDeclines, appHttp.NewDeclineStatsHandler(
+		declineStatsService.New(a.storage, a.logger),
+		a.config.path.statsDeclines,
+		a.logger,
+	))
+	a.handle(a.config.path.statsDistribution, appHttp.NewLoadDistributionHandler(
+		loadDistributionService.New(a.storage, a.logger),
+		a.config.path.statsDistribution,
+		a.logger,
+	))
+	a.handle(a.config.path.statsLatency, appHttp.NewLatencyReportHandler(
+		latencyReportService.New(a.reports, a.logger),
+		a.config.path.statsLatency,
+		a.logger,
+	))
+	a.handle(a.config.path.statsKnowledge, appHttp.NewKnowledgeReportHandler(
+		knowledgeReportService.New(a.storage, a.logger),
+		a.config.path.statsKnowledge,
+		a.logger,
+	))
+	a.handle(a.config.path.statsGraph, appHttp.NewReviewGraphHandler(
+		reviewGraphService.New(a.reports, a.logger),
+		a.config.path.statsGraph,
+		a.logger,
+	))
+
+	a.handle(a.config.path.exportReviews, appHttp.NewExportReviewsHandler(
+		exportReviewsService.New(a.reports, a.logger),
+		a.config.path.exportReviews,
+		a.logger,
+	))
+
+	sink := notify.NewLogSink(a.logger.With(zap.String("service", "notify")))
+	if a.config.workers.escalationInterval > 0 {
+		go escalation.New(a.storage, selector, sink, a.domainMetrics, a.logger, a.config.workers.escalationInterval).
+			Run(context.Background())
+	}
+	if a.config.workers.reminderInterval > 0 {
+		go reminder.New(a.storage, sink, a.logger, a.config.workers.reminderInterval).Run(context.Background())
+	}
+
 	a.logger.Info("Starting server", zap.String("address", net.JoinHostPort(a.config.web.host, a.config.web.port)))
 
 	return a.server.ListenAndServe()
@@ -29,11 +29,49 @@ type (
 		DbMinConns      string
 		DbMaxConnLife   string
 		DbConnMaxIdle   string
+
+		EscalationInterval string
+		ReminderInterval   string
+
+		GitHubWebhookSecret string
+		GitLabWebhookToken  string
 	}
 	path struct {
-		index   string
-		teamAdd string
-		teamGet string
+		index                    string
+		metrics                  string
+		teamAdd                  string
+		teamGet                  string
+		teamPolicyUpload         string
+		teamPolicyGet            string
+		teamPolicyVersions       string
+		teamPolicyRollback       string
+		teamReminderRuns         string
+		pullRequestCreate        string
+		pullRequestGet           string
+		pullRequestList          string
+		pullRequestPreviewAssign string
+		pullRequestReview        string
+		pullRequestReRequest     string
+		pullRequestDecline       string
+		pullRequestDependencies  string
+		pullRequestMerge         string
+		pullRequestMergeability  string
+		pullRequestClose         string
+		pullRequestReopen        string
+		pullRequestReady         string
+		pullRequestToDraft       string
+		usersGetReview           string
+		usersSetIdentity         string
+		usersAddAbsence          string
+		stats                    string
+		statsDeclines            string
+		statsDistribution        string
+		statsLatency             string
+		statsKnowledge           string
+		statsGraph               string
+		exportReviews            string
+		webhookGitHub            string
+		webhookGitLab            string
 	}
 	web struct {
 		port            string
@@ -51,10 +89,22 @@ type (
 		connMaxIdle time.Duration
 	}
 
+	workers struct {
+		escalationInterval time.Duration
+		reminderInterval   time.Duration
+	}
+
+	webhooks struct {
+		githubSecret string
+		gitlabToken  string
+	}
+
 	config struct {
-		web  web
-		db   db
-		path path
+		web      web
+		db       db
+		path     path
+		workers  workers
+		webhooks webhooks
 	}
 )
 
@@ -95,6 +145,14 @@ func NewConfig(opts Options) (config, error) {
 	if err != nil {
 		return config{}, err
 	}
+	escalationInterval, err := time.ParseDuration(opts.EscalationInterval)
+	if err != nil {
+		return config{}, err
+	}
+	reminderInterval, err := time.ParseDuration(opts.ReminderInterval)
+	if err != nil {
+		return config{}, err
+	}
 
 	return config{
 		web: web{
@@ -112,10 +170,50 @@ func NewConfig(opts Options) (config, error) {
 			maxConnLife: dbMaxConnLife,
 			connMaxIdle: dbConnMaxIdle,
 		},
+		workers: workers{
+			escalationInterval: escalationInterval,
+			reminderInterval:   reminderInterval,
+		},
+		webhooks: webhooks{
+			githubSecret: opts.GitHubWebhookSecret,
+			gitlabToken:  opts.GitLabWebhookToken,
+		},
 		path: path{
-			index:   "/",
-			teamAdd: "POST /team/add",
-			teamGet: "GET /team/get",
+			index:                    "/",
+			metrics:                  "GET /metrics",
+			teamAdd:                  "POST /team/add",
+			teamGet:                  "GET /team/get",
+			teamPolicyUpload:         "POST /team/policy/upload",
+			teamPolicyGet:            "GET /team/policy/get",
+			teamPolicyVersions:       "GET /team/policy/versions",
+			teamPolicyRollback:       "POST /team/policy/rollback",
+			teamReminderRuns:         "GET /team/reminderRuns",
+			pullRequestCreate:        "POST /pullRequest/create",
+			pullRequestGet:           "GET /pullRequest/get",
+			pullRequestList:          "GET /pullRequest/list",
+			pullRequestPreviewAssign: "POST /pullRequest/previewAssignment",
+			pullRequestReview:        "POST /pullRequest/review",
+			pullRequestReRequest:     "POST /pullRequest/reRequestReview",
+			pullRequestDecline:       "POST /pullRequest/decline",
+			pullRequestDependencies:  "POST /pullRequest/setDependencies",
+			pullRequestMerge:         "POST /pullRequest/merge",
+			pullRequestMergeability:  "GET /pullRequest/mergeability",
+			pullRequestClose:         "POST /pullRequest/close",
+			pullRequestReopen:        "POST /pullRequest/reopen",
+			pullRequestReady:         "POST /pullRequest/readyForReview",
+			pullRequestToDraft:       "POST /pullRequest/convertToDraft",
+			usersGetReview:           "GET /users/getReview",
+			usersSetIdentity:         "POST /users/setIdentity",
+			usersAddAbsence:          "POST /users/addAbsence",
+			stats:                    "GET /stats",
+			statsDeclines:            "GET /stats/declines",
+			statsDistribution:        "GET /stats/distribution",
+			statsLatency:             "GET /stats/latency",
+			statsKnowledge:           "GET /stats/knowledge",
+			statsGraph:               "GET /stats/graph",
+			exportReviews:            "GET /export/reviews",
+			webhookGitHub:            "POST /webhooks/github",
+			webhookGitLab:            "POST /webhooks/gitlab",
 		},
 	}, nil
 }
@@ -25,6 +25,14 @@ func handleError(w http.ResponseWriter, err error, msg string, logger logger) {
 		statusCode = http.StatusBadRequest
 		errCode = domain.ErrCodeInvalidRequest
 
+	case errors.Is(err, domain.ErrInvalidWebhookPayload) || errors.Is(err, domain.ErrPullRequestKeyTooLong):
+		statusCode = http.StatusBadRequest
+		errCode = domain.ErrCodeInvalidRequest
+
+	case errors.Is(err, domain.ErrInvalidWebhookSignature) || errors.Is(err, domain.ErrInvalidWebhookToken):
+		statusCode = http.StatusUnauthorized
+		errCode = domain.ErrCodeUnauthorized
+
 	case errors.Is(err, domain.ErrTeamExists):
 		statusCode = http.StatusBadRequest
 		errCode = domain.ErrCodeTeamExists
@@ -33,7 +41,41 @@ func handleError(w http.ResponseWriter, err error, msg string, logger logger) {
 		statusCode = http.StatusBadRequest
 		errCode = domain.ErrCodeUserExists
 
-	case errors.Is(err, domain.ErrTeamNotFound):
+	case errors.Is(err, domain.ErrPRExists):
+		statusCode = http.StatusConflict
+		errCode = domain.ErrCodePRExists
+
+	case errors.Is(err, domain.ErrNotEnoughReviewers) || errors.Is(err, domain.ErrMandatoryReviewerUnavailable):
+		statusCode = http.StatusConflict
+		errCode = domain.ErrCodeNoCandidate
+
+	case errors.Is(err, domain.ErrPRMerged):
+		statusCode = http.StatusConflict
+		errCode = domain.ErrCodePRMerged
+
+	case errors.Is(err, domain.ErrPRNotOpen):
+		statusCode = http.StatusConflict
+		errCode = domain.ErrCodePRNotOpen
+
+	case errors.Is(err, domain.ErrMergeBlocked):
+		statusCode = http.StatusConflict
+		errCode = domain.ErrCodeMergeBlocked
+
+	case errors.Is(err, domain.ErrInvalidTransition):
+		statusCode = http.StatusConflict
+		errCode = domain.ErrCodeInvalidTransition
+
+	case errors.Is(err, domain.ErrDependencyCycle):
+		statusCode = http.StatusConflict
+		errCode = domain.ErrCodeDependencyCycle
+
+	case errors.Is(err, domain.ErrNotAssigned):
+		statusCode = http.StatusConflict
+		errCode = domain.ErrCodeNotAssigned
+
+	case errors.Is(err, domain.ErrTeamNotFound) || errors.Is(err, domain.ErrAuthorNotFound) ||
+		errors.Is(err, domain.ErrUserNotFound) || errors.Is(err, domain.ErrPolicyNotFound) ||
+		errors.Is(err, domain.ErrPRNotFound) || errors.Is(err, domain.ErrDependencyNotFound):
 		statusCode = http.StatusNotFound
 		errCode = domain.ErrCodeNotFound
 
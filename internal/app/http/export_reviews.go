@@ -0,0 +1,211 @@
+package http
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+const (
+	exportFormatCSV    = "csv"
+	exportFormatNDJSON = "ndjson"
+
+	// exportFlushEvery is how many records are buffered before they are sent to the client.
+	exportFlushEvery = 1000
+)
+
+var ErrInvalidExportFormat = errors.New("format must be csv or ndjson")
+
+var reviewRecordCSVHeader = []string{
+	"kind", "occurred_at", "pull_request_id", "repository", "author_id", "user_id", "role", "source",
+	"verdict", "message",
+}
+
+type (
+	exportReviewsService interface {
+		ExportReviews(ctx context.Context, filter domain.ReportFilter,
+			yield func(record domain.ReviewRecord) error) error
+	}
+
+	ExportReviewsHandler struct {
+		name                 string
+		exportReviewsService exportReviewsService
+		logger               logger
+	}
+
+	// recordWriter encodes the exported records into the response body.
+	recordWriter interface {
+		WriteHeader() error
+		Write(record domain.ReviewRecord) error
+		Flush() error
+	}
+
+	csvRecordWriter struct {
+		writer *csv.Writer
+	}
+
+	ndjsonRecordWriter struct {
+		buffer  *bufio.Writer
+		encoder *json.Encoder
+	}
+)
+
+func NewExportReviewsHandler(service exportReviewsService, name string, logger logger) *ExportReviewsHandler {
+	return &ExportReviewsHandler{
+		name:                 name,
+		exportReviewsService: service,
+		logger:               logger,
+	}
+}
+
+// ServeHTTP streams the reviewer history as it is read. The response status and headers are sent
+// with the first record, so a failure after that can only cut the body short and is logged. The
+// request ID is returned in X-Request-ID to match the export with the logs.
+func (h *ExportReviewsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "export.reviews"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	query := r.URL.Query()
+
+	statsFilter, err := parseStatsFilter(query, time.Now())
+	if err != nil {
+		handleError(w, ErrInvalidQuery, err.Error(), logger)
+		return
+	}
+	filter := domain.ReportFilter{
+		StatsFilter: statsFilter,
+		Repository:  query.Get("repository"),
+	}
+	if len(filter.Repository) > maxRepositoryLength {
+		handleError(w, ErrInvalidQuery, ErrRepositoryTooLong.Error(), logger)
+		return
+	}
+
+	format := query.Get("format")
+	if format == "" {
+		format = exportFormatCSV
+	}
+
+	var (
+		records     recordWriter
+		contentType string
+	)
+	switch format {
+	case exportFormatCSV:
+		records = &csvRecordWriter{writer: csv.NewWriter(w)}
+		contentType = "text/csv; charset=utf-8"
+	case exportFormatNDJSON:
+		buffer := bufio.NewWriter(w)
+		records = &ndjsonRecordWriter{buffer: buffer, encoder: json.NewEncoder(buffer)}
+		contentType = "application/x-ndjson"
+	default:
+		handleError(w, ErrInvalidQuery, ErrInvalidExportFormat.Error(), logger)
+		return
+	}
+
+	controller := http.NewResponseController(w)
+	// The export may take longer than the server write timeout.
+	if err = controller.SetWriteDeadline(time.Time{}); err != nil {
+		logger.Error("controller.SetWriteDeadline", zap.Error(err))
+	}
+
+	var (
+		started bool
+		written int
+	)
+	start := func() error {
+		started = true
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition",
+			fmt.Sprintf(`attachment; filename="reviews-%s-%s.%s"`, filter.From.Format(statsDateLayout),
+				filter.To.Format(statsDateLayout), format))
+		w.WriteHeader(http.StatusOK)
+		return records.WriteHeader()
+	}
+
+	err = h.exportReviewsService.ExportReviews(ctx, filter, func(record domain.ReviewRecord) error {
+		if !started {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		if err := records.Write(record); err != nil {
+			return err
+		}
+		written++
+		if written%exportFlushEvery != 0 {
+			return nil
+		}
+		if err := records.Flush(); err != nil {
+			return err
+		}
+		return controller.Flush()
+	})
+	if err != nil {
+		if !started {
+			handleError(w, err, err.Error(), logger)
+			return
+		}
+		logger.Error("export interrupted", zap.Error(err), zap.Int("records", written))
+		return
+	}
+
+	if !started {
+		if err = start(); err != nil {
+			logger.Error("start", zap.Error(err))
+			return
+		}
+	}
+	if err = records.Flush(); err != nil {
+		logger.Error("records.Flush", zap.Error(err))
+	}
+}
+
+func (c *csvRecordWriter) WriteHeader() error {
+	return c.writer.Write(reviewRecordCSVHeader)
+}
+
+func (c *csvRecordWriter) Write(record domain.ReviewRecord) error {
+	return c.writer.Write([]string{
+		string(record.Kind),
+		record.OccurredAt.UTC().Format(time.RFC3339Nano),
+		record.PullRequestID,
+		record.Repository,
+		record.AuthorID,
+		record.UserID,
+		string(record.Role),
+		string(record.Source),
+		string(record.Verdict),
+		record.Message,
+	})
+}
+
+func (c *csvRecordWriter) Flush() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+func (n *ndjsonRecordWriter) WriteHeader() error {
+	return nil
+}
+
+func (n *ndjsonRecordWriter) Write(record domain.ReviewRecord) error {
+	return n.encoder.Encode(record)
+}
+
+func (n *ndjsonRecordWriter) Flush() error {
+	return n.buffer.Flush()
+}
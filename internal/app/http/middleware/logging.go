@@ -42,6 +42,11 @@ func (rw *ResponseWriter) Write(b []byte) (int, error) {
 	return rw.ResponseWriter.Write(b)
 }
 
+// Unwrap lets http.ResponseController reach the flushing and deadline methods of the wrapped writer.
+func (rw *ResponseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 func (rw *ResponseWriter) StatusCode() int {
 	return rw.statusCode
 }
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+)
+
+type requestObserver interface {
+	ObserveRequest(route, method string, statusCode int, duration time.Duration)
+}
+
+// MetricsMiddleware records the status code and latency of the requests served by the handler
+// registered for route.
+func MetricsMiddleware(observer requestObserver, route string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			wrapped := NewResponseWriter(w)
+
+			next.ServeHTTP(wrapped, r)
+
+			observer.ObserveRequest(route, r.Method, wrapped.StatusCode(), time.Since(start))
+		})
+	}
+}
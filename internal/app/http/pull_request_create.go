@@ -0,0 +1,112 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	createPullRequestService interface {
+		CreatePullRequest(ctx context.Context, pr domain.CreatePullRequest) (domain.CreatePullRequestResult, error)
+	}
+
+	createPullRequestResponse struct {
+		PullRequest      domain.PullRequest         `json:"pr"`
+		RejectedRequests []domain.RejectedCandidate `json:"rejected_requested_reviewers,omitempty"`
+	}
+
+	CreatePullRequestHandler struct {
+		name                     string
+		createPullRequestService createPullRequestService
+		logger                   logger
+		validator                validator
+	}
+)
+
+func NewCreatePullRequestHandler(service createPullRequestService, name string, logger logger,
+	validator validator,
+) *CreatePullRequestHandler {
+	return &CreatePullRequestHandler{
+		name:                     name,
+		createPullRequestService: service,
+		logger:                   logger,
+		validator:                validator,
+	}
+}
+
+func (h *CreatePullRequestHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.create"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	request, err := decodeCreatePullRequest(r)
+	if err != nil {
+		handleError(w, ErrInvalidJSONSyntax, "invalid json syntax", logger)
+		return
+	}
+
+	if err = h.validator.Struct(request); err != nil {
+		handleError(w, ErrInvalidJSON, ConvertValidationErrors(err).String(), logger)
+		return
+	}
+	if err = request.ValidateKeys(); err != nil {
+		handleError(w, err, err.Error(), logger)
+		return
+	}
+
+	result, err := h.createPullRequestService.CreatePullRequest(ctx, *request)
+	if err != nil {
+		handleError(w, err, createPullRequestErrorMessage(err, request), logger)
+		return
+	}
+
+	marshaledPR, err := json.Marshal(&createPullRequestResponse{
+		PullRequest:      result.PullRequest,
+		RejectedRequests: result.RejectedRequests,
+	})
+	if err != nil {
+		handleError(w, err, "failed to marshal pull request", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, marshaledPR); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
+
+func decodeCreatePullRequest(r *http.Request) (*domain.CreatePullRequest, error) {
+	request := &domain.CreatePullRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func createPullRequestErrorMessage(err error, request *domain.CreatePullRequest) string {
+	switch {
+	case errors.Is(err, domain.ErrPRExists):
+		return fmt.Sprintf("%s already exists", request.Key())
+	case errors.Is(err, domain.ErrAuthorNotFound):
+		return "resource not found"
+	case errors.Is(err, domain.ErrNotEnoughReviewers):
+		return "not enough reviewer candidates to satisfy team policy"
+	case errors.Is(err, domain.ErrMandatoryReviewerUnavailable):
+		return "mandatory reviewer is unavailable and has no available deputy"
+	case errors.Is(err, domain.ErrDependencyNotFound):
+		return "dependency not found"
+	case errors.Is(err, domain.ErrDependencyCycle):
+		return fmt.Sprintf("%s cannot depend on itself", request.Key())
+	default:
+		return err.Error()
+	}
+}
@@ -0,0 +1,63 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	declineReviewService interface {
+		DeclineReview(ctx context.Context, decline domain.DeclineReview) (domain.PullRequest, error)
+	}
+
+	DeclineReviewHandler struct {
+		name                 string
+		declineReviewService declineReviewService
+		logger               logger
+		validator            validator
+	}
+)
+
+func NewDeclineReviewHandler(service declineReviewService, name string, logger logger,
+	validator validator,
+) *DeclineReviewHandler {
+	return &DeclineReviewHandler{
+		name:                 name,
+		declineReviewService: service,
+		logger:               logger,
+		validator:            validator,
+	}
+}
+
+func (h *DeclineReviewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.decline"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	request := &domain.DeclineReview{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		handleError(w, ErrInvalidJSONSyntax, "invalid json syntax", logger)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handleError(w, ErrInvalidJSON, ConvertValidationErrors(err).String(), logger)
+		return
+	}
+
+	pr, err := h.declineReviewService.DeclineReview(ctx, *request)
+	if err != nil {
+		handleError(w, err, pullRequestErrorMessage(err, request.PullRequestID, request.UserID), logger)
+		return
+	}
+
+	writePullRequest(w, pr, logger)
+}
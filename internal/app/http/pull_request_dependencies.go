@@ -0,0 +1,63 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	setDependenciesService interface {
+		SetDependencies(ctx context.Context, request domain.SetDependencies) (domain.PullRequest, error)
+	}
+
+	SetDependenciesHandler struct {
+		name                   string
+		setDependenciesService setDependenciesService
+		logger                 logger
+		validator              validator
+	}
+)
+
+func NewSetDependenciesHandler(service setDependenciesService, name string, logger logger,
+	validator validator,
+) *SetDependenciesHandler {
+	return &SetDependenciesHandler{
+		name:                   name,
+		setDependenciesService: service,
+		logger:                 logger,
+		validator:              validator,
+	}
+}
+
+func (h *SetDependenciesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.setDependencies"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	request := &domain.SetDependencies{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		handleError(w, ErrInvalidJSONSyntax, "invalid json syntax", logger)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handleError(w, ErrInvalidJSON, ConvertValidationErrors(err).String(), logger)
+		return
+	}
+
+	pr, err := h.setDependenciesService.SetDependencies(ctx, *request)
+	if err != nil {
+		handleError(w, err, pullRequestErrorMessage(err, request.PullRequestID, request.ActorID), logger)
+		return
+	}
+
+	writePullRequest(w, pr, logger)
+}
@@ -0,0 +1,63 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	convertToDraftService interface {
+		ConvertToDraft(ctx context.Context, request domain.ConvertToDraft) (domain.PullRequest, error)
+	}
+
+	ConvertToDraftHandler struct {
+		name                  string
+		convertToDraftService convertToDraftService
+		logger                logger
+		validator             validator
+	}
+)
+
+func NewConvertToDraftHandler(service convertToDraftService, name string, logger logger,
+	validator validator,
+) *ConvertToDraftHandler {
+	return &ConvertToDraftHandler{
+		name:                  name,
+		convertToDraftService: service,
+		logger:                logger,
+		validator:             validator,
+	}
+}
+
+func (h *ConvertToDraftHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.convertToDraft"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	request := &domain.ConvertToDraft{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		handleError(w, ErrInvalidJSONSyntax, "invalid json syntax", logger)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handleError(w, ErrInvalidJSON, ConvertValidationErrors(err).String(), logger)
+		return
+	}
+
+	pr, err := h.convertToDraftService.ConvertToDraft(ctx, *request)
+	if err != nil {
+		handleError(w, err, pullRequestErrorMessage(err, request.PullRequestID, request.ActorID), logger)
+		return
+	}
+
+	writePullRequest(w, pr, logger)
+}
@@ -0,0 +1,62 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	getPullRequestService interface {
+		GetPullRequest(ctx context.Context, pullRequestID string) (domain.PullRequestDetails, error)
+	}
+
+	GetPullRequestHandler struct {
+		name                  string
+		getPullRequestService getPullRequestService
+		logger                logger
+	}
+)
+
+func NewGetPullRequestHandler(service getPullRequestService, name string, logger logger) *GetPullRequestHandler {
+	return &GetPullRequestHandler{
+		name:                  name,
+		getPullRequestService: service,
+		logger:                logger,
+	}
+}
+
+func (h *GetPullRequestHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.get"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	pullRequestID := r.URL.Query().Get("pull_request_id")
+	if err := validatePullRequestID(pullRequestID); err != nil {
+		handleError(w, ErrInvalidQuery, err.Error(), logger)
+		return
+	}
+
+	details, err := h.getPullRequestService.GetPullRequest(ctx, pullRequestID)
+	if err != nil {
+		handleError(w, err, pullRequestErrorMessage(err, pullRequestID, ""), logger)
+		return
+	}
+
+	marshaledDetails, err := json.Marshal(details)
+	if err != nil {
+		handleError(w, err, "failed to marshal pull request", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, marshaledDetails); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	pullRequestActionFunc func(ctx context.Context, action domain.PullRequestAction) (domain.PullRequest, error)
+
+	// PullRequestActionHandler serves the lifecycle endpoints that take a PullRequestAction body,
+	// e.g. close and reopen.
+	PullRequestActionHandler struct {
+		name      string
+		service   string
+		action    pullRequestActionFunc
+		logger    logger
+		validator validator
+	}
+)
+
+func NewPullRequestActionHandler(action pullRequestActionFunc, service, name string, logger logger,
+	validator validator,
+) *PullRequestActionHandler {
+	return &PullRequestActionHandler{
+		name:      name,
+		service:   service,
+		action:    action,
+		logger:    logger,
+		validator: validator,
+	}
+}
+
+func (h *PullRequestActionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", h.service),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	request := &domain.PullRequestAction{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		handleError(w, ErrInvalidJSONSyntax, "invalid json syntax", logger)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handleError(w, ErrInvalidJSON, ConvertValidationErrors(err).String(), logger)
+		return
+	}
+
+	pr, err := h.action(ctx, *request)
+	if err != nil {
+		handleError(w, err, pullRequestErrorMessage(err, request.PullRequestID, request.ActorID), logger)
+		return
+	}
+
+	writePullRequest(w, pr, logger)
+}
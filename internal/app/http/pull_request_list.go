@@ -0,0 +1,162 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+const (
+	defaultPullRequestListLimit = 50
+	maxPullRequestListLimit     = 500
+	maxRepositoryLength         = 200
+	maxLabelLength              = 64
+)
+
+var (
+	ErrInvalidListLimit    = fmt.Errorf("limit must be an integer between 1 and %d", maxPullRequestListLimit)
+	ErrRepositoryTooLong   = fmt.Errorf("repository is too long max length is %d", maxRepositoryLength)
+	ErrLabelFilterTooLong  = fmt.Errorf("label is too long max length is %d", maxLabelLength)
+	ErrInvalidStatusFilter = errors.New("status must be a comma separated list of DRAFT, OPEN, MERGED, CLOSED")
+
+	prStatuses = []domain.PRStatus{domain.PRStatusDraft, domain.PRStatusOpen, domain.PRStatusMerged,
+		domain.PRStatusClosed}
+)
+
+type (
+	listPullRequestsService interface {
+		ListPullRequests(ctx context.Context, filter domain.PullRequestFilter) (domain.PullRequestPage, error)
+	}
+
+	ListPullRequestsHandler struct {
+		name                    string
+		listPullRequestsService listPullRequestsService
+		logger                  logger
+	}
+)
+
+func NewListPullRequestsHandler(service listPullRequestsService, name string, logger logger,
+) *ListPullRequestsHandler {
+	return &ListPullRequestsHandler{
+		name:                    name,
+		listPullRequestsService: service,
+		logger:                  logger,
+	}
+}
+
+func (h *ListPullRequestsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.list"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	filter, err := parsePullRequestFilter(r.URL.Query())
+	if err != nil {
+		handleError(w, ErrInvalidQuery, err.Error(), logger)
+		return
+	}
+
+	page, err := h.listPullRequestsService.ListPullRequests(ctx, filter)
+	if err != nil {
+		handleError(w, err, err.Error(), logger)
+		return
+	}
+
+	marshaledPRs, err := json.Marshal(page)
+	if err != nil {
+		handleError(w, err, "failed to marshal pull requests", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, marshaledPRs); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
+
+// parsePullRequestFilter reads the listing filters. status accepts a comma separated list, the
+// date range bounds accept RFC 3339 timestamps or YYYY-MM-DD dates.
+func parsePullRequestFilter(query url.Values) (domain.PullRequestFilter, error) {
+	filter := domain.PullRequestFilter{
+		AuthorID:   query.Get("author_id"),
+		ReviewerID: query.Get("reviewer_id"),
+		TeamName:   query.Get("team_name"),
+		Repository: query.Get("repository"),
+		Label:      query.Get("label"),
+		Limit:      defaultPullRequestListLimit,
+	}
+
+	if rawStatuses := query.Get("status"); rawStatuses != "" {
+		for _, rawStatus := range strings.Split(rawStatuses, ",") {
+			status := domain.PRStatus(strings.ToUpper(strings.TrimSpace(rawStatus)))
+			if !slices.Contains(prStatuses, status) {
+				return domain.PullRequestFilter{}, ErrInvalidStatusFilter
+			}
+			filter.Statuses = append(filter.Statuses, status)
+		}
+	}
+
+	for _, userID := range []string{filter.AuthorID, filter.ReviewerID} {
+		if len(userID) > maxUserIDLength {
+			return domain.PullRequestFilter{}, ErrUserIDTooLong
+		}
+	}
+	if filter.TeamName != "" {
+		if err := validateTeamName(filter.TeamName); err != nil {
+			return domain.PullRequestFilter{}, err
+		}
+	}
+	if len(filter.Repository) > maxRepositoryLength {
+		return domain.PullRequestFilter{}, ErrRepositoryTooLong
+	}
+	if len(filter.Label) > maxLabelLength {
+		return domain.PullRequestFilter{}, ErrLabelFilterTooLong
+	}
+
+	for param, bound := range map[string]**time.Time{
+		"created_from": &filter.CreatedFrom,
+		"created_to":   &filter.CreatedTo,
+		"merged_from":  &filter.MergedFrom,
+		"merged_to":    &filter.MergedTo,
+	} {
+		raw := query.Get(param)
+		if raw == "" {
+			continue
+		}
+		t, err := parseStatsTime(raw)
+		if err != nil {
+			return domain.PullRequestFilter{}, fmt.Errorf("%s: %w", param, ErrInvalidStatsTime)
+		}
+		*bound = &t
+	}
+
+	if rawCursor := query.Get("cursor"); rawCursor != "" {
+		cursor, err := domain.DecodePullRequestCursor(rawCursor)
+		if err != nil {
+			return domain.PullRequestFilter{}, err
+		}
+		filter.After = &cursor
+	}
+
+	if rawLimit := query.Get("limit"); rawLimit != "" {
+		limit, err := strconv.Atoi(rawLimit)
+		if err != nil || limit < 1 || limit > maxPullRequestListLimit {
+			return domain.PullRequestFilter{}, ErrInvalidListLimit
+		}
+		filter.Limit = limit
+	}
+
+	return filter, nil
+}
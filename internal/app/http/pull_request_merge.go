@@ -0,0 +1,63 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	mergePullRequestService interface {
+		MergePullRequest(ctx context.Context, request domain.MergePullRequest) (domain.PullRequest, error)
+	}
+
+	MergePullRequestHandler struct {
+		name                    string
+		mergePullRequestService mergePullRequestService
+		logger                  logger
+		validator               validator
+	}
+)
+
+func NewMergePullRequestHandler(service mergePullRequestService, name string, logger logger,
+	validator validator,
+) *MergePullRequestHandler {
+	return &MergePullRequestHandler{
+		name:                    name,
+		mergePullRequestService: service,
+		logger:                  logger,
+		validator:               validator,
+	}
+}
+
+func (h *MergePullRequestHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.merge"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	request := &domain.MergePullRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		handleError(w, ErrInvalidJSONSyntax, "invalid json syntax", logger)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handleError(w, ErrInvalidJSON, ConvertValidationErrors(err).String(), logger)
+		return
+	}
+
+	pr, err := h.mergePullRequestService.MergePullRequest(ctx, *request)
+	if err != nil {
+		handleError(w, err, pullRequestErrorMessage(err, request.PullRequestID, request.ActorID), logger)
+		return
+	}
+
+	writePullRequest(w, pr, logger)
+}
@@ -0,0 +1,81 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+const maxPullRequestIDLength = 255
+
+var (
+	ErrPullRequestIDRequired = errors.New("pull_request_id query required")
+	ErrPullRequestIDTooLong  = fmt.Errorf("pull request id is too long max length is %d", maxPullRequestIDLength)
+)
+
+type (
+	mergeabilityService interface {
+		CheckMergeability(ctx context.Context, pullRequestID string) (domain.Mergeability, error)
+	}
+
+	MergeabilityHandler struct {
+		name                string
+		mergeabilityService mergeabilityService
+		logger              logger
+	}
+)
+
+func NewMergeabilityHandler(service mergeabilityService, name string, logger logger) *MergeabilityHandler {
+	return &MergeabilityHandler{
+		name:                name,
+		mergeabilityService: service,
+		logger:              logger,
+	}
+}
+
+func (h *MergeabilityHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.mergeability"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	pullRequestID := r.URL.Query().Get("pull_request_id")
+	if err := validatePullRequestID(pullRequestID); err != nil {
+		handleError(w, ErrInvalidQuery, err.Error(), logger)
+		return
+	}
+
+	result, err := h.mergeabilityService.CheckMergeability(ctx, pullRequestID)
+	if err != nil {
+		handleError(w, err, pullRequestErrorMessage(err, pullRequestID, ""), logger)
+		return
+	}
+
+	marshaledResult, err := json.Marshal(result)
+	if err != nil {
+		handleError(w, err, "failed to marshal mergeability", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, marshaledResult); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
+
+func validatePullRequestID(pullRequestID string) error {
+	if pullRequestID == "" {
+		return ErrPullRequestIDRequired
+	}
+	if len(pullRequestID) > maxPullRequestIDLength {
+		return ErrPullRequestIDTooLong
+	}
+	return nil
+}
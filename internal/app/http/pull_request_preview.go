@@ -0,0 +1,97 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	previewAssignmentService interface {
+		PreviewAssignment(ctx context.Context, pr domain.CreatePullRequest) (domain.Assignment, error)
+	}
+
+	previewAssignmentResponse struct {
+		TeamName           string                     `json:"team_name"`
+		PolicyVersion      int                        `json:"policy_version,omitempty"`
+		Strategy           domain.AssignmentStrategy  `json:"strategy"`
+		EnoughReviewers    bool                       `json:"enough_reviewers"`
+		AssignedReviewers  []domain.Reviewer          `json:"assigned_reviewers"`
+		ShadowReviewer     *domain.Reviewer           `json:"shadow_reviewer,omitempty"`
+		RejectedCandidates []domain.RejectedCandidate `json:"rejected_candidates"`
+		RejectedRequests   []domain.RejectedCandidate `json:"rejected_requested_reviewers"`
+	}
+
+	PreviewAssignmentHandler struct {
+		name                     string
+		previewAssignmentService previewAssignmentService
+		logger                   logger
+		validator                validator
+	}
+)
+
+func NewPreviewAssignmentHandler(service previewAssignmentService, name string, logger logger,
+	validator validator,
+) *PreviewAssignmentHandler {
+	return &PreviewAssignmentHandler{
+		name:                     name,
+		previewAssignmentService: service,
+		logger:                   logger,
+		validator:                validator,
+	}
+}
+
+func (h *PreviewAssignmentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.previewAssignment"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	request, err := decodeCreatePullRequest(r)
+	if err != nil {
+		handleError(w, ErrInvalidJSONSyntax, "invalid json syntax", logger)
+		return
+	}
+
+	if err = h.validator.Struct(request); err != nil {
+		handleError(w, ErrInvalidJSON, ConvertValidationErrors(err).String(), logger)
+		return
+	}
+	if err = request.ValidateKeys(); err != nil {
+		handleError(w, err, err.Error(), logger)
+		return
+	}
+
+	assignment, err := h.previewAssignmentService.PreviewAssignment(ctx, *request)
+	if err != nil {
+		handleError(w, err, createPullRequestErrorMessage(err, request), logger)
+		return
+	}
+
+	response := &previewAssignmentResponse{
+		TeamName:           assignment.TeamName,
+		PolicyVersion:      assignment.PolicyVersion,
+		Strategy:           assignment.Strategy,
+		EnoughReviewers:    len(assignment.Reviewers) >= assignment.MinReviewers,
+		AssignedReviewers:  assignment.Reviewers,
+		ShadowReviewer:     assignment.Shadow,
+		RejectedCandidates: assignment.Rejected,
+		RejectedRequests:   assignment.RejectedRequests,
+	}
+
+	marshaledPreview, err := json.Marshal(response)
+	if err != nil {
+		handleError(w, err, "failed to marshal assignment preview", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, marshaledPreview); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
@@ -0,0 +1,63 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	reRequestReviewService interface {
+		ReRequestReview(ctx context.Context, request domain.ReRequestReview) (domain.PullRequest, error)
+	}
+
+	ReRequestReviewHandler struct {
+		name                   string
+		reRequestReviewService reRequestReviewService
+		logger                 logger
+		validator              validator
+	}
+)
+
+func NewReRequestReviewHandler(service reRequestReviewService, name string, logger logger,
+	validator validator,
+) *ReRequestReviewHandler {
+	return &ReRequestReviewHandler{
+		name:                   name,
+		reRequestReviewService: service,
+		logger:                 logger,
+		validator:              validator,
+	}
+}
+
+func (h *ReRequestReviewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.reRequestReview"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	request := &domain.ReRequestReview{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		handleError(w, ErrInvalidJSONSyntax, "invalid json syntax", logger)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handleError(w, ErrInvalidJSON, ConvertValidationErrors(err).String(), logger)
+		return
+	}
+
+	pr, err := h.reRequestReviewService.ReRequestReview(ctx, *request)
+	if err != nil {
+		handleError(w, err, pullRequestErrorMessage(err, request.PullRequestID, request.UserID), logger)
+		return
+	}
+
+	writePullRequest(w, pr, logger)
+}
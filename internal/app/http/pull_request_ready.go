@@ -0,0 +1,63 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	readyForReviewService interface {
+		ReadyForReview(ctx context.Context, request domain.ReadyForReview) (domain.PullRequest, error)
+	}
+
+	ReadyForReviewHandler struct {
+		name                  string
+		readyForReviewService readyForReviewService
+		logger                logger
+		validator             validator
+	}
+)
+
+func NewReadyForReviewHandler(service readyForReviewService, name string, logger logger,
+	validator validator,
+) *ReadyForReviewHandler {
+	return &ReadyForReviewHandler{
+		name:                  name,
+		readyForReviewService: service,
+		logger:                logger,
+		validator:             validator,
+	}
+}
+
+func (h *ReadyForReviewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.readyForReview"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	request := &domain.ReadyForReview{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		handleError(w, ErrInvalidJSONSyntax, "invalid json syntax", logger)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handleError(w, ErrInvalidJSON, ConvertValidationErrors(err).String(), logger)
+		return
+	}
+
+	pr, err := h.readyForReviewService.ReadyForReview(ctx, *request)
+	if err != nil {
+		handleError(w, err, pullRequestErrorMessage(err, request.PullRequestID, request.ActorID), logger)
+		return
+	}
+
+	writePullRequest(w, pr, logger)
+}
@@ -0,0 +1,113 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	submitReviewService interface {
+		SubmitReview(ctx context.Context, review domain.SubmitReview) (domain.PullRequest, error)
+	}
+
+	pullRequestResponse struct {
+		PullRequest domain.PullRequest `json:"pr"`
+	}
+
+	SubmitReviewHandler struct {
+		name                string
+		submitReviewService submitReviewService
+		logger              logger
+		validator           validator
+	}
+)
+
+func NewSubmitReviewHandler(service submitReviewService, name string, logger logger,
+	validator validator,
+) *SubmitReviewHandler {
+	return &SubmitReviewHandler{
+		name:                name,
+		submitReviewService: service,
+		logger:              logger,
+		validator:           validator,
+	}
+}
+
+func (h *SubmitReviewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.review"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	request := &domain.SubmitReview{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		handleError(w, ErrInvalidJSONSyntax, "invalid json syntax", logger)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handleError(w, ErrInvalidJSON, ConvertValidationErrors(err).String(), logger)
+		return
+	}
+
+	pr, err := h.submitReviewService.SubmitReview(ctx, *request)
+	if err != nil {
+		handleError(w, err, pullRequestErrorMessage(err, request.PullRequestID, request.UserID), logger)
+		return
+	}
+
+	writePullRequest(w, pr, logger)
+}
+
+func writePullRequest(w http.ResponseWriter, pr domain.PullRequest, logger logger) {
+	marshaledPR, err := json.Marshal(&pullRequestResponse{PullRequest: pr})
+	if err != nil {
+		handleError(w, err, "failed to marshal pull request", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, marshaledPR); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
+
+func pullRequestErrorMessage(err error, pullRequestID, userID string) string {
+	var (
+		blockedErr    *domain.MergeBlockedError
+		transitionErr *domain.InvalidTransitionError
+	)
+
+	switch {
+	case errors.As(err, &blockedErr):
+		return blockedErr.Error()
+	case errors.As(err, &transitionErr):
+		return transitionErr.Error()
+	case errors.Is(err, domain.ErrPRNotFound):
+		return "resource not found"
+	case errors.Is(err, domain.ErrPRMerged):
+		return fmt.Sprintf("%s is already merged", pullRequestID)
+	case errors.Is(err, domain.ErrPRNotOpen):
+		return fmt.Sprintf("%s is not open", pullRequestID)
+	case errors.Is(err, domain.ErrNotAssigned):
+		return fmt.Sprintf("%s is not a current reviewer of %s", userID, pullRequestID)
+	case errors.Is(err, domain.ErrNotEnoughReviewers):
+		return "not enough reviewer candidates to satisfy team policy"
+	case errors.Is(err, domain.ErrMandatoryReviewerUnavailable):
+		return "mandatory reviewer is unavailable and has no available deputy"
+	case errors.Is(err, domain.ErrDependencyNotFound):
+		return "dependency not found"
+	case errors.Is(err, domain.ErrDependencyCycle):
+		return fmt.Sprintf("dependencies of %s would form a cycle", pullRequestID)
+	default:
+		return err.Error()
+	}
+}
@@ -16,6 +16,10 @@ type (
 	ErrorResponse struct {
 		Error APIError `json:"error"`
 	}
+	ValidationErrorsResponse struct {
+		Error  APIError              `json:"error"`
+		Errors []ValidationErrorItem `json:"errors"`
+	}
 )
 
 func GetSuccessResponseWithBody(w http.ResponseWriter, body []byte) error {
@@ -42,3 +46,19 @@ func GetErrorResponse(w http.ResponseWriter, statusCode int, code domain.ErrorCo
 	}
 	return nil
 }
+
+func GetValidationErrorResponse(w http.ResponseWriter, message string, validation ValidationErrorResponse) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	errEnc := json.NewEncoder(w).Encode(ValidationErrorsResponse{
+		Error: APIError{
+			Code:    domain.ErrCodeInvalidRequest,
+			Message: message,
+		},
+		Errors: validation.Errors,
+	})
+	if errEnc != nil {
+		return fmt.Errorf("json.NewEncoder(w).Encode: %w", errEnc)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+var ErrInvalidStatsGroupBy = errors.New("group_by must be team")
+
+type (
+	assignmentStatsService interface {
+		GetAssignmentStats(ctx context.Context, filter domain.StatsFilter, groupBy domain.StatsGroupBy,
+		) (domain.AssignmentStats, error)
+	}
+
+	AssignmentStatsHandler struct {
+		name                   string
+		assignmentStatsService assignmentStatsService
+		logger                 logger
+	}
+)
+
+func NewAssignmentStatsHandler(service assignmentStatsService, name string, logger logger,
+) *AssignmentStatsHandler {
+	return &AssignmentStatsHandler{
+		name:                   name,
+		assignmentStatsService: service,
+		logger:                 logger,
+	}
+}
+
+func (h *AssignmentStatsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "stats.assignments"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	query := r.URL.Query()
+
+	filter, err := parseStatsFilter(query, time.Now())
+	if err != nil {
+		handleError(w, ErrInvalidQuery, err.Error(), logger)
+		return
+	}
+
+	groupBy := domain.StatsGroupBy(query.Get("group_by"))
+	if groupBy != domain.StatsGroupByNone && groupBy != domain.StatsGroupByTeam {
+		handleError(w, ErrInvalidQuery, ErrInvalidStatsGroupBy.Error(), logger)
+		return
+	}
+
+	stats, err := h.assignmentStatsService.GetAssignmentStats(ctx, filter, groupBy)
+	if err != nil {
+		handleError(w, err, err.Error(), logger)
+		return
+	}
+
+	marshaledStats, err := json.Marshal(stats)
+	if err != nil {
+		handleError(w, err, "failed to marshal stats", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, marshaledStats); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
@@ -0,0 +1,116 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+const (
+	defaultStatsRange = 30 * 24 * time.Hour
+	statsDateLayout   = "2006-01-02"
+)
+
+var (
+	ErrInvalidStatsTime  = errors.New("from and to must be RFC 3339 timestamps or YYYY-MM-DD dates")
+	ErrInvalidStatsRange = errors.New("from must be before to")
+)
+
+type (
+	declineStatsService interface {
+		GetDeclineStats(ctx context.Context, filter domain.StatsFilter) (domain.DeclineStats, error)
+	}
+
+	DeclineStatsHandler struct {
+		name                string
+		declineStatsService declineStatsService
+		logger              logger
+	}
+)
+
+func NewDeclineStatsHandler(service declineStatsService, name string, logger logger) *DeclineStatsHandler {
+	return &DeclineStatsHandler{
+		name:                name,
+		declineStatsService: service,
+		logger:              logger,
+	}
+}
+
+func (h *DeclineStatsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "stats.declines"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	filter, err := parseStatsFilter(r.URL.Query(), time.Now())
+	if err != nil {
+		handleError(w, ErrInvalidQuery, err.Error(), logger)
+		return
+	}
+
+	stats, err := h.declineStatsService.GetDeclineStats(ctx, filter)
+	if err != nil {
+		handleError(w, err, err.Error(), logger)
+		return
+	}
+
+	marshaledStats, err := json.Marshal(stats)
+	if err != nil {
+		handleError(w, err, "failed to marshal stats", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, marshaledStats); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
+
+// parseStatsFilter reads the optional team_name, from and to query parameters. The range defaults
+// to the last 30 days, a date without time stands for its midnight in UTC.
+func parseStatsFilter(query url.Values, now time.Time) (domain.StatsFilter, error) {
+	filter := domain.StatsFilter{
+		TeamName: query.Get("team_name"),
+		From:     now.Add(-defaultStatsRange),
+		To:       now,
+	}
+
+	if filter.TeamName != "" {
+		if err := validateTeamName(filter.TeamName); err != nil {
+			return domain.StatsFilter{}, err
+		}
+	}
+
+	for param, value := range map[string]*time.Time{"from": &filter.From, "to": &filter.To} {
+		raw := query.Get(param)
+		if raw == "" {
+			continue
+		}
+		t, err := parseStatsTime(raw)
+		if err != nil {
+			return domain.StatsFilter{}, ErrInvalidStatsTime
+		}
+		*value = t
+	}
+
+	if !filter.From.Before(filter.To) {
+		return domain.StatsFilter{}, ErrInvalidStatsRange
+	}
+
+	return filter, nil
+}
+
+func parseStatsTime(raw string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t, nil
+	}
+	return time.Parse(statsDateLayout, raw)
+}
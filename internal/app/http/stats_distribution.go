@@ -0,0 +1,80 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+var ErrInvalidStatsThreshold = errors.New("stddev_threshold must be a positive number")
+
+type (
+	loadDistributionService interface {
+		GetLoadDistribution(ctx context.Context, filter domain.StatsFilter, threshold float64,
+		) (domain.LoadDistribution, error)
+	}
+
+	LoadDistributionHandler struct {
+		name                    string
+		loadDistributionService loadDistributionService
+		logger                  logger
+	}
+)
+
+func NewLoadDistributionHandler(service loadDistributionService, name string, logger logger,
+) *LoadDistributionHandler {
+	return &LoadDistributionHandler{
+		name:                    name,
+		loadDistributionService: service,
+		logger:                  logger,
+	}
+}
+
+func (h *LoadDistributionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "stats.distribution"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	query := r.URL.Query()
+
+	filter, err := parseStatsFilter(query, time.Now())
+	if err != nil {
+		handleError(w, ErrInvalidQuery, err.Error(), logger)
+		return
+	}
+
+	threshold := domain.DefaultOverloadThreshold
+	if value := query.Get("stddev_threshold"); value != "" {
+		threshold, err = strconv.ParseFloat(value, 64)
+		if err != nil || threshold <= 0 {
+			handleError(w, ErrInvalidQuery, ErrInvalidStatsThreshold.Error(), logger)
+			return
+		}
+	}
+
+	distribution, err := h.loadDistributionService.GetLoadDistribution(ctx, filter, threshold)
+	if err != nil {
+		handleError(w, err, err.Error(), logger)
+		return
+	}
+
+	marshaledDistribution, err := json.Marshal(distribution)
+	if err != nil {
+		handleError(w, err, "failed to marshal stats", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, marshaledDistribution); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
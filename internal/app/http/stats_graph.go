@@ -0,0 +1,88 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+const graphFormatDOT = "dot"
+
+var ErrInvalidGraphFormat = errors.New("format must be json or dot")
+
+type (
+	reviewGraphService interface {
+		GetReviewGraph(ctx context.Context, filter domain.StatsFilter) (domain.ReviewGraph, error)
+	}
+
+	ReviewGraphHandler struct {
+		name               string
+		reviewGraphService reviewGraphService
+		logger             logger
+	}
+)
+
+func NewReviewGraphHandler(service reviewGraphService, name string, logger logger) *ReviewGraphHandler {
+	return &ReviewGraphHandler{
+		name:               name,
+		reviewGraphService: service,
+		logger:             logger,
+	}
+}
+
+func (h *ReviewGraphHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "stats.graph"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	query := r.URL.Query()
+
+	filter, err := parseStatsFilter(query, time.Now())
+	if err != nil {
+		handleError(w, ErrInvalidQuery, err.Error(), logger)
+		return
+	}
+
+	format := query.Get("format")
+	if format == "" {
+		format = reportFormatJSON
+	}
+	if format != reportFormatJSON && format != graphFormatDOT {
+		handleError(w, ErrInvalidQuery, ErrInvalidGraphFormat.Error(), logger)
+		return
+	}
+
+	graph, err := h.reviewGraphService.GetReviewGraph(ctx, filter)
+	if err != nil {
+		handleError(w, err, err.Error(), logger)
+		return
+	}
+
+	if format == graphFormatDOT {
+		w.Header().Set("Content-Type", "text/vnd.graphviz; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		if _, err = w.Write([]byte(graph.DOT())); err != nil {
+			logger.Error("w.Write", zap.Error(err))
+		}
+		return
+	}
+
+	marshaledGraph, err := json.Marshal(graph)
+	if err != nil {
+		handleError(w, err, "failed to marshal graph", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, marshaledGraph); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
@@ -0,0 +1,89 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+var ErrInvalidKnowledgeWeeks = fmt.Errorf("weeks must be an integer between 1 and %d", domain.MaxKnowledgeWeeks)
+
+type (
+	knowledgeReportService interface {
+		GetKnowledgeReport(ctx context.Context, filter domain.KnowledgeFilter, weeks int,
+		) (domain.KnowledgeReport, error)
+	}
+
+	KnowledgeReportHandler struct {
+		name                   string
+		knowledgeReportService knowledgeReportService
+		logger                 logger
+	}
+)
+
+func NewKnowledgeReportHandler(service knowledgeReportService, name string, logger logger,
+) *KnowledgeReportHandler {
+	return &KnowledgeReportHandler{
+		name:                   name,
+		knowledgeReportService: service,
+		logger:                 logger,
+	}
+}
+
+func (h *KnowledgeReportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "stats.knowledge"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	query := r.URL.Query()
+
+	filter := domain.KnowledgeFilter{
+		TeamName:   query.Get("team_name"),
+		Repository: query.Get("repository"),
+	}
+	if filter.TeamName != "" {
+		if err := validateTeamName(filter.TeamName); err != nil {
+			handleError(w, ErrInvalidQuery, err.Error(), logger)
+			return
+		}
+	}
+	if len(filter.Repository) > maxRepositoryLength {
+		handleError(w, ErrInvalidQuery, ErrRepositoryTooLong.Error(), logger)
+		return
+	}
+
+	weeks := domain.DefaultKnowledgeWeeks
+	if value := query.Get("weeks"); value != "" {
+		var err error
+		weeks, err = strconv.Atoi(value)
+		if err != nil || weeks < 1 || weeks > domain.MaxKnowledgeWeeks {
+			handleError(w, ErrInvalidQuery, ErrInvalidKnowledgeWeeks.Error(), logger)
+			return
+		}
+	}
+
+	report, err := h.knowledgeReportService.GetKnowledgeReport(ctx, filter, weeks)
+	if err != nil {
+		handleError(w, err, err.Error(), logger)
+		return
+	}
+
+	marshaledReport, err := json.Marshal(report)
+	if err != nil {
+		handleError(w, err, "failed to marshal report", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, marshaledReport); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
@@ -0,0 +1,149 @@
+package http
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+const (
+	reportFormatJSON = "json"
+	reportFormatCSV  = "csv"
+)
+
+var ErrInvalidReportFormat = errors.New("format must be json or csv")
+
+var latencyCSVHeader = []string{
+	"team_name", "repository", "week", "pull_requests",
+	"first_verdict_count", "first_verdict_p50_hours", "first_verdict_p90_hours", "first_verdict_p99_hours",
+	"approval_count", "approval_p50_hours", "approval_p90_hours", "approval_p99_hours",
+	"merge_count", "merge_p50_hours", "merge_p90_hours", "merge_p99_hours",
+}
+
+type (
+	latencyReportService interface {
+		GetLatencyReport(ctx context.Context, filter domain.ReportFilter) (domain.LatencyReport, error)
+	}
+
+	LatencyReportHandler struct {
+		name                 string
+		latencyReportService latencyReportService
+		logger               logger
+	}
+)
+
+func NewLatencyReportHandler(service latencyReportService, name string, logger logger) *LatencyReportHandler {
+	return &LatencyReportHandler{
+		name:                 name,
+		latencyReportService: service,
+		logger:               logger,
+	}
+}
+
+func (h *LatencyReportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "stats.latency"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	query := r.URL.Query()
+
+	statsFilter, err := parseStatsFilter(query, time.Now())
+	if err != nil {
+		handleError(w, ErrInvalidQuery, err.Error(), logger)
+		return
+	}
+	filter := domain.ReportFilter{
+		StatsFilter: statsFilter,
+		Repository:  query.Get("repository"),
+	}
+	if len(filter.Repository) > maxRepositoryLength {
+		handleError(w, ErrInvalidQuery, ErrRepositoryTooLong.Error(), logger)
+		return
+	}
+
+	format := query.Get("format")
+	if format == "" {
+		format = reportFormatJSON
+	}
+	if format != reportFormatJSON && format != reportFormatCSV {
+		handleError(w, ErrInvalidQuery, ErrInvalidReportFormat.Error(), logger)
+		return
+	}
+
+	report, err := h.latencyReportService.GetLatencyReport(ctx, filter)
+	if err != nil {
+		handleError(w, err, err.Error(), logger)
+		return
+	}
+
+	if format == reportFormatCSV {
+		if err = writeCSV(w, "review-latency.csv", latencyCSVHeader, latencyCSVRecords(report)); err != nil {
+			logger.Error("writeCSV", zap.Error(err))
+		}
+		return
+	}
+
+	marshaledReport, err := json.Marshal(report)
+	if err != nil {
+		handleError(w, err, "failed to marshal report", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, marshaledReport); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
+
+func latencyCSVRecords(report domain.LatencyReport) [][]string {
+	records := make([][]string, 0, len(report.Rows))
+	for _, row := range report.Rows {
+		record := []string{
+			row.TeamName,
+			row.Repository,
+			row.Week.Format(statsDateLayout),
+			strconv.Itoa(row.PullRequests),
+		}
+		for _, percentiles := range []domain.LatencyPercentiles{row.FirstVerdict, row.Approval, row.Merge} {
+			record = append(record, strconv.Itoa(percentiles.Count),
+				formatHours(percentiles.P50), formatHours(percentiles.P90), formatHours(percentiles.P99))
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// formatHours leaves the cell empty for a missing percentile so spreadsheets do not read it as 0.
+func formatHours(hours *float64) string {
+	if hours == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*hours, 'f', -1, 64)
+}
+
+// writeCSV sends the records as an attachment with the given file name.
+func writeCSV(w http.ResponseWriter, filename string, header []string, records [][]string) error {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	w.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("writer.Write: %w", err)
+	}
+	if err := writer.WriteAll(records); err != nil {
+		return fmt.Errorf("writer.WriteAll: %w", err)
+	}
+	return nil
+}
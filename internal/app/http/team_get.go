@@ -54,7 +54,7 @@ func (h *GetTeamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	)
 
 	teamName := r.URL.Query().Get("team_name")
-	if err := h.validateTeamName(teamName); err != nil {
+	if err := validateTeamName(teamName); err != nil {
 		handleError(w, ErrInvalidQuery, err.Error(), h.logger)
 		return
 	}
@@ -80,7 +80,7 @@ func (h *GetTeamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (h *GetTeamHandler) validateTeamName(teamName string) error {
+func validateTeamName(teamName string) error {
 	if teamName == "" {
 		return ErrTeamNameRequired
 	}
@@ -0,0 +1,80 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+var ErrInvalidPolicyVersion = errors.New("version must be a positive integer")
+
+type (
+	getTeamPolicyService interface {
+		GetTeamPolicy(ctx context.Context, teamName string, version int) (domain.TeamPolicy, error)
+	}
+
+	GetTeamPolicyHandler struct {
+		name                 string
+		getTeamPolicyService getTeamPolicyService
+		logger               logger
+	}
+)
+
+func NewGetTeamPolicyHandler(service getTeamPolicyService, name string, logger logger) *GetTeamPolicyHandler {
+	return &GetTeamPolicyHandler{
+		name:                 name,
+		getTeamPolicyService: service,
+		logger:               logger,
+	}
+}
+
+// ServeHTTP returns the active policy of the team or the version given in the "version" query parameter.
+func (h *GetTeamPolicyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "team.policy.get"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	teamName := r.URL.Query().Get("team_name")
+	if err := validateTeamName(teamName); err != nil {
+		handleError(w, ErrInvalidQuery, err.Error(), logger)
+		return
+	}
+
+	var version int
+	if rawVersion := r.URL.Query().Get("version"); rawVersion != "" {
+		var err error
+		if version, err = strconv.Atoi(rawVersion); err != nil || version < 1 {
+			handleError(w, ErrInvalidQuery, ErrInvalidPolicyVersion.Error(), logger)
+			return
+		}
+	}
+
+	policy, err := h.getTeamPolicyService.GetTeamPolicy(ctx, teamName, version)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, domain.ErrPolicyNotFound) {
+			msg = "resource not found"
+		}
+		handleError(w, err, msg, logger)
+		return
+	}
+
+	marshaledPolicy, err := json.Marshal(&teamPolicyResponse{Policy: policy})
+	if err != nil {
+		handleError(w, err, "failed to marshal policy", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, marshaledPolicy); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
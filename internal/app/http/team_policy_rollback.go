@@ -0,0 +1,81 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	rollbackTeamPolicyService interface {
+		RollbackTeamPolicy(ctx context.Context, teamName string, version int) (domain.TeamPolicy, error)
+	}
+
+	rollbackTeamPolicyRequest struct {
+		TeamName string `json:"team_name" validate:"required,gte=3,lte=255"`
+		Version  int    `json:"version" validate:"required,gte=1"`
+	}
+
+	RollbackTeamPolicyHandler struct {
+		name                      string
+		rollbackTeamPolicyService rollbackTeamPolicyService
+		logger                    logger
+		validator                 validator
+	}
+)
+
+func NewRollbackTeamPolicyHandler(service rollbackTeamPolicyService, name string, logger logger,
+	validator validator,
+) *RollbackTeamPolicyHandler {
+	return &RollbackTeamPolicyHandler{
+		name:                      name,
+		rollbackTeamPolicyService: service,
+		logger:                    logger,
+		validator:                 validator,
+	}
+}
+
+func (h *RollbackTeamPolicyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "team.policy.rollback"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	request := &rollbackTeamPolicyRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		handleError(w, ErrInvalidJSONSyntax, "invalid json syntax", logger)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handleError(w, ErrInvalidJSON, ConvertValidationErrors(err).String(), logger)
+		return
+	}
+
+	policy, err := h.rollbackTeamPolicyService.RollbackTeamPolicy(ctx, request.TeamName, request.Version)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, domain.ErrPolicyNotFound) {
+			msg = "resource not found"
+		}
+		handleError(w, err, msg, logger)
+		return
+	}
+
+	marshaledPolicy, err := json.Marshal(&teamPolicyResponse{Policy: policy})
+	if err != nil {
+		handleError(w, err, "failed to marshal policy", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, marshaledPolicy); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
@@ -0,0 +1,124 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+const maxPolicyDocumentSize = 64 << 10
+
+var ErrUnsupportedPolicyFormat = errors.New("unsupported policy format, use application/json or application/yaml")
+
+type (
+	uploadTeamPolicyService interface {
+		UploadTeamPolicy(ctx context.Context, teamName string, format domain.PolicyFormat, raw []byte,
+		) (domain.TeamPolicy, error)
+	}
+
+	teamPolicyResponse struct {
+		Policy domain.TeamPolicy `json:"policy"`
+	}
+
+	UploadTeamPolicyHandler struct {
+		name                    string
+		uploadTeamPolicyService uploadTeamPolicyService
+		logger                  logger
+	}
+)
+
+func NewUploadTeamPolicyHandler(service uploadTeamPolicyService, name string, logger logger,
+) *UploadTeamPolicyHandler {
+	return &UploadTeamPolicyHandler{
+		name:                    name,
+		uploadTeamPolicyService: service,
+		logger:                  logger,
+	}
+}
+
+// ServeHTTP accepts the raw policy document as the request body. The format is taken from
+// the "format" query parameter or, when it is absent, from the Content-Type header.
+func (h *UploadTeamPolicyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "team.policy.upload"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	teamName := r.URL.Query().Get("team_name")
+	if err := validateTeamName(teamName); err != nil {
+		handleError(w, ErrInvalidQuery, err.Error(), logger)
+		return
+	}
+
+	format, err := policyFormat(r)
+	if err != nil {
+		handleError(w, ErrInvalidQuery, err.Error(), logger)
+		return
+	}
+
+	raw, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPolicyDocumentSize))
+	if err != nil {
+		handleError(w, ErrInvalidJSON, "failed to read policy document", logger)
+		return
+	}
+
+	policy, err := h.uploadTeamPolicyService.UploadTeamPolicy(ctx, teamName, format, raw)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidPolicy) {
+			if err = GetValidationErrorResponse(w, "invalid policy", ConvertPolicyErrors(err)); err != nil {
+				logger.Error("GetValidationErrorResponse", zap.Error(err))
+			}
+			return
+		}
+		msg := err.Error()
+		if errors.Is(err, domain.ErrTeamNotFound) {
+			msg = "resource not found"
+		}
+		handleError(w, err, msg, logger)
+		return
+	}
+
+	marshaledPolicy, err := json.Marshal(&teamPolicyResponse{Policy: policy})
+	if err != nil {
+		handleError(w, err, "failed to marshal policy", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, marshaledPolicy); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
+
+func policyFormat(r *http.Request) (domain.PolicyFormat, error) {
+	if format := r.URL.Query().Get("format"); format != "" {
+		switch domain.PolicyFormat(format) {
+		case domain.PolicyFormatJSON, domain.PolicyFormatYAML:
+			return domain.PolicyFormat(format), nil
+		default:
+			return "", ErrUnsupportedPolicyFormat
+		}
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return "", ErrUnsupportedPolicyFormat
+	}
+
+	switch mediaType {
+	case "application/json":
+		return domain.PolicyFormatJSON, nil
+	case "application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml":
+		return domain.PolicyFormatYAML, nil
+	default:
+		return "", ErrUnsupportedPolicyFormat
+	}
+}
@@ -0,0 +1,76 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	listTeamPoliciesService interface {
+		ListTeamPolicies(ctx context.Context, teamName string) ([]domain.TeamPolicy, error)
+	}
+
+	listTeamPoliciesResponse struct {
+		TeamName string              `json:"team_name"`
+		Versions []domain.TeamPolicy `json:"versions"`
+	}
+
+	ListTeamPoliciesHandler struct {
+		name                    string
+		listTeamPoliciesService listTeamPoliciesService
+		logger                  logger
+	}
+)
+
+func NewListTeamPoliciesHandler(service listTeamPoliciesService, name string, logger logger,
+) *ListTeamPoliciesHandler {
+	return &ListTeamPoliciesHandler{
+		name:                    name,
+		listTeamPoliciesService: service,
+		logger:                  logger,
+	}
+}
+
+func (h *ListTeamPoliciesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "team.policy.versions"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	teamName := r.URL.Query().Get("team_name")
+	if err := validateTeamName(teamName); err != nil {
+		handleError(w, ErrInvalidQuery, err.Error(), logger)
+		return
+	}
+
+	policies, err := h.listTeamPoliciesService.ListTeamPolicies(ctx, teamName)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, domain.ErrPolicyNotFound) {
+			msg = "resource not found"
+		}
+		handleError(w, err, msg, logger)
+		return
+	}
+
+	marshaledPolicies, err := json.Marshal(&listTeamPoliciesResponse{
+		TeamName: teamName,
+		Versions: policies,
+	})
+	if err != nil {
+		handleError(w, err, "failed to marshal policies", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, marshaledPolicies); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
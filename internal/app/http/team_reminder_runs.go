@@ -0,0 +1,94 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+const (
+	defaultReminderRunsLimit = 20
+	maxReminderRunsLimit     = 100
+)
+
+var ErrInvalidLimit = fmt.Errorf("limit must be an integer between 1 and %d", maxReminderRunsLimit)
+
+type (
+	listReminderRunsService interface {
+		ListReminderRuns(ctx context.Context, teamName string, limit int) ([]domain.ReminderRun, error)
+	}
+
+	listReminderRunsResponse struct {
+		TeamName string               `json:"team_name"`
+		Runs     []domain.ReminderRun `json:"runs"`
+	}
+
+	ListReminderRunsHandler struct {
+		name                    string
+		listReminderRunsService listReminderRunsService
+		logger                  logger
+	}
+)
+
+func NewListReminderRunsHandler(service listReminderRunsService, name string, logger logger,
+) *ListReminderRunsHandler {
+	return &ListReminderRunsHandler{
+		name:                    name,
+		listReminderRunsService: service,
+		logger:                  logger,
+	}
+}
+
+func (h *ListReminderRunsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "team.reminderRuns"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	teamName := r.URL.Query().Get("team_name")
+	if err := validateTeamName(teamName); err != nil {
+		handleError(w, ErrInvalidQuery, err.Error(), logger)
+		return
+	}
+
+	limit := defaultReminderRunsLimit
+	if rawLimit := r.URL.Query().Get("limit"); rawLimit != "" {
+		var err error
+		if limit, err = strconv.Atoi(rawLimit); err != nil || limit < 1 || limit > maxReminderRunsLimit {
+			handleError(w, ErrInvalidQuery, ErrInvalidLimit.Error(), logger)
+			return
+		}
+	}
+
+	runs, err := h.listReminderRunsService.ListReminderRuns(ctx, teamName, limit)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, domain.ErrTeamNotFound) {
+			msg = "resource not found"
+		}
+		handleError(w, err, msg, logger)
+		return
+	}
+
+	marshaledRuns, err := json.Marshal(&listReminderRunsResponse{
+		TeamName: teamName,
+		Runs:     runs,
+	})
+	if err != nil {
+		handleError(w, err, "failed to marshal reminder runs", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, marshaledRuns); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
@@ -0,0 +1,76 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	addUserAbsenceService interface {
+		AddUserAbsence(ctx context.Context, absence domain.UserAbsence) (domain.UserAbsence, error)
+	}
+
+	AddUserAbsenceHandler struct {
+		name                  string
+		addUserAbsenceService addUserAbsenceService
+		logger                logger
+		validator             validator
+	}
+)
+
+func NewAddUserAbsenceHandler(service addUserAbsenceService, name string, logger logger,
+	validator validator,
+) *AddUserAbsenceHandler {
+	return &AddUserAbsenceHandler{
+		name:                  name,
+		addUserAbsenceService: service,
+		logger:                logger,
+		validator:             validator,
+	}
+}
+
+func (h *AddUserAbsenceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "users.addAbsence"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	request := &domain.UserAbsence{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		handleError(w, ErrInvalidJSONSyntax, "invalid json syntax", logger)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handleError(w, ErrInvalidJSON, ConvertValidationErrors(err).String(), logger)
+		return
+	}
+
+	absence, err := h.addUserAbsenceService.AddUserAbsence(ctx, *request)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, domain.ErrUserNotFound) {
+			msg = "resource not found"
+		}
+		handleError(w, err, msg, logger)
+		return
+	}
+
+	absenceJSON, err := json.Marshal(absence)
+	if err != nil {
+		handleError(w, err, "failed to marshal absence", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, absenceJSON); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
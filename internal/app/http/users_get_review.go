@@ -0,0 +1,85 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+const maxUserIDLength = 255
+
+var (
+	ErrUserIDRequired = errors.New("user_id query required")
+	ErrUserIDTooLong  = fmt.Errorf("user id is too long max length is %d", maxUserIDLength)
+)
+
+type (
+	getUserReviewsService interface {
+		GetUserReviews(ctx context.Context, userID string) (domain.UserReviews, error)
+	}
+
+	GetUserReviewsHandler struct {
+		name                  string
+		getUserReviewsService getUserReviewsService
+		logger                logger
+	}
+)
+
+func NewGetUserReviewsHandler(service getUserReviewsService, name string, logger logger) *GetUserReviewsHandler {
+	return &GetUserReviewsHandler{
+		name:                  name,
+		getUserReviewsService: service,
+		logger:                logger,
+	}
+}
+
+func (h *GetUserReviewsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "users.getReview"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	userID := r.URL.Query().Get("user_id")
+	if err := validateUserID(userID); err != nil {
+		handleError(w, ErrInvalidQuery, err.Error(), logger)
+		return
+	}
+
+	reviews, err := h.getUserReviewsService.GetUserReviews(ctx, userID)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, domain.ErrUserNotFound) {
+			msg = "resource not found"
+		}
+		handleError(w, err, msg, logger)
+		return
+	}
+
+	reviewsJSON, err := json.Marshal(reviews)
+	if err != nil {
+		handleError(w, err, "failed to marshal reviews", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, reviewsJSON); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
+
+func validateUserID(userID string) error {
+	if userID == "" {
+		return ErrUserIDRequired
+	}
+	if len(userID) > maxUserIDLength {
+		return ErrUserIDTooLong
+	}
+	return nil
+}
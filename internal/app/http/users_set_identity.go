@@ -0,0 +1,76 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	setUserIdentityService interface {
+		SetUserIdentity(ctx context.Context, identity domain.UserIdentity) (domain.UserIdentity, error)
+	}
+
+	SetUserIdentityHandler struct {
+		name                   string
+		setUserIdentityService setUserIdentityService
+		logger                 logger
+		validator              validator
+	}
+)
+
+func NewSetUserIdentityHandler(service setUserIdentityService, name string, logger logger,
+	validator validator,
+) *SetUserIdentityHandler {
+	return &SetUserIdentityHandler{
+		name:                   name,
+		setUserIdentityService: service,
+		logger:                 logger,
+		validator:              validator,
+	}
+}
+
+func (h *SetUserIdentityHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", "users.setIdentity"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	request := &domain.UserIdentity{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		handleError(w, ErrInvalidJSONSyntax, "invalid json syntax", logger)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handleError(w, ErrInvalidJSON, ConvertValidationErrors(err).String(), logger)
+		return
+	}
+
+	identity, err := h.setUserIdentityService.SetUserIdentity(ctx, *request)
+	if err != nil {
+		msg := err.Error()
+		if errors.Is(err, domain.ErrUserNotFound) {
+			msg = "resource not found"
+		}
+		handleError(w, err, msg, logger)
+		return
+	}
+
+	identityJSON, err := json.Marshal(identity)
+	if err != nil {
+		handleError(w, err, "failed to marshal identity", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, identityJSON); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
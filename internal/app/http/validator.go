@@ -3,8 +3,11 @@ package http
 import (
 	"errors"
 	"strings"
+	"unicode"
 
 	validatorV10 "github.com/go-playground/validator/v10"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
 )
 
 type (
@@ -48,6 +51,23 @@ func ConvertValidationErrors(err error) ValidationErrorResponse {
 	return response
 }
 
+// ConvertPolicyErrors collects both decoding and rule violations of a policy document.
+func ConvertPolicyErrors(err error) ValidationErrorResponse {
+	response := ConvertValidationErrors(err)
+
+	var policyErr *domain.PolicyValidationError
+	if errors.As(err, &policyErr) {
+		for _, e := range policyErr.Errors {
+			response.Errors = append(response.Errors, ValidationErrorItem{
+				Field:   e.Field,
+				Message: e.Message,
+			})
+		}
+	}
+
+	return response
+}
+
 func messageForTag(tag, param string) string {
 	switch tag {
 	case "required":
@@ -56,6 +76,38 @@ func messageForTag(tag, param string) string {
 		return "value is too short, min=" + param
 	case "max", "lt", "lte":
 		return "value is too long, max=" + param
+	case "ltefield":
+		return "value must not exceed " + fieldName(param)
+	case "gtfield":
+		return "value must be greater than " + fieldName(param)
+	case "datetime":
+		return "value must match the layout " + param
+	case "timezone":
+		return "value must be an IANA timezone"
+	case "nefield":
+		return "value must differ from " + fieldName(param)
+	case "required_without":
+		return "field is required when " + fieldName(param) + " is not set"
+	case "required_if":
+		field, value, _ := strings.Cut(param, " ")
+		return "field is required when " + fieldName(field) + " is " + value
+	case "excluded_if":
+		field, value, _ := strings.Cut(param, " ")
+		return "field must not be set when " + fieldName(field) + " is " + value
+	case "excluded_with":
+		return "field must not be set together with " + fieldName(param)
+	case "excluded_without":
+		return "field must not be set without " + fieldName(param)
+	case "oneof":
+		return "value must be one of: " + param
+	case "unique":
+		return "values must be unique"
+	case "url":
+		return "value must be a URL"
+	case "hexadecimal":
+		return "value must be hexadecimal"
+	case "excludesall":
+		return "value must not contain any of: " + param
 	case "email":
 		return "invalid email format"
 	default:
@@ -70,3 +122,19 @@ func toSnakeCase(ns string) string {
 	}
 	return strings.ToLower(ns)
 }
+
+// fieldName converts a struct field name used as a validation parameter to snake case.
+func fieldName(param string) string {
+	var sb strings.Builder
+
+	for i, r := range param {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				sb.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
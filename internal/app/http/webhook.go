@@ -0,0 +1,77 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// maxWebhookPayload is the largest payload accepted, GitHub and GitLab cap their payloads at 25 MB.
+const maxWebhookPayload = 25 << 20
+
+type (
+	webhookService interface {
+		HandleDelivery(ctx context.Context, delivery domain.WebhookDelivery, payload []byte,
+		) (domain.WebhookResult, error)
+	}
+
+	// WebhookHandler receives the webhook deliveries of a code host. The code host is described by
+	// how its deliveries are verified and identified, see NewGitHubWebhookHandler and
+	// NewGitLabWebhookHandler.
+	WebhookHandler struct {
+		name           string
+		service        string
+		webhookService webhookService
+		verify         func(r *http.Request, body []byte) error
+		delivery       func(r *http.Request) domain.WebhookDelivery
+		missingHeaders error
+		logger         logger
+	}
+)
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	logger := h.logger.With(
+		zap.String("service", h.service),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookPayload))
+	if err != nil {
+		handleError(w, ErrInvalidJSON, "failed to read payload", logger)
+		return
+	}
+
+	if err = h.verify(r, body); err != nil {
+		handleError(w, err, err.Error(), logger)
+		return
+	}
+
+	delivery := h.delivery(r)
+	if delivery.DeliveryID == "" || delivery.Event == "" {
+		handleError(w, ErrInvalidQuery, h.missingHeaders.Error(), logger)
+		return
+	}
+
+	result, err := h.webhookService.HandleDelivery(ctx, delivery, body)
+	if err != nil {
+		handleError(w, err, err.Error(), logger)
+		return
+	}
+
+	marshaledResult, err := json.Marshal(result)
+	if err != nil {
+		handleError(w, err, "failed to marshal result", logger)
+		return
+	}
+
+	if err = GetSuccessResponseWithBody(w, marshaledResult); err != nil {
+		logger.Error("GetSuccessResponseWithBody", zap.Error(err))
+	}
+}
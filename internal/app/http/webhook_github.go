@@ -0,0 +1,35 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+var ErrMissingGitHubWebhookHeaders = errors.New("X-GitHub-Event and X-GitHub-Delivery headers are required")
+
+type githubWebhookService interface {
+	webhookService
+	VerifySignature(body []byte, signature string) error
+}
+
+func NewGitHubWebhookHandler(service githubWebhookService, name string, logger logger) *WebhookHandler {
+	return &WebhookHandler{
+		name:           name,
+		service:        "webhook.github",
+		webhookService: service,
+		verify: func(r *http.Request, body []byte) error {
+			return service.VerifySignature(body, r.Header.Get("X-Hub-Signature-256"))
+		},
+		delivery: func(r *http.Request) domain.WebhookDelivery {
+			return domain.WebhookDelivery{
+				Provider:   domain.WebhookProviderGitHub,
+				DeliveryID: r.Header.Get("X-GitHub-Delivery"),
+				Event:      r.Header.Get("X-GitHub-Event"),
+			}
+		},
+		missingHeaders: ErrMissingGitHubWebhookHeaders,
+		logger:         logger,
+	}
+}
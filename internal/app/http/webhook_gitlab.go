@@ -0,0 +1,41 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+var ErrMissingGitLabWebhookHeaders = errors.New("X-Gitlab-Event and X-Gitlab-Event-UUID headers are required")
+
+type gitlabWebhookService interface {
+	webhookService
+	VerifyToken(token string) error
+}
+
+func NewGitLabWebhookHandler(service gitlabWebhookService, name string, logger logger) *WebhookHandler {
+	return &WebhookHandler{
+		name:           name,
+		service:        "webhook.gitlab",
+		webhookService: service,
+		verify: func(r *http.Request, _ []byte) error {
+			return service.VerifyToken(r.Header.Get("X-Gitlab-Token"))
+		},
+		delivery: func(r *http.Request) domain.WebhookDelivery {
+			// Idempotency-Key stays the same when GitLab retries a call, X-Gitlab-Event-UUID does on
+			// GitLab versions without it.
+			deliveryID := r.Header.Get("Idempotency-Key")
+			if deliveryID == "" {
+				deliveryID = r.Header.Get("X-Gitlab-Event-UUID")
+			}
+			return domain.WebhookDelivery{
+				Provider:   domain.WebhookProviderGitLab,
+				DeliveryID: deliveryID,
+				Event:      r.Header.Get("X-Gitlab-Event"),
+			}
+		},
+		missingHeaders: ErrMissingGitLabWebhookHeaders,
+		logger:         logger,
+	}
+}
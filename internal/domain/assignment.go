@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+const DefaultReviewersCount = 2
+
+type RejectionReason string
+
+const (
+	RejectionInactive       RejectionReason = "INACTIVE"
+	RejectionAbsent         RejectionReason = "ABSENT"
+	RejectionAuthor         RejectionReason = "AUTHOR"
+	RejectionAtCapacity     RejectionReason = "AT_CAPACITY"
+	RejectionExcludedByRule RejectionReason = "EXCLUDED_BY_RULE"
+	RejectionMissingSkill   RejectionReason = "MISSING_SKILL"
+	RejectionUnknownUser    RejectionReason = "UNKNOWN_USER"
+	RejectionNotInTeam      RejectionReason = "NOT_IN_TEAM"
+	RejectionNoFreeSlot     RejectionReason = "NO_FREE_SLOT"
+	RejectionTrainee        RejectionReason = "TRAINEE"
+	RejectionLowerScore     RejectionReason = "LOWER_SCORE"
+)
+
+// Candidate is a potential reviewer together with the data the selection is based on.
+type Candidate struct {
+	UserID   string
+	Username string
+	TeamName string
+	IsActive bool
+	// IsAbsent is set when an absence of the user covers the current time.
+	IsAbsent       bool
+	IsTrainee      bool
+	Skills         []string
+	OpenReviews    int
+	OpenShadows    int
+	LastAssignedAt *time.Time
+	// AuthorReviews is how many PRs of the author the candidate reviewed in the knowledge window.
+	// It is only loaded for the knowledge_spread strategy.
+	AuthorReviews int
+}
+
+// AssignmentPool is the set of candidates available for a PR of the given author.
+type AssignmentPool struct {
+	AuthorID   string
+	TeamName   string
+	Candidates []Candidate
+}
+
+type ReviewerSource string
+
+const (
+	ReviewerSourceAuto      ReviewerSource = "AUTO"
+	ReviewerSourceMandatory ReviewerSource = "MANDATORY"
+	ReviewerSourceDeputy    ReviewerSource = "DEPUTY"
+	ReviewerSourceRequested ReviewerSource = "REQUESTED"
+	// ReviewerSourceStack is a reviewer of a PR the reviewed PR is stacked on.
+	ReviewerSourceStack ReviewerSource = "STACK"
+)
+
+type ReviewerRole string
+
+const (
+	ReviewerRoleReviewer ReviewerRole = "REVIEWER"
+	// ReviewerRoleShadow is a non-blocking trainee reviewer. Shadows do not count
+	// toward the approval quorum nor toward the review load.
+	ReviewerRoleShadow ReviewerRole = "SHADOW"
+)
+
+// Reviewer is a reviewer assigned to a PR and the reason it was assigned.
+// DeputyFor is set when the reviewer replaces an unavailable mandatory reviewer.
+// Verdict is empty while the review is pending.
+type Reviewer struct {
+	UserID    string         `json:"user_id"`
+	Role      ReviewerRole   `json:"role"`
+	Source    ReviewerSource `json:"source"`
+	DeputyFor string         `json:"deputy_for,omitempty"`
+	Verdict   Verdict        `json:"verdict,omitempty"`
+	VerdictAt *time.Time     `json:"verdict_at,omitempty"`
+	// ReRequests counts how many times the author requested this review again.
+	ReRequests    int        `json:"re_requests"`
+	ReRequestedAt *time.Time `json:"re_requested_at,omitempty"`
+}
+
+type RejectedCandidate struct {
+	UserID string          `json:"user_id"`
+	Reason RejectionReason `json:"reason"`
+}
+
+type Assignment struct {
+	TeamName      string
+	PolicyVersion int
+	Strategy      AssignmentStrategy
+	MinReviewers  int
+	Reviewers     []Reviewer
+	Rejected      []RejectedCandidate
+	// RejectedRequests lists reviewers requested by the author that could not be honoured.
+	RejectedRequests []RejectedCandidate
+	// Shadow is the trainee assigned as a shadow reviewer, if one was requested and available.
+	Shadow *Reviewer
+}
+
+func (a Assignment) ReviewerIDs() []string {
+	ids := make([]string, len(a.Reviewers))
+	for i, reviewer := range a.Reviewers {
+		ids[i] = reviewer.UserID
+	}
+	return ids
+}
+
+// AllReviewers returns the reviewers followed by the shadow, if any.
+func (a Assignment) AllReviewers() []Reviewer {
+	reviewers := slices.Clone(a.Reviewers)
+	if a.Shadow != nil {
+		reviewers = append(reviewers, *a.Shadow)
+	}
+	return reviewers
+}
+
+func (a Assignment) HasReviewer(userID string) bool {
+	for _, reviewer := range a.Reviewers {
+		if reviewer.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+package domain
+
+type DeclineReason string
+
+const (
+	DeclineNoContext          DeclineReason = "NO_CONTEXT"
+	DeclineNoTime             DeclineReason = "NO_TIME"
+	DeclineConflictOfInterest DeclineReason = "CONFLICT_OF_INTEREST"
+	DeclineOutOfOffice        DeclineReason = "OUT_OF_OFFICE"
+	DeclineOther              DeclineReason = "OTHER"
+)
+
+// DeclineReview releases a current reviewer from the PR. A replacement is picked automatically,
+// never among the reviewers who already declined the PR.
+type DeclineReview struct {
+	PullRequestID string        `json:"pull_request_id" validate:"required,gte=1,lte=255"`
+	UserID        string        `json:"user_id" validate:"required,gte=2,lte=255"`
+	Reason        DeclineReason `json:"reason" validate:"required,oneof=NO_CONTEXT NO_TIME CONFLICT_OF_INTEREST OUT_OF_OFFICE OTHER"`
+	Comment       string        `json:"comment,omitempty" validate:"required_if=Reason OTHER,lte=1000"`
+}
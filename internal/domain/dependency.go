@@ -0,0 +1,21 @@
+package domain
+
+// PullRequestDependency is a PR another PR is stacked on, together with its current status.
+type PullRequestDependency struct {
+	PullRequestID string   `json:"pull_request_id"`
+	Status        PRStatus `json:"status"`
+}
+
+// Blocks reports whether the dependency still prevents merging the PR stacked on it.
+// Merged and closed dependencies do not block.
+func (d PullRequestDependency) Blocks() bool {
+	return d.Status == PRStatusDraft || d.Status == PRStatusOpen
+}
+
+// SetDependencies replaces the PRs a PR is stacked on, e.g. after the stack was rebased.
+// DependsOn takes stored PR ids; an empty list removes every dependency.
+type SetDependencies struct {
+	PullRequestID string   `json:"pull_request_id" validate:"required,gte=1,lte=255"`
+	DependsOn     []string `json:"depends_on" validate:"unique,max=20,dive,required,lte=255"`
+	ActorID       string   `json:"actor_id,omitempty" validate:"omitempty,gte=2,lte=255"`
+}
@@ -5,16 +5,51 @@ import "errors"
 type ErrorCode string
 
 const (
-	ErrCodeInvalidRequest ErrorCode = "INVALID_REQUEST"
-	ErrCodeTeamExists     ErrorCode = "TEAM_EXISTS"
-	ErrCodeUserExists     ErrorCode = "USER_EXISTS"
-	ErrCodeInternalError  ErrorCode = "INTERNAL_ERROR"
-	ErrCodeNotFound       ErrorCode = "NOT_FOUND"
+	ErrCodeInvalidRequest    ErrorCode = "INVALID_REQUEST"
+	ErrCodeTeamExists        ErrorCode = "TEAM_EXISTS"
+	ErrCodeUserExists        ErrorCode = "USER_EXISTS"
+	ErrCodePRExists          ErrorCode = "PR_EXISTS"
+	ErrCodeNoCandidate       ErrorCode = "NO_CANDIDATE"
+	ErrCodeInternalError     ErrorCode = "INTERNAL_ERROR"
+	ErrCodeNotFound          ErrorCode = "NOT_FOUND"
+	ErrCodePRMerged          ErrorCode = "PR_MERGED"
+	ErrCodeNotAssigned       ErrorCode = "NOT_ASSIGNED"
+	ErrCodeMergeBlocked      ErrorCode = "MERGE_BLOCKED"
+	ErrCodeInvalidTransition ErrorCode = "INVALID_TRANSITION"
+	ErrCodePRNotOpen         ErrorCode = "PR_NOT_OPEN"
+	ErrCodeDependencyCycle   ErrorCode = "DEPENDENCY_CYCLE"
+	ErrCodeUnauthorized      ErrorCode = "UNAUTHORIZED"
 )
 
 var (
-	ErrTeamExists   = errors.New("team already exists")
-	ErrUsersInTeam  = errors.New("one or more users are already in a team")
-	ErrEmptyTeam    = errors.New("team is empty")
-	ErrTeamNotFound = errors.New("team not found")
+	ErrTeamExists     = errors.New("team already exists")
+	ErrUsersInTeam    = errors.New("one or more users are already in a team")
+	ErrEmptyTeam      = errors.New("team is empty")
+	ErrTeamNotFound   = errors.New("team not found")
+	ErrPRExists       = errors.New("pull request already exists")
+	ErrAuthorNotFound = errors.New("author not found")
+	ErrUserNotFound   = errors.New("user not found")
+	ErrPRNotFound     = errors.New("pull request not found")
+	ErrPRMerged       = errors.New("pull request is merged")
+	ErrPRNotOpen      = errors.New("pull request is not open")
+	ErrNotAssigned    = errors.New("user is not a current reviewer of the pull request")
+	ErrMergeBlocked   = errors.New("merge blocked")
+	ErrInvalidCursor  = errors.New("invalid cursor")
+
+	ErrPullRequestKeyTooLong = errors.New("value is too long together with repository")
+
+	ErrInvalidTransition = errors.New("invalid pull request transition")
+
+	ErrDependencyNotFound = errors.New("pull request dependency not found")
+	ErrDependencyCycle    = errors.New("pull request dependencies form a cycle")
+
+	ErrInvalidPolicy      = errors.New("invalid policy")
+	ErrPolicyNotFound     = errors.New("policy not found")
+	ErrNotEnoughReviewers = errors.New("not enough reviewer candidates")
+
+	ErrMandatoryReviewerUnavailable = errors.New("mandatory reviewer and deputy are unavailable")
+
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+	ErrInvalidWebhookPayload   = errors.New("invalid webhook payload")
+	ErrInvalidWebhookToken     = errors.New("invalid webhook token")
 )
@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+type PullRequestEventType string
+
+const (
+	EventForceMerged PullRequestEventType = "FORCE_MERGED"
+	EventClosed      PullRequestEventType = "CLOSED"
+	EventReopened    PullRequestEventType = "REOPENED"
+	EventReady       PullRequestEventType = "READY_FOR_REVIEW"
+	EventDrafted     PullRequestEventType = "CONVERTED_TO_DRAFT"
+	EventEscalated   PullRequestEventType = "ESCALATED"
+	EventReRequested PullRequestEventType = "REVIEW_RE_REQUESTED"
+	EventDeclined    PullRequestEventType = "REVIEW_DECLINED"
+	// EventDependenciesChanged records a replaced list of the PRs a PR is stacked on.
+	EventDependenciesChanged PullRequestEventType = "DEPENDENCIES_CHANGED"
+	// EventDependencyMerged is recorded on the dependents of a merged PR with their re-evaluated mergeability.
+	EventDependencyMerged PullRequestEventType = "DEPENDENCY_MERGED"
+)
+
+// PullRequestEvent is an entry of the audit trail of a PR.
+type PullRequestEvent struct {
+	PullRequestID string               `json:"pull_request_id"`
+	Type          PullRequestEventType `json:"type"`
+	ActorID       string               `json:"actor_id,omitempty"`
+	Details       map[string]any       `json:"details,omitempty"`
+	CreatedAt     time.Time            `json:"created_at"`
+}
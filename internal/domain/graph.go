@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ReviewGraph is the weighted author to reviewer graph of the reviews in the range. Nodes include
+// members without any review, so people who never review each other stand out. They are ordered
+// by team and user id.
+type ReviewGraph struct {
+	TeamName string      `json:"team_name,omitempty"`
+	From     time.Time   `json:"from"`
+	To       time.Time   `json:"to"`
+	Nodes    []GraphNode `json:"nodes"`
+	Edges    []GraphEdge `json:"edges"`
+}
+
+type GraphNode struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	TeamName string `json:"team_name"`
+	IsActive bool   `json:"is_active"`
+}
+
+// GraphEdge goes from the author to the reviewer, Weight is the number of PRs reviewed.
+type GraphEdge struct {
+	AuthorID   string `json:"author_id"`
+	ReviewerID string `json:"reviewer_id"`
+	Weight     int    `json:"weight"`
+}
+
+// DOT renders the graph in the Graphviz DOT language. Members of a team are drawn in a cluster and
+// inactive members with a dashed outline.
+func (g ReviewGraph) DOT() string {
+	var sb strings.Builder
+
+	sb.WriteString("digraph reviews {\n")
+	sb.WriteString("\tnode [shape=box];\n")
+
+	var teamName string
+	for i, node := range g.Nodes {
+		if i == 0 || node.TeamName != teamName {
+			if i > 0 {
+				sb.WriteString("\t}\n")
+			}
+			teamName = node.TeamName
+			fmt.Fprintf(&sb, "\tsubgraph %s {\n\t\tlabel=%s;\n", dotID("cluster_"+teamName), dotID(teamName))
+		}
+
+		style := "solid"
+		if !node.IsActive {
+			style = "dashed"
+		}
+		fmt.Fprintf(&sb, "\t\t%s [label=%s, team=%s, active=%t, style=%s];\n", dotID(node.UserID),
+			dotID(node.Username), dotID(node.TeamName), node.IsActive, style)
+	}
+	if len(g.Nodes) > 0 {
+		sb.WriteString("\t}\n")
+	}
+
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&sb, "\t%s -> %s [weight=%d, label=%d];\n", dotID(edge.AuthorID), dotID(edge.ReviewerID),
+			edge.Weight, edge.Weight)
+	}
+
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// dotID quotes a DOT identifier so any user id or name is safe to embed.
+func dotID(id string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(id) + `"`
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviewGraph_DOT(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		graph ReviewGraph
+		want  string
+	}{
+		{
+			name: "empty graph",
+			want: "digraph reviews {\n\tnode [shape=box];\n}\n",
+		},
+		{
+			name: "teams are clustered and edges weighted",
+			graph: ReviewGraph{
+				Nodes: []GraphNode{
+					{UserID: "u1", Username: "alice", TeamName: "backend", IsActive: true},
+					{UserID: "u2", Username: `bob "b"`, TeamName: "backend"},
+					{UserID: "p1", Username: "carol", TeamName: "platform", IsActive: true},
+				},
+				Edges: []GraphEdge{
+					{AuthorID: "u1", ReviewerID: "p1", Weight: 3},
+				},
+			},
+			want: "digraph reviews {\n" +
+				"\tnode [shape=box];\n" +
+				"\tsubgraph \"cluster_backend\" {\n" +
+				"\t\tlabel=\"backend\";\n" +
+				"\t\t\"u1\" [label=\"alice\", team=\"backend\", active=true, style=solid];\n" +
+				"\t\t\"u2\" [label=\"bob \\\"b\\\"\", team=\"backend\", active=false, style=dashed];\n" +
+				"\t}\n" +
+				"\tsubgraph \"cluster_platform\" {\n" +
+				"\t\tlabel=\"platform\";\n" +
+				"\t\t\"p1\" [label=\"carol\", team=\"platform\", active=true, style=solid];\n" +
+				"\t}\n" +
+				"\t\"u1\" -> \"p1\" [weight=3, label=3];\n" +
+				"}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.graph.DOT())
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+const (
+	// DefaultKnowledgeWeeks is the window over which knowledge spread is measured when the report
+	// or the policy does not set one.
+	DefaultKnowledgeWeeks = 12
+	MaxKnowledgeWeeks     = 104
+)
+
+// KnowledgeFilter limits the review counts to assignments made since Since and, when set, to PRs
+// of a team, a repository or a single author.
+type KnowledgeFilter struct {
+	TeamName   string
+	Repository string
+	AuthorID   string
+	Since      time.Time
+}
+
+// ReviewCount is the number of PRs of an author in a repository that a reviewer reviewed.
+// A reviewer counts when still assigned or after giving a verdict, so replaced and declined
+// assignments do not.
+type ReviewCount struct {
+	AuthorID   string
+	Repository string
+	ReviewerID string
+	Reviews    int
+}
+
+// KnowledgeReport shows how many distinct people reviewed the work of each author and each
+// repository. The concentrated lists name the ones with a bus factor of 1.
+type KnowledgeReport struct {
+	TeamName                 string            `json:"team_name,omitempty"`
+	Repository               string            `json:"repository,omitempty"`
+	Weeks                    int               `json:"weeks"`
+	Since                    time.Time         `json:"since"`
+	Authors                  []KnowledgeSpread `json:"authors"`
+	Repositories             []KnowledgeSpread `json:"repositories"`
+	ConcentratedAuthors      []string          `json:"concentrated_authors"`
+	ConcentratedRepositories []string          `json:"concentrated_repositories"`
+}
+
+// KnowledgeSpread describes the reviews of an author or a repository. BusFactor is the smallest
+// number of reviewers who together did more than half of the reviews.
+type KnowledgeSpread struct {
+	AuthorID         string  `json:"author_id,omitempty"`
+	Repository       string  `json:"repository,omitempty"`
+	Reviews          int     `json:"reviews"`
+	Reviewers        int     `json:"reviewers"`
+	TopReviewerID    string  `json:"top_reviewer_id"`
+	TopReviewerShare float64 `json:"top_reviewer_share"`
+	BusFactor        int     `json:"bus_factor"`
+}
+
+// BusFactor returns the smallest number of reviewers whose reviews add up to more than half of
+// all of them, 0 when there are no reviews.
+func BusFactor(reviews []int) int {
+	sorted := slices.Clone(reviews)
+	slices.Sort(sorted)
+	slices.Reverse(sorted)
+
+	var total int
+	for _, count := range sorted {
+		total += count
+	}
+
+	var covered int
+	for i, count := range sorted {
+		covered += count
+		if 2*covered > total {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// ReviewsByReviewer sums the counts per reviewer.
+func ReviewsByReviewer(counts []ReviewCount) map[string]int {
+	reviews := make(map[string]int, len(counts))
+	for _, count := range counts {
+		reviews[count.ReviewerID] += count.Reviews
+	}
+	return reviews
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBusFactor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		reviews []int
+		want    int
+	}{
+		{name: "no reviews", want: 0},
+		{name: "single reviewer", reviews: []int{5}, want: 1},
+		{name: "one reviewer does most", reviews: []int{1, 6, 2}, want: 1},
+		{name: "even split between two", reviews: []int{3, 3}, want: 2},
+		{name: "spread over many", reviews: []int{2, 2, 2, 2, 2}, want: 3},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, BusFactor(tt.reviews))
+		})
+	}
+}
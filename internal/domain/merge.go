@@ -0,0 +1,55 @@
+package domain
+
+import "strings"
+
+const DefaultQuorumApprovals = 1
+
+type MergeBlockerCode string
+
+const (
+	BlockerNotOpen            MergeBlockerCode = "NOT_OPEN"
+	BlockerNotEnoughApprovals MergeBlockerCode = "NOT_ENOUGH_APPROVALS"
+	BlockerChangesRequested   MergeBlockerCode = "CHANGES_REQUESTED"
+	BlockerDependencyOpen     MergeBlockerCode = "DEPENDENCY_OPEN"
+)
+
+// MergeBlocker is one reason why a PR cannot be merged yet.
+type MergeBlocker struct {
+	Code    MergeBlockerCode `json:"code"`
+	Message string           `json:"message"`
+}
+
+type Mergeability struct {
+	PullRequestID      string         `json:"pull_request_id"`
+	Status             PRStatus       `json:"status"`
+	Mergeable          bool           `json:"mergeable"`
+	Approvals          int            `json:"approvals"`
+	RequiredApprovals  int            `json:"required_approvals"`
+	ChangesRequestedBy []string       `json:"changes_requested_by"`
+	Blockers           []MergeBlocker `json:"blockers"`
+}
+
+type MergePullRequest struct {
+	PullRequestID string `json:"pull_request_id" validate:"required,gte=1,lte=255"`
+	// Force merges despite the blockers. The override is recorded with ActorID and Reason.
+	Force   bool   `json:"force,omitempty"`
+	ActorID string `json:"actor_id,omitempty" validate:"required_if=Force true,omitempty,gte=2,lte=255"`
+	Reason  string `json:"reason,omitempty" validate:"lte=1000"`
+}
+
+// MergeBlockedError is returned when a PR is merged without force while blockers remain.
+type MergeBlockedError struct {
+	Blockers []MergeBlocker
+}
+
+func (e *MergeBlockedError) Error() string {
+	messages := make([]string, len(e.Blockers))
+	for i, blocker := range e.Blockers {
+		messages[i] = blocker.Message
+	}
+	return ErrMergeBlocked.Error() + ": " + strings.Join(messages, "; ")
+}
+
+func (e *MergeBlockedError) Unwrap() error {
+	return ErrMergeBlocked
+}
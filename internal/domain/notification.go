@@ -0,0 +1,17 @@
+package domain
+
+type NotificationKind string
+
+const (
+	NotificationEscalation NotificationKind = "SLA_ESCALATION"
+	NotificationAssigned   NotificationKind = "ASSIGNED"
+	NotificationReminder   NotificationKind = "REMINDER"
+)
+
+// Notification is a message for a single user about one or more pull requests.
+type Notification struct {
+	UserID         string           `json:"user_id"`
+	Kind           NotificationKind `json:"kind"`
+	Text           string           `json:"text"`
+	PullRequestIDs []string         `json:"pull_request_ids"`
+}
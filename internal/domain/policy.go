@@ -0,0 +1,182 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+type AssignmentStrategy string
+
+const (
+	StrategyLeastLoaded AssignmentStrategy = "least_loaded"
+	StrategyRoundRobin  AssignmentStrategy = "round_robin"
+	// StrategyKnowledgeSpread prefers the candidates who reviewed the author's work the least
+	// within the knowledge window, so more people get to know each author's code.
+	StrategyKnowledgeSpread AssignmentStrategy = "knowledge_spread"
+)
+
+type PolicyFormat string
+
+const (
+	PolicyFormatJSON PolicyFormat = "json"
+	PolicyFormatYAML PolicyFormat = "yaml"
+)
+
+// AssignmentPolicy is the declarative description of how reviewers are assigned within a team.
+type AssignmentPolicy struct {
+	Strategy       AssignmentStrategy `json:"strategy" yaml:"strategy" validate:"oneof=least_loaded round_robin knowledge_spread"`
+	Reviewers      ReviewersPolicy    `json:"reviewers" yaml:"reviewers"`
+	MaxOpenReviews int                `json:"max_open_reviews,omitempty" yaml:"max_open_reviews" validate:"gte=0"`
+	RequiredSkills []string           `json:"required_skills,omitempty" yaml:"required_skills" validate:"unique,dive,required,lte=64"`
+	FallbackTeams  []string           `json:"fallback_teams,omitempty" yaml:"fallback_teams" validate:"unique,dive,required,gte=3,lte=255"`
+	Exclusions     []PolicyExclusion  `json:"exclusions,omitempty" yaml:"exclusions" validate:"dive"`
+
+	MandatoryReviewers []MandatoryReviewer `json:"mandatory_reviewers,omitempty" yaml:"mandatory_reviewers" validate:"dive"`
+	// Quorum gates merges. DefaultQuorumPolicy applies when it is not set.
+	Quorum *QuorumPolicy `json:"quorum,omitempty" yaml:"quorum"`
+	// SLA enables escalation of reviews without a verdict, measured in WorkingHours.
+	SLA          *SLAPolicy    `json:"sla,omitempty" yaml:"sla"`
+	WorkingHours *WorkingHours `json:"working_hours,omitempty" yaml:"working_hours"`
+	// Reminders sends reviewers a periodic digest of their pending reviews.
+	Reminders *ReminderSchedule `json:"reminders,omitempty" yaml:"reminders"`
+	// PreferStackReviewers assigns the reviewers of the PRs a PR is stacked on before the strategy
+	// fills the remaining slots.
+	PreferStackReviewers bool `json:"prefer_stack_reviewers,omitempty" yaml:"prefer_stack_reviewers"`
+	// KnowledgeWeeks is the window of the knowledge_spread strategy, DefaultKnowledgeWeeks when 0.
+	KnowledgeWeeks int `json:"knowledge_weeks,omitempty" yaml:"knowledge_weeks" validate:"gte=0,lte=104"`
+}
+
+// ReviewersPolicy sets how many reviewers are assigned. Creation fails when fewer than Min can be found.
+type ReviewersPolicy struct {
+	Count int `json:"count" yaml:"count" validate:"gte=1,lte=10"`
+	Min   int `json:"min" yaml:"min" validate:"gte=0,ltefield=Count"`
+}
+
+// QuorumPolicy is the approval quorum a PR needs to be merged. Only reviewers count toward
+// Approvals, shadows never do. An outstanding CHANGES_REQUESTED blocks the merge unless
+// AllowChangesRequested is set. DismissStaleApprovals resets the approvals of the other reviewers
+// whenever a review is requested again.
+type QuorumPolicy struct {
+	Approvals             int  `json:"approvals" yaml:"approvals" validate:"gte=0,lte=10"`
+	AllowChangesRequested bool `json:"allow_changes_requested,omitempty" yaml:"allow_changes_requested"`
+	DismissStaleApprovals bool `json:"dismiss_stale_approvals,omitempty" yaml:"dismiss_stale_approvals"`
+}
+
+// PolicyExclusion forbids assigning UserID, either to every PR or only to PRs of AuthorID.
+type PolicyExclusion struct {
+	UserID   string `json:"user_id" yaml:"user_id" validate:"required,gte=2,lte=255"`
+	AuthorID string `json:"author_id,omitempty" yaml:"author_id" validate:"omitempty,gte=2,lte=255"`
+}
+
+// MandatoryReviewer is assigned before the strategy fills the remaining slots. It is either
+// a specific user, replaced by DeputyID when unavailable, or any member of TeamName.
+// A rule without labels applies to every PR, otherwise to PRs having one of the labels.
+type MandatoryReviewer struct {
+	UserID   string   `json:"user_id,omitempty" yaml:"user_id" validate:"required_without=TeamName,excluded_with=TeamName,omitempty,gte=2,lte=255"`
+	TeamName string   `json:"team_name,omitempty" yaml:"team_name" validate:"omitempty,gte=3,lte=255"`
+	DeputyID string   `json:"deputy_id,omitempty" yaml:"deputy_id" validate:"excluded_without=UserID,omitempty,gte=2,lte=255,nefield=UserID"`
+	Labels   []string `json:"labels,omitempty" yaml:"labels" validate:"unique,dive,required,lte=64"`
+}
+
+type TeamPolicy struct {
+	TeamName  string           `json:"team_name"`
+	Version   int              `json:"version"`
+	Active    bool             `json:"active"`
+	Format    PolicyFormat     `json:"format"`
+	CreatedAt time.Time        `json:"created_at"`
+	Policy    AssignmentPolicy `json:"policy"`
+	Document  string           `json:"document,omitempty"`
+}
+
+type TeamPolicyDTO struct {
+	TeamName string
+	Format   PolicyFormat
+	Document string
+	Policy   AssignmentPolicy
+}
+
+// FieldError describes a problem with a single field of a submitted document.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+type PolicyValidationError struct {
+	Errors []FieldError
+}
+
+func (e *PolicyValidationError) Error() string {
+	var sb strings.Builder
+
+	sb.WriteString("invalid policy")
+	for i, fieldErr := range e.Errors {
+		if i == 0 {
+			sb.WriteString(": ")
+		} else {
+			sb.WriteString("; ")
+		}
+		sb.WriteString(fieldErr.Field)
+		sb.WriteString(": ")
+		sb.WriteString(fieldErr.Message)
+	}
+	return sb.String()
+}
+
+func DefaultAssignmentPolicy() AssignmentPolicy {
+	return AssignmentPolicy{
+		Strategy:  StrategyLeastLoaded,
+		Reviewers: ReviewersPolicy{Count: DefaultReviewersCount},
+	}
+}
+
+func DefaultQuorumPolicy() QuorumPolicy {
+	return QuorumPolicy{Approvals: DefaultQuorumApprovals}
+}
+
+// MergeQuorum returns the quorum configured by the policy or the default one.
+func (p AssignmentPolicy) MergeQuorum() QuorumPolicy {
+	if p.Quorum == nil {
+		return DefaultQuorumPolicy()
+	}
+	return *p.Quorum
+}
+
+// Calendar returns the working hours configured by the policy or the default ones.
+func (p AssignmentPolicy) Calendar() WorkingHours {
+	if p.WorkingHours == nil {
+		return DefaultWorkingHours()
+	}
+	return *p.WorkingHours
+}
+
+// KnowledgeWindow returns the number of weeks the knowledge_spread strategy looks back.
+func (p AssignmentPolicy) KnowledgeWindow() int {
+	if p.KnowledgeWeeks == 0 {
+		return DefaultKnowledgeWeeks
+	}
+	return p.KnowledgeWeeks
+}
+
+// IsExcluded reports whether the policy forbids userID to review PRs of authorID.
+func (p AssignmentPolicy) IsExcluded(authorID, userID string) bool {
+	for _, exclusion := range p.Exclusions {
+		if exclusion.UserID == userID && (exclusion.AuthorID == "" || exclusion.AuthorID == authorID) {
+			return true
+		}
+	}
+	return false
+}
+
+// AppliesTo reports whether the rule applies to a PR with the given labels.
+func (m MandatoryReviewer) AppliesTo(labels []string) bool {
+	if len(m.Labels) == 0 {
+		return true
+	}
+	for _, label := range labels {
+		if slices.Contains(m.Labels, label) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package domain
+
+import "fmt"
+
+type PRTransition string
+
+const (
+	TransitionReadyForReview PRTransition = "READY_FOR_REVIEW"
+	TransitionConvertToDraft PRTransition = "CONVERT_TO_DRAFT"
+	TransitionMerge          PRTransition = "MERGE"
+	TransitionClose          PRTransition = "CLOSE"
+	TransitionReopen         PRTransition = "REOPEN"
+)
+
+// prTransitions is the PR state machine. MERGED is terminal, a CLOSED PR can only be reopened.
+var prTransitions = map[PRStatus]map[PRTransition]PRStatus{
+	PRStatusDraft: {
+		TransitionReadyForReview: PRStatusOpen,
+		TransitionClose:          PRStatusClosed,
+	},
+	PRStatusOpen: {
+		TransitionConvertToDraft: PRStatusDraft,
+		TransitionMerge:          PRStatusMerged,
+		TransitionClose:          PRStatusClosed,
+	},
+	PRStatusClosed: {
+		TransitionReopen: PRStatusOpen,
+	},
+}
+
+// PullRequestUpdate is what a lifecycle action changes on a locked PR. Reviewers are released
+// before AddReviewers are assigned, released reviewers stay in the history.
+type PullRequestUpdate struct {
+	Status           PRStatus
+	ReleaseReviewers bool
+	AddReviewers     []Reviewer
+	Events           []PullRequestEvent
+}
+
+// InvalidTransitionError is returned for a transition the state machine does not allow.
+type InvalidTransitionError struct {
+	From       PRStatus
+	Transition PRTransition
+}
+
+func (e *InvalidTransitionError) Error() string {
+	return fmt.Sprintf("%s: cannot %s a %s pull request", ErrInvalidTransition, e.Transition, e.From)
+}
+
+func (e *InvalidTransitionError) Unwrap() error {
+	return ErrInvalidTransition
+}
+
+// Next returns the status the PR moves to on transition or an *InvalidTransitionError.
+func (s PRStatus) Next(transition PRTransition) (PRStatus, error) {
+	next, ok := prTransitions[s][transition]
+	if !ok {
+		return "", &InvalidTransitionError{From: s, Transition: transition}
+	}
+	return next, nil
+}
+
+// Reviewable returns nil when the reviews of the PR can change through verdicts, re-requests and
+// declines, which only open PRs allow. A merged PR is ErrPRMerged, any other status ErrPRNotOpen.
+func (s PRStatus) Reviewable() error {
+	switch s {
+	case PRStatusOpen:
+		return nil
+	case PRStatusMerged:
+		return ErrPRMerged
+	default:
+		return ErrPRNotOpen
+	}
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRStatus_Next(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		from       PRStatus
+		transition PRTransition
+		want       PRStatus
+		wantErr    bool
+	}{
+		{name: "draft is marked ready", from: PRStatusDraft, transition: TransitionReadyForReview, want: PRStatusOpen},
+		{name: "draft is closed", from: PRStatusDraft, transition: TransitionClose, want: PRStatusClosed},
+		{name: "open is converted to draft", from: PRStatusOpen, transition: TransitionConvertToDraft, want: PRStatusDraft},
+		{name: "open is merged", from: PRStatusOpen, transition: TransitionMerge, want: PRStatusMerged},
+		{name: "open is closed", from: PRStatusOpen, transition: TransitionClose, want: PRStatusClosed},
+		{name: "closed is reopened", from: PRStatusClosed, transition: TransitionReopen, want: PRStatusOpen},
+		{name: "draft cannot be merged", from: PRStatusDraft, transition: TransitionMerge, wantErr: true},
+		{name: "open cannot be reopened", from: PRStatusOpen, transition: TransitionReopen, wantErr: true},
+		{name: "closed cannot be merged", from: PRStatusClosed, transition: TransitionMerge, wantErr: true},
+		{name: "merged is terminal", from: PRStatusMerged, transition: TransitionReopen, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.from.Next(tt.transition)
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidTransition)
+				var transitionErr *InvalidTransitionError
+				require.ErrorAs(t, err, &transitionErr)
+				assert.Equal(t, tt.from, transitionErr.From)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPRStatus_Reviewable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		status  PRStatus
+		wantErr error
+	}{
+		{name: "open takes verdicts", status: PRStatusOpen},
+		{name: "draft takes no verdicts", status: PRStatusDraft, wantErr: ErrPRNotOpen},
+		{name: "closed takes no verdicts", status: PRStatusClosed, wantErr: ErrPRNotOpen},
+		{name: "merged takes no verdicts", status: PRStatusMerged, wantErr: ErrPRMerged},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.status.Reviewable()
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type PRStatus string
+
+const (
+	PRStatusDraft  PRStatus = "DRAFT"
+	PRStatusOpen   PRStatus = "OPEN"
+	PRStatusMerged PRStatus = "MERGED"
+	PRStatusClosed PRStatus = "CLOSED"
+)
+
+const (
+	// pullRequestKeySeparator joins the repository and the code host id of a PR, e.g. "acme/api#123".
+	pullRequestKeySeparator = "#"
+	// MaxPullRequestKeyLength is the length of pull_requests.id, the column the key is stored in.
+	MaxPullRequestKeyLength = 255
+)
+
+type PullRequest struct {
+	PullRequestID     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+	AuthorID          string     `json:"author_id"`
+	Status            PRStatus   `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	Reviewers         []Reviewer `json:"reviewers"`
+	Repository        string     `json:"repository,omitempty"`
+	SourceBranch      string     `json:"source_branch,omitempty"`
+	TargetBranch      string     `json:"target_branch,omitempty"`
+	URL               string     `json:"url,omitempty"`
+	Labels            []string   `json:"labels,omitempty"`
+	HeadSHA           string     `json:"head_sha,omitempty"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	MergedAt          *time.Time `json:"merged_at,omitempty"`
+	ClosedAt          *time.Time `json:"closed_at,omitempty"`
+
+	// DependsOn lists the PRs this PR is stacked on.
+	DependsOn []PullRequestDependency `json:"depends_on,omitempty"`
+}
+
+type CreatePullRequest struct {
+	PullRequestID      string   `json:"pull_request_id" validate:"required,gte=1,lte=255"`
+	PullRequestName    string   `json:"pull_request_name" validate:"required,gte=1,lte=500"`
+	AuthorID           string   `json:"author_id" validate:"required,gte=2,lte=255"`
+	Labels             []string `json:"labels,omitempty" validate:"omitempty,unique,dive,required,lte=64"`
+	RequestedReviewers []string `json:"requested_reviewers,omitempty" validate:"excluded_if=Draft true,omitempty,unique,max=10,dive,required,gte=2,lte=255"`
+	AddShadow          bool     `json:"add_shadow,omitempty" validate:"excluded_if=Draft true"`
+	// Draft PRs get no reviewers until they are marked ready for review.
+	Draft bool `json:"draft,omitempty"`
+
+	// Repository namespaces PullRequestID, see PullRequestKey.
+	Repository   string `json:"repository,omitempty" validate:"omitempty,lte=200,excludesall=#"`
+	SourceBranch string `json:"source_branch,omitempty" validate:"omitempty,lte=255"`
+	TargetBranch string `json:"target_branch,omitempty" validate:"omitempty,lte=255"`
+	URL          string `json:"url,omitempty" validate:"omitempty,url,lte=2048"`
+	HeadSHA      string `json:"head_sha,omitempty" validate:"omitempty,hexadecimal,gte=7,lte=64"`
+
+	// DependsOn lists the PRs this PR is stacked on, namespaced with Repository like PullRequestID.
+	DependsOn []string `json:"depends_on,omitempty" validate:"omitempty,unique,max=20,dive,required,lte=255"`
+}
+
+// Key returns the id the PR is stored under.
+func (pr CreatePullRequest) Key() string {
+	return PullRequestKey(pr.Repository, pr.PullRequestID)
+}
+
+// ValidateKeys checks that the PR and its dependencies fit into pull_requests.id once namespaced
+// with the repository, which the field limits alone do not guarantee.
+func (pr CreatePullRequest) ValidateKeys() error {
+	if len(pr.Key()) > MaxPullRequestKeyLength {
+		return fmt.Errorf("pull_request_id: %w, max=%d", ErrPullRequestKeyTooLong, MaxPullRequestKeyLength)
+	}
+	for i, key := range pr.DependencyKeys() {
+		if len(key) > MaxPullRequestKeyLength {
+			return fmt.Errorf("depends_on[%d]: %w, max=%d", i, ErrPullRequestKeyTooLong, MaxPullRequestKeyLength)
+		}
+	}
+	return nil
+}
+
+// DependencyKeys returns the ids the dependencies of the PR are stored under.
+func (pr CreatePullRequest) DependencyKeys() []string {
+	if len(pr.DependsOn) == 0 {
+		return nil
+	}
+	keys := make([]string, len(pr.DependsOn))
+	for i, dependency := range pr.DependsOn {
+		keys[i] = PullRequestKey(pr.Repository, dependency)
+	}
+	return keys
+}
+
+// DependencyIDs returns the ids of the PRs this PR is stacked on.
+func (pr PullRequest) DependencyIDs() []string {
+	if len(pr.DependsOn) == 0 {
+		return nil
+	}
+	ids := make([]string, len(pr.DependsOn))
+	for i, dependency := range pr.DependsOn {
+		ids[i] = dependency.PullRequestID
+	}
+	return ids
+}
+
+// PullRequestKey namespaces the code host id of a PR with its repository, so that #123 of two
+// repositories are different PRs. Ids without a repository and already namespaced ids are
+// returned unchanged.
+func PullRequestKey(repository, pullRequestID string) string {
+	if repository == "" || strings.HasPrefix(pullRequestID, repository+pullRequestKeySeparator) {
+		return pullRequestID
+	}
+	return repository + pullRequestKeySeparator + pullRequestID
+}
+
+type CreatePullRequestResult struct {
+	PullRequest      PullRequest
+	RejectedRequests []RejectedCandidate
+}
+
+// PullRequestDetails is a PR together with its audit trail.
+type PullRequestDetails struct {
+	PullRequest PullRequest        `json:"pr"`
+	Events      []PullRequestEvent `json:"events"`
+}
+
+type PullRequestDTO struct {
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	Status          PRStatus
+	Reviewers       []Reviewer
+	Repository      string
+	SourceBranch    string
+	TargetBranch    string
+	URL             string
+	Labels          []string
+	HeadSHA         string
+	DependsOn       []string
+}
+
+// PullRequestFilter selects PRs for listing. Empty fields do not filter, time ranges are [from, to).
+// PRs are listed newest first, After continues the listing behind the last PR of the previous page.
+type PullRequestFilter struct {
+	Statuses    []PRStatus
+	AuthorID    string
+	ReviewerID  string
+	TeamName    string
+	Repository  string
+	Label       string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	MergedFrom  *time.Time
+	MergedTo    *time.Time
+	After       *PullRequestCursor
+	Limit       int
+}
+
+// PullRequestCursor is the keyset position of a PR in the listing order.
+type PullRequestCursor struct {
+	CreatedAt     time.Time `json:"c"`
+	PullRequestID string    `json:"i"`
+}
+
+// PullRequestPage is one page of the listing. NextCursor is empty on the last page.
+type PullRequestPage struct {
+	PullRequests []PullRequest `json:"pull_requests"`
+	NextCursor   string        `json:"next_cursor,omitempty"`
+}
+
+// Encode returns the cursor as an opaque URL-safe token.
+func (c PullRequestCursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodePullRequestCursor parses a token returned by Encode.
+func DecodePullRequestCursor(token string) (PullRequestCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return PullRequestCursor{}, ErrInvalidCursor
+	}
+
+	var cursor PullRequestCursor
+	if err = json.Unmarshal(raw, &cursor); err != nil || cursor.PullRequestID == "" || cursor.CreatedAt.IsZero() {
+		return PullRequestCursor{}, ErrInvalidCursor
+	}
+	return cursor, nil
+}
+
+type PullRequestShort struct {
+	PullRequestID   string       `json:"pull_request_id"`
+	PullRequestName string       `json:"pull_request_name"`
+	AuthorID        string       `json:"author_id"`
+	Status          PRStatus     `json:"status"`
+	Role            ReviewerRole `json:"role"`
+}
+
+type UserReviews struct {
+	UserID       string             `json:"user_id"`
+	PullRequests []PullRequestShort `json:"pull_requests"`
+}
+
+// PullRequestAction is the body of the lifecycle endpoints. ActorID is recorded on the PR events.
+type PullRequestAction struct {
+	PullRequestID string `json:"pull_request_id" validate:"required,gte=1,lte=255"`
+	ActorID       string `json:"actor_id,omitempty" validate:"omitempty,gte=2,lte=255"`
+}
+
+// ReadyForReview moves a draft to OPEN. Reviewers are assigned at that moment, taking the same
+// selection input as creation, unless the draft kept its reviewers.
+type ReadyForReview struct {
+	PullRequestID      string   `json:"pull_request_id" validate:"required,gte=1,lte=255"`
+	ActorID            string   `json:"actor_id,omitempty" validate:"omitempty,gte=2,lte=255"`
+	Labels             []string `json:"labels,omitempty" validate:"omitempty,unique,dive,required,lte=64"`
+	RequestedReviewers []string `json:"requested_reviewers,omitempty" validate:"omitempty,unique,max=10,dive,required,gte=2,lte=255"`
+	AddShadow          bool     `json:"add_shadow,omitempty"`
+}
+
+// ConvertToDraft moves an open PR back to DRAFT. ReleaseReviewers unassigns the current
+// reviewers so they stop counting toward the review load.
+type ConvertToDraft struct {
+	PullRequestID    string `json:"pull_request_id" validate:"required,gte=1,lte=255"`
+	ActorID          string `json:"actor_id,omitempty" validate:"omitempty,gte=2,lte=255"`
+	ReleaseReviewers bool   `json:"release_reviewers,omitempty"`
+}
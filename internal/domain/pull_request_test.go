@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullRequestKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		repository    string
+		pullRequestID string
+		want          string
+	}{
+		{
+			name:          "without repository",
+			pullRequestID: "pr-1001",
+			want:          "pr-1001",
+		},
+		{
+			name:          "namespaced by repository",
+			repository:    "acme/api",
+			pullRequestID: "123",
+			want:          "acme/api#123",
+		},
+		{
+			name:          "already namespaced",
+			repository:    "acme/api",
+			pullRequestID: "acme/api#123",
+			want:          "acme/api#123",
+		},
+		{
+			name:          "namespaced by another repository",
+			repository:    "acme/web",
+			pullRequestID: "acme/api#123",
+			want:          "acme/web#acme/api#123",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, PullRequestKey(tt.repository, tt.pullRequestID))
+		})
+	}
+}
+
+func TestCreatePullRequest_ValidateKeys(t *testing.T) {
+	t.Parallel()
+
+	// The longest repository allowed, "#" and the id make up the key.
+	repository := strings.Repeat("r", 200)
+	fitting := strings.Repeat("1", MaxPullRequestKeyLength-len(repository)-1)
+
+	tests := []struct {
+		name    string
+		pr      CreatePullRequest
+		wantErr string
+	}{
+		{
+			name: "success: key at the column length",
+			pr:   CreatePullRequest{PullRequestID: fitting, Repository: repository, DependsOn: []string{fitting}},
+		},
+		{
+			name: "success: id without repository is stored as is",
+			pr:   CreatePullRequest{PullRequestID: strings.Repeat("1", MaxPullRequestKeyLength)},
+		},
+		{
+			name:    "error: key one character over the column length",
+			pr:      CreatePullRequest{PullRequestID: fitting + "1", Repository: repository},
+			wantErr: "pull_request_id: value is too long together with repository, max=255",
+		},
+		{
+			name: "error: dependency key over the column length",
+			pr: CreatePullRequest{
+				PullRequestID: "1",
+				Repository:    repository,
+				DependsOn:     []string{"2", fitting + "1"},
+			},
+			wantErr: "depends_on[1]: value is too long together with repository, max=255",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.pr.ValidateKeys()
+
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, ErrPullRequestKeyTooLong)
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDecodePullRequestCursor(t *testing.T) {
+	t.Parallel()
+
+	cursor := PullRequestCursor{
+		CreatedAt:     time.Date(2025, 3, 5, 10, 0, 0, 123000, time.UTC),
+		PullRequestID: "acme/api#123",
+	}
+
+	decoded, err := DecodePullRequestCursor(cursor.Encode())
+	require.NoError(t, err)
+	assert.True(t, cursor.CreatedAt.Equal(decoded.CreatedAt))
+	assert.Equal(t, cursor.PullRequestID, decoded.PullRequestID)
+
+	for _, token := range []string{"", "!!!", "e30"} {
+		_, err = DecodePullRequestCursor(token)
+		assert.ErrorIs(t, err, ErrInvalidCursor, token)
+	}
+}
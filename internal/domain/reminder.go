@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	ReminderDeliverySent   ReminderDeliveryStatus = "SENT"
+	ReminderDeliveryFailed ReminderDeliveryStatus = "FAILED"
+)
+
+var defaultReminderDays = []string{"mon", "tue", "wed", "thu", "fri"}
+
+type ReminderDeliveryStatus string
+
+// ReminderSchedule sends every reviewer of the team a digest of PRs waiting on them at Time
+// ("15:04") on Days, in the timezone of the team working hours. Days default to weekdays.
+type ReminderSchedule struct {
+	Time string   `json:"time" yaml:"time" validate:"required,datetime=15:04"`
+	Days []string `json:"days,omitempty" yaml:"days" validate:"unique,dive,oneof=mon tue wed thu fri sat sun"`
+}
+
+// Last returns the latest scheduled moment not after now in loc, looking back at most a week.
+func (s ReminderSchedule) Last(now time.Time, loc *time.Location) (time.Time, bool) {
+	at, err := time.Parse("15:04", s.Time)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	days := s.Days
+	if len(days) == 0 {
+		days = defaultReminderDays
+	}
+
+	now = now.In(loc)
+	for i := 0; i <= 7; i++ {
+		day := now.AddDate(0, 0, -i)
+		slot := time.Date(day.Year(), day.Month(), day.Day(), at.Hour(), at.Minute(), 0, 0, loc)
+		if slot.After(now) {
+			continue
+		}
+		if slices.Contains(days, strings.ToLower(slot.Weekday().String()[:3])) {
+			return slot, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// ReminderRun is a single scheduled reminder of a team. A run is stored once per team and
+// scheduled moment, which guarantees that every reviewer gets at most one reminder for it.
+type ReminderRun struct {
+	ID           int64              `json:"id"`
+	TeamName     string             `json:"team_name"`
+	ScheduledFor time.Time          `json:"scheduled_for"`
+	StartedAt    time.Time          `json:"started_at"`
+	FinishedAt   *time.Time         `json:"finished_at,omitempty"`
+	Deliveries   []ReminderDelivery `json:"deliveries"`
+}
+
+// ReminderDelivery is the result of sending the reminder to one reviewer.
+type ReminderDelivery struct {
+	UserID         string                 `json:"user_id"`
+	PullRequestIDs []string               `json:"pull_request_ids"`
+	Status         ReminderDeliveryStatus `json:"status"`
+	Error          string                 `json:"error,omitempty"`
+}
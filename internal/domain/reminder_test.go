@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReminderSchedule_Last(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		schedule ReminderSchedule
+		now      time.Time
+		loc      *time.Location
+		want     time.Time
+		wantOK   bool
+	}{
+		{
+			name:     "today after the slot",
+			schedule: ReminderSchedule{Time: "10:00"},
+			now:      time.Date(2025, 3, 5, 11, 30, 0, 0, time.UTC), // Wednesday
+			loc:      time.UTC,
+			want:     time.Date(2025, 3, 5, 10, 0, 0, 0, time.UTC),
+			wantOK:   true,
+		},
+		{
+			name:     "today before the slot",
+			schedule: ReminderSchedule{Time: "10:00"},
+			now:      time.Date(2025, 3, 5, 9, 59, 0, 0, time.UTC),
+			loc:      time.UTC,
+			want:     time.Date(2025, 3, 4, 10, 0, 0, 0, time.UTC),
+			wantOK:   true,
+		},
+		{
+			name:     "weekend falls back to friday",
+			schedule: ReminderSchedule{Time: "10:00"},
+			now:      time.Date(2025, 3, 9, 12, 0, 0, 0, time.UTC), // Sunday
+			loc:      time.UTC,
+			want:     time.Date(2025, 3, 7, 10, 0, 0, 0, time.UTC),
+			wantOK:   true,
+		},
+		{
+			name:     "custom days",
+			schedule: ReminderSchedule{Time: "16:30", Days: []string{"mon"}},
+			now:      time.Date(2025, 3, 5, 12, 0, 0, 0, time.UTC),
+			loc:      time.UTC,
+			want:     time.Date(2025, 3, 3, 16, 30, 0, 0, time.UTC),
+			wantOK:   true,
+		},
+		{
+			name:     "team timezone",
+			schedule: ReminderSchedule{Time: "10:00"},
+			now:      time.Date(2025, 3, 5, 9, 30, 0, 0, time.UTC), // 10:30 in Berlin
+			loc:      berlin,
+			want:     time.Date(2025, 3, 5, 10, 0, 0, 0, berlin),
+			wantOK:   true,
+		},
+		{
+			name:     "invalid time",
+			schedule: ReminderSchedule{Time: "25:00"},
+			now:      time.Date(2025, 3, 5, 12, 0, 0, 0, time.UTC),
+			loc:      time.UTC,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := tt.schedule.Last(tt.now, tt.loc)
+
+			assert.Equal(t, tt.wantOK, ok)
+			assert.True(t, tt.want.Equal(got), "got %s, want %s", got, tt.want)
+		})
+	}
+}
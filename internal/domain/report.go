@@ -0,0 +1,65 @@
+package domain
+
+import "time"
+
+// ReportFilter limits a report to the pull requests created in the range and, when Repository is
+// set, to a single repository.
+type ReportFilter struct {
+	StatsFilter
+	Repository string
+}
+
+// LatencyReport holds the review latencies of the pull requests created in the range, one row per
+// team of the author, repository and week of creation.
+type LatencyReport struct {
+	TeamName   string       `json:"team_name,omitempty"`
+	Repository string       `json:"repository,omitempty"`
+	From       time.Time    `json:"from"`
+	To         time.Time    `json:"to"`
+	Rows       []LatencyRow `json:"rows"`
+}
+
+// LatencyRow holds the latencies of a group. Time to first verdict and time to approval run from
+// the first reviewer assignment, time to merge runs from the creation of the pull request.
+type LatencyRow struct {
+	TeamName     string             `json:"team_name"`
+	Repository   string             `json:"repository"`
+	Week         time.Time          `json:"week"`
+	PullRequests int                `json:"pull_requests"`
+	FirstVerdict LatencyPercentiles `json:"time_to_first_verdict"`
+	Approval     LatencyPercentiles `json:"time_to_approval"`
+	Merge        LatencyPercentiles `json:"time_to_merge"`
+}
+
+// LatencyPercentiles are in hours over the Count pull requests that got that far, nil when none
+// did.
+type LatencyPercentiles struct {
+	Count int      `json:"count"`
+	P50   *float64 `json:"p50_hours"`
+	P90   *float64 `json:"p90_hours"`
+	P99   *float64 `json:"p99_hours"`
+}
+
+// ReviewRecordKind tells what happened to a reviewer in an exported review record.
+type ReviewRecordKind string
+
+const (
+	ReviewRecordAssigned ReviewRecordKind = "ASSIGNED"
+	ReviewRecordReplaced ReviewRecordKind = "REPLACED"
+	ReviewRecordVerdict  ReviewRecordKind = "VERDICT"
+)
+
+// ReviewRecord is a single entry of the reviewer history export. Role and Source describe the
+// assignment, Verdict and Message are only set for verdicts.
+type ReviewRecord struct {
+	Kind          ReviewRecordKind `json:"kind"`
+	OccurredAt    time.Time        `json:"occurred_at"`
+	PullRequestID string           `json:"pull_request_id"`
+	Repository    string           `json:"repository,omitempty"`
+	AuthorID      string           `json:"author_id"`
+	UserID        string           `json:"user_id"`
+	Role          ReviewerRole     `json:"role,omitempty"`
+	Source        ReviewerSource   `json:"source,omitempty"`
+	Verdict       Verdict          `json:"verdict,omitempty"`
+	Message       string           `json:"message,omitempty"`
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+type Verdict string
+
+const (
+	VerdictApproved         Verdict = "APPROVED"
+	VerdictChangesRequested Verdict = "CHANGES_REQUESTED"
+	VerdictCommented        Verdict = "COMMENTED"
+)
+
+type SubmitReview struct {
+	PullRequestID string  `json:"pull_request_id" validate:"required,gte=1,lte=255"`
+	UserID        string  `json:"user_id" validate:"required,gte=2,lte=255"`
+	Verdict       Verdict `json:"verdict" validate:"required,oneof=APPROVED CHANGES_REQUESTED COMMENTED"`
+	Message       string  `json:"message,omitempty" validate:"lte=10000"`
+}
+
+// ReRequestReview puts a reviewer back to pending, typically after the author addressed
+// CHANGES_REQUESTED. ActorID is recorded on the PR event.
+type ReRequestReview struct {
+	PullRequestID string `json:"pull_request_id" validate:"required,gte=1,lte=255"`
+	UserID        string `json:"user_id" validate:"required,gte=2,lte=255"`
+	ActorID       string `json:"actor_id,omitempty" validate:"omitempty,gte=2,lte=255,nefield=UserID"`
+}
+
+// ReviewVerdict is one entry of the verdict history of a PR.
+type ReviewVerdict struct {
+	PullRequestID string    `json:"pull_request_id"`
+	UserID        string    `json:"user_id"`
+	Verdict       Verdict   `json:"verdict"`
+	Message       string    `json:"message,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
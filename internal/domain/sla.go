@@ -0,0 +1,95 @@
+package domain
+
+import "time"
+
+const (
+	DefaultWorkdayStart = 9
+	DefaultWorkdayEnd   = 18
+)
+
+type EscalationAction string
+
+const (
+	EscalationNotify      EscalationAction = "notify"
+	EscalationAddReviewer EscalationAction = "add_reviewer"
+	EscalationReassign    EscalationAction = "reassign"
+)
+
+// SLAPolicy escalates a review that got no verdict within FirstReviewHours working hours
+// after the reviewer was assigned.
+type SLAPolicy struct {
+	FirstReviewHours int              `json:"first_review_hours" yaml:"first_review_hours" validate:"gte=1,lte=720"`
+	Action           EscalationAction `json:"action" yaml:"action" validate:"oneof=notify add_reviewer reassign"`
+}
+
+// WorkingHours is the team calendar: working days are Monday to Friday, from Start
+// to End o'clock in Timezone (UTC when empty).
+type WorkingHours struct {
+	Timezone string `json:"timezone,omitempty" yaml:"timezone" validate:"omitempty,timezone"`
+	Start    int    `json:"start" yaml:"start" validate:"gte=0,lte=23"`
+	End      int    `json:"end" yaml:"end" validate:"gtfield=Start,lte=24"`
+}
+
+func DefaultWorkingHours() WorkingHours {
+	return WorkingHours{Start: DefaultWorkdayStart, End: DefaultWorkdayEnd}
+}
+
+// Location returns the team timezone, falling back to UTC when it cannot be loaded.
+func (w WorkingHours) Location() *time.Location {
+	loc, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// Deadline returns the moment when d of working time has elapsed since start.
+func (w WorkingHours) Deadline(start time.Time, d time.Duration) time.Time {
+	loc := w.Location()
+	t := start.In(loc)
+
+	for {
+		dayStart := time.Date(t.Year(), t.Month(), t.Day(), w.Start, 0, 0, 0, loc)
+		dayEnd := time.Date(t.Year(), t.Month(), t.Day(), w.End, 0, 0, 0, loc)
+		nextDay := time.Date(t.Year(), t.Month(), t.Day()+1, w.Start, 0, 0, 0, loc)
+
+		if !IsWorkday(t) || !t.Before(dayEnd) {
+			t = nextDay
+			continue
+		}
+		if t.Before(dayStart) {
+			t = dayStart
+		}
+
+		available := dayEnd.Sub(t)
+		if d <= available {
+			return t.Add(d)
+		}
+		d -= available
+		t = nextDay
+	}
+}
+
+func IsWorkday(t time.Time) bool {
+	return t.Weekday() != time.Saturday && t.Weekday() != time.Sunday
+}
+
+// PendingReview is a current reviewer who has not given a verdict yet. AssignedAt is when the
+// review was last requested, the assignment or the latest re-request.
+type PendingReview struct {
+	PullRequestID   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AuthorID        string    `json:"author_id"`
+	TeamName        string    `json:"team_name"`
+	UserID          string    `json:"user_id"`
+	AssignedAt      time.Time `json:"assigned_at"`
+}
+
+// Escalation is applied to a pending review that breached the SLA. With reassign the reviewer
+// is released and replaced by AddReviewers, with add_reviewer they are assigned next to it.
+type Escalation struct {
+	Review       PendingReview
+	Action       EscalationAction
+	Deadline     time.Time
+	AddReviewers []Reviewer
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkingHours_Deadline(t *testing.T) {
+	t.Parallel()
+
+	hours := WorkingHours{Timezone: "Europe/Moscow", Start: 10, End: 18}
+	moscow := hours.Location()
+
+	tests := []struct {
+		name  string
+		start time.Time
+		d     time.Duration
+		want  time.Time
+	}{
+		{
+			name:  "within the same working day",
+			start: time.Date(2025, 3, 3, 11, 0, 0, 0, moscow), // Monday
+			d:     4 * time.Hour,
+			want:  time.Date(2025, 3, 3, 15, 0, 0, 0, moscow),
+		},
+		{
+			name:  "carried over to the next working day",
+			start: time.Date(2025, 3, 3, 16, 0, 0, 0, moscow),
+			d:     4 * time.Hour,
+			want:  time.Date(2025, 3, 4, 12, 0, 0, 0, moscow),
+		},
+		{
+			name:  "assigned before the working day starts",
+			start: time.Date(2025, 3, 3, 7, 30, 0, 0, moscow),
+			d:     time.Hour,
+			want:  time.Date(2025, 3, 3, 11, 0, 0, 0, moscow),
+		},
+		{
+			name:  "weekend is skipped",
+			start: time.Date(2025, 3, 7, 17, 0, 0, 0, moscow), // Friday
+			d:     2 * time.Hour,
+			want:  time.Date(2025, 3, 10, 11, 0, 0, 0, moscow),
+		},
+		{
+			name:  "assigned on a weekend",
+			start: time.Date(2025, 3, 8, 12, 0, 0, 0, moscow), // Saturday
+			d:     time.Hour,
+			want:  time.Date(2025, 3, 10, 11, 0, 0, 0, moscow),
+		},
+		{
+			name:  "start in another timezone",
+			start: time.Date(2025, 3, 3, 8, 0, 0, 0, time.UTC), // 11:00 in Moscow
+			d:     time.Hour,
+			want:  time.Date(2025, 3, 3, 12, 0, 0, 0, moscow),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := hours.Deadline(tt.start, tt.d)
+
+			assert.True(t, tt.want.Equal(got), "want %s, got %s", tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package domain
+
+import "time"
+
+// StatsFilter limits statistics to the [From, To) time range and, when TeamName is set, to the
+// members of a single team.
+type StatsFilter struct {
+	TeamName string
+	From     time.Time
+	To       time.Time
+}
+
+// DeclineStats shows how often reviewers decline their assignments and why.
+type DeclineStats struct {
+	TeamName string               `json:"team_name,omitempty"`
+	From     time.Time            `json:"from"`
+	To       time.Time            `json:"to"`
+	Users    []UserDeclineStats   `json:"users"`
+	Reasons  []ReasonDeclineStats `json:"reasons"`
+}
+
+// UserDeclineStats compares the declines of a user with the assignments they got in the range.
+type UserDeclineStats struct {
+	UserID      string  `json:"user_id"`
+	Username    string  `json:"username"`
+	TeamName    string  `json:"team_name"`
+	Assignments int     `json:"assignments"`
+	Declines    int     `json:"declines"`
+	DeclineRate float64 `json:"decline_rate"`
+}
+
+type ReasonDeclineStats struct {
+	Reason   DeclineReason `json:"reason"`
+	Declines int           `json:"declines"`
+	Share    float64       `json:"share"`
+}
+
+// StatsGroupBy adds aggregates per group on top of the per-user and per-PR statistics.
+type StatsGroupBy string
+
+const (
+	StatsGroupByNone StatsGroupBy = ""
+	StatsGroupByTeam StatsGroupBy = "team"
+)
+
+// AssignmentStats shows how reviews were assigned in the range. Only reviewers count, shadows do not.
+// Teams is filled only when the statistics are grouped by team.
+type AssignmentStats struct {
+	TeamName     string                       `json:"team_name,omitempty"`
+	From         time.Time                    `json:"from"`
+	To           time.Time                    `json:"to"`
+	Users        []UserAssignmentStats        `json:"users"`
+	PullRequests []PullRequestAssignmentStats `json:"pull_requests"`
+	Teams        []TeamAssignmentStats        `json:"teams,omitempty"`
+}
+
+// UserAssignmentStats counts the assignments a user got and lost in the range. OpenReviews is the
+// current load: the open PRs the user is a current reviewer of, regardless of the range.
+type UserAssignmentStats struct {
+	UserID        string `json:"user_id"`
+	Username      string `json:"username"`
+	TeamName      string `json:"team_name"`
+	IsActive      bool   `json:"is_active"`
+	Assignments   int    `json:"assignments"`
+	Reassignments int    `json:"reassignments"`
+	OpenReviews   int    `json:"open_reviews"`
+}
+
+// PullRequestAssignmentStats describes the reviewers of a PR created in the range. Reviewers counts
+// everyone who was ever assigned, Reassignments the assignments that were replaced or released.
+type PullRequestAssignmentStats struct {
+	PullRequestID    string   `json:"pull_request_id"`
+	TeamName         string   `json:"team_name"`
+	Status           PRStatus `json:"status"`
+	Reviewers        int      `json:"reviewers"`
+	CurrentReviewers int      `json:"current_reviewers"`
+	Reassignments    int      `json:"reassignments"`
+}
+
+// TeamAssignmentStats sums the statistics of the team members and of the PRs authored by them.
+type TeamAssignmentStats struct {
+	TeamName             string  `json:"team_name"`
+	Members              int     `json:"members"`
+	Assignments          int     `json:"assignments"`
+	Reassignments        int     `json:"reassignments"`
+	OpenReviews          int     `json:"open_reviews"`
+	PullRequests         int     `json:"pull_requests"`
+	ReviewersPerPR       float64 `json:"reviewers_per_pr"`
+	AssignmentsPerMember float64 `json:"assignments_per_member"`
+}
+
+// DefaultOverloadThreshold is how many standard deviations above the mean a member's assignments
+// must be for the member to be flagged as overloaded.
+const DefaultOverloadThreshold = 2.0
+
+// ReviewLoad is the review work of a member in the range. MeanTimeToVerdict is the mean time from
+// assignment to the first verdict of the completed reviews, nil when none was completed.
+type ReviewLoad struct {
+	UserID            string
+	Username          string
+	TeamName          string
+	IsActive          bool
+	Assignments       int
+	ReviewsCompleted  int
+	MeanTimeToVerdict *time.Duration
+}
+
+// LoadDistribution shows how evenly assignments are spread over the members. Gini is 0 for a
+// perfectly even spread and approaches 1 when one member gets everything. MaxMinRatio is nil when
+// a member got no assignment at all.
+type LoadDistribution struct {
+	TeamName          string       `json:"team_name,omitempty"`
+	From              time.Time    `json:"from"`
+	To                time.Time    `json:"to"`
+	Members           []MemberLoad `json:"members"`
+	Assignments       int          `json:"assignments"`
+	Mean              float64      `json:"mean"`
+	StdDev            float64      `json:"std_dev"`
+	Gini              float64      `json:"gini"`
+	MaxMinRatio       *float64     `json:"max_min_ratio"`
+	OverloadThreshold float64      `json:"overload_threshold"`
+}
+
+// MemberLoad is the review work of a member. Overloaded is set when the assignments are more than
+// the overload threshold of standard deviations above the mean, ZScore tells by how many.
+type MemberLoad struct {
+	UserID                 string   `json:"user_id"`
+	Username               string   `json:"username"`
+	TeamName               string   `json:"team_name"`
+	IsActive               bool     `json:"is_active"`
+	Assignments            int      `json:"assignments"`
+	ReviewsCompleted       int      `json:"reviews_completed"`
+	MeanTimeToVerdictHours *float64 `json:"mean_time_to_verdict_hours"`
+	ZScore                 float64  `json:"z_score"`
+	Overloaded             bool     `json:"overloaded"`
+}
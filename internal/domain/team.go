@@ -1,9 +1,11 @@
 package domain
 
 type TeamMember struct {
-	UserID   string `json:"user_id" validate:"required,gte=2,lte=255"`
-	Username string `json:"username" validate:"required,gte=3,lte=255"`
-	IsActive bool   `json:"is_active" validate:"required,boolean"`
+	UserID    string   `json:"user_id" validate:"required,gte=2,lte=255"`
+	Username  string   `json:"username" validate:"required,gte=3,lte=255"`
+	IsActive  bool     `json:"is_active" validate:"required,boolean"`
+	Skills    []string `json:"skills,omitempty" validate:"omitempty,dive,required,lte=64"`
+	IsTrainee bool     `json:"is_trainee,omitempty"`
 }
 
 type Team struct {
@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 type User struct {
 	Username string
 	TeamID   int64
@@ -7,7 +9,18 @@ type User struct {
 }
 
 type UserDTO struct {
-	UserID   string
-	Username string
-	IsActive bool
+	UserID    string
+	Username  string
+	IsActive  bool
+	Skills    []string
+	IsTrainee bool
+}
+
+// UserAbsence is a period the user is away, e.g. on vacation. From is inclusive, Until exclusive.
+// While absent the user is not picked as a reviewer.
+type UserAbsence struct {
+	UserID  string    `json:"user_id" validate:"required,gte=2,lte=255"`
+	From    time.Time `json:"from" validate:"required"`
+	Until   time.Time `json:"until" validate:"required,gtfield=From"`
+	Comment string    `json:"comment,omitempty" validate:"lte=1000"`
 }
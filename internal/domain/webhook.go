@@ -0,0 +1,36 @@
+package domain
+
+type WebhookProvider string
+
+const (
+	WebhookProviderGitHub WebhookProvider = "github"
+	WebhookProviderGitLab WebhookProvider = "gitlab"
+)
+
+// WebhookDelivery identifies a webhook call. Code hosts retry and redeliver calls with the same
+// DeliveryID, which is how duplicates are recognised.
+type WebhookDelivery struct {
+	Provider   WebhookProvider
+	DeliveryID string
+	Event      string
+}
+
+// WebhookResult tells the code host what a delivery did. Ignored deliveries are acknowledged
+// without any change, Reason says why.
+type WebhookResult struct {
+	DeliveryID    string `json:"delivery_id"`
+	Event         string `json:"event"`
+	Action        string `json:"action,omitempty"`
+	PullRequestID string `json:"pull_request_id,omitempty"`
+	Duplicate     bool   `json:"duplicate,omitempty"`
+	Ignored       bool   `json:"ignored,omitempty"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+// UserIdentity maps an account on a code host to a user. Webhooks resolve authors and actors
+// through it, the ExternalID is the numeric account id on GitHub and GitLab.
+type UserIdentity struct {
+	UserID     string          `json:"user_id" validate:"required,gte=2,lte=255"`
+	Provider   WebhookProvider `json:"provider" validate:"required,oneof=github gitlab"`
+	ExternalID string          `json:"external_id" validate:"required,lte=255"`
+}
@@ -0,0 +1,81 @@
+// Package metrics defines the Prometheus metrics of the service.
+package metrics
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// Assignment failure reasons.
+const (
+	FailureNotEnoughReviewers = "not_enough_reviewers"
+	FailureMandatoryReviewer  = "mandatory_reviewer_unavailable"
+	FailureAuthorNotFound     = "author_not_found"
+	FailureInternalError      = "internal_error"
+)
+
+// Domain counts what happens to PRs and their reviewers.
+type Domain struct {
+	created            *prometheus.CounterVec
+	merged             *prometheus.CounterVec
+	reassignments      *prometheus.CounterVec
+	assignmentFailures *prometheus.CounterVec
+}
+
+func NewDomain(registerer prometheus.Registerer) *Domain {
+	m := &Domain{
+		created: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "review_assigner_pull_requests_created_total",
+			Help: "Pull requests created by initial status.",
+		}, []string{"status"}),
+		merged: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "review_assigner_pull_requests_merged_total",
+			Help: "Pull requests merged, forced merges overrode the blockers.",
+		}, []string{"forced"}),
+		reassignments: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "review_assigner_reassignments_total",
+			Help: "Reviewers replaced by another reviewer by trigger.",
+		}, []string{"reason"}),
+		assignmentFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "review_assigner_assignment_failures_total",
+			Help: "Reviewer selections that failed by reason.",
+		}, []string{"reason"}),
+	}
+	registerer.MustRegister(m.created, m.merged, m.reassignments, m.assignmentFailures)
+	return m
+}
+
+func (m *Domain) PullRequestCreated(status domain.PRStatus) {
+	m.created.WithLabelValues(string(status)).Inc()
+}
+
+func (m *Domain) PullRequestMerged(forced bool) {
+	m.merged.WithLabelValues(strconv.FormatBool(forced)).Inc()
+}
+
+// ReviewerReassigned counts a replacement, the reason is the event that triggered it.
+func (m *Domain) ReviewerReassigned(reason domain.PullRequestEventType) {
+	m.reassignments.WithLabelValues(string(reason)).Inc()
+}
+
+// AssignmentFailed counts a failed reviewer selection by the reason behind err.
+func (m *Domain) AssignmentFailed(err error) {
+	m.assignmentFailures.WithLabelValues(failureReason(err)).Inc()
+}
+
+func failureReason(err error) string {
+	switch {
+	case errors.Is(err, domain.ErrNotEnoughReviewers):
+		return FailureNotEnoughReviewers
+	case errors.Is(err, domain.ErrMandatoryReviewerUnavailable):
+		return FailureMandatoryReviewer
+	case errors.Is(err, domain.ErrAuthorNotFound):
+		return FailureAuthorNotFound
+	default:
+		return FailureInternalError
+	}
+}
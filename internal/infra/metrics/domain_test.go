@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func TestDomain_AssignmentFailed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "not enough reviewers",
+			err:  fmt.Errorf("selector.Select: %w", domain.ErrNotEnoughReviewers),
+			want: FailureNotEnoughReviewers,
+		},
+		{
+			name: "mandatory reviewer unavailable",
+			err:  domain.ErrMandatoryReviewerUnavailable,
+			want: FailureMandatoryReviewer,
+		},
+		{name: "author not found", err: domain.ErrAuthorNotFound, want: FailureAuthorNotFound},
+		{name: "other errors", err: errors.New("database connection failed"), want: FailureInternalError},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := NewDomain(prometheus.NewRegistry())
+
+			m.AssignmentFailed(tt.err)
+
+			assert.Equal(t, 1, testutil.CollectAndCount(m.assignmentFailures))
+			assert.InDelta(t, 1, testutil.ToFloat64(m.assignmentFailures.WithLabelValues(tt.want)), 0)
+		})
+	}
+}
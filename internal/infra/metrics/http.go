@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// HTTP counts requests and their latency per route pattern.
+type HTTP struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+func NewHTTP(registerer prometheus.Registerer) *HTTP {
+	m := &HTTP{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "review_assigner_http_requests_total",
+			Help: "HTTP requests by route pattern, method and status code.",
+		}, []string{"route", "method", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "review_assigner_http_request_duration_seconds",
+			Help:    "HTTP request latency by route pattern and method.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"route", "method"}),
+	}
+	registerer.MustRegister(m.requests, m.duration)
+	return m
+}
+
+// ObserveRequest records a served request. The route is the pattern the handler is registered for,
+// so the number of series does not grow with the requested paths.
+func (m *HTTP) ObserveRequest(route, method string, statusCode int, duration time.Duration) {
+	m.requests.WithLabelValues(route, method, strconv.Itoa(statusCode)).Inc()
+	m.duration.WithLabelValues(route, method).Observe(duration.Seconds())
+}
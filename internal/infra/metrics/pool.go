@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type poolStat struct {
+	desc      *prometheus.Desc
+	valueType prometheus.ValueType
+	value     func(stat *pgxpool.Stat) float64
+}
+
+func newPoolStat(name, help string, valueType prometheus.ValueType, value func(stat *pgxpool.Stat) float64,
+) poolStat {
+	return poolStat{
+		desc:      prometheus.NewDesc(name, help, nil, nil),
+		valueType: valueType,
+		value:     value,
+	}
+}
+
+// Pool exposes the statistics of the database connection pool, read on every scrape.
+type Pool struct {
+	pool  *pgxpool.Pool
+	stats []poolStat
+}
+
+func NewPool(pool *pgxpool.Pool) *Pool {
+	gauge, counter := prometheus.GaugeValue, prometheus.CounterValue
+
+	return &Pool{
+		pool: pool,
+		stats: []poolStat{
+			newPoolStat("review_assigner_db_pool_total_conns", "Connections currently in the pool.", gauge,
+				func(s *pgxpool.Stat) float64 { return float64(s.TotalConns()) }),
+			newPoolStat("review_assigner_db_pool_acquired_conns", "Connections currently acquired.", gauge,
+				func(s *pgxpool.Stat) float64 { return float64(s.AcquiredConns()) }),
+			newPoolStat("review_assigner_db_pool_idle_conns", "Connections currently idle.", gauge,
+				func(s *pgxpool.Stat) float64 { return float64(s.IdleConns()) }),
+			newPoolStat("review_assigner_db_pool_constructing_conns", "Connections being established.", gauge,
+				func(s *pgxpool.Stat) float64 { return float64(s.ConstructingConns()) }),
+			newPoolStat("review_assigner_db_pool_max_conns", "Maximum size of the pool.", gauge,
+				func(s *pgxpool.Stat) float64 { return float64(s.MaxConns()) }),
+			newPoolStat("review_assigner_db_pool_acquires_total", "Successful connection acquires.", counter,
+				func(s *pgxpool.Stat) float64 { return float64(s.AcquireCount()) }),
+			newPoolStat("review_assigner_db_pool_acquire_duration_seconds_total", "Time spent acquiring connections.",
+				counter, func(s *pgxpool.Stat) float64 { return s.AcquireDuration().Seconds() }),
+			newPoolStat("review_assigner_db_pool_empty_acquires_total", "Acquires that had to wait for a connection.",
+				counter, func(s *pgxpool.Stat) float64 { return float64(s.EmptyAcquireCount()) }),
+			newPoolStat("review_assigner_db_pool_canceled_acquires_total", "Acquires canceled by their context.",
+				counter, func(s *pgxpool.Stat) float64 { return float64(s.CanceledAcquireCount()) }),
+			newPoolStat("review_assigner_db_pool_new_conns_total", "Connections opened.", counter,
+				func(s *pgxpool.Stat) float64 { return float64(s.NewConnsCount()) }),
+			newPoolStat("review_assigner_db_pool_max_lifetime_destroys_total",
+				"Connections closed for exceeding the max lifetime.", counter,
+				func(s *pgxpool.Stat) float64 { return float64(s.MaxLifetimeDestroyCount()) }),
+			newPoolStat("review_assigner_db_pool_max_idle_destroys_total",
+				"Connections closed for exceeding the max idle time.", counter,
+				func(s *pgxpool.Stat) float64 { return float64(s.MaxIdleDestroyCount()) }),
+		},
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (p *Pool) Describe(ch chan<- *prometheus.Desc) {
+	for _, stat := range p.stats {
+		ch <- stat.desc
+	}
+}
+
+// Collect implements prometheus.Collector, every value comes from one snapshot of the pool.
+func (p *Pool) Collect(ch chan<- prometheus.Metric) {
+	snapshot := p.pool.Stat()
+	for _, stat := range p.stats {
+		ch <- prometheus.MustNewConstMetric(stat.desc, stat.valueType, stat.value(snapshot))
+	}
+}
@@ -0,0 +1,31 @@
+// Package notify provides notification sinks used to deliver messages to users.
+package notify
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// LogSink is the default sink, it writes notifications to the service log.
+type LogSink struct {
+	logger *zap.Logger
+}
+
+func NewLogSink(logger *zap.Logger) *LogSink {
+	return &LogSink{
+		logger: logger,
+	}
+}
+
+func (s *LogSink) Send(_ context.Context, notification domain.Notification) error {
+	s.logger.Info("notification",
+		zap.String("user_id", notification.UserID),
+		zap.String("kind", string(notification.Kind)),
+		zap.String("text", notification.Text),
+		zap.Strings("pull_request_ids", notification.PullRequestIDs),
+	)
+	return nil
+}
@@ -0,0 +1,21 @@
+package db_repo
+
+import (
+	"context"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// AddUserAbsence records a period the user is away. Absences may overlap, the user is absent
+// while any of them covers the current time.
+func (r *Repo) AddUserAbsence(ctx context.Context, absence domain.UserAbsence) error {
+	const query = `
+	INSERT INTO user_absences (user_id, starts_at, ends_at, comment)
+	VALUES ($1, $2, $3, NULLIF($4, ''));`
+
+	_, err := r.conn.Exec(ctx, query, absence.UserID, absence.From, absence.Until, absence.Comment)
+	if isForeignKeyViolation(err) {
+		return domain.ErrUserNotFound
+	}
+	return err
+}
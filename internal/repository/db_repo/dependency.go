@@ -0,0 +1,197 @@
+package db_repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// dependenciesLockKey serializes the writers of pull_request_dependencies, so that two concurrent
+// changes cannot close a cycle that neither of them sees.
+const dependenciesLockKey = "pull_request_dependencies"
+
+// SetPullRequestDependencies replaces the PRs the PR is stacked on and records the change.
+// Merged and closed PRs cannot be restacked.
+func (r *Repo) SetPullRequestDependencies(ctx context.Context, request domain.SetDependencies,
+) (domain.PullRequest, error) {
+	const (
+		lockQuery   = `SELECT status FROM pull_requests WHERE id = $1 FOR UPDATE;`
+		deleteQuery = `DELETE FROM pull_request_dependencies WHERE pull_request_id = $1;`
+	)
+
+	var pr domain.PullRequest
+
+	err := r.InTx(ctx, func(tx pgx.Tx) error {
+		var status domain.PRStatus
+		if err := tx.QueryRow(ctx, lockQuery, request.PullRequestID).Scan(&status); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrPRNotFound
+			}
+			return err
+		}
+		if status == domain.PRStatusMerged || status == domain.PRStatusClosed {
+			return domain.ErrPRNotOpen
+		}
+
+		previous, err := r.getDependencies(ctx, tx, request.PullRequestID)
+		if err != nil {
+			return fmt.Errorf("r.getDependencies: %w", err)
+		}
+		previousIDs := make([]string, len(previous))
+		for i, dependency := range previous {
+			previousIDs[i] = dependency.PullRequestID
+		}
+
+		if _, err = tx.Exec(ctx, deleteQuery, request.PullRequestID); err != nil {
+			return err
+		}
+
+		if err = r.addDependencies(ctx, tx, request.PullRequestID, request.DependsOn); err != nil {
+			return fmt.Errorf("r.addDependencies: %w", err)
+		}
+
+		if err = r.addEvents(ctx, tx, []domain.PullRequestEvent{{
+			PullRequestID: request.PullRequestID,
+			Type:          domain.EventDependenciesChanged,
+			ActorID:       request.ActorID,
+			Details: map[string]any{
+				"previous":   previousIDs,
+				"depends_on": request.DependsOn,
+			},
+		}}); err != nil {
+			return fmt.Errorf("r.addEvents: %w", err)
+		}
+
+		pr, err = r.getPullRequest(ctx, tx, request.PullRequestID)
+		if err != nil {
+			return fmt.Errorf("r.getPullRequest: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	return pr, nil
+}
+
+// addDependencies stacks the PR on dependsOn. It fails with ErrDependencyCycle when the PR is
+// reachable from one of dependsOn and with ErrDependencyNotFound when one of them does not exist.
+func (r *Repo) addDependencies(ctx context.Context, tx pgx.Tx, pullRequestID string, dependsOn []string) error {
+	const (
+		lockQuery = `SELECT pg_advisory_xact_lock(hashtext($1));`
+
+		cycleQuery = `
+		WITH RECURSIVE reachable (id) AS (
+			SELECT unnest($2::text[])
+			UNION
+			SELECT d.depends_on_id FROM pull_request_dependencies d
+			JOIN reachable r ON r.id = d.pull_request_id
+		)
+		SELECT EXISTS (SELECT 1 FROM reachable WHERE id = $1);`
+
+		insertQuery = `
+		INSERT INTO pull_request_dependencies (pull_request_id, depends_on_id, created_at)
+		SELECT $1, unnest($2::text[]), $3;`
+	)
+
+	if len(dependsOn) == 0 {
+		return nil
+	}
+
+	var db DBTX = r.conn
+	if tx != nil {
+		db = tx
+	}
+
+	if _, err := db.Exec(ctx, lockQuery, dependenciesLockKey); err != nil {
+		return err
+	}
+
+	var cycle bool
+	if err := db.QueryRow(ctx, cycleQuery, pullRequestID, dependsOn).Scan(&cycle); err != nil {
+		return err
+	}
+	if cycle {
+		return domain.ErrDependencyCycle
+	}
+
+	if _, err := db.Exec(ctx, insertQuery, pullRequestID, dependsOn, time.Now()); err != nil {
+		if isForeignKeyViolation(err) {
+			return domain.ErrDependencyNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repo) getDependencies(ctx context.Context, db DBTX, pullRequestID string,
+) ([]domain.PullRequestDependency, error) {
+	const query = `
+	SELECT d.depends_on_id, p.status
+	FROM pull_request_dependencies d
+	JOIN pull_requests p ON p.id = d.depends_on_id
+	WHERE d.pull_request_id = $1
+	ORDER BY d.created_at, d.depends_on_id;`
+
+	rows, err := db.Query(ctx, query, pullRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	dependencies, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.PullRequestDependency, error) {
+		var dependency domain.PullRequestDependency
+		err := row.Scan(&dependency.PullRequestID, &dependency.Status)
+		return dependency, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(dependencies) == 0 {
+		return nil, nil
+	}
+
+	return dependencies, nil
+}
+
+// ListDependents returns the draft and open PRs stacked directly on the PR.
+func (r *Repo) ListDependents(ctx context.Context, pullRequestID string) ([]string, error) {
+	const query = `
+	SELECT d.pull_request_id
+	FROM pull_request_dependencies d
+	JOIN pull_requests p ON p.id = d.pull_request_id
+	WHERE d.depends_on_id = $1 AND p.status IN ('DRAFT', 'OPEN')
+	ORDER BY d.pull_request_id;`
+
+	rows, err := r.conn.Query(ctx, query, pullRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+// GetStackReviewers returns the current reviewers of the given PRs, shadows excluded, in the order
+// they were first assigned.
+func (r *Repo) GetStackReviewers(ctx context.Context, pullRequestIDs []string) ([]string, error) {
+	const query = `
+	SELECT user_id
+	FROM reviewers
+	WHERE pull_request_id = ANY($1) AND is_current AND role = 'REVIEWER'
+	GROUP BY user_id
+	ORDER BY MIN(id);`
+
+	rows, err := r.conn.Query(ctx, query, pullRequestIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
@@ -14,3 +14,11 @@ func isUniqueViolation(err error) bool {
 	}
 	return false
 }
+
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == pgerrcode.ForeignKeyViolation
+	}
+	return false
+}
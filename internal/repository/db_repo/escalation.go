@@ -0,0 +1,129 @@
+package db_repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// ListPendingReviews returns current reviewers of open PRs without a verdict, requested no later than
+// assignedBefore. Reviews of PRs that are already escalated are left out.
+func (r *Repo) ListPendingReviews(ctx context.Context, assignedBefore time.Time) ([]domain.PendingReview, error) {
+	const query = `
+	SELECT p.id, p.name, p.author_id, t.name, r.user_id, COALESCE(r.re_requested_at, r.assigned_at)
+	FROM reviewers r
+	JOIN pull_requests p ON p.id = r.pull_request_id
+	JOIN users a ON a.id = p.author_id
+	JOIN teams t ON t.id = a.team_id
+	WHERE r.is_current AND r.verdict IS NULL AND r.role = 'REVIEWER'
+		AND r.escalated_at IS NULL AND p.status = 'OPEN' AND p.escalated_at IS NULL
+		AND COALESCE(r.re_requested_at, r.assigned_at) <= $1
+	ORDER BY COALESCE(r.re_requested_at, r.assigned_at), p.id, r.user_id;`
+
+	rows, err := r.conn.Query(ctx, query, assignedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviews := []domain.PendingReview{}
+	for rows.Next() {
+		var review domain.PendingReview
+
+		if err := rows.Scan(&review.PullRequestID, &review.PullRequestName, &review.AuthorID, &review.TeamName,
+			&review.UserID, &review.AssignedAt); err != nil {
+			return nil, err
+		}
+
+		reviews = append(reviews, review)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reviews, nil
+}
+
+// EscalateReview claims the PR of the pending review and applies the escalation. The claim locks
+// the PR row, so a PR is escalated once even when several of its reviews breach the SLA. PRs locked
+// by another replica or already escalated are skipped, in which case false is returned and nothing
+// changes.
+func (r *Repo) EscalateReview(ctx context.Context, escalation domain.Escalation) (bool, error) {
+	const (
+		claimQuery = `
+		SELECT r.id FROM pull_requests p
+		JOIN reviewers r ON r.pull_request_id = p.id
+		WHERE p.id = $1 AND p.status = 'OPEN' AND p.escalated_at IS NULL
+			AND r.user_id = $2 AND r.is_current AND r.verdict IS NULL AND r.escalated_at IS NULL
+		FOR UPDATE OF p SKIP LOCKED;`
+
+		escalatePullRequestQuery = `UPDATE pull_requests SET escalated_at = $2 WHERE id = $1;`
+
+		escalateQuery = `
+		UPDATE reviewers SET escalated_at = $2,
+			is_current = NOT $3, replaced_at = CASE WHEN $3 THEN $2 ELSE replaced_at END
+		WHERE id = $1;`
+	)
+
+	review := escalation.Review
+	claimed := false
+
+	err := r.InTx(ctx, func(tx pgx.Tx) error {
+		var reviewerID int64
+		if err := tx.QueryRow(ctx, claimQuery, review.PullRequestID, review.UserID).Scan(&reviewerID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+
+		now := time.Now()
+		release := escalation.Action == domain.EscalationReassign && len(escalation.AddReviewers) > 0
+
+		if _, err := tx.Exec(ctx, escalatePullRequestQuery, review.PullRequestID, now); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, escalateQuery, reviewerID, now, release); err != nil {
+			return err
+		}
+
+		if err := r.addReviewers(ctx, tx, review.PullRequestID, escalation.AddReviewers, now); err != nil {
+			return fmt.Errorf("r.addReviewers: %w", err)
+		}
+
+		added := make([]string, len(escalation.AddReviewers))
+		for i, reviewer := range escalation.AddReviewers {
+			added[i] = reviewer.UserID
+		}
+
+		if err := r.addEvents(ctx, tx, []domain.PullRequestEvent{{
+			PullRequestID: review.PullRequestID,
+			Type:          domain.EventEscalated,
+			Details: map[string]any{
+				"reviewer":        review.UserID,
+				"action":          escalation.Action,
+				"assigned_at":     review.AssignedAt,
+				"deadline":        escalation.Deadline,
+				"added_reviewers": added,
+			},
+			CreatedAt: now,
+		}}); err != nil {
+			return fmt.Errorf("r.addEvents: %w", err)
+		}
+
+		claimed = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return claimed, nil
+}
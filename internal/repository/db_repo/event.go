@@ -0,0 +1,71 @@
+package db_repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func (r *Repo) addEvents(ctx context.Context, tx pgx.Tx, events []domain.PullRequestEvent) error {
+	const query = `
+	INSERT INTO pull_request_events (pull_request_id, event_type, actor_id, details, created_at)
+	VALUES ($1, $2, NULLIF($3, ''), $4, $5);`
+
+	var db DBTX = r.conn
+	if tx != nil {
+		db = tx
+	}
+
+	for _, event := range events {
+		createdAt := event.CreatedAt
+		if createdAt.IsZero() {
+			createdAt = time.Now()
+		}
+		details := event.Details
+		if details == nil {
+			details = map[string]any{}
+		}
+
+		if _, err := db.Exec(ctx, query, event.PullRequestID, event.Type, event.ActorID, details,
+			createdAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Repo) ListPullRequestEvents(ctx context.Context, pullRequestID string) ([]domain.PullRequestEvent, error) {
+	const query = `
+	SELECT pull_request_id, event_type, COALESCE(actor_id, ''), details, created_at
+	FROM pull_request_events
+	WHERE pull_request_id = $1
+	ORDER BY created_at, id;`
+
+	rows, err := r.conn.Query(ctx, query, pullRequestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []domain.PullRequestEvent{}
+	for rows.Next() {
+		var event domain.PullRequestEvent
+
+		if err := rows.Scan(&event.PullRequestID, &event.Type, &event.ActorID, &event.Details,
+			&event.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
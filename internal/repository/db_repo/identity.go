@@ -0,0 +1,43 @@
+package db_repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// SetUserIdentity maps the code host account to the user, replacing an earlier mapping of the
+// account.
+func (r *Repo) SetUserIdentity(ctx context.Context, identity domain.UserIdentity) error {
+	const query = `
+	INSERT INTO user_identities (provider, external_id, user_id)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (provider, external_id) DO UPDATE
+	SET user_id = EXCLUDED.user_id, updated_at = NOW();`
+
+	_, err := r.conn.Exec(ctx, query, identity.Provider, identity.ExternalID, identity.UserID)
+	if isForeignKeyViolation(err) {
+		return domain.ErrUserNotFound
+	}
+	return err
+}
+
+// GetUserIDByIdentity returns the user the code host account is mapped to, ErrUserNotFound when
+// the account is not mapped.
+func (r *Repo) GetUserIDByIdentity(ctx context.Context, provider domain.WebhookProvider, externalID string,
+) (string, error) {
+	const query = `SELECT user_id FROM user_identities WHERE provider = $1 AND external_id = $2;`
+
+	var userID string
+	if err := r.conn.QueryRow(ctx, query, provider, externalID).Scan(&userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", domain.ErrUserNotFound
+		}
+		return "", err
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,38 @@
+package db_repo
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// GetReviewCounts counts per author, repository and reviewer the PRs reviewed since filter.Since.
+// Shadows do not count, neither do assignments that were replaced without a verdict.
+func (r *Repo) GetReviewCounts(ctx context.Context, filter domain.KnowledgeFilter) ([]domain.ReviewCount, error) {
+	const query = `
+	SELECT pr.author_id, COALESCE(pr.repository, ''), r.user_id, COUNT(DISTINCT r.pull_request_id)
+	FROM reviewers r
+	JOIN pull_requests pr ON pr.id = r.pull_request_id
+	JOIN users u ON u.id = pr.author_id
+	JOIN teams t ON t.id = u.team_id
+	WHERE r.role = 'REVIEWER' AND r.assigned_at >= $1
+		AND (r.is_current OR EXISTS (
+			SELECT 1 FROM review_verdicts v
+			WHERE v.pull_request_id = r.pull_request_id AND v.user_id = r.user_id))
+		AND ($2 = '' OR t.name = $2) AND ($3 = '' OR pr.repository = $3) AND ($4 = '' OR pr.author_id = $4)
+	GROUP BY pr.author_id, pr.repository, r.user_id
+	ORDER BY pr.author_id, pr.repository, r.user_id;`
+
+	rows, err := r.conn.Query(ctx, query, filter.Since, filter.TeamName, filter.Repository, filter.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.ReviewCount, error) {
+		var count domain.ReviewCount
+		err := row.Scan(&count.AuthorID, &count.Repository, &count.ReviewerID, &count.Reviews)
+		return count, err
+	})
+}
@@ -0,0 +1,152 @@
+package db_repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// AddTeamPolicy stores the policy as the next version of the team policy and makes it active.
+func (r *Repo) AddTeamPolicy(ctx context.Context, policy domain.TeamPolicyDTO) (domain.TeamPolicy, error) {
+	const (
+		lockTeamQuery = `SELECT id FROM teams WHERE name = $1 FOR UPDATE;`
+		insertQuery   = `
+		INSERT INTO team_policies (team_id, version, format, document, policy, created_at)
+		SELECT $1, COALESCE(MAX(version), 0) + 1, $2, $3, $4, $5 FROM team_policies WHERE team_id = $1
+		RETURNING version;`
+		activateQuery = `UPDATE teams SET policy_version = $2, updated_at = $3 WHERE id = $1;`
+	)
+
+	now := time.Now()
+	stored := domain.TeamPolicy{
+		TeamName:  policy.TeamName,
+		Active:    true,
+		Format:    policy.Format,
+		CreatedAt: now,
+		Policy:    policy.Policy,
+		Document:  policy.Document,
+	}
+
+	err := r.InTx(ctx, func(tx pgx.Tx) error {
+		var teamID int64
+		if err := tx.QueryRow(ctx, lockTeamQuery, policy.TeamName).Scan(&teamID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrTeamNotFound
+			}
+			return fmt.Errorf("lock team: %w", err)
+		}
+
+		err := tx.QueryRow(ctx, insertQuery, teamID, policy.Format, policy.Document, policy.Policy, now).
+			Scan(&stored.Version)
+		if err != nil {
+			return fmt.Errorf("insert policy: %w", err)
+		}
+
+		if _, err = tx.Exec(ctx, activateQuery, teamID, stored.Version, now); err != nil {
+			return fmt.Errorf("activate policy: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return domain.TeamPolicy{}, err
+	}
+
+	return stored, nil
+}
+
+// GetTeamPolicy returns the given policy version of the team, version 0 means the active one.
+func (r *Repo) GetTeamPolicy(ctx context.Context, teamName string, version int) (domain.TeamPolicy, error) {
+	const query = `
+	SELECT p.version, p.format, p.document, p.policy, p.created_at, p.version = t.policy_version
+	FROM teams t
+	JOIN team_policies p ON p.team_id = t.id
+	WHERE t.name = $1 AND p.version = COALESCE(NULLIF($2::int, 0), t.policy_version);`
+
+	policy := domain.TeamPolicy{TeamName: teamName}
+
+	err := r.conn.QueryRow(ctx, query, teamName, version).Scan(&policy.Version, &policy.Format,
+		&policy.Document, &policy.Policy, &policy.CreatedAt, &policy.Active)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.TeamPolicy{}, domain.ErrPolicyNotFound
+		}
+		return domain.TeamPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+func (r *Repo) ListTeamPolicies(ctx context.Context, teamName string) ([]domain.TeamPolicy, error) {
+	const query = `
+	SELECT p.version, p.format, p.policy, p.created_at, p.version IS NOT DISTINCT FROM t.policy_version
+	FROM teams t
+	JOIN team_policies p ON p.team_id = t.id
+	WHERE t.name = $1
+	ORDER BY p.version DESC;`
+
+	rows, err := r.conn.Query(ctx, query, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var policies []domain.TeamPolicy
+	for rows.Next() {
+		policy := domain.TeamPolicy{TeamName: teamName}
+
+		if err := rows.Scan(&policy.Version, &policy.Format, &policy.Policy, &policy.CreatedAt,
+			&policy.Active); err != nil {
+			return nil, err
+		}
+
+		policies = append(policies, policy)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(policies) == 0 {
+		return nil, domain.ErrPolicyNotFound
+	}
+
+	return policies, nil
+}
+
+// ActivateTeamPolicy makes an already stored policy version the active one.
+func (r *Repo) ActivateTeamPolicy(ctx context.Context, teamName string, version int) (domain.TeamPolicy, error) {
+	const query = `
+	UPDATE teams t SET policy_version = $2, updated_at = $3
+	WHERE t.name = $1
+		AND EXISTS (SELECT 1 FROM team_policies p WHERE p.team_id = t.id AND p.version = $2);`
+
+	tag, err := r.conn.Exec(ctx, query, teamName, version, time.Now())
+	if err != nil {
+		return domain.TeamPolicy{}, err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.TeamPolicy{}, domain.ErrPolicyNotFound
+	}
+
+	return r.GetTeamPolicy(ctx, teamName, version)
+}
+
+// FindMissingTeams returns the names which do not belong to any team.
+func (r *Repo) FindMissingTeams(ctx context.Context, teamNames []string) ([]string, error) {
+	const query = `
+	SELECT n.name FROM unnest($1::text[]) AS n(name)
+	WHERE NOT EXISTS (SELECT 1 FROM teams t WHERE t.name = n.name);`
+
+	rows, err := r.conn.Query(ctx, query, teamNames)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
@@ -0,0 +1,451 @@
+package db_repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+const candidatesQuery = `
+	SELECT u.id, u.username, t.name, u.is_active, u.is_trainee, u.skills,
+		(SELECT COUNT(*) FROM reviewers r
+		JOIN pull_requests p ON p.id = r.pull_request_id
+		WHERE r.user_id = u.id AND r.is_current AND r.role = 'REVIEWER' AND p.status = 'OPEN') AS open_reviews,
+		(SELECT COUNT(*) FROM reviewers r
+		JOIN pull_requests p ON p.id = r.pull_request_id
+		WHERE r.user_id = u.id AND r.is_current AND r.role = 'SHADOW' AND p.status = 'OPEN') AS open_shadows,
+		(SELECT MAX(r.assigned_at) FROM reviewers r
+		WHERE r.user_id = u.id AND r.role = 'REVIEWER') AS last_assigned_at,
+		EXISTS (SELECT 1 FROM user_absences ab
+		WHERE ab.user_id = u.id AND ab.starts_at <= NOW() AND NOW() < ab.ends_at) AS is_absent
+	FROM users u
+	JOIN teams t ON t.id = u.team_id`
+
+func (r *Repo) GetAssignmentPool(ctx context.Context, authorID string) (domain.AssignmentPool, error) {
+	const query = `
+	SELECT t.id, t.name FROM users a
+	JOIN teams t ON t.id = a.team_id
+	WHERE a.id = $1;`
+
+	var teamID int64
+	pool := domain.AssignmentPool{AuthorID: authorID}
+
+	err := r.conn.QueryRow(ctx, query, authorID).Scan(&teamID, &pool.TeamName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.AssignmentPool{}, domain.ErrAuthorNotFound
+		}
+		return domain.AssignmentPool{}, err
+	}
+
+	pool.Candidates, err = r.getCandidates(ctx, teamID)
+	if err != nil {
+		return domain.AssignmentPool{}, err
+	}
+
+	return pool, nil
+}
+
+func (r *Repo) GetTeamCandidates(ctx context.Context, teamName string) ([]domain.Candidate, error) {
+	const query = `SELECT id FROM teams WHERE name = $1;`
+
+	var teamID int64
+	err := r.conn.QueryRow(ctx, query, teamName).Scan(&teamID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	return r.getCandidates(ctx, teamID)
+}
+
+// GetCandidates returns the given users regardless of their team, unknown ids are skipped.
+func (r *Repo) GetCandidates(ctx context.Context, userIDs []string) ([]domain.Candidate, error) {
+	return r.queryCandidates(ctx, candidatesQuery+" WHERE u.id = ANY($1) ORDER BY u.id;", userIDs)
+}
+
+func (r *Repo) getCandidates(ctx context.Context, teamID int64) ([]domain.Candidate, error) {
+	return r.queryCandidates(ctx, candidatesQuery+" WHERE u.team_id = $1 ORDER BY u.id;", teamID)
+}
+
+func (r *Repo) queryCandidates(ctx context.Context, query string, args ...any) ([]domain.Candidate, error) {
+	rows, err := r.conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var candidates []domain.Candidate
+	for rows.Next() {
+		var candidate domain.Candidate
+
+		if err := rows.Scan(&candidate.UserID, &candidate.Username, &candidate.TeamName, &candidate.IsActive,
+			&candidate.IsTrainee, &candidate.Skills, &candidate.OpenReviews, &candidate.OpenShadows,
+			&candidate.LastAssignedAt, &candidate.IsAbsent); err != nil {
+			return nil, err
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return candidates, nil
+}
+
+func (r *Repo) PullRequestExists(ctx context.Context, pullRequestID string) (bool, error) {
+	const query = `SELECT EXISTS (SELECT 1 FROM pull_requests WHERE id = $1);`
+
+	var exists bool
+	if err := r.conn.QueryRow(ctx, query, pullRequestID).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *Repo) CreatePullRequest(ctx context.Context, pr domain.PullRequestDTO) (domain.PullRequest, error) {
+	var (
+		createdAt    time.Time
+		dependencies []domain.PullRequestDependency
+	)
+
+	err := r.InTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		createdAt, err = r.addPullRequest(ctx, tx, pr)
+		if err != nil {
+			return fmt.Errorf("r.addPullRequest: %w", err)
+		}
+
+		err = r.addReviewers(ctx, tx, pr.PullRequestID, pr.Reviewers, createdAt)
+		if err != nil {
+			return fmt.Errorf("r.addReviewers: %w", err)
+		}
+
+		if err = r.addDependencies(ctx, tx, pr.PullRequestID, pr.DependsOn); err != nil {
+			return fmt.Errorf("r.addDependencies: %w", err)
+		}
+
+		dependencies, err = r.getDependencies(ctx, tx, pr.PullRequestID)
+		if err != nil {
+			return fmt.Errorf("r.getDependencies: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	return domain.PullRequest{
+		PullRequestID:     pr.PullRequestID,
+		PullRequestName:   pr.PullRequestName,
+		AuthorID:          pr.AuthorID,
+		Status:            pr.Status,
+		AssignedReviewers: assignedReviewers(pr.Reviewers),
+		Reviewers:         pr.Reviewers,
+		Repository:        pr.Repository,
+		SourceBranch:      pr.SourceBranch,
+		TargetBranch:      pr.TargetBranch,
+		URL:               pr.URL,
+		Labels:            pr.Labels,
+		HeadSHA:           pr.HeadSHA,
+		CreatedAt:         &createdAt,
+		DependsOn:         dependencies,
+	}, nil
+}
+
+func (r *Repo) addPullRequest(ctx context.Context, tx pgx.Tx, pr domain.PullRequestDTO) (time.Time, error) {
+	const query = `
+	INSERT INTO pull_requests (id, name, author_id, status, repository, source_branch, target_branch, url,
+		labels, head_sha, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, NULLIF($5, ''), NULLIF($6, ''), NULLIF($7, ''), NULLIF($8, ''), $9,
+		NULLIF($10, ''), $11, $11);`
+
+	now := time.Now()
+
+	var db DBTX = r.conn
+	if tx != nil {
+		db = tx
+	}
+
+	labels := pr.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+
+	_, err := db.Exec(ctx, query, pr.PullRequestID, pr.PullRequestName, pr.AuthorID, pr.Status, pr.Repository,
+		pr.SourceBranch, pr.TargetBranch, pr.URL, labels, pr.HeadSHA, now)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return time.Time{}, domain.ErrPRExists
+		}
+		if isForeignKeyViolation(err) {
+			return time.Time{}, domain.ErrAuthorNotFound
+		}
+		return time.Time{}, err
+	}
+
+	return now, nil
+}
+
+func (r *Repo) addReviewers(ctx context.Context, tx pgx.Tx, pullRequestID string, reviewers []domain.Reviewer,
+	assignedAt time.Time,
+) error {
+	if len(reviewers) == 0 {
+		return nil
+	}
+
+	const colsNum = 6
+	var sb strings.Builder
+	args := make([]any, 0, len(reviewers)*colsNum)
+
+	sb.WriteString("INSERT INTO reviewers (pull_request_id, user_id, assigned_at, role, source, deputy_for) VALUES ")
+
+	for i, reviewer := range reviewers {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		paramOffset := i*colsNum + 1
+		sb.WriteString(fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, NULLIF($%d, ''))", paramOffset, paramOffset+1,
+			paramOffset+2, paramOffset+3, paramOffset+4, paramOffset+5))
+
+		args = append(args, pullRequestID, reviewer.UserID, assignedAt, reviewer.Role, reviewer.Source,
+			reviewer.DeputyFor)
+	}
+
+	var db DBTX = r.conn
+	if tx != nil {
+		db = tx
+	}
+
+	_, err := db.Exec(ctx, sb.String(), args...)
+	return err
+}
+
+func (r *Repo) GetPullRequest(ctx context.Context, pullRequestID string) (domain.PullRequest, error) {
+	return r.getPullRequest(ctx, r.conn, pullRequestID)
+}
+
+func (r *Repo) getPullRequest(ctx context.Context, db DBTX, pullRequestID string) (domain.PullRequest, error) {
+	query := `SELECT ` + pullRequestColumns + ` FROM pull_requests WHERE id = $1;`
+
+	pr, err := scanPullRequest(db.QueryRow(ctx, query, pullRequestID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PullRequest{}, domain.ErrPRNotFound
+		}
+		return domain.PullRequest{}, err
+	}
+
+	pr.Reviewers, err = r.getReviewers(ctx, db, pullRequestID)
+	if err != nil {
+		return domain.PullRequest{}, fmt.Errorf("r.getReviewers: %w", err)
+	}
+
+	pr.AssignedReviewers = assignedReviewers(pr.Reviewers)
+
+	pr.DependsOn, err = r.getDependencies(ctx, db, pullRequestID)
+	if err != nil {
+		return domain.PullRequest{}, fmt.Errorf("r.getDependencies: %w", err)
+	}
+
+	return pr, nil
+}
+
+const pullRequestColumns = `id, name, author_id, status, COALESCE(repository, ''), COALESCE(source_branch, ''),
+	COALESCE(target_branch, ''), COALESCE(url, ''), labels, COALESCE(head_sha, ''), created_at, merged_at, closed_at`
+
+func scanPullRequest(row pgx.Row) (domain.PullRequest, error) {
+	var pr domain.PullRequest
+
+	err := row.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status, &pr.Repository,
+		&pr.SourceBranch, &pr.TargetBranch, &pr.URL, &pr.Labels, &pr.HeadSHA, &pr.CreatedAt, &pr.MergedAt,
+		&pr.ClosedAt)
+	if len(pr.Labels) == 0 {
+		pr.Labels = nil
+	}
+	return pr, err
+}
+
+// assignedReviewers returns the ids of the reviewers, shadows excluded.
+func assignedReviewers(reviewers []domain.Reviewer) []string {
+	ids := make([]string, 0, len(reviewers))
+	for _, reviewer := range reviewers {
+		if reviewer.Role == domain.ReviewerRoleReviewer {
+			ids = append(ids, reviewer.UserID)
+		}
+	}
+	return ids
+}
+
+func (r *Repo) getReviewers(ctx context.Context, db DBTX, pullRequestID string) ([]domain.Reviewer, error) {
+	reviewers, err := r.getReviewersOf(ctx, db, []string{pullRequestID})
+	if err != nil {
+		return nil, err
+	}
+	if reviewers[pullRequestID] == nil {
+		return []domain.Reviewer{}, nil
+	}
+	return reviewers[pullRequestID], nil
+}
+
+// getReviewersOf returns the current reviewers of several PRs keyed by PR id.
+func (r *Repo) getReviewersOf(ctx context.Context, db DBTX, pullRequestIDs []string,
+) (map[string][]domain.Reviewer, error) {
+	const query = `
+	SELECT pull_request_id, user_id, role, source, COALESCE(deputy_for, ''), COALESCE(verdict, ''), verdict_at,
+		re_request_count, re_requested_at
+	FROM reviewers
+	WHERE pull_request_id = ANY($1) AND is_current
+	ORDER BY id;`
+
+	rows, err := db.Query(ctx, query, pullRequestIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviewers := make(map[string][]domain.Reviewer, len(pullRequestIDs))
+	for rows.Next() {
+		var (
+			pullRequestID string
+			reviewer      domain.Reviewer
+		)
+
+		if err := rows.Scan(&pullRequestID, &reviewer.UserID, &reviewer.Role, &reviewer.Source,
+			&reviewer.DeputyFor, &reviewer.Verdict, &reviewer.VerdictAt, &reviewer.ReRequests,
+			&reviewer.ReRequestedAt); err != nil {
+			return nil, err
+		}
+
+		reviewers[pullRequestID] = append(reviewers[pullRequestID], reviewer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reviewers, nil
+}
+
+// GetPullRequestTeam returns the team of the PR author, whose policy governs the PR.
+func (r *Repo) GetPullRequestTeam(ctx context.Context, pullRequestID string) (string, error) {
+	const query = `
+	SELECT t.name FROM pull_requests p
+	JOIN users u ON u.id = p.author_id
+	JOIN teams t ON t.id = u.team_id
+	WHERE p.id = $1;`
+
+	var teamName string
+	if err := r.conn.QueryRow(ctx, query, pullRequestID).Scan(&teamName); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", domain.ErrPRNotFound
+		}
+		return "", err
+	}
+
+	return teamName, nil
+}
+
+// TransitionPullRequest locks the PR and lets decide pick the update on the locked state.
+// The status, reviewer changes and events are stored together; an update keeping the current
+// status and changing nothing else leaves the PR untouched, so repeated actions are idempotent.
+func (r *Repo) TransitionPullRequest(ctx context.Context, pullRequestID string,
+	decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error),
+) (domain.PullRequest, error) {
+	const (
+		lockQuery = `SELECT 1 FROM pull_requests WHERE id = $1 FOR UPDATE;`
+
+		updateQuery = `
+		UPDATE pull_requests SET status = $2::pr_status, updated_at = $3,
+			merged_at = CASE WHEN $2::pr_status = 'MERGED' THEN $3 ELSE merged_at END,
+			closed_at = CASE WHEN $2::pr_status = 'CLOSED' THEN $3 END
+		WHERE id = $1;`
+	)
+
+	var pr domain.PullRequest
+
+	err := r.InTx(ctx, func(tx pgx.Tx) error {
+		var locked int
+		if err := tx.QueryRow(ctx, lockQuery, pullRequestID).Scan(&locked); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrPRNotFound
+			}
+			return err
+		}
+
+		var err error
+		pr, err = r.getPullRequest(ctx, tx, pullRequestID)
+		if err != nil {
+			return fmt.Errorf("r.getPullRequest: %w", err)
+		}
+
+		update, err := decide(pr)
+		if err != nil {
+			return err
+		}
+		if update.Status == pr.Status && !update.ReleaseReviewers && len(update.AddReviewers) == 0 &&
+			len(update.Events) == 0 {
+			return nil
+		}
+
+		now := time.Now()
+		if update.Status != pr.Status {
+			if _, err = tx.Exec(ctx, updateQuery, pullRequestID, update.Status, now); err != nil {
+				return err
+			}
+		}
+
+		if update.ReleaseReviewers {
+			if err = r.releaseReviewers(ctx, tx, pullRequestID, now); err != nil {
+				return fmt.Errorf("r.releaseReviewers: %w", err)
+			}
+		}
+
+		if err = r.addReviewers(ctx, tx, pullRequestID, update.AddReviewers, now); err != nil {
+			return fmt.Errorf("r.addReviewers: %w", err)
+		}
+
+		if err = r.addEvents(ctx, tx, update.Events); err != nil {
+			return fmt.Errorf("r.addEvents: %w", err)
+		}
+
+		pr, err = r.getPullRequest(ctx, tx, pullRequestID)
+		if err != nil {
+			return fmt.Errorf("r.getPullRequest: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	return pr, nil
+}
+
+// releaseReviewers unassigns the current reviewers of the PR, keeping their rows as history.
+func (r *Repo) releaseReviewers(ctx context.Context, tx pgx.Tx, pullRequestID string, releasedAt time.Time) error {
+	const query = `
+	UPDATE reviewers SET is_current = false, replaced_at = $2
+	WHERE pull_request_id = $1 AND is_current;`
+
+	var db DBTX = r.conn
+	if tx != nil {
+		db = tx
+	}
+
+	_, err := db.Exec(ctx, query, pullRequestID, releasedAt)
+	return err
+}
@@ -0,0 +1,106 @@
+package db_repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// ListPullRequests returns the PRs matching the filter with their current reviewers, ordered by
+// (created_at, id) descending. The order is total, so keyset pagination never skips nor repeats a PR.
+func (r *Repo) ListPullRequests(ctx context.Context, filter domain.PullRequestFilter) ([]domain.PullRequest, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+	where := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			statuses[i] = string(status)
+		}
+		where("status = ANY($%d::text[]::pr_status[])", statuses)
+	}
+	if filter.AuthorID != "" {
+		where("author_id = $%d", filter.AuthorID)
+	}
+	if filter.ReviewerID != "" {
+		where(`EXISTS (SELECT 1 FROM reviewers r
+			WHERE r.pull_request_id = pull_requests.id AND r.user_id = $%d AND r.is_current)`, filter.ReviewerID)
+	}
+	if filter.TeamName != "" {
+		where(`author_id IN (SELECT u.id FROM users u
+			JOIN teams t ON t.id = u.team_id WHERE t.name = $%d)`, filter.TeamName)
+	}
+	if filter.Repository != "" {
+		where("repository = $%d", filter.Repository)
+	}
+	if filter.Label != "" {
+		where("labels @> ARRAY[$%d]::text[]", filter.Label)
+	}
+	if filter.CreatedFrom != nil {
+		where("created_at >= $%d", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		where("created_at < $%d", *filter.CreatedTo)
+	}
+	if filter.MergedFrom != nil {
+		where("merged_at >= $%d", *filter.MergedFrom)
+	}
+	if filter.MergedTo != nil {
+		where("merged_at < $%d", *filter.MergedTo)
+	}
+	if filter.After != nil {
+		args = append(args, filter.After.CreatedAt, filter.After.PullRequestID)
+		conditions = append(conditions, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	var sb strings.Builder
+	sb.WriteString("SELECT " + pullRequestColumns + " FROM pull_requests")
+	if len(conditions) > 0 {
+		sb.WriteString(" WHERE ")
+		sb.WriteString(strings.Join(conditions, " AND "))
+	}
+	args = append(args, filter.Limit)
+	sb.WriteString(fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d;", len(args)))
+
+	rows, err := r.conn.Query(ctx, sb.String(), args...)
+	if err != nil {
+		return nil, err
+	}
+
+	prs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.PullRequest, error) {
+		return scanPullRequest(row)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(prs))
+	for i, pr := range prs {
+		ids[i] = pr.PullRequestID
+	}
+
+	reviewers, err := r.getReviewersOf(ctx, r.conn, ids)
+	if err != nil {
+		return nil, fmt.Errorf("r.getReviewersOf: %w", err)
+	}
+
+	for i := range prs {
+		prs[i].Reviewers = reviewers[prs[i].PullRequestID]
+		if prs[i].Reviewers == nil {
+			prs[i].Reviewers = []domain.Reviewer{}
+		}
+		prs[i].AssignedReviewers = assignedReviewers(prs[i].Reviewers)
+	}
+
+	return prs, nil
+}
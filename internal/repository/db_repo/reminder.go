@@ -0,0 +1,161 @@
+package db_repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// StartReminderRun claims the reminder run of the team for the scheduled moment. False is returned
+// when the run was already claimed, by this or another replica.
+func (r *Repo) StartReminderRun(ctx context.Context, teamName string, scheduledFor time.Time,
+) (domain.ReminderRun, bool, error) {
+	const query = `
+	INSERT INTO reminder_runs (team_id, scheduled_for)
+	SELECT id, $2 FROM teams WHERE name = $1
+	ON CONFLICT (team_id, scheduled_for) DO NOTHING
+	RETURNING id, started_at;`
+
+	run := domain.ReminderRun{
+		TeamName:     teamName,
+		ScheduledFor: scheduledFor,
+	}
+
+	if err := r.conn.QueryRow(ctx, query, teamName, scheduledFor).Scan(&run.ID, &run.StartedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ReminderRun{}, false, nil
+		}
+		return domain.ReminderRun{}, false, err
+	}
+
+	return run, true, nil
+}
+
+// FinishReminderRun stores the deliveries of the run and marks it finished.
+func (r *Repo) FinishReminderRun(ctx context.Context, runID int64, deliveries []domain.ReminderDelivery) error {
+	const finishQuery = `
+	UPDATE reminder_runs SET finished_at = $2 WHERE id = $1;`
+
+	return r.InTx(ctx, func(tx pgx.Tx) error {
+		if err := r.addReminderDeliveries(ctx, tx, runID, deliveries); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(ctx, finishQuery, runID, time.Now())
+		return err
+	})
+}
+
+func (r *Repo) addReminderDeliveries(ctx context.Context, tx pgx.Tx, runID int64,
+	deliveries []domain.ReminderDelivery,
+) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	const colsNum = 5
+	var sb strings.Builder
+	args := make([]any, 0, len(deliveries)*colsNum)
+
+	sb.WriteString("INSERT INTO reminder_deliveries (run_id, user_id, pull_request_ids, status, error) VALUES ")
+
+	for i, delivery := range deliveries {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		paramOffset := i*colsNum + 1
+		sb.WriteString(fmt.Sprintf("($%d, $%d, $%d, $%d, NULLIF($%d, ''))", paramOffset, paramOffset+1,
+			paramOffset+2, paramOffset+3, paramOffset+4))
+
+		args = append(args, runID, delivery.UserID, delivery.PullRequestIDs, delivery.Status, delivery.Error)
+	}
+
+	_, err := tx.Exec(ctx, sb.String(), args...)
+	return err
+}
+
+// ListReminderRuns returns the latest runs of the team with their deliveries, newest first.
+func (r *Repo) ListReminderRuns(ctx context.Context, teamName string, limit int) ([]domain.ReminderRun, error) {
+	const (
+		teamQuery = `
+		SELECT id FROM teams WHERE name = $1;`
+
+		runsQuery = `
+		SELECT id, scheduled_for, started_at, finished_at
+		FROM reminder_runs
+		WHERE team_id = $1
+		ORDER BY scheduled_for DESC
+		LIMIT $2;`
+
+		deliveriesQuery = `
+		SELECT run_id, user_id, pull_request_ids, status, COALESCE(error, '')
+		FROM reminder_deliveries
+		WHERE run_id = ANY($1)
+		ORDER BY run_id, user_id;`
+	)
+
+	var teamID int64
+	if err := r.conn.QueryRow(ctx, teamQuery, teamName).Scan(&teamID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTeamNotFound
+		}
+		return nil, err
+	}
+
+	rows, err := r.conn.Query(ctx, runsQuery, teamID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	runs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.ReminderRun, error) {
+		run := domain.ReminderRun{
+			TeamName:   teamName,
+			Deliveries: []domain.ReminderDelivery{},
+		}
+		err := row.Scan(&run.ID, &run.ScheduledFor, &run.StartedAt, &run.FinishedAt)
+		return run, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	runIDs := make([]int64, len(runs))
+	byID := make(map[int64]*domain.ReminderRun, len(runs))
+	for i := range runs {
+		runIDs[i] = runs[i].ID
+		byID[runs[i].ID] = &runs[i]
+	}
+
+	rows, err = r.conn.Query(ctx, deliveriesQuery, runIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			runID    int64
+			delivery domain.ReminderDelivery
+		)
+
+		if err := rows.Scan(&runID, &delivery.UserID, &delivery.PullRequestIDs, &delivery.Status,
+			&delivery.Error); err != nil {
+			return nil, err
+		}
+
+		run := byID[runID]
+		run.Deliveries = append(run.Deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return runs, nil
+}
@@ -0,0 +1,252 @@
+package db_repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// SubmitReview stores the verdict as the reviewer's latest one and appends it to the verdict history.
+// Only open PRs take verdicts.
+func (r *Repo) SubmitReview(ctx context.Context, review domain.SubmitReview) (domain.PullRequest, error) {
+	const (
+		lockQuery = `SELECT status FROM pull_requests WHERE id = $1 FOR UPDATE;`
+
+		updateQuery = `
+		UPDATE reviewers SET verdict = $3, verdict_at = $4
+		WHERE pull_request_id = $1 AND user_id = $2 AND is_current;`
+
+		historyQuery = `
+		INSERT INTO review_verdicts (pull_request_id, user_id, verdict, message, created_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5);`
+	)
+
+	var pr domain.PullRequest
+
+	err := r.InTx(ctx, func(tx pgx.Tx) error {
+		var status domain.PRStatus
+		if err := tx.QueryRow(ctx, lockQuery, review.PullRequestID).Scan(&status); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrPRNotFound
+			}
+			return err
+		}
+		if err := status.Reviewable(); err != nil {
+			return err
+		}
+
+		now := time.Now()
+
+		tag, err := tx.Exec(ctx, updateQuery, review.PullRequestID, review.UserID, review.Verdict, now)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return domain.ErrNotAssigned
+		}
+
+		if _, err = tx.Exec(ctx, historyQuery, review.PullRequestID, review.UserID, review.Verdict,
+			review.Message, now); err != nil {
+			return err
+		}
+
+		pr, err = r.getPullRequest(ctx, tx, review.PullRequestID)
+		if err != nil {
+			return fmt.Errorf("r.getPullRequest: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	return pr, nil
+}
+
+// ReRequestReview resets the verdict of the reviewer to pending and records the re-request. With
+// dismissApprovals the approvals of the other current reviewers are reset as well.
+func (r *Repo) ReRequestReview(ctx context.Context, request domain.ReRequestReview, dismissApprovals bool,
+) (domain.PullRequest, error) {
+	const (
+		lockQuery = `SELECT status FROM pull_requests WHERE id = $1 FOR UPDATE;`
+
+		// The re-requested review gets its SLA again, so the PR can be escalated again.
+		resetEscalationQuery = `UPDATE pull_requests SET escalated_at = NULL WHERE id = $1;`
+
+		reRequestQuery = `
+		UPDATE reviewers SET verdict = NULL, verdict_at = NULL, escalated_at = NULL,
+			re_request_count = re_request_count + 1, re_requested_at = $3
+		WHERE pull_request_id = $1 AND user_id = $2 AND is_current;`
+
+		dismissQuery = `
+		UPDATE reviewers SET verdict = NULL, verdict_at = NULL, escalated_at = NULL, re_requested_at = $3
+		WHERE pull_request_id = $1 AND user_id <> $2 AND is_current AND verdict = 'APPROVED'
+		RETURNING user_id;`
+	)
+
+	var pr domain.PullRequest
+
+	err := r.InTx(ctx, func(tx pgx.Tx) error {
+		var status domain.PRStatus
+		if err := tx.QueryRow(ctx, lockQuery, request.PullRequestID).Scan(&status); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrPRNotFound
+			}
+			return err
+		}
+		if err := status.Reviewable(); err != nil {
+			return err
+		}
+
+		now := time.Now()
+
+		tag, err := tx.Exec(ctx, reRequestQuery, request.PullRequestID, request.UserID, now)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return domain.ErrNotAssigned
+		}
+
+		if _, err = tx.Exec(ctx, resetEscalationQuery, request.PullRequestID); err != nil {
+			return err
+		}
+
+		dismissed := []string{}
+		if dismissApprovals {
+			rows, err := tx.Query(ctx, dismissQuery, request.PullRequestID, request.UserID, now)
+			if err != nil {
+				return err
+			}
+			if dismissed, err = pgx.CollectRows(rows, pgx.RowTo[string]); err != nil {
+				return err
+			}
+		}
+
+		if err = r.addEvents(ctx, tx, []domain.PullRequestEvent{{
+			PullRequestID: request.PullRequestID,
+			Type:          domain.EventReRequested,
+			ActorID:       request.ActorID,
+			Details: map[string]any{
+				"reviewer":            request.UserID,
+				"dismissed_approvals": dismissed,
+			},
+			CreatedAt: now,
+		}}); err != nil {
+			return fmt.Errorf("r.addEvents: %w", err)
+		}
+
+		pr, err = r.getPullRequest(ctx, tx, request.PullRequestID)
+		if err != nil {
+			return fmt.Errorf("r.getPullRequest: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	return pr, nil
+}
+
+// DeclineReview releases the reviewer, assigns the replacement, when there is one, and records
+// the decline.
+func (r *Repo) DeclineReview(ctx context.Context, decline domain.DeclineReview, replacement []domain.Reviewer,
+) (domain.PullRequest, error) {
+	const (
+		lockQuery = `SELECT status FROM pull_requests WHERE id = $1 FOR UPDATE;`
+
+		releaseQuery = `
+		UPDATE reviewers SET is_current = false, replaced_at = $3
+		WHERE pull_request_id = $1 AND user_id = $2 AND is_current;`
+
+		declineQuery = `
+		INSERT INTO review_declines (pull_request_id, user_id, reason, comment, replacement_id, created_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), $6);`
+	)
+
+	var pr domain.PullRequest
+
+	err := r.InTx(ctx, func(tx pgx.Tx) error {
+		var status domain.PRStatus
+		if err := tx.QueryRow(ctx, lockQuery, decline.PullRequestID).Scan(&status); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrPRNotFound
+			}
+			return err
+		}
+		if err := status.Reviewable(); err != nil {
+			return err
+		}
+
+		now := time.Now()
+
+		tag, err := tx.Exec(ctx, releaseQuery, decline.PullRequestID, decline.UserID, now)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return domain.ErrNotAssigned
+		}
+
+		if err = r.addReviewers(ctx, tx, decline.PullRequestID, replacement, now); err != nil {
+			return fmt.Errorf("r.addReviewers: %w", err)
+		}
+
+		var replacementID string
+		if len(replacement) > 0 {
+			replacementID = replacement[0].UserID
+		}
+
+		if _, err = tx.Exec(ctx, declineQuery, decline.PullRequestID, decline.UserID, decline.Reason,
+			decline.Comment, replacementID, now); err != nil {
+			return err
+		}
+
+		if err = r.addEvents(ctx, tx, []domain.PullRequestEvent{{
+			PullRequestID: decline.PullRequestID,
+			Type:          domain.EventDeclined,
+			ActorID:       decline.UserID,
+			Details: map[string]any{
+				"reviewer":    decline.UserID,
+				"reason":      decline.Reason,
+				"replaced_by": replacementID,
+			},
+			CreatedAt: now,
+		}}); err != nil {
+			return fmt.Errorf("r.addEvents: %w", err)
+		}
+
+		pr, err = r.getPullRequest(ctx, tx, decline.PullRequestID)
+		if err != nil {
+			return fmt.Errorf("r.getPullRequest: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	return pr, nil
+}
+
+// ListDeclinedReviewers returns every user who declined to review the PR.
+func (r *Repo) ListDeclinedReviewers(ctx context.Context, pullRequestID string) ([]string, error) {
+	const query = `
+	SELECT DISTINCT user_id FROM review_declines WHERE pull_request_id = $1 ORDER BY user_id;`
+
+	rows, err := r.conn.Query(ctx, query, pullRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
@@ -0,0 +1,83 @@
+package db_repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// GetDeclineStats aggregates declines per user and per reason. The decline rate of a user is the
+// share of their assignments in the range that they declined.
+func (r *Repo) GetDeclineStats(ctx context.Context, filter domain.StatsFilter) (domain.DeclineStats, error) {
+	const (
+		usersQuery = `
+		WITH assigned AS (
+			SELECT user_id, COUNT(*) AS assignments
+			FROM reviewers
+			WHERE role = 'REVIEWER' AND assigned_at >= $1 AND assigned_at < $2
+			GROUP BY user_id
+		), declined AS (
+			SELECT user_id, COUNT(*) AS declines
+			FROM review_declines
+			WHERE created_at >= $1 AND created_at < $2
+			GROUP BY user_id
+		)
+		SELECT u.id, u.username, t.name,
+			COALESCE(a.assignments, 0), COALESCE(d.declines, 0),
+			COALESCE(ROUND(d.declines::numeric / NULLIF(a.assignments, 0), 4), 0)::float8
+		FROM users u
+		JOIN teams t ON t.id = u.team_id
+		LEFT JOIN assigned a ON a.user_id = u.id
+		LEFT JOIN declined d ON d.user_id = u.id
+		WHERE ($3 = '' OR t.name = $3) AND (a.user_id IS NOT NULL OR d.user_id IS NOT NULL)
+		ORDER BY COALESCE(d.declines, 0) DESC, u.id;`
+
+		reasonsQuery = `
+		SELECT d.reason, COUNT(*),
+			ROUND(COUNT(*)::numeric / SUM(COUNT(*)) OVER (), 4)::float8
+		FROM review_declines d
+		JOIN users u ON u.id = d.user_id
+		JOIN teams t ON t.id = u.team_id
+		WHERE d.created_at >= $1 AND d.created_at < $2 AND ($3 = '' OR t.name = $3)
+		GROUP BY d.reason
+		ORDER BY COUNT(*) DESC, d.reason;`
+	)
+
+	stats := domain.DeclineStats{
+		TeamName: filter.TeamName,
+		From:     filter.From,
+		To:       filter.To,
+	}
+
+	rows, err := r.conn.Query(ctx, usersQuery, filter.From, filter.To, filter.TeamName)
+	if err != nil {
+		return domain.DeclineStats{}, err
+	}
+	stats.Users, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.UserDeclineStats, error) {
+		var user domain.UserDeclineStats
+		err := row.Scan(&user.UserID, &user.Username, &user.TeamName, &user.Assignments, &user.Declines,
+			&user.DeclineRate)
+		return user, err
+	})
+	if err != nil {
+		return domain.DeclineStats{}, fmt.Errorf("users: %w", err)
+	}
+
+	rows, err = r.conn.Query(ctx, reasonsQuery, filter.From, filter.To, filter.TeamName)
+	if err != nil {
+		return domain.DeclineStats{}, err
+	}
+	stats.Reasons, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.ReasonDeclineStats, error) {
+		var reason domain.ReasonDeclineStats
+		err := row.Scan(&reason.Reason, &reason.Declines, &reason.Share)
+		return reason, err
+	})
+	if err != nil {
+		return domain.DeclineStats{}, fmt.Errorf("reasons: %w", err)
+	}
+
+	return stats, nil
+}
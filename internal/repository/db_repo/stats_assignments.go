@@ -0,0 +1,150 @@
+package db_repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// userAssignmentsCTE counts per user the assignments started and ended in [$1, $2) and the current
+// load on open PRs.
+const userAssignmentsCTE = `
+	WITH assigned AS (
+		SELECT user_id, COUNT(*) AS assignments
+		FROM reviewers
+		WHERE role = 'REVIEWER' AND assigned_at >= $1 AND assigned_at < $2
+		GROUP BY user_id
+	), replaced AS (
+		SELECT user_id, COUNT(*) AS reassignments
+		FROM reviewers
+		WHERE role = 'REVIEWER' AND replaced_at >= $1 AND replaced_at < $2
+		GROUP BY user_id
+	), open_load AS (
+		SELECT r.user_id, COUNT(*) AS open_reviews
+		FROM reviewers r
+		JOIN pull_requests p ON p.id = r.pull_request_id
+		WHERE r.is_current AND r.role = 'REVIEWER' AND p.status = 'OPEN'
+		GROUP BY r.user_id
+	), member_stats AS (
+		SELECT u.id, u.username, t.name AS team_name, u.is_active,
+			COALESCE(a.assignments, 0) AS assignments,
+			COALESCE(x.reassignments, 0) AS reassignments,
+			COALESCE(l.open_reviews, 0) AS open_reviews
+		FROM users u
+		JOIN teams t ON t.id = u.team_id
+		LEFT JOIN assigned a ON a.user_id = u.id
+		LEFT JOIN replaced x ON x.user_id = u.id
+		LEFT JOIN open_load l ON l.user_id = u.id
+		WHERE $3 = '' OR t.name = $3
+	)`
+
+// GetAssignmentStats aggregates assignments per user and reviewers per PR created in the range,
+// and with StatsGroupByTeam per team as well. Reassignments are assignments that were replaced or
+// released, e.g. on decline, escalation or conversion to draft.
+func (r *Repo) GetAssignmentStats(ctx context.Context, filter domain.StatsFilter, groupBy domain.StatsGroupBy,
+) (domain.AssignmentStats, error) {
+	const (
+		usersQuery = userAssignmentsCTE + `
+		SELECT id, username, team_name, is_active, assignments, reassignments, open_reviews
+		FROM member_stats
+		ORDER BY team_name, id;`
+
+		pullRequestsQuery = `
+		SELECT p.id, t.name, p.status,
+			COUNT(DISTINCT r.user_id),
+			COUNT(r.id) FILTER (WHERE r.is_current),
+			COUNT(r.id) FILTER (WHERE r.replaced_at IS NOT NULL)
+		FROM pull_requests p
+		JOIN users u ON u.id = p.author_id
+		JOIN teams t ON t.id = u.team_id
+		LEFT JOIN reviewers r ON r.pull_request_id = p.id AND r.role = 'REVIEWER'
+		WHERE p.created_at >= $1 AND p.created_at < $2 AND ($3 = '' OR t.name = $3)
+		GROUP BY p.id, t.name
+		ORDER BY p.created_at DESC, p.id DESC;`
+
+		teamsQuery = userAssignmentsCTE + `, team_members AS (
+			SELECT team_name, COUNT(*) AS members, SUM(assignments) AS assignments,
+				SUM(reassignments) AS reassignments, SUM(open_reviews) AS open_reviews
+			FROM member_stats
+			GROUP BY team_name
+		), team_pull_requests AS (
+			SELECT t.name AS team_name, COUNT(*) AS pull_requests, AVG(pr.reviewers) AS reviewers_per_pr
+			FROM (
+				SELECT p.id, p.author_id, COUNT(DISTINCT r.user_id) AS reviewers
+				FROM pull_requests p
+				LEFT JOIN reviewers r ON r.pull_request_id = p.id AND r.role = 'REVIEWER'
+				WHERE p.created_at >= $1 AND p.created_at < $2
+				GROUP BY p.id
+			) pr
+			JOIN users u ON u.id = pr.author_id
+			JOIN teams t ON t.id = u.team_id
+			WHERE $3 = '' OR t.name = $3
+			GROUP BY t.name
+		)
+		SELECT m.team_name, m.members, m.assignments::bigint, m.reassignments::bigint, m.open_reviews::bigint,
+			COALESCE(p.pull_requests, 0),
+			COALESCE(ROUND(p.reviewers_per_pr, 2), 0)::float8,
+			ROUND(m.assignments::numeric / m.members, 2)::float8
+		FROM team_members m
+		LEFT JOIN team_pull_requests p ON p.team_name = m.team_name
+		ORDER BY m.team_name;`
+	)
+
+	stats := domain.AssignmentStats{
+		TeamName: filter.TeamName,
+		From:     filter.From,
+		To:       filter.To,
+	}
+
+	rows, err := r.conn.Query(ctx, usersQuery, filter.From, filter.To, filter.TeamName)
+	if err != nil {
+		return domain.AssignmentStats{}, err
+	}
+	stats.Users, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.UserAssignmentStats, error) {
+		var user domain.UserAssignmentStats
+		err := row.Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive, &user.Assignments,
+			&user.Reassignments, &user.OpenReviews)
+		return user, err
+	})
+	if err != nil {
+		return domain.AssignmentStats{}, fmt.Errorf("users: %w", err)
+	}
+
+	rows, err = r.conn.Query(ctx, pullRequestsQuery, filter.From, filter.To, filter.TeamName)
+	if err != nil {
+		return domain.AssignmentStats{}, err
+	}
+	stats.PullRequests, err = pgx.CollectRows(rows,
+		func(row pgx.CollectableRow) (domain.PullRequestAssignmentStats, error) {
+			var pr domain.PullRequestAssignmentStats
+			err := row.Scan(&pr.PullRequestID, &pr.TeamName, &pr.Status, &pr.Reviewers, &pr.CurrentReviewers,
+				&pr.Reassignments)
+			return pr, err
+		})
+	if err != nil {
+		return domain.AssignmentStats{}, fmt.Errorf("pull requests: %w", err)
+	}
+
+	if groupBy != domain.StatsGroupByTeam {
+		return stats, nil
+	}
+
+	rows, err = r.conn.Query(ctx, teamsQuery, filter.From, filter.To, filter.TeamName)
+	if err != nil {
+		return domain.AssignmentStats{}, err
+	}
+	stats.Teams, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.TeamAssignmentStats, error) {
+		var team domain.TeamAssignmentStats
+		err := row.Scan(&team.TeamName, &team.Members, &team.Assignments, &team.Reassignments, &team.OpenReviews,
+			&team.PullRequests, &team.ReviewersPerPR, &team.AssignmentsPerMember)
+		return team, err
+	})
+	if err != nil {
+		return domain.AssignmentStats{}, fmt.Errorf("teams: %w", err)
+	}
+
+	return stats, nil
+}
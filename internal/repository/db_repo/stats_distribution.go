@@ -0,0 +1,55 @@
+package db_repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// GetReviewLoads returns the review work of the members for the assignments started in the range.
+// A review is completed by the first verdict given after the assignment. Inactive members and
+// trainees are included only when they got assignments, so they do not distort the distribution.
+func (r *Repo) GetReviewLoads(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewLoad, error) {
+	const query = `
+	WITH assignments AS (
+		SELECT r.user_id, r.assigned_at,
+			(SELECT MIN(v.created_at) FROM review_verdicts v
+			WHERE v.pull_request_id = r.pull_request_id AND v.user_id = r.user_id
+				AND v.created_at >= r.assigned_at) AS verdict_at
+		FROM reviewers r
+		WHERE r.role = 'REVIEWER' AND r.assigned_at >= $1 AND r.assigned_at < $2
+	)
+	SELECT u.id, u.username, t.name, u.is_active, COUNT(a.user_id), COUNT(a.verdict_at),
+		AVG(EXTRACT(EPOCH FROM a.verdict_at - a.assigned_at))::float8
+	FROM users u
+	JOIN teams t ON t.id = u.team_id
+	LEFT JOIN assignments a ON a.user_id = u.id
+	WHERE ($3 = '' OR t.name = $3)
+	GROUP BY u.id, t.name
+	HAVING (u.is_active AND NOT u.is_trainee) OR COUNT(a.user_id) > 0
+	ORDER BY t.name, u.id;`
+
+	rows, err := r.conn.Query(ctx, query, filter.From, filter.To, filter.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.ReviewLoad, error) {
+		var (
+			load    domain.ReviewLoad
+			seconds *float64
+		)
+		if err := row.Scan(&load.UserID, &load.Username, &load.TeamName, &load.IsActive, &load.Assignments,
+			&load.ReviewsCompleted, &seconds); err != nil {
+			return domain.ReviewLoad{}, err
+		}
+		if seconds != nil {
+			mean := time.Duration(*seconds * float64(time.Second))
+			load.MeanTimeToVerdict = &mean
+		}
+		return load, nil
+	})
+}
@@ -99,22 +99,26 @@ func (r *Repo) addUsers(ctx context.Context, tx pgx.Tx, teamID int64, users []do
 		return nil
 	}
 
-	const colsNum = 6
+	const colsNum = 8
 	now := time.Now()
 	var sb strings.Builder
 	args := make([]any, 0, len(users)*colsNum)
 
-	sb.WriteString("INSERT INTO users (id, username, team_id, is_active, created_at, updated_at) VALUES ")
+	sb.WriteString("INSERT INTO users (id, username, team_id, is_active, skills, is_trainee, created_at, updated_at) VALUES ")
 
 	for i, user := range users {
 		if i > 0 {
 			sb.WriteString(", ")
 		}
 		paramOffset := i*colsNum + 1
-		sb.WriteString(fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d)", paramOffset, paramOffset+1,
-			paramOffset+2, paramOffset+3, paramOffset+4, paramOffset+5))
+		sb.WriteString(fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)", paramOffset, paramOffset+1,
+			paramOffset+2, paramOffset+3, paramOffset+4, paramOffset+5, paramOffset+6, paramOffset+7))
 
-		args = append(args, user.UserID, user.Username, teamID, user.IsActive, now, now)
+		skills := user.Skills
+		if skills == nil {
+			skills = []string{}
+		}
+		args = append(args, user.UserID, user.Username, teamID, user.IsActive, skills, user.IsTrainee, now, now)
 	}
 
 	var db DBTX = r.conn
@@ -134,7 +138,7 @@ func (r *Repo) addUsers(ctx context.Context, tx pgx.Tx, teamID int64, users []do
 
 func (r *Repo) GetTeam(ctx context.Context, teamName string) (domain.Team, error) {
 	const query = `
-	SELECT t.id, u.id, u.username, u.is_active from teams t
+	SELECT t.id, u.id, u.username, u.is_active, u.skills, u.is_trainee from teams t
 	LEFT JOIN users u on t.id = u.team_id
 	WHERE name = $1;`
 
@@ -150,7 +154,8 @@ func (r *Repo) GetTeam(ctx context.Context, teamName string) (domain.Team, error
 		var member domain.TeamMember
 		var teamID string
 
-		if err := rows.Scan(&teamID, &member.UserID, &member.Username, &member.IsActive); err != nil {
+		if err := rows.Scan(&teamID, &member.UserID, &member.Username, &member.IsActive,
+			&member.Skills, &member.IsTrainee); err != nil {
 			return domain.Team{}, err
 		}
 
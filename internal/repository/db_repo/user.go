@@ -0,0 +1,52 @@
+package db_repo
+
+import (
+	"context"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func (r *Repo) GetUserReviews(ctx context.Context, userID string) (domain.UserReviews, error) {
+	const query = `
+	SELECT p.id, p.name, p.author_id, p.status, r.role
+	FROM reviewers r
+	JOIN pull_requests p ON p.id = r.pull_request_id
+	WHERE r.user_id = $1 AND r.is_current
+	ORDER BY p.created_at DESC, p.id;`
+
+	var exists bool
+	if err := r.conn.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM users WHERE id = $1);", userID).
+		Scan(&exists); err != nil {
+		return domain.UserReviews{}, err
+	}
+	if !exists {
+		return domain.UserReviews{}, domain.ErrUserNotFound
+	}
+
+	rows, err := r.conn.Query(ctx, query, userID)
+	if err != nil {
+		return domain.UserReviews{}, err
+	}
+	defer rows.Close()
+
+	reviews := domain.UserReviews{
+		UserID:       userID,
+		PullRequests: []domain.PullRequestShort{},
+	}
+	for rows.Next() {
+		var pr domain.PullRequestShort
+
+		if err := rows.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status,
+			&pr.Role); err != nil {
+			return domain.UserReviews{}, err
+		}
+
+		reviews.PullRequests = append(reviews.PullRequests, pr)
+	}
+
+	if err := rows.Err(); err != nil {
+		return domain.UserReviews{}, err
+	}
+
+	return reviews, nil
+}
@@ -0,0 +1,31 @@
+package db_repo
+
+import (
+	"context"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// WebhookDeliveryExists reports whether the delivery was processed before.
+func (r *Repo) WebhookDeliveryExists(ctx context.Context, delivery domain.WebhookDelivery) (bool, error) {
+	const query = `
+	SELECT EXISTS (SELECT 1 FROM webhook_deliveries WHERE provider = $1 AND delivery_id = $2);`
+
+	var exists bool
+	if err := r.conn.QueryRow(ctx, query, delivery.Provider, delivery.DeliveryID).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// RecordWebhookDelivery stores the processed delivery. A delivery recorded before is kept as is.
+func (r *Repo) RecordWebhookDelivery(ctx context.Context, delivery domain.WebhookDelivery) error {
+	const query = `
+	INSERT INTO webhook_deliveries (provider, delivery_id, event)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (provider, delivery_id) DO NOTHING;`
+
+	_, err := r.conn.Exec(ctx, query, delivery.Provider, delivery.DeliveryID, delivery.Event)
+	return err
+}
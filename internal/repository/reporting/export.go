@@ -0,0 +1,93 @@
+package reporting
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// exportBatchSize is how many rows are fetched from the cursor at a time.
+const exportBatchSize = 1000
+
+var fetchQuery = fmt.Sprintf(`FETCH FORWARD %d FROM review_export;`, exportBatchSize)
+
+// ExportReviews passes every assignment, replacement and verdict that happened in the range to
+// yield, oldest first. The rows are read in batches through a server-side cursor inside a read-only
+// snapshot, so the export is consistent and its size does not depend on memory. An error from
+// yield stops the export and is returned as is.
+func (r *Repo) ExportReviews(ctx context.Context, filter domain.ReportFilter,
+	yield func(record domain.ReviewRecord) error,
+) error {
+	const declareQuery = `
+		DECLARE review_export NO SCROLL CURSOR FOR
+		WITH records AS (
+			SELECT 'ASSIGNED' AS kind, r.assigned_at AS occurred_at, r.pull_request_id, r.user_id,
+				r.role, r.source, NULL::text AS verdict, NULL::text AS message, r.id AS seq
+			FROM reviewers r
+			WHERE r.assigned_at >= $1 AND r.assigned_at < $2
+			UNION ALL
+			SELECT 'REPLACED', r.replaced_at, r.pull_request_id, r.user_id,
+				r.role, r.source, NULL, NULL, r.id
+			FROM reviewers r
+			WHERE r.replaced_at >= $1 AND r.replaced_at < $2
+			UNION ALL
+			SELECT 'VERDICT', v.created_at, v.pull_request_id, v.user_id,
+				NULL, NULL, v.verdict::text, v.message, v.id
+			FROM review_verdicts v
+			WHERE v.created_at >= $1 AND v.created_at < $2
+		)
+		SELECT rec.kind, rec.occurred_at, rec.pull_request_id, COALESCE(pr.repository, ''), pr.author_id,
+			rec.user_id, COALESCE(rec.role, ''), COALESCE(rec.source, ''), COALESCE(rec.verdict, ''),
+			COALESCE(rec.message, '')
+		FROM records rec
+		JOIN pull_requests pr ON pr.id = rec.pull_request_id
+		JOIN users u ON u.id = rec.user_id
+		JOIN teams t ON t.id = u.team_id
+		WHERE ($3 = '' OR t.name = $3) AND ($4 = '' OR pr.repository = $4)
+		ORDER BY rec.occurred_at, rec.kind, rec.seq;`
+
+	tx, err := r.conn.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return err
+	}
+	defer func(tx pgx.Tx, ctx context.Context) {
+		_ = tx.Rollback(ctx)
+	}(tx, ctx)
+
+	if _, err = tx.Exec(ctx, declareQuery, filter.From, filter.To, filter.TeamName, filter.Repository); err != nil {
+		return fmt.Errorf("declare: %w", err)
+	}
+
+	for {
+		rows, err := tx.Query(ctx, fetchQuery)
+		if err != nil {
+			return fmt.Errorf("fetch: %w", err)
+		}
+		records, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.ReviewRecord, error) {
+			var record domain.ReviewRecord
+			err := row.Scan(&record.Kind, &record.OccurredAt, &record.PullRequestID, &record.Repository,
+				&record.AuthorID, &record.UserID, &record.Role, &record.Source, &record.Verdict, &record.Message)
+			return record, err
+		})
+		if err != nil {
+			return fmt.Errorf("fetch: %w", err)
+		}
+
+		for _, record := range records {
+			if err = yield(record); err != nil {
+				return err
+			}
+		}
+		if len(records) < exportBatchSize {
+			break
+		}
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,80 @@
+package reporting
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// GetReviewGraph returns who reviewed whom in the range. A reviewer counts for a PR while still
+// assigned or once they gave a verdict, shadows never do. With a team, the edges are the ones
+// with the author or the reviewer in the team and the nodes are its members plus everyone they
+// are connected to.
+func (r *Repo) GetReviewGraph(ctx context.Context, filter domain.StatsFilter) (domain.ReviewGraph, error) {
+	const (
+		edgesQuery = `
+		SELECT pr.author_id, r.user_id, COUNT(DISTINCT r.pull_request_id)
+		FROM reviewers r
+		JOIN pull_requests pr ON pr.id = r.pull_request_id
+		JOIN users ua ON ua.id = pr.author_id
+		JOIN teams ta ON ta.id = ua.team_id
+		JOIN users ur ON ur.id = r.user_id
+		JOIN teams tr ON tr.id = ur.team_id
+		WHERE r.role = 'REVIEWER' AND r.assigned_at >= $1 AND r.assigned_at < $2
+			AND (r.is_current OR EXISTS (
+				SELECT 1 FROM review_verdicts v
+				WHERE v.pull_request_id = r.pull_request_id AND v.user_id = r.user_id))
+			AND ($3 = '' OR ta.name = $3 OR tr.name = $3)
+		GROUP BY pr.author_id, r.user_id
+		ORDER BY pr.author_id, r.user_id;`
+
+		nodesQuery = `
+		SELECT u.id, u.username, t.name, u.is_active
+		FROM users u
+		JOIN teams t ON t.id = u.team_id
+		WHERE $1 = '' OR t.name = $1 OR u.id = ANY($2)
+		ORDER BY t.name, u.id;`
+	)
+
+	graph := domain.ReviewGraph{
+		TeamName: filter.TeamName,
+		From:     filter.From,
+		To:       filter.To,
+	}
+
+	rows, err := r.conn.Query(ctx, edgesQuery, filter.From, filter.To, filter.TeamName)
+	if err != nil {
+		return domain.ReviewGraph{}, err
+	}
+	graph.Edges, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.GraphEdge, error) {
+		var edge domain.GraphEdge
+		err := row.Scan(&edge.AuthorID, &edge.ReviewerID, &edge.Weight)
+		return edge, err
+	})
+	if err != nil {
+		return domain.ReviewGraph{}, fmt.Errorf("edges: %w", err)
+	}
+
+	connected := make([]string, 0, 2*len(graph.Edges))
+	for _, edge := range graph.Edges {
+		connected = append(connected, edge.AuthorID, edge.ReviewerID)
+	}
+
+	rows, err = r.conn.Query(ctx, nodesQuery, filter.TeamName, connected)
+	if err != nil {
+		return domain.ReviewGraph{}, err
+	}
+	graph.Nodes, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.GraphNode, error) {
+		var node domain.GraphNode
+		err := row.Scan(&node.UserID, &node.Username, &node.TeamName, &node.IsActive)
+		return node, err
+	})
+	if err != nil {
+		return domain.ReviewGraph{}, fmt.Errorf("nodes: %w", err)
+	}
+
+	return graph, nil
+}
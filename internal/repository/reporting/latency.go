@@ -0,0 +1,96 @@
+package reporting
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// GetLatencyReport computes the p50, p90 and p99 review latencies in hours. Weeks start on Monday
+// in UTC, pull requests without a repository are grouped under an empty one.
+func (r *Repo) GetLatencyReport(ctx context.Context, filter domain.ReportFilter) (domain.LatencyReport, error) {
+	const query = `
+	WITH prs AS (
+		SELECT pr.id, t.name AS team_name, COALESCE(pr.repository, '') AS repository,
+			date_trunc('week', pr.created_at AT TIME ZONE 'UTC') AS week, pr.created_at, pr.merged_at,
+			(SELECT MIN(r.assigned_at) FROM reviewers r
+			WHERE r.pull_request_id = pr.id AND r.role = 'REVIEWER') AS assigned_at
+		FROM pull_requests pr
+		JOIN users u ON u.id = pr.author_id
+		JOIN teams t ON t.id = u.team_id
+		WHERE pr.created_at >= $1 AND pr.created_at < $2
+			AND ($3 = '' OR t.name = $3) AND ($4 = '' OR pr.repository = $4)
+	), latencies AS (
+		SELECT p.team_name, p.repository, p.week,
+			EXTRACT(EPOCH FROM (SELECT MIN(v.created_at) FROM review_verdicts v
+				WHERE v.pull_request_id = p.id AND v.created_at >= p.assigned_at) - p.assigned_at) / 3600
+				AS first_verdict,
+			EXTRACT(EPOCH FROM (SELECT MIN(v.created_at) FROM review_verdicts v
+				WHERE v.pull_request_id = p.id AND v.created_at >= p.assigned_at AND v.verdict = 'APPROVED')
+				- p.assigned_at) / 3600 AS approval,
+			EXTRACT(EPOCH FROM p.merged_at - p.created_at) / 3600 AS merge
+		FROM prs p
+	)
+	SELECT team_name, repository, week, COUNT(*),
+		COUNT(first_verdict),
+		percentile_cont(ARRAY[0.5, 0.9, 0.99]) WITHIN GROUP (ORDER BY first_verdict::float8),
+		COUNT(approval),
+		percentile_cont(ARRAY[0.5, 0.9, 0.99]) WITHIN GROUP (ORDER BY approval::float8),
+		COUNT(merge),
+		percentile_cont(ARRAY[0.5, 0.9, 0.99]) WITHIN GROUP (ORDER BY merge::float8)
+	FROM latencies
+	GROUP BY team_name, repository, week
+	ORDER BY week, team_name, repository;`
+
+	rows, err := r.conn.Query(ctx, query, filter.From, filter.To, filter.TeamName, filter.Repository)
+	if err != nil {
+		return domain.LatencyReport{}, err
+	}
+
+	latencyRows, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.LatencyRow, error) {
+		var (
+			latency                       domain.LatencyRow
+			week                          time.Time
+			firstVerdict, approval, merge []float64
+		)
+		err := row.Scan(&latency.TeamName, &latency.Repository, &week, &latency.PullRequests,
+			&latency.FirstVerdict.Count, &firstVerdict,
+			&latency.Approval.Count, &approval,
+			&latency.Merge.Count, &merge)
+		if err != nil {
+			return domain.LatencyRow{}, err
+		}
+		latency.Week = week.UTC()
+		setPercentiles(&latency.FirstVerdict, firstVerdict)
+		setPercentiles(&latency.Approval, approval)
+		setPercentiles(&latency.Merge, merge)
+		return latency, nil
+	})
+	if err != nil {
+		return domain.LatencyReport{}, err
+	}
+
+	return domain.LatencyReport{
+		TeamName:   filter.TeamName,
+		Repository: filter.Repository,
+		From:       filter.From,
+		To:         filter.To,
+		Rows:       latencyRows,
+	}, nil
+}
+
+// setPercentiles fills p50, p90 and p99 from the array of percentile_cont, which is NULL when the
+// group has no value.
+func setPercentiles(percentiles *domain.LatencyPercentiles, values []float64) {
+	if len(values) != 3 {
+		return
+	}
+	for i, target := range []**float64{&percentiles.P50, &percentiles.P90, &percentiles.P99} {
+		value := math.Round(values[i]*100) / 100
+		*target = &value
+	}
+}
@@ -0,0 +1,17 @@
+// Package reporting holds the read-only queries behind the analytics reports. They scan large
+// ranges of history, so they are kept apart from the transactional queries of db_repo.
+package reporting
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repo struct {
+	conn *pgxpool.Pool
+}
+
+func NewRepo(conn *pgxpool.Pool) *Repo {
+	return &Repo{
+		conn: conn,
+	}
+}
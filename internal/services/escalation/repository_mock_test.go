@@ -0,0 +1,1518 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package escalation
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/escalation.repository -o repository_mock_test.go -n RepositoryMock -p escalation
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcEscalateReview          func(ctx context.Context, escalation domain.Escalation) (b1 bool, err error)
+	funcEscalateReviewOrigin    string
+	inspectFuncEscalateReview   func(ctx context.Context, escalation domain.Escalation)
+	afterEscalateReviewCounter  uint64
+	beforeEscalateReviewCounter uint64
+	EscalateReviewMock          mRepositoryMockEscalateReview
+
+	funcGetPullRequest          func(ctx context.Context, pullRequestID string) (p1 domain.PullRequest, err error)
+	funcGetPullRequestOrigin    string
+	inspectFuncGetPullRequest   func(ctx context.Context, pullRequestID string)
+	afterGetPullRequestCounter  uint64
+	beforeGetPullRequestCounter uint64
+	GetPullRequestMock          mRepositoryMockGetPullRequest
+
+	funcGetTeamPolicy          func(ctx context.Context, teamName string, version int) (t1 domain.TeamPolicy, err error)
+	funcGetTeamPolicyOrigin    string
+	inspectFuncGetTeamPolicy   func(ctx context.Context, teamName string, version int)
+	afterGetTeamPolicyCounter  uint64
+	beforeGetTeamPolicyCounter uint64
+	GetTeamPolicyMock          mRepositoryMockGetTeamPolicy
+
+	funcListPendingReviews          func(ctx context.Context, assignedBefore time.Time) (pa1 []domain.PendingReview, err error)
+	funcListPendingReviewsOrigin    string
+	inspectFuncListPendingReviews   func(ctx context.Context, assignedBefore time.Time)
+	afterListPendingReviewsCounter  uint64
+	beforeListPendingReviewsCounter uint64
+	ListPendingReviewsMock          mRepositoryMockListPendingReviews
+}
+
+// NewRepositoryMock returns a mock for repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.EscalateReviewMock = mRepositoryMockEscalateReview{mock: m}
+	m.EscalateReviewMock.callArgs = []*RepositoryMockEscalateReviewParams{}
+
+	m.GetPullRequestMock = mRepositoryMockGetPullRequest{mock: m}
+	m.GetPullRequestMock.callArgs = []*RepositoryMockGetPullRequestParams{}
+
+	m.GetTeamPolicyMock = mRepositoryMockGetTeamPolicy{mock: m}
+	m.GetTeamPolicyMock.callArgs = []*RepositoryMockGetTeamPolicyParams{}
+
+	m.ListPendingReviewsMock = mRepositoryMockListPendingReviews{mock: m}
+	m.ListPendingReviewsMock.callArgs = []*RepositoryMockListPendingReviewsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockEscalateReview struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockEscalateReviewExpectation
+	expectations       []*RepositoryMockEscalateReviewExpectation
+
+	callArgs []*RepositoryMockEscalateReviewParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockEscalateReviewExpectation specifies expectation struct of the repository.EscalateReview
+type RepositoryMockEscalateReviewExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockEscalateReviewParams
+	paramPtrs          *RepositoryMockEscalateReviewParamPtrs
+	expectationOrigins RepositoryMockEscalateReviewExpectationOrigins
+	results            *RepositoryMockEscalateReviewResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockEscalateReviewParams contains parameters of the repository.EscalateReview
+type RepositoryMockEscalateReviewParams struct {
+	ctx        context.Context
+	escalation domain.Escalation
+}
+
+// RepositoryMockEscalateReviewParamPtrs contains pointers to parameters of the repository.EscalateReview
+type RepositoryMockEscalateReviewParamPtrs struct {
+	ctx        *context.Context
+	escalation *domain.Escalation
+}
+
+// RepositoryMockEscalateReviewResults contains results of the repository.EscalateReview
+type RepositoryMockEscalateReviewResults struct {
+	b1  bool
+	err error
+}
+
+// RepositoryMockEscalateReviewOrigins contains origins of expectations of the repository.EscalateReview
+type RepositoryMockEscalateReviewExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originEscalation string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEscalateReview *mRepositoryMockEscalateReview) Optional() *mRepositoryMockEscalateReview {
+	mmEscalateReview.optional = true
+	return mmEscalateReview
+}
+
+// Expect sets up expected params for repository.EscalateReview
+func (mmEscalateReview *mRepositoryMockEscalateReview) Expect(ctx context.Context, escalation domain.Escalation) *mRepositoryMockEscalateReview {
+	if mmEscalateReview.mock.funcEscalateReview != nil {
+		mmEscalateReview.mock.t.Fatalf("RepositoryMock.EscalateReview mock is already set by Set")
+	}
+
+	if mmEscalateReview.defaultExpectation == nil {
+		mmEscalateReview.defaultExpectation = &RepositoryMockEscalateReviewExpectation{}
+	}
+
+	if mmEscalateReview.defaultExpectation.paramPtrs != nil {
+		mmEscalateReview.mock.t.Fatalf("RepositoryMock.EscalateReview mock is already set by ExpectParams functions")
+	}
+
+	mmEscalateReview.defaultExpectation.params = &RepositoryMockEscalateReviewParams{ctx, escalation}
+	mmEscalateReview.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEscalateReview.expectations {
+		if minimock.Equal(e.params, mmEscalateReview.defaultExpectation.params) {
+			mmEscalateReview.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEscalateReview.defaultExpectation.params)
+		}
+	}
+
+	return mmEscalateReview
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.EscalateReview
+func (mmEscalateReview *mRepositoryMockEscalateReview) ExpectCtxParam1(ctx context.Context) *mRepositoryMockEscalateReview {
+	if mmEscalateReview.mock.funcEscalateReview != nil {
+		mmEscalateReview.mock.t.Fatalf("RepositoryMock.EscalateReview mock is already set by Set")
+	}
+
+	if mmEscalateReview.defaultExpectation == nil {
+		mmEscalateReview.defaultExpectation = &RepositoryMockEscalateReviewExpectation{}
+	}
+
+	if mmEscalateReview.defaultExpectation.params != nil {
+		mmEscalateReview.mock.t.Fatalf("RepositoryMock.EscalateReview mock is already set by Expect")
+	}
+
+	if mmEscalateReview.defaultExpectation.paramPtrs == nil {
+		mmEscalateReview.defaultExpectation.paramPtrs = &RepositoryMockEscalateReviewParamPtrs{}
+	}
+	mmEscalateReview.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEscalateReview.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEscalateReview
+}
+
+// ExpectEscalationParam2 sets up expected param escalation for repository.EscalateReview
+func (mmEscalateReview *mRepositoryMockEscalateReview) ExpectEscalationParam2(escalation domain.Escalation) *mRepositoryMockEscalateReview {
+	if mmEscalateReview.mock.funcEscalateReview != nil {
+		mmEscalateReview.mock.t.Fatalf("RepositoryMock.EscalateReview mock is already set by Set")
+	}
+
+	if mmEscalateReview.defaultExpectation == nil {
+		mmEscalateReview.defaultExpectation = &RepositoryMockEscalateReviewExpectation{}
+	}
+
+	if mmEscalateReview.defaultExpectation.params != nil {
+		mmEscalateReview.mock.t.Fatalf("RepositoryMock.EscalateReview mock is already set by Expect")
+	}
+
+	if mmEscalateReview.defaultExpectation.paramPtrs == nil {
+		mmEscalateReview.defaultExpectation.paramPtrs = &RepositoryMockEscalateReviewParamPtrs{}
+	}
+	mmEscalateReview.defaultExpectation.paramPtrs.escalation = &escalation
+	mmEscalateReview.defaultExpectation.expectationOrigins.originEscalation = minimock.CallerInfo(1)
+
+	return mmEscalateReview
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.EscalateReview
+func (mmEscalateReview *mRepositoryMockEscalateReview) Inspect(f func(ctx context.Context, escalation domain.Escalation)) *mRepositoryMockEscalateReview {
+	if mmEscalateReview.mock.inspectFuncEscalateReview != nil {
+		mmEscalateReview.mock.t.Fatalf("Inspect function is already set for RepositoryMock.EscalateReview")
+	}
+
+	mmEscalateReview.mock.inspectFuncEscalateReview = f
+
+	return mmEscalateReview
+}
+
+// Return sets up results that will be returned by repository.EscalateReview
+func (mmEscalateReview *mRepositoryMockEscalateReview) Return(b1 bool, err error) *RepositoryMock {
+	if mmEscalateReview.mock.funcEscalateReview != nil {
+		mmEscalateReview.mock.t.Fatalf("RepositoryMock.EscalateReview mock is already set by Set")
+	}
+
+	if mmEscalateReview.defaultExpectation == nil {
+		mmEscalateReview.defaultExpectation = &RepositoryMockEscalateReviewExpectation{mock: mmEscalateReview.mock}
+	}
+	mmEscalateReview.defaultExpectation.results = &RepositoryMockEscalateReviewResults{b1, err}
+	mmEscalateReview.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEscalateReview.mock
+}
+
+// Set uses given function f to mock the repository.EscalateReview method
+func (mmEscalateReview *mRepositoryMockEscalateReview) Set(f func(ctx context.Context, escalation domain.Escalation) (b1 bool, err error)) *RepositoryMock {
+	if mmEscalateReview.defaultExpectation != nil {
+		mmEscalateReview.mock.t.Fatalf("Default expectation is already set for the repository.EscalateReview method")
+	}
+
+	if len(mmEscalateReview.expectations) > 0 {
+		mmEscalateReview.mock.t.Fatalf("Some expectations are already set for the repository.EscalateReview method")
+	}
+
+	mmEscalateReview.mock.funcEscalateReview = f
+	mmEscalateReview.mock.funcEscalateReviewOrigin = minimock.CallerInfo(1)
+	return mmEscalateReview.mock
+}
+
+// When sets expectation for the repository.EscalateReview which will trigger the result defined by the following
+// Then helper
+func (mmEscalateReview *mRepositoryMockEscalateReview) When(ctx context.Context, escalation domain.Escalation) *RepositoryMockEscalateReviewExpectation {
+	if mmEscalateReview.mock.funcEscalateReview != nil {
+		mmEscalateReview.mock.t.Fatalf("RepositoryMock.EscalateReview mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockEscalateReviewExpectation{
+		mock:               mmEscalateReview.mock,
+		params:             &RepositoryMockEscalateReviewParams{ctx, escalation},
+		expectationOrigins: RepositoryMockEscalateReviewExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEscalateReview.expectations = append(mmEscalateReview.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.EscalateReview return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockEscalateReviewExpectation) Then(b1 bool, err error) *RepositoryMock {
+	e.results = &RepositoryMockEscalateReviewResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.EscalateReview should be invoked
+func (mmEscalateReview *mRepositoryMockEscalateReview) Times(n uint64) *mRepositoryMockEscalateReview {
+	if n == 0 {
+		mmEscalateReview.mock.t.Fatalf("Times of RepositoryMock.EscalateReview mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEscalateReview.expectedInvocations, n)
+	mmEscalateReview.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEscalateReview
+}
+
+func (mmEscalateReview *mRepositoryMockEscalateReview) invocationsDone() bool {
+	if len(mmEscalateReview.expectations) == 0 && mmEscalateReview.defaultExpectation == nil && mmEscalateReview.mock.funcEscalateReview == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEscalateReview.mock.afterEscalateReviewCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEscalateReview.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EscalateReview implements repository
+func (mmEscalateReview *RepositoryMock) EscalateReview(ctx context.Context, escalation domain.Escalation) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmEscalateReview.beforeEscalateReviewCounter, 1)
+	defer mm_atomic.AddUint64(&mmEscalateReview.afterEscalateReviewCounter, 1)
+
+	mmEscalateReview.t.Helper()
+
+	if mmEscalateReview.inspectFuncEscalateReview != nil {
+		mmEscalateReview.inspectFuncEscalateReview(ctx, escalation)
+	}
+
+	mm_params := RepositoryMockEscalateReviewParams{ctx, escalation}
+
+	// Record call args
+	mmEscalateReview.EscalateReviewMock.mutex.Lock()
+	mmEscalateReview.EscalateReviewMock.callArgs = append(mmEscalateReview.EscalateReviewMock.callArgs, &mm_params)
+	mmEscalateReview.EscalateReviewMock.mutex.Unlock()
+
+	for _, e := range mmEscalateReview.EscalateReviewMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmEscalateReview.EscalateReviewMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEscalateReview.EscalateReviewMock.defaultExpectation.Counter, 1)
+		mm_want := mmEscalateReview.EscalateReviewMock.defaultExpectation.params
+		mm_want_ptrs := mmEscalateReview.EscalateReviewMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockEscalateReviewParams{ctx, escalation}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEscalateReview.t.Errorf("RepositoryMock.EscalateReview got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEscalateReview.EscalateReviewMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.escalation != nil && !minimock.Equal(*mm_want_ptrs.escalation, mm_got.escalation) {
+				mmEscalateReview.t.Errorf("RepositoryMock.EscalateReview got unexpected parameter escalation, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEscalateReview.EscalateReviewMock.defaultExpectation.expectationOrigins.originEscalation, *mm_want_ptrs.escalation, mm_got.escalation, minimock.Diff(*mm_want_ptrs.escalation, mm_got.escalation))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEscalateReview.t.Errorf("RepositoryMock.EscalateReview got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEscalateReview.EscalateReviewMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEscalateReview.EscalateReviewMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEscalateReview.t.Fatal("No results are set for the RepositoryMock.EscalateReview")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmEscalateReview.funcEscalateReview != nil {
+		return mmEscalateReview.funcEscalateReview(ctx, escalation)
+	}
+	mmEscalateReview.t.Fatalf("Unexpected call to RepositoryMock.EscalateReview. %v %v", ctx, escalation)
+	return
+}
+
+// EscalateReviewAfterCounter returns a count of finished RepositoryMock.EscalateReview invocations
+func (mmEscalateReview *RepositoryMock) EscalateReviewAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEscalateReview.afterEscalateReviewCounter)
+}
+
+// EscalateReviewBeforeCounter returns a count of RepositoryMock.EscalateReview invocations
+func (mmEscalateReview *RepositoryMock) EscalateReviewBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEscalateReview.beforeEscalateReviewCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.EscalateReview.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEscalateReview *mRepositoryMockEscalateReview) Calls() []*RepositoryMockEscalateReviewParams {
+	mmEscalateReview.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockEscalateReviewParams, len(mmEscalateReview.callArgs))
+	copy(argCopy, mmEscalateReview.callArgs)
+
+	mmEscalateReview.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEscalateReviewDone returns true if the count of the EscalateReview invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockEscalateReviewDone() bool {
+	if m.EscalateReviewMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EscalateReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EscalateReviewMock.invocationsDone()
+}
+
+// MinimockEscalateReviewInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockEscalateReviewInspect() {
+	for _, e := range m.EscalateReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.EscalateReview at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEscalateReviewCounter := mm_atomic.LoadUint64(&m.afterEscalateReviewCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EscalateReviewMock.defaultExpectation != nil && afterEscalateReviewCounter < 1 {
+		if m.EscalateReviewMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.EscalateReview at\n%s", m.EscalateReviewMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.EscalateReview at\n%s with params: %#v", m.EscalateReviewMock.defaultExpectation.expectationOrigins.origin, *m.EscalateReviewMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEscalateReview != nil && afterEscalateReviewCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.EscalateReview at\n%s", m.funcEscalateReviewOrigin)
+	}
+
+	if !m.EscalateReviewMock.invocationsDone() && afterEscalateReviewCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.EscalateReview at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EscalateReviewMock.expectedInvocations), m.EscalateReviewMock.expectedInvocationsOrigin, afterEscalateReviewCounter)
+	}
+}
+
+type mRepositoryMockGetPullRequest struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetPullRequestExpectation
+	expectations       []*RepositoryMockGetPullRequestExpectation
+
+	callArgs []*RepositoryMockGetPullRequestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetPullRequestExpectation specifies expectation struct of the repository.GetPullRequest
+type RepositoryMockGetPullRequestExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetPullRequestParams
+	paramPtrs          *RepositoryMockGetPullRequestParamPtrs
+	expectationOrigins RepositoryMockGetPullRequestExpectationOrigins
+	results            *RepositoryMockGetPullRequestResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetPullRequestParams contains parameters of the repository.GetPullRequest
+type RepositoryMockGetPullRequestParams struct {
+	ctx           context.Context
+	pullRequestID string
+}
+
+// RepositoryMockGetPullRequestParamPtrs contains pointers to parameters of the repository.GetPullRequest
+type RepositoryMockGetPullRequestParamPtrs struct {
+	ctx           *context.Context
+	pullRequestID *string
+}
+
+// RepositoryMockGetPullRequestResults contains results of the repository.GetPullRequest
+type RepositoryMockGetPullRequestResults struct {
+	p1  domain.PullRequest
+	err error
+}
+
+// RepositoryMockGetPullRequestOrigins contains origins of expectations of the repository.GetPullRequest
+type RepositoryMockGetPullRequestExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originPullRequestID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Optional() *mRepositoryMockGetPullRequest {
+	mmGetPullRequest.optional = true
+	return mmGetPullRequest
+}
+
+// Expect sets up expected params for repository.GetPullRequest
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Expect(ctx context.Context, pullRequestID string) *mRepositoryMockGetPullRequest {
+	if mmGetPullRequest.mock.funcGetPullRequest != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Set")
+	}
+
+	if mmGetPullRequest.defaultExpectation == nil {
+		mmGetPullRequest.defaultExpectation = &RepositoryMockGetPullRequestExpectation{}
+	}
+
+	if mmGetPullRequest.defaultExpectation.paramPtrs != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by ExpectParams functions")
+	}
+
+	mmGetPullRequest.defaultExpectation.params = &RepositoryMockGetPullRequestParams{ctx, pullRequestID}
+	mmGetPullRequest.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPullRequest.expectations {
+		if minimock.Equal(e.params, mmGetPullRequest.defaultExpectation.params) {
+			mmGetPullRequest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPullRequest.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPullRequest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.GetPullRequest
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetPullRequest {
+	if mmGetPullRequest.mock.funcGetPullRequest != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Set")
+	}
+
+	if mmGetPullRequest.defaultExpectation == nil {
+		mmGetPullRequest.defaultExpectation = &RepositoryMockGetPullRequestExpectation{}
+	}
+
+	if mmGetPullRequest.defaultExpectation.params != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Expect")
+	}
+
+	if mmGetPullRequest.defaultExpectation.paramPtrs == nil {
+		mmGetPullRequest.defaultExpectation.paramPtrs = &RepositoryMockGetPullRequestParamPtrs{}
+	}
+	mmGetPullRequest.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPullRequest.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPullRequest
+}
+
+// ExpectPullRequestIDParam2 sets up expected param pullRequestID for repository.GetPullRequest
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) ExpectPullRequestIDParam2(pullRequestID string) *mRepositoryMockGetPullRequest {
+	if mmGetPullRequest.mock.funcGetPullRequest != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Set")
+	}
+
+	if mmGetPullRequest.defaultExpectation == nil {
+		mmGetPullRequest.defaultExpectation = &RepositoryMockGetPullRequestExpectation{}
+	}
+
+	if mmGetPullRequest.defaultExpectation.params != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Expect")
+	}
+
+	if mmGetPullRequest.defaultExpectation.paramPtrs == nil {
+		mmGetPullRequest.defaultExpectation.paramPtrs = &RepositoryMockGetPullRequestParamPtrs{}
+	}
+	mmGetPullRequest.defaultExpectation.paramPtrs.pullRequestID = &pullRequestID
+	mmGetPullRequest.defaultExpectation.expectationOrigins.originPullRequestID = minimock.CallerInfo(1)
+
+	return mmGetPullRequest
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.GetPullRequest
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Inspect(f func(ctx context.Context, pullRequestID string)) *mRepositoryMockGetPullRequest {
+	if mmGetPullRequest.mock.inspectFuncGetPullRequest != nil {
+		mmGetPullRequest.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetPullRequest")
+	}
+
+	mmGetPullRequest.mock.inspectFuncGetPullRequest = f
+
+	return mmGetPullRequest
+}
+
+// Return sets up results that will be returned by repository.GetPullRequest
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Return(p1 domain.PullRequest, err error) *RepositoryMock {
+	if mmGetPullRequest.mock.funcGetPullRequest != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Set")
+	}
+
+	if mmGetPullRequest.defaultExpectation == nil {
+		mmGetPullRequest.defaultExpectation = &RepositoryMockGetPullRequestExpectation{mock: mmGetPullRequest.mock}
+	}
+	mmGetPullRequest.defaultExpectation.results = &RepositoryMockGetPullRequestResults{p1, err}
+	mmGetPullRequest.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPullRequest.mock
+}
+
+// Set uses given function f to mock the repository.GetPullRequest method
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Set(f func(ctx context.Context, pullRequestID string) (p1 domain.PullRequest, err error)) *RepositoryMock {
+	if mmGetPullRequest.defaultExpectation != nil {
+		mmGetPullRequest.mock.t.Fatalf("Default expectation is already set for the repository.GetPullRequest method")
+	}
+
+	if len(mmGetPullRequest.expectations) > 0 {
+		mmGetPullRequest.mock.t.Fatalf("Some expectations are already set for the repository.GetPullRequest method")
+	}
+
+	mmGetPullRequest.mock.funcGetPullRequest = f
+	mmGetPullRequest.mock.funcGetPullRequestOrigin = minimock.CallerInfo(1)
+	return mmGetPullRequest.mock
+}
+
+// When sets expectation for the repository.GetPullRequest which will trigger the result defined by the following
+// Then helper
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) When(ctx context.Context, pullRequestID string) *RepositoryMockGetPullRequestExpectation {
+	if mmGetPullRequest.mock.funcGetPullRequest != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetPullRequestExpectation{
+		mock:               mmGetPullRequest.mock,
+		params:             &RepositoryMockGetPullRequestParams{ctx, pullRequestID},
+		expectationOrigins: RepositoryMockGetPullRequestExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPullRequest.expectations = append(mmGetPullRequest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.GetPullRequest return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetPullRequestExpectation) Then(p1 domain.PullRequest, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetPullRequestResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.GetPullRequest should be invoked
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Times(n uint64) *mRepositoryMockGetPullRequest {
+	if n == 0 {
+		mmGetPullRequest.mock.t.Fatalf("Times of RepositoryMock.GetPullRequest mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPullRequest.expectedInvocations, n)
+	mmGetPullRequest.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPullRequest
+}
+
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) invocationsDone() bool {
+	if len(mmGetPullRequest.expectations) == 0 && mmGetPullRequest.defaultExpectation == nil && mmGetPullRequest.mock.funcGetPullRequest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPullRequest.mock.afterGetPullRequestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPullRequest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPullRequest implements repository
+func (mmGetPullRequest *RepositoryMock) GetPullRequest(ctx context.Context, pullRequestID string) (p1 domain.PullRequest, err error) {
+	mm_atomic.AddUint64(&mmGetPullRequest.beforeGetPullRequestCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPullRequest.afterGetPullRequestCounter, 1)
+
+	mmGetPullRequest.t.Helper()
+
+	if mmGetPullRequest.inspectFuncGetPullRequest != nil {
+		mmGetPullRequest.inspectFuncGetPullRequest(ctx, pullRequestID)
+	}
+
+	mm_params := RepositoryMockGetPullRequestParams{ctx, pullRequestID}
+
+	// Record call args
+	mmGetPullRequest.GetPullRequestMock.mutex.Lock()
+	mmGetPullRequest.GetPullRequestMock.callArgs = append(mmGetPullRequest.GetPullRequestMock.callArgs, &mm_params)
+	mmGetPullRequest.GetPullRequestMock.mutex.Unlock()
+
+	for _, e := range mmGetPullRequest.GetPullRequestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetPullRequest.GetPullRequestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPullRequest.GetPullRequestMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPullRequest.GetPullRequestMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPullRequest.GetPullRequestMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetPullRequestParams{ctx, pullRequestID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPullRequest.t.Errorf("RepositoryMock.GetPullRequest got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPullRequest.GetPullRequestMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pullRequestID != nil && !minimock.Equal(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID) {
+				mmGetPullRequest.t.Errorf("RepositoryMock.GetPullRequest got unexpected parameter pullRequestID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPullRequest.GetPullRequestMock.defaultExpectation.expectationOrigins.originPullRequestID, *mm_want_ptrs.pullRequestID, mm_got.pullRequestID, minimock.Diff(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPullRequest.t.Errorf("RepositoryMock.GetPullRequest got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPullRequest.GetPullRequestMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPullRequest.GetPullRequestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPullRequest.t.Fatal("No results are set for the RepositoryMock.GetPullRequest")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetPullRequest.funcGetPullRequest != nil {
+		return mmGetPullRequest.funcGetPullRequest(ctx, pullRequestID)
+	}
+	mmGetPullRequest.t.Fatalf("Unexpected call to RepositoryMock.GetPullRequest. %v %v", ctx, pullRequestID)
+	return
+}
+
+// GetPullRequestAfterCounter returns a count of finished RepositoryMock.GetPullRequest invocations
+func (mmGetPullRequest *RepositoryMock) GetPullRequestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPullRequest.afterGetPullRequestCounter)
+}
+
+// GetPullRequestBeforeCounter returns a count of RepositoryMock.GetPullRequest invocations
+func (mmGetPullRequest *RepositoryMock) GetPullRequestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPullRequest.beforeGetPullRequestCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetPullRequest.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Calls() []*RepositoryMockGetPullRequestParams {
+	mmGetPullRequest.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetPullRequestParams, len(mmGetPullRequest.callArgs))
+	copy(argCopy, mmGetPullRequest.callArgs)
+
+	mmGetPullRequest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPullRequestDone returns true if the count of the GetPullRequest invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetPullRequestDone() bool {
+	if m.GetPullRequestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPullRequestMock.invocationsDone()
+}
+
+// MinimockGetPullRequestInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetPullRequestInspect() {
+	for _, e := range m.GetPullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetPullRequest at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPullRequestCounter := mm_atomic.LoadUint64(&m.afterGetPullRequestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPullRequestMock.defaultExpectation != nil && afterGetPullRequestCounter < 1 {
+		if m.GetPullRequestMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetPullRequest at\n%s", m.GetPullRequestMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetPullRequest at\n%s with params: %#v", m.GetPullRequestMock.defaultExpectation.expectationOrigins.origin, *m.GetPullRequestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPullRequest != nil && afterGetPullRequestCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetPullRequest at\n%s", m.funcGetPullRequestOrigin)
+	}
+
+	if !m.GetPullRequestMock.invocationsDone() && afterGetPullRequestCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetPullRequest at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPullRequestMock.expectedInvocations), m.GetPullRequestMock.expectedInvocationsOrigin, afterGetPullRequestCounter)
+	}
+}
+
+type mRepositoryMockGetTeamPolicy struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetTeamPolicyExpectation
+	expectations       []*RepositoryMockGetTeamPolicyExpectation
+
+	callArgs []*RepositoryMockGetTeamPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetTeamPolicyExpectation specifies expectation struct of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetTeamPolicyParams
+	paramPtrs          *RepositoryMockGetTeamPolicyParamPtrs
+	expectationOrigins RepositoryMockGetTeamPolicyExpectationOrigins
+	results            *RepositoryMockGetTeamPolicyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetTeamPolicyParams contains parameters of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyParams struct {
+	ctx      context.Context
+	teamName string
+	version  int
+}
+
+// RepositoryMockGetTeamPolicyParamPtrs contains pointers to parameters of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyParamPtrs struct {
+	ctx      *context.Context
+	teamName *string
+	version  *int
+}
+
+// RepositoryMockGetTeamPolicyResults contains results of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyResults struct {
+	t1  domain.TeamPolicy
+	err error
+}
+
+// RepositoryMockGetTeamPolicyOrigins contains origins of expectations of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originTeamName string
+	originVersion  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Optional() *mRepositoryMockGetTeamPolicy {
+	mmGetTeamPolicy.optional = true
+	return mmGetTeamPolicy
+}
+
+// Expect sets up expected params for repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Expect(ctx context.Context, teamName string, version int) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{}
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.paramPtrs != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmGetTeamPolicy.defaultExpectation.params = &RepositoryMockGetTeamPolicyParams{ctx, teamName, version}
+	mmGetTeamPolicy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTeamPolicy.expectations {
+		if minimock.Equal(e.params, mmGetTeamPolicy.defaultExpectation.params) {
+			mmGetTeamPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTeamPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTeamPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{}
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.params != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Expect")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetTeamPolicy.defaultExpectation.paramPtrs = &RepositoryMockGetTeamPolicyParamPtrs{}
+	}
+	mmGetTeamPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTeamPolicy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTeamPolicy
+}
+
+// ExpectTeamNameParam2 sets up expected param teamName for repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) ExpectTeamNameParam2(teamName string) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{}
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.params != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Expect")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetTeamPolicy.defaultExpectation.paramPtrs = &RepositoryMockGetTeamPolicyParamPtrs{}
+	}
+	mmGetTeamPolicy.defaultExpectation.paramPtrs.teamName = &teamName
+	mmGetTeamPolicy.defaultExpectation.expectationOrigins.originTeamName = minimock.CallerInfo(1)
+
+	return mmGetTeamPolicy
+}
+
+// ExpectVersionParam3 sets up expected param version for repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) ExpectVersionParam3(version int) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{}
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.params != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Expect")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetTeamPolicy.defaultExpectation.paramPtrs = &RepositoryMockGetTeamPolicyParamPtrs{}
+	}
+	mmGetTeamPolicy.defaultExpectation.paramPtrs.version = &version
+	mmGetTeamPolicy.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmGetTeamPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Inspect(f func(ctx context.Context, teamName string, version int)) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.inspectFuncGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetTeamPolicy")
+	}
+
+	mmGetTeamPolicy.mock.inspectFuncGetTeamPolicy = f
+
+	return mmGetTeamPolicy
+}
+
+// Return sets up results that will be returned by repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Return(t1 domain.TeamPolicy, err error) *RepositoryMock {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{mock: mmGetTeamPolicy.mock}
+	}
+	mmGetTeamPolicy.defaultExpectation.results = &RepositoryMockGetTeamPolicyResults{t1, err}
+	mmGetTeamPolicy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTeamPolicy.mock
+}
+
+// Set uses given function f to mock the repository.GetTeamPolicy method
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Set(f func(ctx context.Context, teamName string, version int) (t1 domain.TeamPolicy, err error)) *RepositoryMock {
+	if mmGetTeamPolicy.defaultExpectation != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("Default expectation is already set for the repository.GetTeamPolicy method")
+	}
+
+	if len(mmGetTeamPolicy.expectations) > 0 {
+		mmGetTeamPolicy.mock.t.Fatalf("Some expectations are already set for the repository.GetTeamPolicy method")
+	}
+
+	mmGetTeamPolicy.mock.funcGetTeamPolicy = f
+	mmGetTeamPolicy.mock.funcGetTeamPolicyOrigin = minimock.CallerInfo(1)
+	return mmGetTeamPolicy.mock
+}
+
+// When sets expectation for the repository.GetTeamPolicy which will trigger the result defined by the following
+// Then helper
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) When(ctx context.Context, teamName string, version int) *RepositoryMockGetTeamPolicyExpectation {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetTeamPolicyExpectation{
+		mock:               mmGetTeamPolicy.mock,
+		params:             &RepositoryMockGetTeamPolicyParams{ctx, teamName, version},
+		expectationOrigins: RepositoryMockGetTeamPolicyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTeamPolicy.expectations = append(mmGetTeamPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.GetTeamPolicy return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetTeamPolicyExpectation) Then(t1 domain.TeamPolicy, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetTeamPolicyResults{t1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.GetTeamPolicy should be invoked
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Times(n uint64) *mRepositoryMockGetTeamPolicy {
+	if n == 0 {
+		mmGetTeamPolicy.mock.t.Fatalf("Times of RepositoryMock.GetTeamPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTeamPolicy.expectedInvocations, n)
+	mmGetTeamPolicy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTeamPolicy
+}
+
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) invocationsDone() bool {
+	if len(mmGetTeamPolicy.expectations) == 0 && mmGetTeamPolicy.defaultExpectation == nil && mmGetTeamPolicy.mock.funcGetTeamPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTeamPolicy.mock.afterGetTeamPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTeamPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTeamPolicy implements repository
+func (mmGetTeamPolicy *RepositoryMock) GetTeamPolicy(ctx context.Context, teamName string, version int) (t1 domain.TeamPolicy, err error) {
+	mm_atomic.AddUint64(&mmGetTeamPolicy.beforeGetTeamPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTeamPolicy.afterGetTeamPolicyCounter, 1)
+
+	mmGetTeamPolicy.t.Helper()
+
+	if mmGetTeamPolicy.inspectFuncGetTeamPolicy != nil {
+		mmGetTeamPolicy.inspectFuncGetTeamPolicy(ctx, teamName, version)
+	}
+
+	mm_params := RepositoryMockGetTeamPolicyParams{ctx, teamName, version}
+
+	// Record call args
+	mmGetTeamPolicy.GetTeamPolicyMock.mutex.Lock()
+	mmGetTeamPolicy.GetTeamPolicyMock.callArgs = append(mmGetTeamPolicy.GetTeamPolicyMock.callArgs, &mm_params)
+	mmGetTeamPolicy.GetTeamPolicyMock.mutex.Unlock()
+
+	for _, e := range mmGetTeamPolicy.GetTeamPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.err
+		}
+	}
+
+	if mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetTeamPolicyParams{ctx, teamName, version}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTeamPolicy.t.Errorf("RepositoryMock.GetTeamPolicy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.teamName != nil && !minimock.Equal(*mm_want_ptrs.teamName, mm_got.teamName) {
+				mmGetTeamPolicy.t.Errorf("RepositoryMock.GetTeamPolicy got unexpected parameter teamName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.expectationOrigins.originTeamName, *mm_want_ptrs.teamName, mm_got.teamName, minimock.Diff(*mm_want_ptrs.teamName, mm_got.teamName))
+			}
+
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmGetTeamPolicy.t.Errorf("RepositoryMock.GetTeamPolicy got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTeamPolicy.t.Errorf("RepositoryMock.GetTeamPolicy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTeamPolicy.t.Fatal("No results are set for the RepositoryMock.GetTeamPolicy")
+		}
+		return (*mm_results).t1, (*mm_results).err
+	}
+	if mmGetTeamPolicy.funcGetTeamPolicy != nil {
+		return mmGetTeamPolicy.funcGetTeamPolicy(ctx, teamName, version)
+	}
+	mmGetTeamPolicy.t.Fatalf("Unexpected call to RepositoryMock.GetTeamPolicy. %v %v %v", ctx, teamName, version)
+	return
+}
+
+// GetTeamPolicyAfterCounter returns a count of finished RepositoryMock.GetTeamPolicy invocations
+func (mmGetTeamPolicy *RepositoryMock) GetTeamPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTeamPolicy.afterGetTeamPolicyCounter)
+}
+
+// GetTeamPolicyBeforeCounter returns a count of RepositoryMock.GetTeamPolicy invocations
+func (mmGetTeamPolicy *RepositoryMock) GetTeamPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTeamPolicy.beforeGetTeamPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetTeamPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Calls() []*RepositoryMockGetTeamPolicyParams {
+	mmGetTeamPolicy.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetTeamPolicyParams, len(mmGetTeamPolicy.callArgs))
+	copy(argCopy, mmGetTeamPolicy.callArgs)
+
+	mmGetTeamPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTeamPolicyDone returns true if the count of the GetTeamPolicy invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetTeamPolicyDone() bool {
+	if m.GetTeamPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTeamPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTeamPolicyMock.invocationsDone()
+}
+
+// MinimockGetTeamPolicyInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetTeamPolicyInspect() {
+	for _, e := range m.GetTeamPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetTeamPolicy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTeamPolicyCounter := mm_atomic.LoadUint64(&m.afterGetTeamPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTeamPolicyMock.defaultExpectation != nil && afterGetTeamPolicyCounter < 1 {
+		if m.GetTeamPolicyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetTeamPolicy at\n%s", m.GetTeamPolicyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetTeamPolicy at\n%s with params: %#v", m.GetTeamPolicyMock.defaultExpectation.expectationOrigins.origin, *m.GetTeamPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTeamPolicy != nil && afterGetTeamPolicyCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetTeamPolicy at\n%s", m.funcGetTeamPolicyOrigin)
+	}
+
+	if !m.GetTeamPolicyMock.invocationsDone() && afterGetTeamPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetTeamPolicy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTeamPolicyMock.expectedInvocations), m.GetTeamPolicyMock.expectedInvocationsOrigin, afterGetTeamPolicyCounter)
+	}
+}
+
+type mRepositoryMockListPendingReviews struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListPendingReviewsExpectation
+	expectations       []*RepositoryMockListPendingReviewsExpectation
+
+	callArgs []*RepositoryMockListPendingReviewsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockListPendingReviewsExpectation specifies expectation struct of the repository.ListPendingReviews
+type RepositoryMockListPendingReviewsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockListPendingReviewsParams
+	paramPtrs          *RepositoryMockListPendingReviewsParamPtrs
+	expectationOrigins RepositoryMockListPendingReviewsExpectationOrigins
+	results            *RepositoryMockListPendingReviewsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockListPendingReviewsParams contains parameters of the repository.ListPendingReviews
+type RepositoryMockListPendingReviewsParams struct {
+	ctx            context.Context
+	assignedBefore time.Time
+}
+
+// RepositoryMockListPendingReviewsParamPtrs contains pointers to parameters of the repository.ListPendingReviews
+type RepositoryMockListPendingReviewsParamPtrs struct {
+	ctx            *context.Context
+	assignedBefore *time.Time
+}
+
+// RepositoryMockListPendingReviewsResults contains results of the repository.ListPendingReviews
+type RepositoryMockListPendingReviewsResults struct {
+	pa1 []domain.PendingReview
+	err error
+}
+
+// RepositoryMockListPendingReviewsOrigins contains origins of expectations of the repository.ListPendingReviews
+type RepositoryMockListPendingReviewsExpectationOrigins struct {
+	origin               string
+	originCtx            string
+	originAssignedBefore string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) Optional() *mRepositoryMockListPendingReviews {
+	mmListPendingReviews.optional = true
+	return mmListPendingReviews
+}
+
+// Expect sets up expected params for repository.ListPendingReviews
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) Expect(ctx context.Context, assignedBefore time.Time) *mRepositoryMockListPendingReviews {
+	if mmListPendingReviews.mock.funcListPendingReviews != nil {
+		mmListPendingReviews.mock.t.Fatalf("RepositoryMock.ListPendingReviews mock is already set by Set")
+	}
+
+	if mmListPendingReviews.defaultExpectation == nil {
+		mmListPendingReviews.defaultExpectation = &RepositoryMockListPendingReviewsExpectation{}
+	}
+
+	if mmListPendingReviews.defaultExpectation.paramPtrs != nil {
+		mmListPendingReviews.mock.t.Fatalf("RepositoryMock.ListPendingReviews mock is already set by ExpectParams functions")
+	}
+
+	mmListPendingReviews.defaultExpectation.params = &RepositoryMockListPendingReviewsParams{ctx, assignedBefore}
+	mmListPendingReviews.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPendingReviews.expectations {
+		if minimock.Equal(e.params, mmListPendingReviews.defaultExpectation.params) {
+			mmListPendingReviews.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPendingReviews.defaultExpectation.params)
+		}
+	}
+
+	return mmListPendingReviews
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.ListPendingReviews
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListPendingReviews {
+	if mmListPendingReviews.mock.funcListPendingReviews != nil {
+		mmListPendingReviews.mock.t.Fatalf("RepositoryMock.ListPendingReviews mock is already set by Set")
+	}
+
+	if mmListPendingReviews.defaultExpectation == nil {
+		mmListPendingReviews.defaultExpectation = &RepositoryMockListPendingReviewsExpectation{}
+	}
+
+	if mmListPendingReviews.defaultExpectation.params != nil {
+		mmListPendingReviews.mock.t.Fatalf("RepositoryMock.ListPendingReviews mock is already set by Expect")
+	}
+
+	if mmListPendingReviews.defaultExpectation.paramPtrs == nil {
+		mmListPendingReviews.defaultExpectation.paramPtrs = &RepositoryMockListPendingReviewsParamPtrs{}
+	}
+	mmListPendingReviews.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPendingReviews.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPendingReviews
+}
+
+// ExpectAssignedBeforeParam2 sets up expected param assignedBefore for repository.ListPendingReviews
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) ExpectAssignedBeforeParam2(assignedBefore time.Time) *mRepositoryMockListPendingReviews {
+	if mmListPendingReviews.mock.funcListPendingReviews != nil {
+		mmListPendingReviews.mock.t.Fatalf("RepositoryMock.ListPendingReviews mock is already set by Set")
+	}
+
+	if mmListPendingReviews.defaultExpectation == nil {
+		mmListPendingReviews.defaultExpectation = &RepositoryMockListPendingReviewsExpectation{}
+	}
+
+	if mmListPendingReviews.defaultExpectation.params != nil {
+		mmListPendingReviews.mock.t.Fatalf("RepositoryMock.ListPendingReviews mock is already set by Expect")
+	}
+
+	if mmListPendingReviews.defaultExpectation.paramPtrs == nil {
+		mmListPendingReviews.defaultExpectation.paramPtrs = &RepositoryMockListPendingReviewsParamPtrs{}
+	}
+	mmListPendingReviews.defaultExpectation.paramPtrs.assignedBefore = &assignedBefore
+	mmListPendingReviews.defaultExpectation.expectationOrigins.originAssignedBefore = minimock.CallerInfo(1)
+
+	return mmListPendingReviews
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.ListPendingReviews
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) Inspect(f func(ctx context.Context, assignedBefore time.Time)) *mRepositoryMockListPendingReviews {
+	if mmListPendingReviews.mock.inspectFuncListPendingReviews != nil {
+		mmListPendingReviews.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListPendingReviews")
+	}
+
+	mmListPendingReviews.mock.inspectFuncListPendingReviews = f
+
+	return mmListPendingReviews
+}
+
+// Return sets up results that will be returned by repository.ListPendingReviews
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) Return(pa1 []domain.PendingReview, err error) *RepositoryMock {
+	if mmListPendingReviews.mock.funcListPendingReviews != nil {
+		mmListPendingReviews.mock.t.Fatalf("RepositoryMock.ListPendingReviews mock is already set by Set")
+	}
+
+	if mmListPendingReviews.defaultExpectation == nil {
+		mmListPendingReviews.defaultExpectation = &RepositoryMockListPendingReviewsExpectation{mock: mmListPendingReviews.mock}
+	}
+	mmListPendingReviews.defaultExpectation.results = &RepositoryMockListPendingReviewsResults{pa1, err}
+	mmListPendingReviews.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPendingReviews.mock
+}
+
+// Set uses given function f to mock the repository.ListPendingReviews method
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) Set(f func(ctx context.Context, assignedBefore time.Time) (pa1 []domain.PendingReview, err error)) *RepositoryMock {
+	if mmListPendingReviews.defaultExpectation != nil {
+		mmListPendingReviews.mock.t.Fatalf("Default expectation is already set for the repository.ListPendingReviews method")
+	}
+
+	if len(mmListPendingReviews.expectations) > 0 {
+		mmListPendingReviews.mock.t.Fatalf("Some expectations are already set for the repository.ListPendingReviews method")
+	}
+
+	mmListPendingReviews.mock.funcListPendingReviews = f
+	mmListPendingReviews.mock.funcListPendingReviewsOrigin = minimock.CallerInfo(1)
+	return mmListPendingReviews.mock
+}
+
+// When sets expectation for the repository.ListPendingReviews which will trigger the result defined by the following
+// Then helper
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) When(ctx context.Context, assignedBefore time.Time) *RepositoryMockListPendingReviewsExpectation {
+	if mmListPendingReviews.mock.funcListPendingReviews != nil {
+		mmListPendingReviews.mock.t.Fatalf("RepositoryMock.ListPendingReviews mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListPendingReviewsExpectation{
+		mock:               mmListPendingReviews.mock,
+		params:             &RepositoryMockListPendingReviewsParams{ctx, assignedBefore},
+		expectationOrigins: RepositoryMockListPendingReviewsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPendingReviews.expectations = append(mmListPendingReviews.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.ListPendingReviews return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListPendingReviewsExpectation) Then(pa1 []domain.PendingReview, err error) *RepositoryMock {
+	e.results = &RepositoryMockListPendingReviewsResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.ListPendingReviews should be invoked
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) Times(n uint64) *mRepositoryMockListPendingReviews {
+	if n == 0 {
+		mmListPendingReviews.mock.t.Fatalf("Times of RepositoryMock.ListPendingReviews mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPendingReviews.expectedInvocations, n)
+	mmListPendingReviews.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPendingReviews
+}
+
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) invocationsDone() bool {
+	if len(mmListPendingReviews.expectations) == 0 && mmListPendingReviews.defaultExpectation == nil && mmListPendingReviews.mock.funcListPendingReviews == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPendingReviews.mock.afterListPendingReviewsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPendingReviews.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPendingReviews implements repository
+func (mmListPendingReviews *RepositoryMock) ListPendingReviews(ctx context.Context, assignedBefore time.Time) (pa1 []domain.PendingReview, err error) {
+	mm_atomic.AddUint64(&mmListPendingReviews.beforeListPendingReviewsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPendingReviews.afterListPendingReviewsCounter, 1)
+
+	mmListPendingReviews.t.Helper()
+
+	if mmListPendingReviews.inspectFuncListPendingReviews != nil {
+		mmListPendingReviews.inspectFuncListPendingReviews(ctx, assignedBefore)
+	}
+
+	mm_params := RepositoryMockListPendingReviewsParams{ctx, assignedBefore}
+
+	// Record call args
+	mmListPendingReviews.ListPendingReviewsMock.mutex.Lock()
+	mmListPendingReviews.ListPendingReviewsMock.callArgs = append(mmListPendingReviews.ListPendingReviewsMock.callArgs, &mm_params)
+	mmListPendingReviews.ListPendingReviewsMock.mutex.Unlock()
+
+	for _, e := range mmListPendingReviews.ListPendingReviewsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmListPendingReviews.ListPendingReviewsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPendingReviews.ListPendingReviewsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPendingReviews.ListPendingReviewsMock.defaultExpectation.params
+		mm_want_ptrs := mmListPendingReviews.ListPendingReviewsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockListPendingReviewsParams{ctx, assignedBefore}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPendingReviews.t.Errorf("RepositoryMock.ListPendingReviews got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPendingReviews.ListPendingReviewsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.assignedBefore != nil && !minimock.Equal(*mm_want_ptrs.assignedBefore, mm_got.assignedBefore) {
+				mmListPendingReviews.t.Errorf("RepositoryMock.ListPendingReviews got unexpected parameter assignedBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPendingReviews.ListPendingReviewsMock.defaultExpectation.expectationOrigins.originAssignedBefore, *mm_want_ptrs.assignedBefore, mm_got.assignedBefore, minimock.Diff(*mm_want_ptrs.assignedBefore, mm_got.assignedBefore))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPendingReviews.t.Errorf("RepositoryMock.ListPendingReviews got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPendingReviews.ListPendingReviewsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPendingReviews.ListPendingReviewsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPendingReviews.t.Fatal("No results are set for the RepositoryMock.ListPendingReviews")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmListPendingReviews.funcListPendingReviews != nil {
+		return mmListPendingReviews.funcListPendingReviews(ctx, assignedBefore)
+	}
+	mmListPendingReviews.t.Fatalf("Unexpected call to RepositoryMock.ListPendingReviews. %v %v", ctx, assignedBefore)
+	return
+}
+
+// ListPendingReviewsAfterCounter returns a count of finished RepositoryMock.ListPendingReviews invocations
+func (mmListPendingReviews *RepositoryMock) ListPendingReviewsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPendingReviews.afterListPendingReviewsCounter)
+}
+
+// ListPendingReviewsBeforeCounter returns a count of RepositoryMock.ListPendingReviews invocations
+func (mmListPendingReviews *RepositoryMock) ListPendingReviewsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPendingReviews.beforeListPendingReviewsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ListPendingReviews.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) Calls() []*RepositoryMockListPendingReviewsParams {
+	mmListPendingReviews.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListPendingReviewsParams, len(mmListPendingReviews.callArgs))
+	copy(argCopy, mmListPendingReviews.callArgs)
+
+	mmListPendingReviews.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPendingReviewsDone returns true if the count of the ListPendingReviews invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListPendingReviewsDone() bool {
+	if m.ListPendingReviewsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPendingReviewsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPendingReviewsMock.invocationsDone()
+}
+
+// MinimockListPendingReviewsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListPendingReviewsInspect() {
+	for _, e := range m.ListPendingReviewsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ListPendingReviews at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPendingReviewsCounter := mm_atomic.LoadUint64(&m.afterListPendingReviewsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPendingReviewsMock.defaultExpectation != nil && afterListPendingReviewsCounter < 1 {
+		if m.ListPendingReviewsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.ListPendingReviews at\n%s", m.ListPendingReviewsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ListPendingReviews at\n%s with params: %#v", m.ListPendingReviewsMock.defaultExpectation.expectationOrigins.origin, *m.ListPendingReviewsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPendingReviews != nil && afterListPendingReviewsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.ListPendingReviews at\n%s", m.funcListPendingReviewsOrigin)
+	}
+
+	if !m.ListPendingReviewsMock.invocationsDone() && afterListPendingReviewsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListPendingReviews at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPendingReviewsMock.expectedInvocations), m.ListPendingReviewsMock.expectedInvocationsOrigin, afterListPendingReviewsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockEscalateReviewInspect()
+
+			m.MinimockGetPullRequestInspect()
+
+			m.MinimockGetTeamPolicyInspect()
+
+			m.MinimockListPendingReviewsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockEscalateReviewDone() &&
+		m.MinimockGetPullRequestDone() &&
+		m.MinimockGetTeamPolicyDone() &&
+		m.MinimockListPendingReviewsDone()
+}
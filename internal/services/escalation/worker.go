@@ -0,0 +1,235 @@
+// Package escalation runs the background worker that escalates reviews breaching the team SLA.
+//
+// Every replica of the service runs the worker. The PR of a breached review is claimed with a row
+// lock in the same transaction that records the escalation, so each PR is escalated exactly once.
+package escalation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// minFirstReviewTime is the shortest SLA a policy can define. Reviews assigned more recently
+// cannot have breached any SLA and are not loaded.
+const minFirstReviewTime = time.Hour
+
+type (
+	repository interface {
+		ListPendingReviews(ctx context.Context, assignedBefore time.Time) ([]domain.PendingReview, error)
+		GetTeamPolicy(ctx context.Context, teamName string, version int) (domain.TeamPolicy, error)
+		GetPullRequest(ctx context.Context, pullRequestID string) (domain.PullRequest, error)
+		EscalateReview(ctx context.Context, escalation domain.Escalation) (bool, error)
+	}
+	selector interface {
+		Additional(ctx context.Context, authorID string, taken []string, count int) (domain.Assignment, error)
+	}
+	sink interface {
+		Send(ctx context.Context, notification domain.Notification) error
+	}
+	metrics interface {
+		ReviewerReassigned(reason domain.PullRequestEventType)
+		AssignmentFailed(err error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Worker struct {
+		repo     repository
+		selector selector
+		sink     sink
+		metrics  metrics
+		logger   logger
+		interval time.Duration
+		now      func() time.Time
+	}
+)
+
+func New(repo repository, selector selector, sink sink, metrics metrics, logger logger,
+	interval time.Duration,
+) *Worker {
+	return &Worker{
+		repo:     repo,
+		selector: selector,
+		sink:     sink,
+		metrics:  metrics,
+		logger:   logger,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run escalates breached reviews every interval until ctx is done.
+func (w *Worker) Run(ctx context.Context) {
+	logger := w.logger.With(zap.String("service", "escalation.worker"))
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			escalated, err := w.Escalate(ctx)
+			if err != nil {
+				logger.Error("Escalate", zap.Error(err))
+				continue
+			}
+			if escalated > 0 {
+				logger.Info("reviews escalated", zap.Int("count", escalated))
+			}
+		}
+	}
+}
+
+// Escalate escalates every PR with a pending review past its SLA deadline and returns how many were
+// escalated by this call. Only the first breached review of a PR is escalated. A failing review is
+// logged and skipped, so it does not block the others.
+func (w *Worker) Escalate(ctx context.Context) (int, error) {
+	logger := w.logger.With(zap.String("service", "escalation.worker"))
+	now := w.now()
+
+	reviews, err := w.repo.ListPendingReviews(ctx, now.Add(-minFirstReviewTime))
+	if err != nil {
+		return 0, fmt.Errorf("repo.ListPendingReviews: %w", err)
+	}
+
+	policies := make(map[string]domain.AssignmentPolicy)
+	claimedPRs := make(map[string]bool)
+	escalated := 0
+
+	for _, review := range reviews {
+		if claimedPRs[review.PullRequestID] {
+			continue
+		}
+
+		policy, ok := policies[review.TeamName]
+		if !ok {
+			policy, err = w.policy(ctx, review.TeamName)
+			if err != nil {
+				logger.Error("policy", zap.Error(err), zap.String("team_name", review.TeamName))
+				continue
+			}
+			policies[review.TeamName] = policy
+		}
+		if policy.SLA == nil {
+			continue
+		}
+
+		deadline := policy.Calendar().Deadline(review.AssignedAt, time.Duration(policy.SLA.FirstReviewHours)*time.Hour)
+		if now.Before(deadline) {
+			continue
+		}
+
+		escalation, err := w.escalation(ctx, review, policy.SLA.Action, deadline)
+		if err != nil {
+			logger.Error("escalation", zap.Error(err), zap.String("pull_request_id", review.PullRequestID))
+			continue
+		}
+
+		claimed, err := w.repo.EscalateReview(ctx, escalation)
+		if err != nil {
+			logger.Error("repo.EscalateReview", zap.Error(err), zap.String("pull_request_id", review.PullRequestID))
+			continue
+		}
+		// Lost claims are skipped too, the PR is escalated or being escalated by another replica.
+		claimedPRs[review.PullRequestID] = true
+		if !claimed {
+			continue
+		}
+		escalated++
+		if escalation.Action == domain.EscalationReassign {
+			w.metrics.ReviewerReassigned(domain.EventEscalated)
+		}
+
+		w.notify(ctx, escalation)
+	}
+
+	return escalated, nil
+}
+
+func (w *Worker) policy(ctx context.Context, teamName string) (domain.AssignmentPolicy, error) {
+	teamPolicy, err := w.repo.GetTeamPolicy(ctx, teamName, 0)
+	if err != nil {
+		if errors.Is(err, domain.ErrPolicyNotFound) {
+			return domain.DefaultAssignmentPolicy(), nil
+		}
+		return domain.AssignmentPolicy{}, fmt.Errorf("repo.GetTeamPolicy: %w", err)
+	}
+	return teamPolicy.Policy, nil
+}
+
+// escalation picks the extra reviewer required by the action. When nobody is available the
+// escalation falls back to notifying the reviewer.
+func (w *Worker) escalation(ctx context.Context, review domain.PendingReview, action domain.EscalationAction,
+	deadline time.Time,
+) (domain.Escalation, error) {
+	escalation := domain.Escalation{
+		Review:   review,
+		Action:   action,
+		Deadline: deadline,
+	}
+	if action == domain.EscalationNotify {
+		return escalation, nil
+	}
+
+	pr, err := w.repo.GetPullRequest(ctx, review.PullRequestID)
+	if err != nil {
+		return domain.Escalation{}, fmt.Errorf("repo.GetPullRequest: %w", err)
+	}
+
+	taken := make([]string, len(pr.Reviewers))
+	for i, reviewer := range pr.Reviewers {
+		taken[i] = reviewer.UserID
+	}
+
+	assignment, err := w.selector.Additional(ctx, review.AuthorID, taken, 1)
+	if err != nil {
+		w.metrics.AssignmentFailed(err)
+	}
+	switch {
+	case errors.Is(err, domain.ErrNotEnoughReviewers):
+		escalation.Action = domain.EscalationNotify
+	case err != nil:
+		return domain.Escalation{}, fmt.Errorf("selector.Additional: %w", err)
+	default:
+		escalation.AddReviewers = assignment.Reviewers
+	}
+
+	return escalation, nil
+}
+
+func (w *Worker) notify(ctx context.Context, escalation domain.Escalation) {
+	review := escalation.Review
+
+	notifications := []domain.Notification{{
+		UserID: review.UserID,
+		Kind:   domain.NotificationEscalation,
+		Text: fmt.Sprintf("review of %q is overdue since %s, escalated: %s", review.PullRequestName,
+			escalation.Deadline.Format(time.RFC3339), escalation.Action),
+		PullRequestIDs: []string{review.PullRequestID},
+	}}
+	for _, reviewer := range escalation.AddReviewers {
+		notifications = append(notifications, domain.Notification{
+			UserID:         reviewer.UserID,
+			Kind:           domain.NotificationAssigned,
+			Text:           fmt.Sprintf("you were assigned to review %q after an SLA escalation", review.PullRequestName),
+			PullRequestIDs: []string{review.PullRequestID},
+		})
+	}
+
+	for _, notification := range notifications {
+		if err := w.sink.Send(ctx, notification); err != nil {
+			w.logger.Error("sink.Send", zap.Error(err), zap.String("user_id", notification.UserID))
+		}
+	}
+}
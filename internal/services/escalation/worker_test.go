@@ -0,0 +1,253 @@
+package escalation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func TestWorker_Escalate(t *testing.T) {
+	t.Parallel()
+
+	// Wednesday 15:00 UTC, default working hours are 9-18 UTC.
+	now := time.Date(2025, 3, 5, 15, 0, 0, 0, time.UTC)
+	deadline := time.Date(2025, 3, 5, 14, 0, 0, 0, time.UTC)
+	review := domain.PendingReview{
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add rate limiting",
+		AuthorID:        "u1",
+		TeamName:        "backend",
+		UserID:          "u2",
+		AssignedAt:      time.Date(2025, 3, 5, 10, 0, 0, 0, time.UTC),
+	}
+	withSLA := func(action domain.EscalationAction, hours int) domain.TeamPolicy {
+		policy := domain.DefaultAssignmentPolicy()
+		policy.SLA = &domain.SLAPolicy{FirstReviewHours: hours, Action: action}
+		return domain.TeamPolicy{Policy: policy}
+	}
+	pr := domain.PullRequest{Reviewers: []domain.Reviewer{
+		{UserID: "u2", Role: domain.ReviewerRoleReviewer},
+		{UserID: "u3", Role: domain.ReviewerRoleReviewer},
+	}}
+	replacement := domain.Reviewer{UserID: "u4", Role: domain.ReviewerRoleReviewer, Source: domain.ReviewerSourceAuto}
+	overdue := func(action domain.EscalationAction) domain.Notification {
+		return domain.Notification{
+			UserID:         "u2",
+			Kind:           domain.NotificationEscalation,
+			Text:           `review of "Add rate limiting" is overdue since 2025-03-05T14:00:00Z, escalated: ` + string(action),
+			PullRequestIDs: []string{"pr-1"},
+		}
+	}
+
+	type fields struct {
+		repo     func(mc *minimock.Controller) repository
+		selector func(mc *minimock.Controller) selector
+		sink     func(mc *minimock.Controller) sink
+		metrics  func(mc *minimock.Controller) metrics
+	}
+	noSelector := func(mc *minimock.Controller) selector { return NewSelectorMock(mc) }
+	noSink := func(mc *minimock.Controller) sink { return NewSinkMock(mc) }
+	noMetrics := func(mc *minimock.Controller) metrics { return NewMetricsMock(mc) }
+
+	tests := []struct {
+		name   string
+		fields fields
+		want   int
+	}{
+		{
+			name: "skip: team without SLA",
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.ListPendingReviewsMock.Expect(minimock.AnyContext, now.Add(-minFirstReviewTime)).
+						Return([]domain.PendingReview{review}, nil)
+					repo.GetTeamPolicyMock.Expect(minimock.AnyContext, "backend", 0).
+						Return(domain.TeamPolicy{}, domain.ErrPolicyNotFound)
+					return repo
+				},
+				selector: noSelector,
+				sink:     noSink,
+				metrics:  noMetrics,
+			},
+		},
+		{
+			name: "skip: deadline not reached",
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.ListPendingReviewsMock.Return([]domain.PendingReview{review}, nil)
+					repo.GetTeamPolicyMock.Return(withSLA(domain.EscalationNotify, 8), nil)
+					return repo
+				},
+				selector: noSelector,
+				sink:     noSink,
+				metrics:  noMetrics,
+			},
+		},
+		{
+			name: "success: PR with two breached reviews is escalated once",
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					second := review
+					second.UserID = "u3"
+					repo := NewRepositoryMock(mc)
+					repo.ListPendingReviewsMock.Return([]domain.PendingReview{review, second}, nil)
+					repo.GetTeamPolicyMock.Return(withSLA(domain.EscalationNotify, 4), nil)
+					repo.EscalateReviewMock.Expect(minimock.AnyContext, domain.Escalation{
+						Review:   review,
+						Action:   domain.EscalationNotify,
+						Deadline: deadline,
+					}).Return(true, nil)
+					return repo
+				},
+				selector: noSelector,
+				sink: func(mc *minimock.Controller) sink {
+					sink := NewSinkMock(mc)
+					sink.SendMock.Expect(minimock.AnyContext, overdue(domain.EscalationNotify)).Return(nil)
+					return sink
+				},
+				metrics: noMetrics,
+			},
+			want: 1,
+		},
+		{
+			name: "success: reviewer is notified",
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.ListPendingReviewsMock.Return([]domain.PendingReview{review}, nil)
+					repo.GetTeamPolicyMock.Return(withSLA(domain.EscalationNotify, 4), nil)
+					repo.EscalateReviewMock.Expect(minimock.AnyContext, domain.Escalation{
+						Review:   review,
+						Action:   domain.EscalationNotify,
+						Deadline: deadline,
+					}).Return(true, nil)
+					return repo
+				},
+				selector: noSelector,
+				sink: func(mc *minimock.Controller) sink {
+					sink := NewSinkMock(mc)
+					sink.SendMock.Expect(minimock.AnyContext, overdue(domain.EscalationNotify)).Return(nil)
+					return sink
+				},
+				metrics: noMetrics,
+			},
+			want: 1,
+		},
+		{
+			name: "success: reviewer is replaced",
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.ListPendingReviewsMock.Return([]domain.PendingReview{review}, nil)
+					repo.GetTeamPolicyMock.Return(withSLA(domain.EscalationReassign, 4), nil)
+					repo.GetPullRequestMock.Expect(minimock.AnyContext, "pr-1").Return(pr, nil)
+					repo.EscalateReviewMock.Expect(minimock.AnyContext, domain.Escalation{
+						Review:       review,
+						Action:       domain.EscalationReassign,
+						Deadline:     deadline,
+						AddReviewers: []domain.Reviewer{replacement},
+					}).Return(true, nil)
+					return repo
+				},
+				selector: func(mc *minimock.Controller) selector {
+					selector := NewSelectorMock(mc)
+					selector.AdditionalMock.Expect(minimock.AnyContext, "u1", []string{"u2", "u3"}, 1).
+						Return(domain.Assignment{Reviewers: []domain.Reviewer{replacement}}, nil)
+					return selector
+				},
+				sink: func(mc *minimock.Controller) sink {
+					sink := NewSinkMock(mc)
+					sink.SendMock.When(minimock.AnyContext, overdue(domain.EscalationReassign)).Then(nil)
+					sink.SendMock.When(minimock.AnyContext, domain.Notification{
+						UserID:         "u4",
+						Kind:           domain.NotificationAssigned,
+						Text:           `you were assigned to review "Add rate limiting" after an SLA escalation`,
+						PullRequestIDs: []string{"pr-1"},
+					}).Then(nil)
+					return sink
+				},
+				metrics: func(mc *minimock.Controller) metrics {
+					metrics := NewMetricsMock(mc)
+					metrics.ReviewerReassignedMock.Expect(domain.EventEscalated).Return()
+					return metrics
+				},
+			},
+			want: 1,
+		},
+		{
+			name: "success: falls back to notify without candidates",
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.ListPendingReviewsMock.Return([]domain.PendingReview{review}, nil)
+					repo.GetTeamPolicyMock.Return(withSLA(domain.EscalationAddReviewer, 4), nil)
+					repo.GetPullRequestMock.Return(pr, nil)
+					repo.EscalateReviewMock.Expect(minimock.AnyContext, domain.Escalation{
+						Review:   review,
+						Action:   domain.EscalationNotify,
+						Deadline: deadline,
+					}).Return(true, nil)
+					return repo
+				},
+				selector: func(mc *minimock.Controller) selector {
+					selector := NewSelectorMock(mc)
+					selector.AdditionalMock.Return(domain.Assignment{}, domain.ErrNotEnoughReviewers)
+					return selector
+				},
+				sink: func(mc *minimock.Controller) sink {
+					sink := NewSinkMock(mc)
+					sink.SendMock.Expect(minimock.AnyContext, overdue(domain.EscalationNotify)).Return(nil)
+					return sink
+				},
+				metrics: func(mc *minimock.Controller) metrics {
+					metrics := NewMetricsMock(mc)
+					metrics.AssignmentFailedMock.Expect(domain.ErrNotEnoughReviewers).Return()
+					return metrics
+				},
+			},
+			want: 1,
+		},
+		{
+			name: "skip: claimed by another replica",
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					second := review
+					second.UserID = "u3"
+					repo := NewRepositoryMock(mc)
+					repo.ListPendingReviewsMock.Return([]domain.PendingReview{review, second}, nil)
+					repo.GetTeamPolicyMock.Return(withSLA(domain.EscalationNotify, 4), nil)
+					repo.EscalateReviewMock.Times(1).Return(false, nil)
+					return repo
+				},
+				selector: noSelector,
+				sink:     noSink,
+				metrics:  noMetrics,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			worker := New(tt.fields.repo(mc), tt.fields.selector(mc), tt.fields.sink(mc), tt.fields.metrics(mc),
+				zap.NewNop(), time.Minute)
+			worker.now = func() time.Time { return now }
+
+			escalated, err := worker.Escalate(context.Background())
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, escalated)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package reviews
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		ExportReviews(ctx context.Context, filter domain.ReportFilter,
+			yield func(record domain.ReviewRecord) error) error
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// ExportReviews streams the reviewer history of the range to yield, one record at a time.
+func (h *Handler) ExportReviews(ctx context.Context, filter domain.ReportFilter,
+	yield func(record domain.ReviewRecord) error,
+) error {
+	logger := h.logger.With(
+		zap.String("service", "export.reviews"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	var records int
+	err := h.repo.ExportReviews(ctx, filter, func(record domain.ReviewRecord) error {
+		records++
+		return yield(record)
+	})
+	if err != nil {
+		logger.Error("repo.ExportReviews", zap.Error(err), zap.Int("records", records),
+			zap.Time("from", filter.From), zap.Time("to", filter.To))
+		return fmt.Errorf("repo.ExportReviews: %w", err)
+	}
+
+	logger.Info("reviews exported", zap.Int("records", records), zap.Time("from", filter.From),
+		zap.Time("to", filter.To))
+	return nil
+}
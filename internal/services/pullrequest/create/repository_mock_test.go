@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package create
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/create.repository -o repository_mock_test.go -n RepositoryMock -p create
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreatePullRequest          func(ctx context.Context, pr domain.PullRequestDTO) (p1 domain.PullRequest, err error)
+	funcCreatePullRequestOrigin    string
+	inspectFuncCreatePullRequest   func(ctx context.Context, pr domain.PullRequestDTO)
+	afterCreatePullRequestCounter  uint64
+	beforeCreatePullRequestCounter uint64
+	CreatePullRequestMock          mRepositoryMockCreatePullRequest
+}
+
+// NewRepositoryMock returns a mock for repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreatePullRequestMock = mRepositoryMockCreatePullRequest{mock: m}
+	m.CreatePullRequestMock.callArgs = []*RepositoryMockCreatePullRequestParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockCreatePullRequest struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockCreatePullRequestExpectation
+	expectations       []*RepositoryMockCreatePullRequestExpectation
+
+	callArgs []*RepositoryMockCreatePullRequestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockCreatePullRequestExpectation specifies expectation struct of the repository.CreatePullRequest
+type RepositoryMockCreatePullRequestExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockCreatePullRequestParams
+	paramPtrs          *RepositoryMockCreatePullRequestParamPtrs
+	expectationOrigins RepositoryMockCreatePullRequestExpectationOrigins
+	results            *RepositoryMockCreatePullRequestResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockCreatePullRequestParams contains parameters of the repository.CreatePullRequest
+type RepositoryMockCreatePullRequestParams struct {
+	ctx context.Context
+	pr  domain.PullRequestDTO
+}
+
+// RepositoryMockCreatePullRequestParamPtrs contains pointers to parameters of the repository.CreatePullRequest
+type RepositoryMockCreatePullRequestParamPtrs struct {
+	ctx *context.Context
+	pr  *domain.PullRequestDTO
+}
+
+// RepositoryMockCreatePullRequestResults contains results of the repository.CreatePullRequest
+type RepositoryMockCreatePullRequestResults struct {
+	p1  domain.PullRequest
+	err error
+}
+
+// RepositoryMockCreatePullRequestOrigins contains origins of expectations of the repository.CreatePullRequest
+type RepositoryMockCreatePullRequestExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originPr  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreatePullRequest *mRepositoryMockCreatePullRequest) Optional() *mRepositoryMockCreatePullRequest {
+	mmCreatePullRequest.optional = true
+	return mmCreatePullRequest
+}
+
+// Expect sets up expected params for repository.CreatePullRequest
+func (mmCreatePullRequest *mRepositoryMockCreatePullRequest) Expect(ctx context.Context, pr domain.PullRequestDTO) *mRepositoryMockCreatePullRequest {
+	if mmCreatePullRequest.mock.funcCreatePullRequest != nil {
+		mmCreatePullRequest.mock.t.Fatalf("RepositoryMock.CreatePullRequest mock is already set by Set")
+	}
+
+	if mmCreatePullRequest.defaultExpectation == nil {
+		mmCreatePullRequest.defaultExpectation = &RepositoryMockCreatePullRequestExpectation{}
+	}
+
+	if mmCreatePullRequest.defaultExpectation.paramPtrs != nil {
+		mmCreatePullRequest.mock.t.Fatalf("RepositoryMock.CreatePullRequest mock is already set by ExpectParams functions")
+	}
+
+	mmCreatePullRequest.defaultExpectation.params = &RepositoryMockCreatePullRequestParams{ctx, pr}
+	mmCreatePullRequest.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreatePullRequest.expectations {
+		if minimock.Equal(e.params, mmCreatePullRequest.defaultExpectation.params) {
+			mmCreatePullRequest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePullRequest.defaultExpectation.params)
+		}
+	}
+
+	return mmCreatePullRequest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.CreatePullRequest
+func (mmCreatePullRequest *mRepositoryMockCreatePullRequest) ExpectCtxParam1(ctx context.Context) *mRepositoryMockCreatePullRequest {
+	if mmCreatePullRequest.mock.funcCreatePullRequest != nil {
+		mmCreatePullRequest.mock.t.Fatalf("RepositoryMock.CreatePullRequest mock is already set by Set")
+	}
+
+	if mmCreatePullRequest.defaultExpectation == nil {
+		mmCreatePullRequest.defaultExpectation = &RepositoryMockCreatePullRequestExpectation{}
+	}
+
+	if mmCreatePullRequest.defaultExpectation.params != nil {
+		mmCreatePullRequest.mock.t.Fatalf("RepositoryMock.CreatePullRequest mock is already set by Expect")
+	}
+
+	if mmCreatePullRequest.defaultExpectation.paramPtrs == nil {
+		mmCreatePullRequest.defaultExpectation.paramPtrs = &RepositoryMockCreatePullRequestParamPtrs{}
+	}
+	mmCreatePullRequest.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreatePullRequest.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreatePullRequest
+}
+
+// ExpectPrParam2 sets up expected param pr for repository.CreatePullRequest
+func (mmCreatePullRequest *mRepositoryMockCreatePullRequest) ExpectPrParam2(pr domain.PullRequestDTO) *mRepositoryMockCreatePullRequest {
+	if mmCreatePullRequest.mock.funcCreatePullRequest != nil {
+		mmCreatePullRequest.mock.t.Fatalf("RepositoryMock.CreatePullRequest mock is already set by Set")
+	}
+
+	if mmCreatePullRequest.defaultExpectation == nil {
+		mmCreatePullRequest.defaultExpectation = &RepositoryMockCreatePullRequestExpectation{}
+	}
+
+	if mmCreatePullRequest.defaultExpectation.params != nil {
+		mmCreatePullRequest.mock.t.Fatalf("RepositoryMock.CreatePullRequest mock is already set by Expect")
+	}
+
+	if mmCreatePullRequest.defaultExpectation.paramPtrs == nil {
+		mmCreatePullRequest.defaultExpectation.paramPtrs = &RepositoryMockCreatePullRequestParamPtrs{}
+	}
+	mmCreatePullRequest.defaultExpectation.paramPtrs.pr = &pr
+	mmCreatePullRequest.defaultExpectation.expectationOrigins.originPr = minimock.CallerInfo(1)
+
+	return mmCreatePullRequest
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.CreatePullRequest
+func (mmCreatePullRequest *mRepositoryMockCreatePullRequest) Inspect(f func(ctx context.Context, pr domain.PullRequestDTO)) *mRepositoryMockCreatePullRequest {
+	if mmCreatePullRequest.mock.inspectFuncCreatePullRequest != nil {
+		mmCreatePullRequest.mock.t.Fatalf("Inspect function is already set for RepositoryMock.CreatePullRequest")
+	}
+
+	mmCreatePullRequest.mock.inspectFuncCreatePullRequest = f
+
+	return mmCreatePullRequest
+}
+
+// Return sets up results that will be returned by repository.CreatePullRequest
+func (mmCreatePullRequest *mRepositoryMockCreatePullRequest) Return(p1 domain.PullRequest, err error) *RepositoryMock {
+	if mmCreatePullRequest.mock.funcCreatePullRequest != nil {
+		mmCreatePullRequest.mock.t.Fatalf("RepositoryMock.CreatePullRequest mock is already set by Set")
+	}
+
+	if mmCreatePullRequest.defaultExpectation == nil {
+		mmCreatePullRequest.defaultExpectation = &RepositoryMockCreatePullRequestExpectation{mock: mmCreatePullRequest.mock}
+	}
+	mmCreatePullRequest.defaultExpectation.results = &RepositoryMockCreatePullRequestResults{p1, err}
+	mmCreatePullRequest.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreatePullRequest.mock
+}
+
+// Set uses given function f to mock the repository.CreatePullRequest method
+func (mmCreatePullRequest *mRepositoryMockCreatePullRequest) Set(f func(ctx context.Context, pr domain.PullRequestDTO) (p1 domain.PullRequest, err error)) *RepositoryMock {
+	if mmCreatePullRequest.defaultExpectation != nil {
+		mmCreatePullRequest.mock.t.Fatalf("Default expectation is already set for the repository.CreatePullRequest method")
+	}
+
+	if len(mmCreatePullRequest.expectations) > 0 {
+		mmCreatePullRequest.mock.t.Fatalf("Some expectations are already set for the repository.CreatePullRequest method")
+	}
+
+	mmCreatePullRequest.mock.funcCreatePullRequest = f
+	mmCreatePullRequest.mock.funcCreatePullRequestOrigin = minimock.CallerInfo(1)
+	return mmCreatePullRequest.mock
+}
+
+// When sets expectation for the repository.CreatePullRequest which will trigger the result defined by the following
+// Then helper
+func (mmCreatePullRequest *mRepositoryMockCreatePullRequest) When(ctx context.Context, pr domain.PullRequestDTO) *RepositoryMockCreatePullRequestExpectation {
+	if mmCreatePullRequest.mock.funcCreatePullRequest != nil {
+		mmCreatePullRequest.mock.t.Fatalf("RepositoryMock.CreatePullRequest mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockCreatePullRequestExpectation{
+		mock:               mmCreatePullRequest.mock,
+		params:             &RepositoryMockCreatePullRequestParams{ctx, pr},
+		expectationOrigins: RepositoryMockCreatePullRequestExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreatePullRequest.expectations = append(mmCreatePullRequest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.CreatePullRequest return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockCreatePullRequestExpectation) Then(p1 domain.PullRequest, err error) *RepositoryMock {
+	e.results = &RepositoryMockCreatePullRequestResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.CreatePullRequest should be invoked
+func (mmCreatePullRequest *mRepositoryMockCreatePullRequest) Times(n uint64) *mRepositoryMockCreatePullRequest {
+	if n == 0 {
+		mmCreatePullRequest.mock.t.Fatalf("Times of RepositoryMock.CreatePullRequest mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreatePullRequest.expectedInvocations, n)
+	mmCreatePullRequest.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreatePullRequest
+}
+
+func (mmCreatePullRequest *mRepositoryMockCreatePullRequest) invocationsDone() bool {
+	if len(mmCreatePullRequest.expectations) == 0 && mmCreatePullRequest.defaultExpectation == nil && mmCreatePullRequest.mock.funcCreatePullRequest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePullRequest.mock.afterCreatePullRequestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePullRequest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreatePullRequest implements repository
+func (mmCreatePullRequest *RepositoryMock) CreatePullRequest(ctx context.Context, pr domain.PullRequestDTO) (p1 domain.PullRequest, err error) {
+	mm_atomic.AddUint64(&mmCreatePullRequest.beforeCreatePullRequestCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePullRequest.afterCreatePullRequestCounter, 1)
+
+	mmCreatePullRequest.t.Helper()
+
+	if mmCreatePullRequest.inspectFuncCreatePullRequest != nil {
+		mmCreatePullRequest.inspectFuncCreatePullRequest(ctx, pr)
+	}
+
+	mm_params := RepositoryMockCreatePullRequestParams{ctx, pr}
+
+	// Record call args
+	mmCreatePullRequest.CreatePullRequestMock.mutex.Lock()
+	mmCreatePullRequest.CreatePullRequestMock.callArgs = append(mmCreatePullRequest.CreatePullRequestMock.callArgs, &mm_params)
+	mmCreatePullRequest.CreatePullRequestMock.mutex.Unlock()
+
+	for _, e := range mmCreatePullRequest.CreatePullRequestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmCreatePullRequest.CreatePullRequestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePullRequest.CreatePullRequestMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePullRequest.CreatePullRequestMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePullRequest.CreatePullRequestMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockCreatePullRequestParams{ctx, pr}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreatePullRequest.t.Errorf("RepositoryMock.CreatePullRequest got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePullRequest.CreatePullRequestMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pr != nil && !minimock.Equal(*mm_want_ptrs.pr, mm_got.pr) {
+				mmCreatePullRequest.t.Errorf("RepositoryMock.CreatePullRequest got unexpected parameter pr, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePullRequest.CreatePullRequestMock.defaultExpectation.expectationOrigins.originPr, *mm_want_ptrs.pr, mm_got.pr, minimock.Diff(*mm_want_ptrs.pr, mm_got.pr))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePullRequest.t.Errorf("RepositoryMock.CreatePullRequest got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreatePullRequest.CreatePullRequestMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePullRequest.CreatePullRequestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePullRequest.t.Fatal("No results are set for the RepositoryMock.CreatePullRequest")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmCreatePullRequest.funcCreatePullRequest != nil {
+		return mmCreatePullRequest.funcCreatePullRequest(ctx, pr)
+	}
+	mmCreatePullRequest.t.Fatalf("Unexpected call to RepositoryMock.CreatePullRequest. %v %v", ctx, pr)
+	return
+}
+
+// CreatePullRequestAfterCounter returns a count of finished RepositoryMock.CreatePullRequest invocations
+func (mmCreatePullRequest *RepositoryMock) CreatePullRequestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePullRequest.afterCreatePullRequestCounter)
+}
+
+// CreatePullRequestBeforeCounter returns a count of RepositoryMock.CreatePullRequest invocations
+func (mmCreatePullRequest *RepositoryMock) CreatePullRequestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePullRequest.beforeCreatePullRequestCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.CreatePullRequest.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePullRequest *mRepositoryMockCreatePullRequest) Calls() []*RepositoryMockCreatePullRequestParams {
+	mmCreatePullRequest.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockCreatePullRequestParams, len(mmCreatePullRequest.callArgs))
+	copy(argCopy, mmCreatePullRequest.callArgs)
+
+	mmCreatePullRequest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePullRequestDone returns true if the count of the CreatePullRequest invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockCreatePullRequestDone() bool {
+	if m.CreatePullRequestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePullRequestMock.invocationsDone()
+}
+
+// MinimockCreatePullRequestInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockCreatePullRequestInspect() {
+	for _, e := range m.CreatePullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.CreatePullRequest at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreatePullRequestCounter := mm_atomic.LoadUint64(&m.afterCreatePullRequestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePullRequestMock.defaultExpectation != nil && afterCreatePullRequestCounter < 1 {
+		if m.CreatePullRequestMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.CreatePullRequest at\n%s", m.CreatePullRequestMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.CreatePullRequest at\n%s with params: %#v", m.CreatePullRequestMock.defaultExpectation.expectationOrigins.origin, *m.CreatePullRequestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePullRequest != nil && afterCreatePullRequestCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.CreatePullRequest at\n%s", m.funcCreatePullRequestOrigin)
+	}
+
+	if !m.CreatePullRequestMock.invocationsDone() && afterCreatePullRequestCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.CreatePullRequest at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePullRequestMock.expectedInvocations), m.CreatePullRequestMock.expectedInvocationsOrigin, afterCreatePullRequestCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreatePullRequestInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreatePullRequestDone()
+}
@@ -0,0 +1,98 @@
+package create
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		CreatePullRequest(ctx context.Context, pr domain.PullRequestDTO) (domain.PullRequest, error)
+	}
+	selector interface {
+		Select(ctx context.Context, pr domain.CreatePullRequest) (domain.Assignment, error)
+	}
+	metrics interface {
+		PullRequestCreated(status domain.PRStatus)
+		AssignmentFailed(err error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo     repository
+		selector selector
+		metrics  metrics
+		logger   logger
+	}
+)
+
+func New(repo repository, selector selector, metrics metrics, logger logger) *Handler {
+	return &Handler{
+		repo:     repo,
+		selector: selector,
+		metrics:  metrics,
+		logger:   logger,
+	}
+}
+
+// CreatePullRequest creates the PR with the selected reviewers. Requested reviewers that could not
+// be honoured do not fail the creation, they are returned in the result instead. Drafts are created
+// without reviewers so they do not consume review capacity. PRs of a repository are stored under
+// their namespaced key, and so are the PRs they depend on.
+func (h *Handler) CreatePullRequest(ctx context.Context, pr domain.CreatePullRequest,
+) (domain.CreatePullRequestResult, error) {
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.create"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	pr.PullRequestID = pr.Key()
+
+	dto := domain.PullRequestDTO{
+		PullRequestID:   pr.PullRequestID,
+		PullRequestName: pr.PullRequestName,
+		AuthorID:        pr.AuthorID,
+		Status:          domain.PRStatusDraft,
+		Repository:      pr.Repository,
+		SourceBranch:    pr.SourceBranch,
+		TargetBranch:    pr.TargetBranch,
+		URL:             pr.URL,
+		Labels:          pr.Labels,
+		HeadSHA:         pr.HeadSHA,
+		DependsOn:       pr.DependencyKeys(),
+	}
+
+	var assignment domain.Assignment
+	if !pr.Draft {
+		var err error
+		assignment, err = h.selector.Select(ctx, pr)
+		if err != nil {
+			h.metrics.AssignmentFailed(err)
+			logger.Error("selector.Select", zap.Error(err), zap.String("author_id", pr.AuthorID))
+			return domain.CreatePullRequestResult{}, fmt.Errorf("selector.Select: %w", err)
+		}
+
+		dto.Status = domain.PRStatusOpen
+		dto.Reviewers = assignment.AllReviewers()
+	}
+
+	created, err := h.repo.CreatePullRequest(ctx, dto)
+	if err != nil {
+		logger.Error("repo.CreatePullRequest", zap.Error(err), zap.String("pull_request_id", pr.PullRequestID))
+		return domain.CreatePullRequestResult{}, fmt.Errorf("repo.CreatePullRequest: %w", err)
+	}
+	h.metrics.PullRequestCreated(created.Status)
+
+	return domain.CreatePullRequestResult{
+		PullRequest:      created,
+		RejectedRequests: assignment.RejectedRequests,
+	}, nil
+}
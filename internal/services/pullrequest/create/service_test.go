@@ -0,0 +1,187 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func TestHandler_CreatePullRequest(t *testing.T) {
+	t.Parallel()
+
+	request := domain.CreatePullRequest{
+		PullRequestID:   "42",
+		PullRequestName: "Add rate limiting",
+		AuthorID:        "u1",
+		Repository:      "acme/api",
+		Labels:          []string{"backend"},
+		DependsOn:       []string{"41"},
+	}
+	keyed := request
+	keyed.PullRequestID = "acme/api#42"
+	draft := request
+	draft.Draft = true
+
+	reviewer := domain.Reviewer{UserID: "u2", Role: domain.ReviewerRoleReviewer, Source: domain.ReviewerSourceAuto}
+	shadow := domain.Reviewer{UserID: "t1", Role: domain.ReviewerRoleShadow, Source: domain.ReviewerSourceAuto}
+	rejected := []domain.RejectedCandidate{{UserID: "u5", Reason: domain.RejectionAtCapacity}}
+	assignment := domain.Assignment{
+		Reviewers:        []domain.Reviewer{reviewer},
+		Shadow:           &shadow,
+		RejectedRequests: rejected,
+	}
+	dto := func(status domain.PRStatus, reviewers ...domain.Reviewer) domain.PullRequestDTO {
+		return domain.PullRequestDTO{
+			PullRequestID:   "acme/api#42",
+			PullRequestName: "Add rate limiting",
+			AuthorID:        "u1",
+			Status:          status,
+			Reviewers:       reviewers,
+			Repository:      "acme/api",
+			Labels:          []string{"backend"},
+			DependsOn:       []string{"acme/api#41"},
+		}
+	}
+
+	type fields struct {
+		repo     func(mc *minimock.Controller) repository
+		selector func(mc *minimock.Controller) selector
+		metrics  func(mc *minimock.Controller) metrics
+	}
+	created := func(status domain.PRStatus) func(mc *minimock.Controller) metrics {
+		return func(mc *minimock.Controller) metrics {
+			metrics := NewMetricsMock(mc)
+			metrics.PullRequestCreatedMock.Expect(status).Return()
+			return metrics
+		}
+	}
+	noRepo := func(mc *minimock.Controller) repository { return NewRepositoryMock(mc) }
+	noSelector := func(mc *minimock.Controller) selector { return NewSelectorMock(mc) }
+	noMetrics := func(mc *minimock.Controller) metrics { return NewMetricsMock(mc) }
+
+	tests := []struct {
+		name    string
+		request domain.CreatePullRequest
+		fields  fields
+		want    domain.CreatePullRequestResult
+		wantErr error
+	}{
+		{
+			name:    "success: open PR is stored with the selected reviewers under its namespaced key",
+			request: request,
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.CreatePullRequestMock.Expect(minimock.AnyContext, dto(domain.PRStatusOpen, reviewer, shadow)).
+						Return(domain.PullRequest{PullRequestID: "acme/api#42", Status: domain.PRStatusOpen}, nil)
+					return repo
+				},
+				selector: func(mc *minimock.Controller) selector {
+					selector := NewSelectorMock(mc)
+					selector.SelectMock.Expect(minimock.AnyContext, keyed).Return(assignment, nil)
+					return selector
+				},
+				metrics: created(domain.PRStatusOpen),
+			},
+			want: domain.CreatePullRequestResult{
+				PullRequest:      domain.PullRequest{PullRequestID: "acme/api#42", Status: domain.PRStatusOpen},
+				RejectedRequests: rejected,
+			},
+		},
+		{
+			name:    "success: draft is stored without reviewers",
+			request: draft,
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.CreatePullRequestMock.Expect(minimock.AnyContext, dto(domain.PRStatusDraft)).
+						Return(domain.PullRequest{PullRequestID: "acme/api#42", Status: domain.PRStatusDraft}, nil)
+					return repo
+				},
+				selector: noSelector,
+				metrics:  created(domain.PRStatusDraft),
+			},
+			want: domain.CreatePullRequestResult{
+				PullRequest: domain.PullRequest{PullRequestID: "acme/api#42", Status: domain.PRStatusDraft},
+			},
+		},
+		{
+			name:    "error: failed selection is counted and nothing is stored",
+			request: request,
+			fields: fields{
+				repo: noRepo,
+				selector: func(mc *minimock.Controller) selector {
+					selector := NewSelectorMock(mc)
+					selector.SelectMock.Return(domain.Assignment{}, domain.ErrNotEnoughReviewers)
+					return selector
+				},
+				metrics: func(mc *minimock.Controller) metrics {
+					metrics := NewMetricsMock(mc)
+					metrics.AssignmentFailedMock.Expect(domain.ErrNotEnoughReviewers).Return()
+					return metrics
+				},
+			},
+			wantErr: domain.ErrNotEnoughReviewers,
+		},
+		{
+			name:    "error: PR already exists",
+			request: request,
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.CreatePullRequestMock.Return(domain.PullRequest{}, domain.ErrPRExists)
+					return repo
+				},
+				selector: func(mc *minimock.Controller) selector {
+					selector := NewSelectorMock(mc)
+					selector.SelectMock.Return(assignment, nil)
+					return selector
+				},
+				metrics: noMetrics,
+			},
+			wantErr: domain.ErrPRExists,
+		},
+		{
+			name:    "error: database failure",
+			request: draft,
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.CreatePullRequestMock.Return(domain.PullRequest{}, errors.New("database connection failed"))
+					return repo
+				},
+				selector: noSelector,
+				metrics:  noMetrics,
+			},
+			wantErr: errors.New("database connection failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			handler := New(tt.fields.repo(mc), tt.fields.selector(mc), tt.fields.metrics(mc), zap.NewNop())
+
+			got, err := handler.CreatePullRequest(context.Background(), tt.request)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr.Error())
+				assert.Equal(t, domain.CreatePullRequestResult{}, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
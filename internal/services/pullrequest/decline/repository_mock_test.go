@@ -0,0 +1,1161 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package decline
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/decline.repository -o repository_mock_test.go -n RepositoryMock -p decline
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDeclineReview          func(ctx context.Context, decline domain.DeclineReview, replacement []domain.Reviewer) (p1 domain.PullRequest, err error)
+	funcDeclineReviewOrigin    string
+	inspectFuncDeclineReview   func(ctx context.Context, decline domain.DeclineReview, replacement []domain.Reviewer)
+	afterDeclineReviewCounter  uint64
+	beforeDeclineReviewCounter uint64
+	DeclineReviewMock          mRepositoryMockDeclineReview
+
+	funcGetPullRequest          func(ctx context.Context, pullRequestID string) (p1 domain.PullRequest, err error)
+	funcGetPullRequestOrigin    string
+	inspectFuncGetPullRequest   func(ctx context.Context, pullRequestID string)
+	afterGetPullRequestCounter  uint64
+	beforeGetPullRequestCounter uint64
+	GetPullRequestMock          mRepositoryMockGetPullRequest
+
+	funcListDeclinedReviewers          func(ctx context.Context, pullRequestID string) (sa1 []string, err error)
+	funcListDeclinedReviewersOrigin    string
+	inspectFuncListDeclinedReviewers   func(ctx context.Context, pullRequestID string)
+	afterListDeclinedReviewersCounter  uint64
+	beforeListDeclinedReviewersCounter uint64
+	ListDeclinedReviewersMock          mRepositoryMockListDeclinedReviewers
+}
+
+// NewRepositoryMock returns a mock for repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeclineReviewMock = mRepositoryMockDeclineReview{mock: m}
+	m.DeclineReviewMock.callArgs = []*RepositoryMockDeclineReviewParams{}
+
+	m.GetPullRequestMock = mRepositoryMockGetPullRequest{mock: m}
+	m.GetPullRequestMock.callArgs = []*RepositoryMockGetPullRequestParams{}
+
+	m.ListDeclinedReviewersMock = mRepositoryMockListDeclinedReviewers{mock: m}
+	m.ListDeclinedReviewersMock.callArgs = []*RepositoryMockListDeclinedReviewersParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockDeclineReview struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockDeclineReviewExpectation
+	expectations       []*RepositoryMockDeclineReviewExpectation
+
+	callArgs []*RepositoryMockDeclineReviewParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockDeclineReviewExpectation specifies expectation struct of the repository.DeclineReview
+type RepositoryMockDeclineReviewExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockDeclineReviewParams
+	paramPtrs          *RepositoryMockDeclineReviewParamPtrs
+	expectationOrigins RepositoryMockDeclineReviewExpectationOrigins
+	results            *RepositoryMockDeclineReviewResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockDeclineReviewParams contains parameters of the repository.DeclineReview
+type RepositoryMockDeclineReviewParams struct {
+	ctx         context.Context
+	decline     domain.DeclineReview
+	replacement []domain.Reviewer
+}
+
+// RepositoryMockDeclineReviewParamPtrs contains pointers to parameters of the repository.DeclineReview
+type RepositoryMockDeclineReviewParamPtrs struct {
+	ctx         *context.Context
+	decline     *domain.DeclineReview
+	replacement *[]domain.Reviewer
+}
+
+// RepositoryMockDeclineReviewResults contains results of the repository.DeclineReview
+type RepositoryMockDeclineReviewResults struct {
+	p1  domain.PullRequest
+	err error
+}
+
+// RepositoryMockDeclineReviewOrigins contains origins of expectations of the repository.DeclineReview
+type RepositoryMockDeclineReviewExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originDecline     string
+	originReplacement string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeclineReview *mRepositoryMockDeclineReview) Optional() *mRepositoryMockDeclineReview {
+	mmDeclineReview.optional = true
+	return mmDeclineReview
+}
+
+// Expect sets up expected params for repository.DeclineReview
+func (mmDeclineReview *mRepositoryMockDeclineReview) Expect(ctx context.Context, decline domain.DeclineReview, replacement []domain.Reviewer) *mRepositoryMockDeclineReview {
+	if mmDeclineReview.mock.funcDeclineReview != nil {
+		mmDeclineReview.mock.t.Fatalf("RepositoryMock.DeclineReview mock is already set by Set")
+	}
+
+	if mmDeclineReview.defaultExpectation == nil {
+		mmDeclineReview.defaultExpectation = &RepositoryMockDeclineReviewExpectation{}
+	}
+
+	if mmDeclineReview.defaultExpectation.paramPtrs != nil {
+		mmDeclineReview.mock.t.Fatalf("RepositoryMock.DeclineReview mock is already set by ExpectParams functions")
+	}
+
+	mmDeclineReview.defaultExpectation.params = &RepositoryMockDeclineReviewParams{ctx, decline, replacement}
+	mmDeclineReview.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeclineReview.expectations {
+		if minimock.Equal(e.params, mmDeclineReview.defaultExpectation.params) {
+			mmDeclineReview.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeclineReview.defaultExpectation.params)
+		}
+	}
+
+	return mmDeclineReview
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.DeclineReview
+func (mmDeclineReview *mRepositoryMockDeclineReview) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeclineReview {
+	if mmDeclineReview.mock.funcDeclineReview != nil {
+		mmDeclineReview.mock.t.Fatalf("RepositoryMock.DeclineReview mock is already set by Set")
+	}
+
+	if mmDeclineReview.defaultExpectation == nil {
+		mmDeclineReview.defaultExpectation = &RepositoryMockDeclineReviewExpectation{}
+	}
+
+	if mmDeclineReview.defaultExpectation.params != nil {
+		mmDeclineReview.mock.t.Fatalf("RepositoryMock.DeclineReview mock is already set by Expect")
+	}
+
+	if mmDeclineReview.defaultExpectation.paramPtrs == nil {
+		mmDeclineReview.defaultExpectation.paramPtrs = &RepositoryMockDeclineReviewParamPtrs{}
+	}
+	mmDeclineReview.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeclineReview.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeclineReview
+}
+
+// ExpectDeclineParam2 sets up expected param decline for repository.DeclineReview
+func (mmDeclineReview *mRepositoryMockDeclineReview) ExpectDeclineParam2(decline domain.DeclineReview) *mRepositoryMockDeclineReview {
+	if mmDeclineReview.mock.funcDeclineReview != nil {
+		mmDeclineReview.mock.t.Fatalf("RepositoryMock.DeclineReview mock is already set by Set")
+	}
+
+	if mmDeclineReview.defaultExpectation == nil {
+		mmDeclineReview.defaultExpectation = &RepositoryMockDeclineReviewExpectation{}
+	}
+
+	if mmDeclineReview.defaultExpectation.params != nil {
+		mmDeclineReview.mock.t.Fatalf("RepositoryMock.DeclineReview mock is already set by Expect")
+	}
+
+	if mmDeclineReview.defaultExpectation.paramPtrs == nil {
+		mmDeclineReview.defaultExpectation.paramPtrs = &RepositoryMockDeclineReviewParamPtrs{}
+	}
+	mmDeclineReview.defaultExpectation.paramPtrs.decline = &decline
+	mmDeclineReview.defaultExpectation.expectationOrigins.originDecline = minimock.CallerInfo(1)
+
+	return mmDeclineReview
+}
+
+// ExpectReplacementParam3 sets up expected param replacement for repository.DeclineReview
+func (mmDeclineReview *mRepositoryMockDeclineReview) ExpectReplacementParam3(replacement []domain.Reviewer) *mRepositoryMockDeclineReview {
+	if mmDeclineReview.mock.funcDeclineReview != nil {
+		mmDeclineReview.mock.t.Fatalf("RepositoryMock.DeclineReview mock is already set by Set")
+	}
+
+	if mmDeclineReview.defaultExpectation == nil {
+		mmDeclineReview.defaultExpectation = &RepositoryMockDeclineReviewExpectation{}
+	}
+
+	if mmDeclineReview.defaultExpectation.params != nil {
+		mmDeclineReview.mock.t.Fatalf("RepositoryMock.DeclineReview mock is already set by Expect")
+	}
+
+	if mmDeclineReview.defaultExpectation.paramPtrs == nil {
+		mmDeclineReview.defaultExpectation.paramPtrs = &RepositoryMockDeclineReviewParamPtrs{}
+	}
+	mmDeclineReview.defaultExpectation.paramPtrs.replacement = &replacement
+	mmDeclineReview.defaultExpectation.expectationOrigins.originReplacement = minimock.CallerInfo(1)
+
+	return mmDeclineReview
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.DeclineReview
+func (mmDeclineReview *mRepositoryMockDeclineReview) Inspect(f func(ctx context.Context, decline domain.DeclineReview, replacement []domain.Reviewer)) *mRepositoryMockDeclineReview {
+	if mmDeclineReview.mock.inspectFuncDeclineReview != nil {
+		mmDeclineReview.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeclineReview")
+	}
+
+	mmDeclineReview.mock.inspectFuncDeclineReview = f
+
+	return mmDeclineReview
+}
+
+// Return sets up results that will be returned by repository.DeclineReview
+func (mmDeclineReview *mRepositoryMockDeclineReview) Return(p1 domain.PullRequest, err error) *RepositoryMock {
+	if mmDeclineReview.mock.funcDeclineReview != nil {
+		mmDeclineReview.mock.t.Fatalf("RepositoryMock.DeclineReview mock is already set by Set")
+	}
+
+	if mmDeclineReview.defaultExpectation == nil {
+		mmDeclineReview.defaultExpectation = &RepositoryMockDeclineReviewExpectation{mock: mmDeclineReview.mock}
+	}
+	mmDeclineReview.defaultExpectation.results = &RepositoryMockDeclineReviewResults{p1, err}
+	mmDeclineReview.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeclineReview.mock
+}
+
+// Set uses given function f to mock the repository.DeclineReview method
+func (mmDeclineReview *mRepositoryMockDeclineReview) Set(f func(ctx context.Context, decline domain.DeclineReview, replacement []domain.Reviewer) (p1 domain.PullRequest, err error)) *RepositoryMock {
+	if mmDeclineReview.defaultExpectation != nil {
+		mmDeclineReview.mock.t.Fatalf("Default expectation is already set for the repository.DeclineReview method")
+	}
+
+	if len(mmDeclineReview.expectations) > 0 {
+		mmDeclineReview.mock.t.Fatalf("Some expectations are already set for the repository.DeclineReview method")
+	}
+
+	mmDeclineReview.mock.funcDeclineReview = f
+	mmDeclineReview.mock.funcDeclineReviewOrigin = minimock.CallerInfo(1)
+	return mmDeclineReview.mock
+}
+
+// When sets expectation for the repository.DeclineReview which will trigger the result defined by the following
+// Then helper
+func (mmDeclineReview *mRepositoryMockDeclineReview) When(ctx context.Context, decline domain.DeclineReview, replacement []domain.Reviewer) *RepositoryMockDeclineReviewExpectation {
+	if mmDeclineReview.mock.funcDeclineReview != nil {
+		mmDeclineReview.mock.t.Fatalf("RepositoryMock.DeclineReview mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeclineReviewExpectation{
+		mock:               mmDeclineReview.mock,
+		params:             &RepositoryMockDeclineReviewParams{ctx, decline, replacement},
+		expectationOrigins: RepositoryMockDeclineReviewExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeclineReview.expectations = append(mmDeclineReview.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.DeclineReview return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeclineReviewExpectation) Then(p1 domain.PullRequest, err error) *RepositoryMock {
+	e.results = &RepositoryMockDeclineReviewResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.DeclineReview should be invoked
+func (mmDeclineReview *mRepositoryMockDeclineReview) Times(n uint64) *mRepositoryMockDeclineReview {
+	if n == 0 {
+		mmDeclineReview.mock.t.Fatalf("Times of RepositoryMock.DeclineReview mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeclineReview.expectedInvocations, n)
+	mmDeclineReview.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeclineReview
+}
+
+func (mmDeclineReview *mRepositoryMockDeclineReview) invocationsDone() bool {
+	if len(mmDeclineReview.expectations) == 0 && mmDeclineReview.defaultExpectation == nil && mmDeclineReview.mock.funcDeclineReview == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeclineReview.mock.afterDeclineReviewCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeclineReview.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeclineReview implements repository
+func (mmDeclineReview *RepositoryMock) DeclineReview(ctx context.Context, decline domain.DeclineReview, replacement []domain.Reviewer) (p1 domain.PullRequest, err error) {
+	mm_atomic.AddUint64(&mmDeclineReview.beforeDeclineReviewCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeclineReview.afterDeclineReviewCounter, 1)
+
+	mmDeclineReview.t.Helper()
+
+	if mmDeclineReview.inspectFuncDeclineReview != nil {
+		mmDeclineReview.inspectFuncDeclineReview(ctx, decline, replacement)
+	}
+
+	mm_params := RepositoryMockDeclineReviewParams{ctx, decline, replacement}
+
+	// Record call args
+	mmDeclineReview.DeclineReviewMock.mutex.Lock()
+	mmDeclineReview.DeclineReviewMock.callArgs = append(mmDeclineReview.DeclineReviewMock.callArgs, &mm_params)
+	mmDeclineReview.DeclineReviewMock.mutex.Unlock()
+
+	for _, e := range mmDeclineReview.DeclineReviewMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmDeclineReview.DeclineReviewMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeclineReview.DeclineReviewMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeclineReview.DeclineReviewMock.defaultExpectation.params
+		mm_want_ptrs := mmDeclineReview.DeclineReviewMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockDeclineReviewParams{ctx, decline, replacement}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeclineReview.t.Errorf("RepositoryMock.DeclineReview got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeclineReview.DeclineReviewMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.decline != nil && !minimock.Equal(*mm_want_ptrs.decline, mm_got.decline) {
+				mmDeclineReview.t.Errorf("RepositoryMock.DeclineReview got unexpected parameter decline, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeclineReview.DeclineReviewMock.defaultExpectation.expectationOrigins.originDecline, *mm_want_ptrs.decline, mm_got.decline, minimock.Diff(*mm_want_ptrs.decline, mm_got.decline))
+			}
+
+			if mm_want_ptrs.replacement != nil && !minimock.Equal(*mm_want_ptrs.replacement, mm_got.replacement) {
+				mmDeclineReview.t.Errorf("RepositoryMock.DeclineReview got unexpected parameter replacement, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeclineReview.DeclineReviewMock.defaultExpectation.expectationOrigins.originReplacement, *mm_want_ptrs.replacement, mm_got.replacement, minimock.Diff(*mm_want_ptrs.replacement, mm_got.replacement))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeclineReview.t.Errorf("RepositoryMock.DeclineReview got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeclineReview.DeclineReviewMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeclineReview.DeclineReviewMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeclineReview.t.Fatal("No results are set for the RepositoryMock.DeclineReview")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmDeclineReview.funcDeclineReview != nil {
+		return mmDeclineReview.funcDeclineReview(ctx, decline, replacement)
+	}
+	mmDeclineReview.t.Fatalf("Unexpected call to RepositoryMock.DeclineReview. %v %v %v", ctx, decline, replacement)
+	return
+}
+
+// DeclineReviewAfterCounter returns a count of finished RepositoryMock.DeclineReview invocations
+func (mmDeclineReview *RepositoryMock) DeclineReviewAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeclineReview.afterDeclineReviewCounter)
+}
+
+// DeclineReviewBeforeCounter returns a count of RepositoryMock.DeclineReview invocations
+func (mmDeclineReview *RepositoryMock) DeclineReviewBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeclineReview.beforeDeclineReviewCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.DeclineReview.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeclineReview *mRepositoryMockDeclineReview) Calls() []*RepositoryMockDeclineReviewParams {
+	mmDeclineReview.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockDeclineReviewParams, len(mmDeclineReview.callArgs))
+	copy(argCopy, mmDeclineReview.callArgs)
+
+	mmDeclineReview.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeclineReviewDone returns true if the count of the DeclineReview invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockDeclineReviewDone() bool {
+	if m.DeclineReviewMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeclineReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeclineReviewMock.invocationsDone()
+}
+
+// MinimockDeclineReviewInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockDeclineReviewInspect() {
+	for _, e := range m.DeclineReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.DeclineReview at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeclineReviewCounter := mm_atomic.LoadUint64(&m.afterDeclineReviewCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeclineReviewMock.defaultExpectation != nil && afterDeclineReviewCounter < 1 {
+		if m.DeclineReviewMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.DeclineReview at\n%s", m.DeclineReviewMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.DeclineReview at\n%s with params: %#v", m.DeclineReviewMock.defaultExpectation.expectationOrigins.origin, *m.DeclineReviewMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeclineReview != nil && afterDeclineReviewCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.DeclineReview at\n%s", m.funcDeclineReviewOrigin)
+	}
+
+	if !m.DeclineReviewMock.invocationsDone() && afterDeclineReviewCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.DeclineReview at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeclineReviewMock.expectedInvocations), m.DeclineReviewMock.expectedInvocationsOrigin, afterDeclineReviewCounter)
+	}
+}
+
+type mRepositoryMockGetPullRequest struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetPullRequestExpectation
+	expectations       []*RepositoryMockGetPullRequestExpectation
+
+	callArgs []*RepositoryMockGetPullRequestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetPullRequestExpectation specifies expectation struct of the repository.GetPullRequest
+type RepositoryMockGetPullRequestExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetPullRequestParams
+	paramPtrs          *RepositoryMockGetPullRequestParamPtrs
+	expectationOrigins RepositoryMockGetPullRequestExpectationOrigins
+	results            *RepositoryMockGetPullRequestResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetPullRequestParams contains parameters of the repository.GetPullRequest
+type RepositoryMockGetPullRequestParams struct {
+	ctx           context.Context
+	pullRequestID string
+}
+
+// RepositoryMockGetPullRequestParamPtrs contains pointers to parameters of the repository.GetPullRequest
+type RepositoryMockGetPullRequestParamPtrs struct {
+	ctx           *context.Context
+	pullRequestID *string
+}
+
+// RepositoryMockGetPullRequestResults contains results of the repository.GetPullRequest
+type RepositoryMockGetPullRequestResults struct {
+	p1  domain.PullRequest
+	err error
+}
+
+// RepositoryMockGetPullRequestOrigins contains origins of expectations of the repository.GetPullRequest
+type RepositoryMockGetPullRequestExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originPullRequestID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Optional() *mRepositoryMockGetPullRequest {
+	mmGetPullRequest.optional = true
+	return mmGetPullRequest
+}
+
+// Expect sets up expected params for repository.GetPullRequest
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Expect(ctx context.Context, pullRequestID string) *mRepositoryMockGetPullRequest {
+	if mmGetPullRequest.mock.funcGetPullRequest != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Set")
+	}
+
+	if mmGetPullRequest.defaultExpectation == nil {
+		mmGetPullRequest.defaultExpectation = &RepositoryMockGetPullRequestExpectation{}
+	}
+
+	if mmGetPullRequest.defaultExpectation.paramPtrs != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by ExpectParams functions")
+	}
+
+	mmGetPullRequest.defaultExpectation.params = &RepositoryMockGetPullRequestParams{ctx, pullRequestID}
+	mmGetPullRequest.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPullRequest.expectations {
+		if minimock.Equal(e.params, mmGetPullRequest.defaultExpectation.params) {
+			mmGetPullRequest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPullRequest.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPullRequest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.GetPullRequest
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetPullRequest {
+	if mmGetPullRequest.mock.funcGetPullRequest != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Set")
+	}
+
+	if mmGetPullRequest.defaultExpectation == nil {
+		mmGetPullRequest.defaultExpectation = &RepositoryMockGetPullRequestExpectation{}
+	}
+
+	if mmGetPullRequest.defaultExpectation.params != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Expect")
+	}
+
+	if mmGetPullRequest.defaultExpectation.paramPtrs == nil {
+		mmGetPullRequest.defaultExpectation.paramPtrs = &RepositoryMockGetPullRequestParamPtrs{}
+	}
+	mmGetPullRequest.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPullRequest.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPullRequest
+}
+
+// ExpectPullRequestIDParam2 sets up expected param pullRequestID for repository.GetPullRequest
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) ExpectPullRequestIDParam2(pullRequestID string) *mRepositoryMockGetPullRequest {
+	if mmGetPullRequest.mock.funcGetPullRequest != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Set")
+	}
+
+	if mmGetPullRequest.defaultExpectation == nil {
+		mmGetPullRequest.defaultExpectation = &RepositoryMockGetPullRequestExpectation{}
+	}
+
+	if mmGetPullRequest.defaultExpectation.params != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Expect")
+	}
+
+	if mmGetPullRequest.defaultExpectation.paramPtrs == nil {
+		mmGetPullRequest.defaultExpectation.paramPtrs = &RepositoryMockGetPullRequestParamPtrs{}
+	}
+	mmGetPullRequest.defaultExpectation.paramPtrs.pullRequestID = &pullRequestID
+	mmGetPullRequest.defaultExpectation.expectationOrigins.originPullRequestID = minimock.CallerInfo(1)
+
+	return mmGetPullRequest
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.GetPullRequest
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Inspect(f func(ctx context.Context, pullRequestID string)) *mRepositoryMockGetPullRequest {
+	if mmGetPullRequest.mock.inspectFuncGetPullRequest != nil {
+		mmGetPullRequest.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetPullRequest")
+	}
+
+	mmGetPullRequest.mock.inspectFuncGetPullRequest = f
+
+	return mmGetPullRequest
+}
+
+// Return sets up results that will be returned by repository.GetPullRequest
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Return(p1 domain.PullRequest, err error) *RepositoryMock {
+	if mmGetPullRequest.mock.funcGetPullRequest != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Set")
+	}
+
+	if mmGetPullRequest.defaultExpectation == nil {
+		mmGetPullRequest.defaultExpectation = &RepositoryMockGetPullRequestExpectation{mock: mmGetPullRequest.mock}
+	}
+	mmGetPullRequest.defaultExpectation.results = &RepositoryMockGetPullRequestResults{p1, err}
+	mmGetPullRequest.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPullRequest.mock
+}
+
+// Set uses given function f to mock the repository.GetPullRequest method
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Set(f func(ctx context.Context, pullRequestID string) (p1 domain.PullRequest, err error)) *RepositoryMock {
+	if mmGetPullRequest.defaultExpectation != nil {
+		mmGetPullRequest.mock.t.Fatalf("Default expectation is already set for the repository.GetPullRequest method")
+	}
+
+	if len(mmGetPullRequest.expectations) > 0 {
+		mmGetPullRequest.mock.t.Fatalf("Some expectations are already set for the repository.GetPullRequest method")
+	}
+
+	mmGetPullRequest.mock.funcGetPullRequest = f
+	mmGetPullRequest.mock.funcGetPullRequestOrigin = minimock.CallerInfo(1)
+	return mmGetPullRequest.mock
+}
+
+// When sets expectation for the repository.GetPullRequest which will trigger the result defined by the following
+// Then helper
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) When(ctx context.Context, pullRequestID string) *RepositoryMockGetPullRequestExpectation {
+	if mmGetPullRequest.mock.funcGetPullRequest != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetPullRequestExpectation{
+		mock:               mmGetPullRequest.mock,
+		params:             &RepositoryMockGetPullRequestParams{ctx, pullRequestID},
+		expectationOrigins: RepositoryMockGetPullRequestExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPullRequest.expectations = append(mmGetPullRequest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.GetPullRequest return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetPullRequestExpectation) Then(p1 domain.PullRequest, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetPullRequestResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.GetPullRequest should be invoked
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Times(n uint64) *mRepositoryMockGetPullRequest {
+	if n == 0 {
+		mmGetPullRequest.mock.t.Fatalf("Times of RepositoryMock.GetPullRequest mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPullRequest.expectedInvocations, n)
+	mmGetPullRequest.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPullRequest
+}
+
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) invocationsDone() bool {
+	if len(mmGetPullRequest.expectations) == 0 && mmGetPullRequest.defaultExpectation == nil && mmGetPullRequest.mock.funcGetPullRequest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPullRequest.mock.afterGetPullRequestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPullRequest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPullRequest implements repository
+func (mmGetPullRequest *RepositoryMock) GetPullRequest(ctx context.Context, pullRequestID string) (p1 domain.PullRequest, err error) {
+	mm_atomic.AddUint64(&mmGetPullRequest.beforeGetPullRequestCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPullRequest.afterGetPullRequestCounter, 1)
+
+	mmGetPullRequest.t.Helper()
+
+	if mmGetPullRequest.inspectFuncGetPullRequest != nil {
+		mmGetPullRequest.inspectFuncGetPullRequest(ctx, pullRequestID)
+	}
+
+	mm_params := RepositoryMockGetPullRequestParams{ctx, pullRequestID}
+
+	// Record call args
+	mmGetPullRequest.GetPullRequestMock.mutex.Lock()
+	mmGetPullRequest.GetPullRequestMock.callArgs = append(mmGetPullRequest.GetPullRequestMock.callArgs, &mm_params)
+	mmGetPullRequest.GetPullRequestMock.mutex.Unlock()
+
+	for _, e := range mmGetPullRequest.GetPullRequestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetPullRequest.GetPullRequestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPullRequest.GetPullRequestMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPullRequest.GetPullRequestMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPullRequest.GetPullRequestMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetPullRequestParams{ctx, pullRequestID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPullRequest.t.Errorf("RepositoryMock.GetPullRequest got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPullRequest.GetPullRequestMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pullRequestID != nil && !minimock.Equal(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID) {
+				mmGetPullRequest.t.Errorf("RepositoryMock.GetPullRequest got unexpected parameter pullRequestID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPullRequest.GetPullRequestMock.defaultExpectation.expectationOrigins.originPullRequestID, *mm_want_ptrs.pullRequestID, mm_got.pullRequestID, minimock.Diff(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPullRequest.t.Errorf("RepositoryMock.GetPullRequest got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPullRequest.GetPullRequestMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPullRequest.GetPullRequestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPullRequest.t.Fatal("No results are set for the RepositoryMock.GetPullRequest")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetPullRequest.funcGetPullRequest != nil {
+		return mmGetPullRequest.funcGetPullRequest(ctx, pullRequestID)
+	}
+	mmGetPullRequest.t.Fatalf("Unexpected call to RepositoryMock.GetPullRequest. %v %v", ctx, pullRequestID)
+	return
+}
+
+// GetPullRequestAfterCounter returns a count of finished RepositoryMock.GetPullRequest invocations
+func (mmGetPullRequest *RepositoryMock) GetPullRequestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPullRequest.afterGetPullRequestCounter)
+}
+
+// GetPullRequestBeforeCounter returns a count of RepositoryMock.GetPullRequest invocations
+func (mmGetPullRequest *RepositoryMock) GetPullRequestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPullRequest.beforeGetPullRequestCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetPullRequest.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Calls() []*RepositoryMockGetPullRequestParams {
+	mmGetPullRequest.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetPullRequestParams, len(mmGetPullRequest.callArgs))
+	copy(argCopy, mmGetPullRequest.callArgs)
+
+	mmGetPullRequest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPullRequestDone returns true if the count of the GetPullRequest invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetPullRequestDone() bool {
+	if m.GetPullRequestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPullRequestMock.invocationsDone()
+}
+
+// MinimockGetPullRequestInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetPullRequestInspect() {
+	for _, e := range m.GetPullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetPullRequest at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPullRequestCounter := mm_atomic.LoadUint64(&m.afterGetPullRequestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPullRequestMock.defaultExpectation != nil && afterGetPullRequestCounter < 1 {
+		if m.GetPullRequestMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetPullRequest at\n%s", m.GetPullRequestMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetPullRequest at\n%s with params: %#v", m.GetPullRequestMock.defaultExpectation.expectationOrigins.origin, *m.GetPullRequestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPullRequest != nil && afterGetPullRequestCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetPullRequest at\n%s", m.funcGetPullRequestOrigin)
+	}
+
+	if !m.GetPullRequestMock.invocationsDone() && afterGetPullRequestCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetPullRequest at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPullRequestMock.expectedInvocations), m.GetPullRequestMock.expectedInvocationsOrigin, afterGetPullRequestCounter)
+	}
+}
+
+type mRepositoryMockListDeclinedReviewers struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListDeclinedReviewersExpectation
+	expectations       []*RepositoryMockListDeclinedReviewersExpectation
+
+	callArgs []*RepositoryMockListDeclinedReviewersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockListDeclinedReviewersExpectation specifies expectation struct of the repository.ListDeclinedReviewers
+type RepositoryMockListDeclinedReviewersExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockListDeclinedReviewersParams
+	paramPtrs          *RepositoryMockListDeclinedReviewersParamPtrs
+	expectationOrigins RepositoryMockListDeclinedReviewersExpectationOrigins
+	results            *RepositoryMockListDeclinedReviewersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockListDeclinedReviewersParams contains parameters of the repository.ListDeclinedReviewers
+type RepositoryMockListDeclinedReviewersParams struct {
+	ctx           context.Context
+	pullRequestID string
+}
+
+// RepositoryMockListDeclinedReviewersParamPtrs contains pointers to parameters of the repository.ListDeclinedReviewers
+type RepositoryMockListDeclinedReviewersParamPtrs struct {
+	ctx           *context.Context
+	pullRequestID *string
+}
+
+// RepositoryMockListDeclinedReviewersResults contains results of the repository.ListDeclinedReviewers
+type RepositoryMockListDeclinedReviewersResults struct {
+	sa1 []string
+	err error
+}
+
+// RepositoryMockListDeclinedReviewersOrigins contains origins of expectations of the repository.ListDeclinedReviewers
+type RepositoryMockListDeclinedReviewersExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originPullRequestID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListDeclinedReviewers *mRepositoryMockListDeclinedReviewers) Optional() *mRepositoryMockListDeclinedReviewers {
+	mmListDeclinedReviewers.optional = true
+	return mmListDeclinedReviewers
+}
+
+// Expect sets up expected params for repository.ListDeclinedReviewers
+func (mmListDeclinedReviewers *mRepositoryMockListDeclinedReviewers) Expect(ctx context.Context, pullRequestID string) *mRepositoryMockListDeclinedReviewers {
+	if mmListDeclinedReviewers.mock.funcListDeclinedReviewers != nil {
+		mmListDeclinedReviewers.mock.t.Fatalf("RepositoryMock.ListDeclinedReviewers mock is already set by Set")
+	}
+
+	if mmListDeclinedReviewers.defaultExpectation == nil {
+		mmListDeclinedReviewers.defaultExpectation = &RepositoryMockListDeclinedReviewersExpectation{}
+	}
+
+	if mmListDeclinedReviewers.defaultExpectation.paramPtrs != nil {
+		mmListDeclinedReviewers.mock.t.Fatalf("RepositoryMock.ListDeclinedReviewers mock is already set by ExpectParams functions")
+	}
+
+	mmListDeclinedReviewers.defaultExpectation.params = &RepositoryMockListDeclinedReviewersParams{ctx, pullRequestID}
+	mmListDeclinedReviewers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListDeclinedReviewers.expectations {
+		if minimock.Equal(e.params, mmListDeclinedReviewers.defaultExpectation.params) {
+			mmListDeclinedReviewers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListDeclinedReviewers.defaultExpectation.params)
+		}
+	}
+
+	return mmListDeclinedReviewers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.ListDeclinedReviewers
+func (mmListDeclinedReviewers *mRepositoryMockListDeclinedReviewers) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListDeclinedReviewers {
+	if mmListDeclinedReviewers.mock.funcListDeclinedReviewers != nil {
+		mmListDeclinedReviewers.mock.t.Fatalf("RepositoryMock.ListDeclinedReviewers mock is already set by Set")
+	}
+
+	if mmListDeclinedReviewers.defaultExpectation == nil {
+		mmListDeclinedReviewers.defaultExpectation = &RepositoryMockListDeclinedReviewersExpectation{}
+	}
+
+	if mmListDeclinedReviewers.defaultExpectation.params != nil {
+		mmListDeclinedReviewers.mock.t.Fatalf("RepositoryMock.ListDeclinedReviewers mock is already set by Expect")
+	}
+
+	if mmListDeclinedReviewers.defaultExpectation.paramPtrs == nil {
+		mmListDeclinedReviewers.defaultExpectation.paramPtrs = &RepositoryMockListDeclinedReviewersParamPtrs{}
+	}
+	mmListDeclinedReviewers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListDeclinedReviewers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListDeclinedReviewers
+}
+
+// ExpectPullRequestIDParam2 sets up expected param pullRequestID for repository.ListDeclinedReviewers
+func (mmListDeclinedReviewers *mRepositoryMockListDeclinedReviewers) ExpectPullRequestIDParam2(pullRequestID string) *mRepositoryMockListDeclinedReviewers {
+	if mmListDeclinedReviewers.mock.funcListDeclinedReviewers != nil {
+		mmListDeclinedReviewers.mock.t.Fatalf("RepositoryMock.ListDeclinedReviewers mock is already set by Set")
+	}
+
+	if mmListDeclinedReviewers.defaultExpectation == nil {
+		mmListDeclinedReviewers.defaultExpectation = &RepositoryMockListDeclinedReviewersExpectation{}
+	}
+
+	if mmListDeclinedReviewers.defaultExpectation.params != nil {
+		mmListDeclinedReviewers.mock.t.Fatalf("RepositoryMock.ListDeclinedReviewers mock is already set by Expect")
+	}
+
+	if mmListDeclinedReviewers.defaultExpectation.paramPtrs == nil {
+		mmListDeclinedReviewers.defaultExpectation.paramPtrs = &RepositoryMockListDeclinedReviewersParamPtrs{}
+	}
+	mmListDeclinedReviewers.defaultExpectation.paramPtrs.pullRequestID = &pullRequestID
+	mmListDeclinedReviewers.defaultExpectation.expectationOrigins.originPullRequestID = minimock.CallerInfo(1)
+
+	return mmListDeclinedReviewers
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.ListDeclinedReviewers
+func (mmListDeclinedReviewers *mRepositoryMockListDeclinedReviewers) Inspect(f func(ctx context.Context, pullRequestID string)) *mRepositoryMockListDeclinedReviewers {
+	if mmListDeclinedReviewers.mock.inspectFuncListDeclinedReviewers != nil {
+		mmListDeclinedReviewers.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListDeclinedReviewers")
+	}
+
+	mmListDeclinedReviewers.mock.inspectFuncListDeclinedReviewers = f
+
+	return mmListDeclinedReviewers
+}
+
+// Return sets up results that will be returned by repository.ListDeclinedReviewers
+func (mmListDeclinedReviewers *mRepositoryMockListDeclinedReviewers) Return(sa1 []string, err error) *RepositoryMock {
+	if mmListDeclinedReviewers.mock.funcListDeclinedReviewers != nil {
+		mmListDeclinedReviewers.mock.t.Fatalf("RepositoryMock.ListDeclinedReviewers mock is already set by Set")
+	}
+
+	if mmListDeclinedReviewers.defaultExpectation == nil {
+		mmListDeclinedReviewers.defaultExpectation = &RepositoryMockListDeclinedReviewersExpectation{mock: mmListDeclinedReviewers.mock}
+	}
+	mmListDeclinedReviewers.defaultExpectation.results = &RepositoryMockListDeclinedReviewersResults{sa1, err}
+	mmListDeclinedReviewers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListDeclinedReviewers.mock
+}
+
+// Set uses given function f to mock the repository.ListDeclinedReviewers method
+func (mmListDeclinedReviewers *mRepositoryMockListDeclinedReviewers) Set(f func(ctx context.Context, pullRequestID string) (sa1 []string, err error)) *RepositoryMock {
+	if mmListDeclinedReviewers.defaultExpectation != nil {
+		mmListDeclinedReviewers.mock.t.Fatalf("Default expectation is already set for the repository.ListDeclinedReviewers method")
+	}
+
+	if len(mmListDeclinedReviewers.expectations) > 0 {
+		mmListDeclinedReviewers.mock.t.Fatalf("Some expectations are already set for the repository.ListDeclinedReviewers method")
+	}
+
+	mmListDeclinedReviewers.mock.funcListDeclinedReviewers = f
+	mmListDeclinedReviewers.mock.funcListDeclinedReviewersOrigin = minimock.CallerInfo(1)
+	return mmListDeclinedReviewers.mock
+}
+
+// When sets expectation for the repository.ListDeclinedReviewers which will trigger the result defined by the following
+// Then helper
+func (mmListDeclinedReviewers *mRepositoryMockListDeclinedReviewers) When(ctx context.Context, pullRequestID string) *RepositoryMockListDeclinedReviewersExpectation {
+	if mmListDeclinedReviewers.mock.funcListDeclinedReviewers != nil {
+		mmListDeclinedReviewers.mock.t.Fatalf("RepositoryMock.ListDeclinedReviewers mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListDeclinedReviewersExpectation{
+		mock:               mmListDeclinedReviewers.mock,
+		params:             &RepositoryMockListDeclinedReviewersParams{ctx, pullRequestID},
+		expectationOrigins: RepositoryMockListDeclinedReviewersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListDeclinedReviewers.expectations = append(mmListDeclinedReviewers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.ListDeclinedReviewers return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListDeclinedReviewersExpectation) Then(sa1 []string, err error) *RepositoryMock {
+	e.results = &RepositoryMockListDeclinedReviewersResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.ListDeclinedReviewers should be invoked
+func (mmListDeclinedReviewers *mRepositoryMockListDeclinedReviewers) Times(n uint64) *mRepositoryMockListDeclinedReviewers {
+	if n == 0 {
+		mmListDeclinedReviewers.mock.t.Fatalf("Times of RepositoryMock.ListDeclinedReviewers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListDeclinedReviewers.expectedInvocations, n)
+	mmListDeclinedReviewers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListDeclinedReviewers
+}
+
+func (mmListDeclinedReviewers *mRepositoryMockListDeclinedReviewers) invocationsDone() bool {
+	if len(mmListDeclinedReviewers.expectations) == 0 && mmListDeclinedReviewers.defaultExpectation == nil && mmListDeclinedReviewers.mock.funcListDeclinedReviewers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListDeclinedReviewers.mock.afterListDeclinedReviewersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListDeclinedReviewers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListDeclinedReviewers implements repository
+func (mmListDeclinedReviewers *RepositoryMock) ListDeclinedReviewers(ctx context.Context, pullRequestID string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmListDeclinedReviewers.beforeListDeclinedReviewersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListDeclinedReviewers.afterListDeclinedReviewersCounter, 1)
+
+	mmListDeclinedReviewers.t.Helper()
+
+	if mmListDeclinedReviewers.inspectFuncListDeclinedReviewers != nil {
+		mmListDeclinedReviewers.inspectFuncListDeclinedReviewers(ctx, pullRequestID)
+	}
+
+	mm_params := RepositoryMockListDeclinedReviewersParams{ctx, pullRequestID}
+
+	// Record call args
+	mmListDeclinedReviewers.ListDeclinedReviewersMock.mutex.Lock()
+	mmListDeclinedReviewers.ListDeclinedReviewersMock.callArgs = append(mmListDeclinedReviewers.ListDeclinedReviewersMock.callArgs, &mm_params)
+	mmListDeclinedReviewers.ListDeclinedReviewersMock.mutex.Unlock()
+
+	for _, e := range mmListDeclinedReviewers.ListDeclinedReviewersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListDeclinedReviewers.ListDeclinedReviewersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListDeclinedReviewers.ListDeclinedReviewersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListDeclinedReviewers.ListDeclinedReviewersMock.defaultExpectation.params
+		mm_want_ptrs := mmListDeclinedReviewers.ListDeclinedReviewersMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockListDeclinedReviewersParams{ctx, pullRequestID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListDeclinedReviewers.t.Errorf("RepositoryMock.ListDeclinedReviewers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDeclinedReviewers.ListDeclinedReviewersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pullRequestID != nil && !minimock.Equal(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID) {
+				mmListDeclinedReviewers.t.Errorf("RepositoryMock.ListDeclinedReviewers got unexpected parameter pullRequestID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDeclinedReviewers.ListDeclinedReviewersMock.defaultExpectation.expectationOrigins.originPullRequestID, *mm_want_ptrs.pullRequestID, mm_got.pullRequestID, minimock.Diff(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListDeclinedReviewers.t.Errorf("RepositoryMock.ListDeclinedReviewers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListDeclinedReviewers.ListDeclinedReviewersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListDeclinedReviewers.ListDeclinedReviewersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListDeclinedReviewers.t.Fatal("No results are set for the RepositoryMock.ListDeclinedReviewers")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListDeclinedReviewers.funcListDeclinedReviewers != nil {
+		return mmListDeclinedReviewers.funcListDeclinedReviewers(ctx, pullRequestID)
+	}
+	mmListDeclinedReviewers.t.Fatalf("Unexpected call to RepositoryMock.ListDeclinedReviewers. %v %v", ctx, pullRequestID)
+	return
+}
+
+// ListDeclinedReviewersAfterCounter returns a count of finished RepositoryMock.ListDeclinedReviewers invocations
+func (mmListDeclinedReviewers *RepositoryMock) ListDeclinedReviewersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDeclinedReviewers.afterListDeclinedReviewersCounter)
+}
+
+// ListDeclinedReviewersBeforeCounter returns a count of RepositoryMock.ListDeclinedReviewers invocations
+func (mmListDeclinedReviewers *RepositoryMock) ListDeclinedReviewersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDeclinedReviewers.beforeListDeclinedReviewersCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ListDeclinedReviewers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListDeclinedReviewers *mRepositoryMockListDeclinedReviewers) Calls() []*RepositoryMockListDeclinedReviewersParams {
+	mmListDeclinedReviewers.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListDeclinedReviewersParams, len(mmListDeclinedReviewers.callArgs))
+	copy(argCopy, mmListDeclinedReviewers.callArgs)
+
+	mmListDeclinedReviewers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDeclinedReviewersDone returns true if the count of the ListDeclinedReviewers invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListDeclinedReviewersDone() bool {
+	if m.ListDeclinedReviewersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListDeclinedReviewersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListDeclinedReviewersMock.invocationsDone()
+}
+
+// MinimockListDeclinedReviewersInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListDeclinedReviewersInspect() {
+	for _, e := range m.ListDeclinedReviewersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ListDeclinedReviewers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListDeclinedReviewersCounter := mm_atomic.LoadUint64(&m.afterListDeclinedReviewersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListDeclinedReviewersMock.defaultExpectation != nil && afterListDeclinedReviewersCounter < 1 {
+		if m.ListDeclinedReviewersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.ListDeclinedReviewers at\n%s", m.ListDeclinedReviewersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ListDeclinedReviewers at\n%s with params: %#v", m.ListDeclinedReviewersMock.defaultExpectation.expectationOrigins.origin, *m.ListDeclinedReviewersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListDeclinedReviewers != nil && afterListDeclinedReviewersCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.ListDeclinedReviewers at\n%s", m.funcListDeclinedReviewersOrigin)
+	}
+
+	if !m.ListDeclinedReviewersMock.invocationsDone() && afterListDeclinedReviewersCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListDeclinedReviewers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListDeclinedReviewersMock.expectedInvocations), m.ListDeclinedReviewersMock.expectedInvocationsOrigin, afterListDeclinedReviewersCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeclineReviewInspect()
+
+			m.MinimockGetPullRequestInspect()
+
+			m.MinimockListDeclinedReviewersInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeclineReviewDone() &&
+		m.MinimockGetPullRequestDone() &&
+		m.MinimockListDeclinedReviewersDone()
+}
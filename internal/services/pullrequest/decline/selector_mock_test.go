@@ -0,0 +1,480 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package decline
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/decline.selector -o selector_mock_test.go -n SelectorMock -p decline
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// SelectorMock implements selector
+type SelectorMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAdditional          func(ctx context.Context, authorID string, taken []string, count int) (a1 domain.Assignment, err error)
+	funcAdditionalOrigin    string
+	inspectFuncAdditional   func(ctx context.Context, authorID string, taken []string, count int)
+	afterAdditionalCounter  uint64
+	beforeAdditionalCounter uint64
+	AdditionalMock          mSelectorMockAdditional
+}
+
+// NewSelectorMock returns a mock for selector
+func NewSelectorMock(t minimock.Tester) *SelectorMock {
+	m := &SelectorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AdditionalMock = mSelectorMockAdditional{mock: m}
+	m.AdditionalMock.callArgs = []*SelectorMockAdditionalParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mSelectorMockAdditional struct {
+	optional           bool
+	mock               *SelectorMock
+	defaultExpectation *SelectorMockAdditionalExpectation
+	expectations       []*SelectorMockAdditionalExpectation
+
+	callArgs []*SelectorMockAdditionalParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SelectorMockAdditionalExpectation specifies expectation struct of the selector.Additional
+type SelectorMockAdditionalExpectation struct {
+	mock               *SelectorMock
+	params             *SelectorMockAdditionalParams
+	paramPtrs          *SelectorMockAdditionalParamPtrs
+	expectationOrigins SelectorMockAdditionalExpectationOrigins
+	results            *SelectorMockAdditionalResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SelectorMockAdditionalParams contains parameters of the selector.Additional
+type SelectorMockAdditionalParams struct {
+	ctx      context.Context
+	authorID string
+	taken    []string
+	count    int
+}
+
+// SelectorMockAdditionalParamPtrs contains pointers to parameters of the selector.Additional
+type SelectorMockAdditionalParamPtrs struct {
+	ctx      *context.Context
+	authorID *string
+	taken    *[]string
+	count    *int
+}
+
+// SelectorMockAdditionalResults contains results of the selector.Additional
+type SelectorMockAdditionalResults struct {
+	a1  domain.Assignment
+	err error
+}
+
+// SelectorMockAdditionalOrigins contains origins of expectations of the selector.Additional
+type SelectorMockAdditionalExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originAuthorID string
+	originTaken    string
+	originCount    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAdditional *mSelectorMockAdditional) Optional() *mSelectorMockAdditional {
+	mmAdditional.optional = true
+	return mmAdditional
+}
+
+// Expect sets up expected params for selector.Additional
+func (mmAdditional *mSelectorMockAdditional) Expect(ctx context.Context, authorID string, taken []string, count int) *mSelectorMockAdditional {
+	if mmAdditional.mock.funcAdditional != nil {
+		mmAdditional.mock.t.Fatalf("SelectorMock.Additional mock is already set by Set")
+	}
+
+	if mmAdditional.defaultExpectation == nil {
+		mmAdditional.defaultExpectation = &SelectorMockAdditionalExpectation{}
+	}
+
+	if mmAdditional.defaultExpectation.paramPtrs != nil {
+		mmAdditional.mock.t.Fatalf("SelectorMock.Additional mock is already set by ExpectParams functions")
+	}
+
+	mmAdditional.defaultExpectation.params = &SelectorMockAdditionalParams{ctx, authorID, taken, count}
+	mmAdditional.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAdditional.expectations {
+		if minimock.Equal(e.params, mmAdditional.defaultExpectation.params) {
+			mmAdditional.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAdditional.defaultExpectation.params)
+		}
+	}
+
+	return mmAdditional
+}
+
+// ExpectCtxParam1 sets up expected param ctx for selector.Additional
+func (mmAdditional *mSelectorMockAdditional) ExpectCtxParam1(ctx context.Context) *mSelectorMockAdditional {
+	if mmAdditional.mock.funcAdditional != nil {
+		mmAdditional.mock.t.Fatalf("SelectorMock.Additional mock is already set by Set")
+	}
+
+	if mmAdditional.defaultExpectation == nil {
+		mmAdditional.defaultExpectation = &SelectorMockAdditionalExpectation{}
+	}
+
+	if mmAdditional.defaultExpectation.params != nil {
+		mmAdditional.mock.t.Fatalf("SelectorMock.Additional mock is already set by Expect")
+	}
+
+	if mmAdditional.defaultExpectation.paramPtrs == nil {
+		mmAdditional.defaultExpectation.paramPtrs = &SelectorMockAdditionalParamPtrs{}
+	}
+	mmAdditional.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAdditional.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAdditional
+}
+
+// ExpectAuthorIDParam2 sets up expected param authorID for selector.Additional
+func (mmAdditional *mSelectorMockAdditional) ExpectAuthorIDParam2(authorID string) *mSelectorMockAdditional {
+	if mmAdditional.mock.funcAdditional != nil {
+		mmAdditional.mock.t.Fatalf("SelectorMock.Additional mock is already set by Set")
+	}
+
+	if mmAdditional.defaultExpectation == nil {
+		mmAdditional.defaultExpectation = &SelectorMockAdditionalExpectation{}
+	}
+
+	if mmAdditional.defaultExpectation.params != nil {
+		mmAdditional.mock.t.Fatalf("SelectorMock.Additional mock is already set by Expect")
+	}
+
+	if mmAdditional.defaultExpectation.paramPtrs == nil {
+		mmAdditional.defaultExpectation.paramPtrs = &SelectorMockAdditionalParamPtrs{}
+	}
+	mmAdditional.defaultExpectation.paramPtrs.authorID = &authorID
+	mmAdditional.defaultExpectation.expectationOrigins.originAuthorID = minimock.CallerInfo(1)
+
+	return mmAdditional
+}
+
+// ExpectTakenParam3 sets up expected param taken for selector.Additional
+func (mmAdditional *mSelectorMockAdditional) ExpectTakenParam3(taken []string) *mSelectorMockAdditional {
+	if mmAdditional.mock.funcAdditional != nil {
+		mmAdditional.mock.t.Fatalf("SelectorMock.Additional mock is already set by Set")
+	}
+
+	if mmAdditional.defaultExpectation == nil {
+		mmAdditional.defaultExpectation = &SelectorMockAdditionalExpectation{}
+	}
+
+	if mmAdditional.defaultExpectation.params != nil {
+		mmAdditional.mock.t.Fatalf("SelectorMock.Additional mock is already set by Expect")
+	}
+
+	if mmAdditional.defaultExpectation.paramPtrs == nil {
+		mmAdditional.defaultExpectation.paramPtrs = &SelectorMockAdditionalParamPtrs{}
+	}
+	mmAdditional.defaultExpectation.paramPtrs.taken = &taken
+	mmAdditional.defaultExpectation.expectationOrigins.originTaken = minimock.CallerInfo(1)
+
+	return mmAdditional
+}
+
+// ExpectCountParam4 sets up expected param count for selector.Additional
+func (mmAdditional *mSelectorMockAdditional) ExpectCountParam4(count int) *mSelectorMockAdditional {
+	if mmAdditional.mock.funcAdditional != nil {
+		mmAdditional.mock.t.Fatalf("SelectorMock.Additional mock is already set by Set")
+	}
+
+	if mmAdditional.defaultExpectation == nil {
+		mmAdditional.defaultExpectation = &SelectorMockAdditionalExpectation{}
+	}
+
+	if mmAdditional.defaultExpectation.params != nil {
+		mmAdditional.mock.t.Fatalf("SelectorMock.Additional mock is already set by Expect")
+	}
+
+	if mmAdditional.defaultExpectation.paramPtrs == nil {
+		mmAdditional.defaultExpectation.paramPtrs = &SelectorMockAdditionalParamPtrs{}
+	}
+	mmAdditional.defaultExpectation.paramPtrs.count = &count
+	mmAdditional.defaultExpectation.expectationOrigins.originCount = minimock.CallerInfo(1)
+
+	return mmAdditional
+}
+
+// Inspect accepts an inspector function that has same arguments as the selector.Additional
+func (mmAdditional *mSelectorMockAdditional) Inspect(f func(ctx context.Context, authorID string, taken []string, count int)) *mSelectorMockAdditional {
+	if mmAdditional.mock.inspectFuncAdditional != nil {
+		mmAdditional.mock.t.Fatalf("Inspect function is already set for SelectorMock.Additional")
+	}
+
+	mmAdditional.mock.inspectFuncAdditional = f
+
+	return mmAdditional
+}
+
+// Return sets up results that will be returned by selector.Additional
+func (mmAdditional *mSelectorMockAdditional) Return(a1 domain.Assignment, err error) *SelectorMock {
+	if mmAdditional.mock.funcAdditional != nil {
+		mmAdditional.mock.t.Fatalf("SelectorMock.Additional mock is already set by Set")
+	}
+
+	if mmAdditional.defaultExpectation == nil {
+		mmAdditional.defaultExpectation = &SelectorMockAdditionalExpectation{mock: mmAdditional.mock}
+	}
+	mmAdditional.defaultExpectation.results = &SelectorMockAdditionalResults{a1, err}
+	mmAdditional.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAdditional.mock
+}
+
+// Set uses given function f to mock the selector.Additional method
+func (mmAdditional *mSelectorMockAdditional) Set(f func(ctx context.Context, authorID string, taken []string, count int) (a1 domain.Assignment, err error)) *SelectorMock {
+	if mmAdditional.defaultExpectation != nil {
+		mmAdditional.mock.t.Fatalf("Default expectation is already set for the selector.Additional method")
+	}
+
+	if len(mmAdditional.expectations) > 0 {
+		mmAdditional.mock.t.Fatalf("Some expectations are already set for the selector.Additional method")
+	}
+
+	mmAdditional.mock.funcAdditional = f
+	mmAdditional.mock.funcAdditionalOrigin = minimock.CallerInfo(1)
+	return mmAdditional.mock
+}
+
+// When sets expectation for the selector.Additional which will trigger the result defined by the following
+// Then helper
+func (mmAdditional *mSelectorMockAdditional) When(ctx context.Context, authorID string, taken []string, count int) *SelectorMockAdditionalExpectation {
+	if mmAdditional.mock.funcAdditional != nil {
+		mmAdditional.mock.t.Fatalf("SelectorMock.Additional mock is already set by Set")
+	}
+
+	expectation := &SelectorMockAdditionalExpectation{
+		mock:               mmAdditional.mock,
+		params:             &SelectorMockAdditionalParams{ctx, authorID, taken, count},
+		expectationOrigins: SelectorMockAdditionalExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAdditional.expectations = append(mmAdditional.expectations, expectation)
+	return expectation
+}
+
+// Then sets up selector.Additional return parameters for the expectation previously defined by the When method
+func (e *SelectorMockAdditionalExpectation) Then(a1 domain.Assignment, err error) *SelectorMock {
+	e.results = &SelectorMockAdditionalResults{a1, err}
+	return e.mock
+}
+
+// Times sets number of times selector.Additional should be invoked
+func (mmAdditional *mSelectorMockAdditional) Times(n uint64) *mSelectorMockAdditional {
+	if n == 0 {
+		mmAdditional.mock.t.Fatalf("Times of SelectorMock.Additional mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAdditional.expectedInvocations, n)
+	mmAdditional.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAdditional
+}
+
+func (mmAdditional *mSelectorMockAdditional) invocationsDone() bool {
+	if len(mmAdditional.expectations) == 0 && mmAdditional.defaultExpectation == nil && mmAdditional.mock.funcAdditional == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAdditional.mock.afterAdditionalCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAdditional.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Additional implements selector
+func (mmAdditional *SelectorMock) Additional(ctx context.Context, authorID string, taken []string, count int) (a1 domain.Assignment, err error) {
+	mm_atomic.AddUint64(&mmAdditional.beforeAdditionalCounter, 1)
+	defer mm_atomic.AddUint64(&mmAdditional.afterAdditionalCounter, 1)
+
+	mmAdditional.t.Helper()
+
+	if mmAdditional.inspectFuncAdditional != nil {
+		mmAdditional.inspectFuncAdditional(ctx, authorID, taken, count)
+	}
+
+	mm_params := SelectorMockAdditionalParams{ctx, authorID, taken, count}
+
+	// Record call args
+	mmAdditional.AdditionalMock.mutex.Lock()
+	mmAdditional.AdditionalMock.callArgs = append(mmAdditional.AdditionalMock.callArgs, &mm_params)
+	mmAdditional.AdditionalMock.mutex.Unlock()
+
+	for _, e := range mmAdditional.AdditionalMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmAdditional.AdditionalMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAdditional.AdditionalMock.defaultExpectation.Counter, 1)
+		mm_want := mmAdditional.AdditionalMock.defaultExpectation.params
+		mm_want_ptrs := mmAdditional.AdditionalMock.defaultExpectation.paramPtrs
+
+		mm_got := SelectorMockAdditionalParams{ctx, authorID, taken, count}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAdditional.t.Errorf("SelectorMock.Additional got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdditional.AdditionalMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.authorID != nil && !minimock.Equal(*mm_want_ptrs.authorID, mm_got.authorID) {
+				mmAdditional.t.Errorf("SelectorMock.Additional got unexpected parameter authorID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdditional.AdditionalMock.defaultExpectation.expectationOrigins.originAuthorID, *mm_want_ptrs.authorID, mm_got.authorID, minimock.Diff(*mm_want_ptrs.authorID, mm_got.authorID))
+			}
+
+			if mm_want_ptrs.taken != nil && !minimock.Equal(*mm_want_ptrs.taken, mm_got.taken) {
+				mmAdditional.t.Errorf("SelectorMock.Additional got unexpected parameter taken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdditional.AdditionalMock.defaultExpectation.expectationOrigins.originTaken, *mm_want_ptrs.taken, mm_got.taken, minimock.Diff(*mm_want_ptrs.taken, mm_got.taken))
+			}
+
+			if mm_want_ptrs.count != nil && !minimock.Equal(*mm_want_ptrs.count, mm_got.count) {
+				mmAdditional.t.Errorf("SelectorMock.Additional got unexpected parameter count, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdditional.AdditionalMock.defaultExpectation.expectationOrigins.originCount, *mm_want_ptrs.count, mm_got.count, minimock.Diff(*mm_want_ptrs.count, mm_got.count))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAdditional.t.Errorf("SelectorMock.Additional got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAdditional.AdditionalMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAdditional.AdditionalMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAdditional.t.Fatal("No results are set for the SelectorMock.Additional")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmAdditional.funcAdditional != nil {
+		return mmAdditional.funcAdditional(ctx, authorID, taken, count)
+	}
+	mmAdditional.t.Fatalf("Unexpected call to SelectorMock.Additional. %v %v %v %v", ctx, authorID, taken, count)
+	return
+}
+
+// AdditionalAfterCounter returns a count of finished SelectorMock.Additional invocations
+func (mmAdditional *SelectorMock) AdditionalAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdditional.afterAdditionalCounter)
+}
+
+// AdditionalBeforeCounter returns a count of SelectorMock.Additional invocations
+func (mmAdditional *SelectorMock) AdditionalBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdditional.beforeAdditionalCounter)
+}
+
+// Calls returns a list of arguments used in each call to SelectorMock.Additional.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAdditional *mSelectorMockAdditional) Calls() []*SelectorMockAdditionalParams {
+	mmAdditional.mutex.RLock()
+
+	argCopy := make([]*SelectorMockAdditionalParams, len(mmAdditional.callArgs))
+	copy(argCopy, mmAdditional.callArgs)
+
+	mmAdditional.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAdditionalDone returns true if the count of the Additional invocations corresponds
+// the number of defined expectations
+func (m *SelectorMock) MinimockAdditionalDone() bool {
+	if m.AdditionalMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AdditionalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AdditionalMock.invocationsDone()
+}
+
+// MinimockAdditionalInspect logs each unmet expectation
+func (m *SelectorMock) MinimockAdditionalInspect() {
+	for _, e := range m.AdditionalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SelectorMock.Additional at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAdditionalCounter := mm_atomic.LoadUint64(&m.afterAdditionalCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AdditionalMock.defaultExpectation != nil && afterAdditionalCounter < 1 {
+		if m.AdditionalMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SelectorMock.Additional at\n%s", m.AdditionalMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SelectorMock.Additional at\n%s with params: %#v", m.AdditionalMock.defaultExpectation.expectationOrigins.origin, *m.AdditionalMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAdditional != nil && afterAdditionalCounter < 1 {
+		m.t.Errorf("Expected call to SelectorMock.Additional at\n%s", m.funcAdditionalOrigin)
+	}
+
+	if !m.AdditionalMock.invocationsDone() && afterAdditionalCounter > 0 {
+		m.t.Errorf("Expected %d calls to SelectorMock.Additional at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AdditionalMock.expectedInvocations), m.AdditionalMock.expectedInvocationsOrigin, afterAdditionalCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SelectorMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAdditionalInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *SelectorMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *SelectorMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAdditionalDone()
+}
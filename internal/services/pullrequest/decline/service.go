@@ -0,0 +1,123 @@
+package decline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		GetPullRequest(ctx context.Context, pullRequestID string) (domain.PullRequest, error)
+		ListDeclinedReviewers(ctx context.Context, pullRequestID string) ([]string, error)
+		DeclineReview(ctx context.Context, decline domain.DeclineReview, replacement []domain.Reviewer) (domain.PullRequest, error)
+	}
+	selector interface {
+		Additional(ctx context.Context, authorID string, taken []string, count int) (domain.Assignment, error)
+	}
+	metrics interface {
+		ReviewerReassigned(reason domain.PullRequestEventType)
+		AssignmentFailed(err error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo     repository
+		selector selector
+		metrics  metrics
+		logger   logger
+	}
+)
+
+func New(repo repository, selector selector, metrics metrics, logger logger) *Handler {
+	return &Handler{
+		repo:     repo,
+		selector: selector,
+		metrics:  metrics,
+		logger:   logger,
+	}
+}
+
+// DeclineReview releases the reviewer and assigns a replacement picked by the team strategy. The
+// current reviewers and everybody who declined the PR before are never picked. Shadows are not
+// replaced, and the decline still succeeds when no replacement is available.
+func (h *Handler) DeclineReview(ctx context.Context, decline domain.DeclineReview) (domain.PullRequest, error) {
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.decline"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+		zap.String("pull_request_id", decline.PullRequestID),
+		zap.String("user_id", decline.UserID),
+	)
+
+	replacement, err := h.replacement(ctx, decline)
+	if err != nil {
+		logger.Error("replacement", zap.Error(err))
+		return domain.PullRequest{}, err
+	}
+
+	pr, err := h.repo.DeclineReview(ctx, decline, replacement)
+	if err != nil {
+		logger.Error("repo.DeclineReview", zap.Error(err))
+		return domain.PullRequest{}, fmt.Errorf("repo.DeclineReview: %w", err)
+	}
+
+	replacedBy := ""
+	if len(replacement) > 0 {
+		replacedBy = replacement[0].UserID
+		h.metrics.ReviewerReassigned(domain.EventDeclined)
+	}
+	logger.Info("review declined", zap.String("reason", string(decline.Reason)),
+		zap.String("replaced_by", replacedBy))
+
+	return pr, nil
+}
+
+func (h *Handler) replacement(ctx context.Context, decline domain.DeclineReview) ([]domain.Reviewer, error) {
+	pr, err := h.repo.GetPullRequest(ctx, decline.PullRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetPullRequest: %w", err)
+	}
+
+	var (
+		declining *domain.Reviewer
+		taken     = make([]string, 0, len(pr.Reviewers))
+	)
+	for i, reviewer := range pr.Reviewers {
+		if reviewer.UserID == decline.UserID {
+			declining = &pr.Reviewers[i]
+		}
+		taken = append(taken, reviewer.UserID)
+	}
+	if declining == nil {
+		return nil, domain.ErrNotAssigned
+	}
+	if declining.Role == domain.ReviewerRoleShadow {
+		return nil, nil
+	}
+
+	declined, err := h.repo.ListDeclinedReviewers(ctx, decline.PullRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListDeclinedReviewers: %w", err)
+	}
+	taken = append(taken, declined...)
+
+	assignment, err := h.selector.Additional(ctx, pr.AuthorID, taken, 1)
+	switch {
+	case errors.Is(err, domain.ErrNotEnoughReviewers):
+		h.metrics.AssignmentFailed(err)
+		return nil, nil
+	case err != nil:
+		h.metrics.AssignmentFailed(err)
+		return nil, fmt.Errorf("selector.Additional: %w", err)
+	}
+
+	return assignment.Reviewers, nil
+}
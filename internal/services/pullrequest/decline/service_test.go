@@ -0,0 +1,146 @@
+package decline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func reviewer(userID string, role domain.ReviewerRole) domain.Reviewer {
+	return domain.Reviewer{UserID: userID, Role: role, Source: domain.ReviewerSourceAuto}
+}
+
+func TestHandler_DeclineReview(t *testing.T) {
+	t.Parallel()
+
+	pr := domain.PullRequest{
+		PullRequestID: "pr-1",
+		AuthorID:      "u1",
+		Status:        domain.PRStatusOpen,
+		Reviewers: []domain.Reviewer{
+			reviewer("u2", domain.ReviewerRoleReviewer),
+			reviewer("u3", domain.ReviewerRoleReviewer),
+			reviewer("t1", domain.ReviewerRoleShadow),
+		},
+	}
+	replacement := reviewer("u5", domain.ReviewerRoleReviewer)
+	decline := func(userID string) domain.DeclineReview {
+		return domain.DeclineReview{PullRequestID: "pr-1", UserID: userID, Reason: domain.DeclineNoTime}
+	}
+
+	type fields struct {
+		repo     func(mc *minimock.Controller) repository
+		selector func(mc *minimock.Controller) selector
+		metrics  func(mc *minimock.Controller) metrics
+	}
+	noSelector := func(mc *minimock.Controller) selector { return NewSelectorMock(mc) }
+	noMetrics := func(mc *minimock.Controller) metrics { return NewMetricsMock(mc) }
+
+	tests := []struct {
+		name    string
+		userID  string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name:   "error: not a current reviewer",
+			userID: "u9",
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.GetPullRequestMock.Expect(minimock.AnyContext, "pr-1").Return(pr, nil)
+					return repo
+				},
+				selector: noSelector,
+				metrics:  noMetrics,
+			},
+			wantErr: domain.ErrNotAssigned,
+		},
+		{
+			name:   "success: reviewer is replaced",
+			userID: "u2",
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.GetPullRequestMock.Return(pr, nil)
+					repo.ListDeclinedReviewersMock.Expect(minimock.AnyContext, "pr-1").Return([]string{"u4"}, nil)
+					repo.DeclineReviewMock.Expect(minimock.AnyContext, decline("u2"), []domain.Reviewer{replacement}).
+						Return(pr, nil)
+					return repo
+				},
+				selector: func(mc *minimock.Controller) selector {
+					selector := NewSelectorMock(mc)
+					selector.AdditionalMock.Expect(minimock.AnyContext, "u1", []string{"u2", "u3", "t1", "u4"}, 1).
+						Return(domain.Assignment{Reviewers: []domain.Reviewer{replacement}}, nil)
+					return selector
+				},
+				metrics: func(mc *minimock.Controller) metrics {
+					metrics := NewMetricsMock(mc)
+					metrics.ReviewerReassignedMock.Expect(domain.EventDeclined).Return()
+					return metrics
+				},
+			},
+		},
+		{
+			name:   "success: no candidate left",
+			userID: "u2",
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.GetPullRequestMock.Return(pr, nil)
+					repo.ListDeclinedReviewersMock.Return(nil, nil)
+					repo.DeclineReviewMock.Expect(minimock.AnyContext, decline("u2"), nil).Return(pr, nil)
+					return repo
+				},
+				selector: func(mc *minimock.Controller) selector {
+					selector := NewSelectorMock(mc)
+					selector.AdditionalMock.Expect(minimock.AnyContext, "u1", []string{"u2", "u3", "t1"}, 1).
+						Return(domain.Assignment{}, domain.ErrNotEnoughReviewers)
+					return selector
+				},
+				metrics: func(mc *minimock.Controller) metrics {
+					metrics := NewMetricsMock(mc)
+					metrics.AssignmentFailedMock.Expect(domain.ErrNotEnoughReviewers).Return()
+					return metrics
+				},
+			},
+		},
+		{
+			name:   "success: shadow is not replaced",
+			userID: "t1",
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.GetPullRequestMock.Return(pr, nil)
+					repo.DeclineReviewMock.Expect(minimock.AnyContext, decline("t1"), nil).Return(pr, nil)
+					return repo
+				},
+				selector: noSelector,
+				metrics:  noMetrics,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			handler := New(tt.fields.repo(mc), tt.fields.selector(mc), tt.fields.metrics(mc), zap.NewNop())
+
+			_, err := handler.DeclineReview(context.Background(), decline(tt.userID))
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package dependencies
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/dependencies.repository -o repository_mock_test.go -n RepositoryMock -p dependencies
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcSetPullRequestDependencies          func(ctx context.Context, request domain.SetDependencies) (p1 domain.PullRequest, err error)
+	funcSetPullRequestDependenciesOrigin    string
+	inspectFuncSetPullRequestDependencies   func(ctx context.Context, request domain.SetDependencies)
+	afterSetPullRequestDependenciesCounter  uint64
+	beforeSetPullRequestDependenciesCounter uint64
+	SetPullRequestDependenciesMock          mRepositoryMockSetPullRequestDependencies
+}
+
+// NewRepositoryMock returns a mock for repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SetPullRequestDependenciesMock = mRepositoryMockSetPullRequestDependencies{mock: m}
+	m.SetPullRequestDependenciesMock.callArgs = []*RepositoryMockSetPullRequestDependenciesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockSetPullRequestDependencies struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockSetPullRequestDependenciesExpectation
+	expectations       []*RepositoryMockSetPullRequestDependenciesExpectation
+
+	callArgs []*RepositoryMockSetPullRequestDependenciesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockSetPullRequestDependenciesExpectation specifies expectation struct of the repository.SetPullRequestDependencies
+type RepositoryMockSetPullRequestDependenciesExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockSetPullRequestDependenciesParams
+	paramPtrs          *RepositoryMockSetPullRequestDependenciesParamPtrs
+	expectationOrigins RepositoryMockSetPullRequestDependenciesExpectationOrigins
+	results            *RepositoryMockSetPullRequestDependenciesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockSetPullRequestDependenciesParams contains parameters of the repository.SetPullRequestDependencies
+type RepositoryMockSetPullRequestDependenciesParams struct {
+	ctx     context.Context
+	request domain.SetDependencies
+}
+
+// RepositoryMockSetPullRequestDependenciesParamPtrs contains pointers to parameters of the repository.SetPullRequestDependencies
+type RepositoryMockSetPullRequestDependenciesParamPtrs struct {
+	ctx     *context.Context
+	request *domain.SetDependencies
+}
+
+// RepositoryMockSetPullRequestDependenciesResults contains results of the repository.SetPullRequestDependencies
+type RepositoryMockSetPullRequestDependenciesResults struct {
+	p1  domain.PullRequest
+	err error
+}
+
+// RepositoryMockSetPullRequestDependenciesOrigins contains origins of expectations of the repository.SetPullRequestDependencies
+type RepositoryMockSetPullRequestDependenciesExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originRequest string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetPullRequestDependencies *mRepositoryMockSetPullRequestDependencies) Optional() *mRepositoryMockSetPullRequestDependencies {
+	mmSetPullRequestDependencies.optional = true
+	return mmSetPullRequestDependencies
+}
+
+// Expect sets up expected params for repository.SetPullRequestDependencies
+func (mmSetPullRequestDependencies *mRepositoryMockSetPullRequestDependencies) Expect(ctx context.Context, request domain.SetDependencies) *mRepositoryMockSetPullRequestDependencies {
+	if mmSetPullRequestDependencies.mock.funcSetPullRequestDependencies != nil {
+		mmSetPullRequestDependencies.mock.t.Fatalf("RepositoryMock.SetPullRequestDependencies mock is already set by Set")
+	}
+
+	if mmSetPullRequestDependencies.defaultExpectation == nil {
+		mmSetPullRequestDependencies.defaultExpectation = &RepositoryMockSetPullRequestDependenciesExpectation{}
+	}
+
+	if mmSetPullRequestDependencies.defaultExpectation.paramPtrs != nil {
+		mmSetPullRequestDependencies.mock.t.Fatalf("RepositoryMock.SetPullRequestDependencies mock is already set by ExpectParams functions")
+	}
+
+	mmSetPullRequestDependencies.defaultExpectation.params = &RepositoryMockSetPullRequestDependenciesParams{ctx, request}
+	mmSetPullRequestDependencies.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetPullRequestDependencies.expectations {
+		if minimock.Equal(e.params, mmSetPullRequestDependencies.defaultExpectation.params) {
+			mmSetPullRequestDependencies.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetPullRequestDependencies.defaultExpectation.params)
+		}
+	}
+
+	return mmSetPullRequestDependencies
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.SetPullRequestDependencies
+func (mmSetPullRequestDependencies *mRepositoryMockSetPullRequestDependencies) ExpectCtxParam1(ctx context.Context) *mRepositoryMockSetPullRequestDependencies {
+	if mmSetPullRequestDependencies.mock.funcSetPullRequestDependencies != nil {
+		mmSetPullRequestDependencies.mock.t.Fatalf("RepositoryMock.SetPullRequestDependencies mock is already set by Set")
+	}
+
+	if mmSetPullRequestDependencies.defaultExpectation == nil {
+		mmSetPullRequestDependencies.defaultExpectation = &RepositoryMockSetPullRequestDependenciesExpectation{}
+	}
+
+	if mmSetPullRequestDependencies.defaultExpectation.params != nil {
+		mmSetPullRequestDependencies.mock.t.Fatalf("RepositoryMock.SetPullRequestDependencies mock is already set by Expect")
+	}
+
+	if mmSetPullRequestDependencies.defaultExpectation.paramPtrs == nil {
+		mmSetPullRequestDependencies.defaultExpectation.paramPtrs = &RepositoryMockSetPullRequestDependenciesParamPtrs{}
+	}
+	mmSetPullRequestDependencies.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetPullRequestDependencies.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetPullRequestDependencies
+}
+
+// ExpectRequestParam2 sets up expected param request for repository.SetPullRequestDependencies
+func (mmSetPullRequestDependencies *mRepositoryMockSetPullRequestDependencies) ExpectRequestParam2(request domain.SetDependencies) *mRepositoryMockSetPullRequestDependencies {
+	if mmSetPullRequestDependencies.mock.funcSetPullRequestDependencies != nil {
+		mmSetPullRequestDependencies.mock.t.Fatalf("RepositoryMock.SetPullRequestDependencies mock is already set by Set")
+	}
+
+	if mmSetPullRequestDependencies.defaultExpectation == nil {
+		mmSetPullRequestDependencies.defaultExpectation = &RepositoryMockSetPullRequestDependenciesExpectation{}
+	}
+
+	if mmSetPullRequestDependencies.defaultExpectation.params != nil {
+		mmSetPullRequestDependencies.mock.t.Fatalf("RepositoryMock.SetPullRequestDependencies mock is already set by Expect")
+	}
+
+	if mmSetPullRequestDependencies.defaultExpectation.paramPtrs == nil {
+		mmSetPullRequestDependencies.defaultExpectation.paramPtrs = &RepositoryMockSetPullRequestDependenciesParamPtrs{}
+	}
+	mmSetPullRequestDependencies.defaultExpectation.paramPtrs.request = &request
+	mmSetPullRequestDependencies.defaultExpectation.expectationOrigins.originRequest = minimock.CallerInfo(1)
+
+	return mmSetPullRequestDependencies
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.SetPullRequestDependencies
+func (mmSetPullRequestDependencies *mRepositoryMockSetPullRequestDependencies) Inspect(f func(ctx context.Context, request domain.SetDependencies)) *mRepositoryMockSetPullRequestDependencies {
+	if mmSetPullRequestDependencies.mock.inspectFuncSetPullRequestDependencies != nil {
+		mmSetPullRequestDependencies.mock.t.Fatalf("Inspect function is already set for RepositoryMock.SetPullRequestDependencies")
+	}
+
+	mmSetPullRequestDependencies.mock.inspectFuncSetPullRequestDependencies = f
+
+	return mmSetPullRequestDependencies
+}
+
+// Return sets up results that will be returned by repository.SetPullRequestDependencies
+func (mmSetPullRequestDependencies *mRepositoryMockSetPullRequestDependencies) Return(p1 domain.PullRequest, err error) *RepositoryMock {
+	if mmSetPullRequestDependencies.mock.funcSetPullRequestDependencies != nil {
+		mmSetPullRequestDependencies.mock.t.Fatalf("RepositoryMock.SetPullRequestDependencies mock is already set by Set")
+	}
+
+	if mmSetPullRequestDependencies.defaultExpectation == nil {
+		mmSetPullRequestDependencies.defaultExpectation = &RepositoryMockSetPullRequestDependenciesExpectation{mock: mmSetPullRequestDependencies.mock}
+	}
+	mmSetPullRequestDependencies.defaultExpectation.results = &RepositoryMockSetPullRequestDependenciesResults{p1, err}
+	mmSetPullRequestDependencies.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetPullRequestDependencies.mock
+}
+
+// Set uses given function f to mock the repository.SetPullRequestDependencies method
+func (mmSetPullRequestDependencies *mRepositoryMockSetPullRequestDependencies) Set(f func(ctx context.Context, request domain.SetDependencies) (p1 domain.PullRequest, err error)) *RepositoryMock {
+	if mmSetPullRequestDependencies.defaultExpectation != nil {
+		mmSetPullRequestDependencies.mock.t.Fatalf("Default expectation is already set for the repository.SetPullRequestDependencies method")
+	}
+
+	if len(mmSetPullRequestDependencies.expectations) > 0 {
+		mmSetPullRequestDependencies.mock.t.Fatalf("Some expectations are already set for the repository.SetPullRequestDependencies method")
+	}
+
+	mmSetPullRequestDependencies.mock.funcSetPullRequestDependencies = f
+	mmSetPullRequestDependencies.mock.funcSetPullRequestDependenciesOrigin = minimock.CallerInfo(1)
+	return mmSetPullRequestDependencies.mock
+}
+
+// When sets expectation for the repository.SetPullRequestDependencies which will trigger the result defined by the following
+// Then helper
+func (mmSetPullRequestDependencies *mRepositoryMockSetPullRequestDependencies) When(ctx context.Context, request domain.SetDependencies) *RepositoryMockSetPullRequestDependenciesExpectation {
+	if mmSetPullRequestDependencies.mock.funcSetPullRequestDependencies != nil {
+		mmSetPullRequestDependencies.mock.t.Fatalf("RepositoryMock.SetPullRequestDependencies mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockSetPullRequestDependenciesExpectation{
+		mock:               mmSetPullRequestDependencies.mock,
+		params:             &RepositoryMockSetPullRequestDependenciesParams{ctx, request},
+		expectationOrigins: RepositoryMockSetPullRequestDependenciesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetPullRequestDependencies.expectations = append(mmSetPullRequestDependencies.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.SetPullRequestDependencies return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockSetPullRequestDependenciesExpectation) Then(p1 domain.PullRequest, err error) *RepositoryMock {
+	e.results = &RepositoryMockSetPullRequestDependenciesResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.SetPullRequestDependencies should be invoked
+func (mmSetPullRequestDependencies *mRepositoryMockSetPullRequestDependencies) Times(n uint64) *mRepositoryMockSetPullRequestDependencies {
+	if n == 0 {
+		mmSetPullRequestDependencies.mock.t.Fatalf("Times of RepositoryMock.SetPullRequestDependencies mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetPullRequestDependencies.expectedInvocations, n)
+	mmSetPullRequestDependencies.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetPullRequestDependencies
+}
+
+func (mmSetPullRequestDependencies *mRepositoryMockSetPullRequestDependencies) invocationsDone() bool {
+	if len(mmSetPullRequestDependencies.expectations) == 0 && mmSetPullRequestDependencies.defaultExpectation == nil && mmSetPullRequestDependencies.mock.funcSetPullRequestDependencies == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetPullRequestDependencies.mock.afterSetPullRequestDependenciesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetPullRequestDependencies.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetPullRequestDependencies implements repository
+func (mmSetPullRequestDependencies *RepositoryMock) SetPullRequestDependencies(ctx context.Context, request domain.SetDependencies) (p1 domain.PullRequest, err error) {
+	mm_atomic.AddUint64(&mmSetPullRequestDependencies.beforeSetPullRequestDependenciesCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetPullRequestDependencies.afterSetPullRequestDependenciesCounter, 1)
+
+	mmSetPullRequestDependencies.t.Helper()
+
+	if mmSetPullRequestDependencies.inspectFuncSetPullRequestDependencies != nil {
+		mmSetPullRequestDependencies.inspectFuncSetPullRequestDependencies(ctx, request)
+	}
+
+	mm_params := RepositoryMockSetPullRequestDependenciesParams{ctx, request}
+
+	// Record call args
+	mmSetPullRequestDependencies.SetPullRequestDependenciesMock.mutex.Lock()
+	mmSetPullRequestDependencies.SetPullRequestDependenciesMock.callArgs = append(mmSetPullRequestDependencies.SetPullRequestDependenciesMock.callArgs, &mm_params)
+	mmSetPullRequestDependencies.SetPullRequestDependenciesMock.mutex.Unlock()
+
+	for _, e := range mmSetPullRequestDependencies.SetPullRequestDependenciesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmSetPullRequestDependencies.SetPullRequestDependenciesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetPullRequestDependencies.SetPullRequestDependenciesMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetPullRequestDependencies.SetPullRequestDependenciesMock.defaultExpectation.params
+		mm_want_ptrs := mmSetPullRequestDependencies.SetPullRequestDependenciesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockSetPullRequestDependenciesParams{ctx, request}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetPullRequestDependencies.t.Errorf("RepositoryMock.SetPullRequestDependencies got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPullRequestDependencies.SetPullRequestDependenciesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmSetPullRequestDependencies.t.Errorf("RepositoryMock.SetPullRequestDependencies got unexpected parameter request, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPullRequestDependencies.SetPullRequestDependenciesMock.defaultExpectation.expectationOrigins.originRequest, *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetPullRequestDependencies.t.Errorf("RepositoryMock.SetPullRequestDependencies got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetPullRequestDependencies.SetPullRequestDependenciesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetPullRequestDependencies.SetPullRequestDependenciesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetPullRequestDependencies.t.Fatal("No results are set for the RepositoryMock.SetPullRequestDependencies")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmSetPullRequestDependencies.funcSetPullRequestDependencies != nil {
+		return mmSetPullRequestDependencies.funcSetPullRequestDependencies(ctx, request)
+	}
+	mmSetPullRequestDependencies.t.Fatalf("Unexpected call to RepositoryMock.SetPullRequestDependencies. %v %v", ctx, request)
+	return
+}
+
+// SetPullRequestDependenciesAfterCounter returns a count of finished RepositoryMock.SetPullRequestDependencies invocations
+func (mmSetPullRequestDependencies *RepositoryMock) SetPullRequestDependenciesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPullRequestDependencies.afterSetPullRequestDependenciesCounter)
+}
+
+// SetPullRequestDependenciesBeforeCounter returns a count of RepositoryMock.SetPullRequestDependencies invocations
+func (mmSetPullRequestDependencies *RepositoryMock) SetPullRequestDependenciesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPullRequestDependencies.beforeSetPullRequestDependenciesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.SetPullRequestDependencies.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetPullRequestDependencies *mRepositoryMockSetPullRequestDependencies) Calls() []*RepositoryMockSetPullRequestDependenciesParams {
+	mmSetPullRequestDependencies.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockSetPullRequestDependenciesParams, len(mmSetPullRequestDependencies.callArgs))
+	copy(argCopy, mmSetPullRequestDependencies.callArgs)
+
+	mmSetPullRequestDependencies.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetPullRequestDependenciesDone returns true if the count of the SetPullRequestDependencies invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockSetPullRequestDependenciesDone() bool {
+	if m.SetPullRequestDependenciesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetPullRequestDependenciesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetPullRequestDependenciesMock.invocationsDone()
+}
+
+// MinimockSetPullRequestDependenciesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockSetPullRequestDependenciesInspect() {
+	for _, e := range m.SetPullRequestDependenciesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.SetPullRequestDependencies at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetPullRequestDependenciesCounter := mm_atomic.LoadUint64(&m.afterSetPullRequestDependenciesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetPullRequestDependenciesMock.defaultExpectation != nil && afterSetPullRequestDependenciesCounter < 1 {
+		if m.SetPullRequestDependenciesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.SetPullRequestDependencies at\n%s", m.SetPullRequestDependenciesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.SetPullRequestDependencies at\n%s with params: %#v", m.SetPullRequestDependenciesMock.defaultExpectation.expectationOrigins.origin, *m.SetPullRequestDependenciesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetPullRequestDependencies != nil && afterSetPullRequestDependenciesCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.SetPullRequestDependencies at\n%s", m.funcSetPullRequestDependenciesOrigin)
+	}
+
+	if !m.SetPullRequestDependenciesMock.invocationsDone() && afterSetPullRequestDependenciesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.SetPullRequestDependencies at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetPullRequestDependenciesMock.expectedInvocations), m.SetPullRequestDependenciesMock.expectedInvocationsOrigin, afterSetPullRequestDependenciesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockSetPullRequestDependenciesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockSetPullRequestDependenciesDone()
+}
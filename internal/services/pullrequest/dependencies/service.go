@@ -0,0 +1,51 @@
+package dependencies
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		SetPullRequestDependencies(ctx context.Context, request domain.SetDependencies) (domain.PullRequest, error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// SetDependencies restacks the PR on the given PRs. Changes that would close a dependency cycle
+// are rejected with ErrDependencyCycle.
+func (h *Handler) SetDependencies(ctx context.Context, request domain.SetDependencies) (domain.PullRequest, error) {
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.setDependencies"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	pr, err := h.repo.SetPullRequestDependencies(ctx, request)
+	if err != nil {
+		logger.Error("repo.SetPullRequestDependencies", zap.Error(err),
+			zap.String("pull_request_id", request.PullRequestID))
+		return domain.PullRequest{}, fmt.Errorf("repo.SetPullRequestDependencies: %w", err)
+	}
+
+	return pr, nil
+}
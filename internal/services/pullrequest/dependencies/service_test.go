@@ -0,0 +1,102 @@
+package dependencies
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func TestHandler_SetDependencies(t *testing.T) {
+	t.Parallel()
+
+	request := domain.SetDependencies{PullRequestID: "pr-3", DependsOn: []string{"pr-1", "pr-2"}, ActorID: "u1"}
+	restacked := domain.PullRequest{
+		PullRequestID: "pr-3",
+		Status:        domain.PRStatusOpen,
+		DependsOn: []domain.PullRequestDependency{
+			{PullRequestID: "pr-1", Status: domain.PRStatusMerged},
+			{PullRequestID: "pr-2", Status: domain.PRStatusOpen},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		repo    func(mc *minimock.Controller) repository
+		want    domain.PullRequest
+		wantErr error
+	}{
+		{
+			name: "success: PR is restacked",
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.SetPullRequestDependenciesMock.Expect(minimock.AnyContext, request).Return(restacked, nil)
+				return repo
+			},
+			want: restacked,
+		},
+		{
+			name: "error: dependencies form a cycle",
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.SetPullRequestDependenciesMock.Return(domain.PullRequest{}, domain.ErrDependencyCycle)
+				return repo
+			},
+			wantErr: domain.ErrDependencyCycle,
+		},
+		{
+			name: "error: dependency not found",
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.SetPullRequestDependenciesMock.Return(domain.PullRequest{}, domain.ErrDependencyNotFound)
+				return repo
+			},
+			wantErr: domain.ErrDependencyNotFound,
+		},
+		{
+			name: "error: PR is not open",
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.SetPullRequestDependenciesMock.Return(domain.PullRequest{}, domain.ErrPRNotOpen)
+				return repo
+			},
+			wantErr: domain.ErrPRNotOpen,
+		},
+		{
+			name: "error: database failure",
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.SetPullRequestDependenciesMock.Return(domain.PullRequest{}, errors.New("database connection failed"))
+				return repo
+			},
+			wantErr: errors.New("database connection failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			handler := New(tt.repo(mc), zap.NewNop())
+
+			got, err := handler.SetDependencies(context.Background(), request)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr.Error())
+				assert.Equal(t, domain.PullRequest{}, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package get
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		GetPullRequest(ctx context.Context, pullRequestID string) (domain.PullRequest, error)
+		ListPullRequestEvents(ctx context.Context, pullRequestID string) ([]domain.PullRequestEvent, error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// GetPullRequest returns the PR with its current reviewers and every recorded event.
+func (h *Handler) GetPullRequest(ctx context.Context, pullRequestID string) (domain.PullRequestDetails, error) {
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.get"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	pr, err := h.repo.GetPullRequest(ctx, pullRequestID)
+	if err != nil {
+		logger.Error("repo.GetPullRequest", zap.Error(err), zap.String("pull_request_id", pullRequestID))
+		return domain.PullRequestDetails{}, fmt.Errorf("repo.GetPullRequest: %w", err)
+	}
+
+	events, err := h.repo.ListPullRequestEvents(ctx, pullRequestID)
+	if err != nil {
+		logger.Error("repo.ListPullRequestEvents", zap.Error(err), zap.String("pull_request_id", pullRequestID))
+		return domain.PullRequestDetails{}, fmt.Errorf("repo.ListPullRequestEvents: %w", err)
+	}
+
+	return domain.PullRequestDetails{
+		PullRequest: pr,
+		Events:      events,
+	}, nil
+}
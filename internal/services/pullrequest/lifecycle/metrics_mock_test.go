@@ -0,0 +1,377 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package lifecycle
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/lifecycle.metrics -o metrics_mock_test.go -n MetricsMock -p lifecycle
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// MetricsMock implements metrics
+type MetricsMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAssignmentFailed          func(err error)
+	funcAssignmentFailedOrigin    string
+	inspectFuncAssignmentFailed   func(err error)
+	afterAssignmentFailedCounter  uint64
+	beforeAssignmentFailedCounter uint64
+	AssignmentFailedMock          mMetricsMockAssignmentFailed
+}
+
+// NewMetricsMock returns a mock for metrics
+func NewMetricsMock(t minimock.Tester) *MetricsMock {
+	m := &MetricsMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AssignmentFailedMock = mMetricsMockAssignmentFailed{mock: m}
+	m.AssignmentFailedMock.callArgs = []*MetricsMockAssignmentFailedParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mMetricsMockAssignmentFailed struct {
+	optional           bool
+	mock               *MetricsMock
+	defaultExpectation *MetricsMockAssignmentFailedExpectation
+	expectations       []*MetricsMockAssignmentFailedExpectation
+
+	callArgs []*MetricsMockAssignmentFailedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MetricsMockAssignmentFailedExpectation specifies expectation struct of the metrics.AssignmentFailed
+type MetricsMockAssignmentFailedExpectation struct {
+	mock               *MetricsMock
+	params             *MetricsMockAssignmentFailedParams
+	paramPtrs          *MetricsMockAssignmentFailedParamPtrs
+	expectationOrigins MetricsMockAssignmentFailedExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// MetricsMockAssignmentFailedParams contains parameters of the metrics.AssignmentFailed
+type MetricsMockAssignmentFailedParams struct {
+	err error
+}
+
+// MetricsMockAssignmentFailedParamPtrs contains pointers to parameters of the metrics.AssignmentFailed
+type MetricsMockAssignmentFailedParamPtrs struct {
+	err *error
+}
+
+// MetricsMockAssignmentFailedOrigins contains origins of expectations of the metrics.AssignmentFailed
+type MetricsMockAssignmentFailedExpectationOrigins struct {
+	origin    string
+	originErr string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAssignmentFailed *mMetricsMockAssignmentFailed) Optional() *mMetricsMockAssignmentFailed {
+	mmAssignmentFailed.optional = true
+	return mmAssignmentFailed
+}
+
+// Expect sets up expected params for metrics.AssignmentFailed
+func (mmAssignmentFailed *mMetricsMockAssignmentFailed) Expect(err error) *mMetricsMockAssignmentFailed {
+	if mmAssignmentFailed.mock.funcAssignmentFailed != nil {
+		mmAssignmentFailed.mock.t.Fatalf("MetricsMock.AssignmentFailed mock is already set by Set")
+	}
+
+	if mmAssignmentFailed.defaultExpectation == nil {
+		mmAssignmentFailed.defaultExpectation = &MetricsMockAssignmentFailedExpectation{}
+	}
+
+	if mmAssignmentFailed.defaultExpectation.paramPtrs != nil {
+		mmAssignmentFailed.mock.t.Fatalf("MetricsMock.AssignmentFailed mock is already set by ExpectParams functions")
+	}
+
+	mmAssignmentFailed.defaultExpectation.params = &MetricsMockAssignmentFailedParams{err}
+	mmAssignmentFailed.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAssignmentFailed.expectations {
+		if minimock.Equal(e.params, mmAssignmentFailed.defaultExpectation.params) {
+			mmAssignmentFailed.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAssignmentFailed.defaultExpectation.params)
+		}
+	}
+
+	return mmAssignmentFailed
+}
+
+// ExpectErrParam1 sets up expected param err for metrics.AssignmentFailed
+func (mmAssignmentFailed *mMetricsMockAssignmentFailed) ExpectErrParam1(err error) *mMetricsMockAssignmentFailed {
+	if mmAssignmentFailed.mock.funcAssignmentFailed != nil {
+		mmAssignmentFailed.mock.t.Fatalf("MetricsMock.AssignmentFailed mock is already set by Set")
+	}
+
+	if mmAssignmentFailed.defaultExpectation == nil {
+		mmAssignmentFailed.defaultExpectation = &MetricsMockAssignmentFailedExpectation{}
+	}
+
+	if mmAssignmentFailed.defaultExpectation.params != nil {
+		mmAssignmentFailed.mock.t.Fatalf("MetricsMock.AssignmentFailed mock is already set by Expect")
+	}
+
+	if mmAssignmentFailed.defaultExpectation.paramPtrs == nil {
+		mmAssignmentFailed.defaultExpectation.paramPtrs = &MetricsMockAssignmentFailedParamPtrs{}
+	}
+	mmAssignmentFailed.defaultExpectation.paramPtrs.err = &err
+	mmAssignmentFailed.defaultExpectation.expectationOrigins.originErr = minimock.CallerInfo(1)
+
+	return mmAssignmentFailed
+}
+
+// Inspect accepts an inspector function that has same arguments as the metrics.AssignmentFailed
+func (mmAssignmentFailed *mMetricsMockAssignmentFailed) Inspect(f func(err error)) *mMetricsMockAssignmentFailed {
+	if mmAssignmentFailed.mock.inspectFuncAssignmentFailed != nil {
+		mmAssignmentFailed.mock.t.Fatalf("Inspect function is already set for MetricsMock.AssignmentFailed")
+	}
+
+	mmAssignmentFailed.mock.inspectFuncAssignmentFailed = f
+
+	return mmAssignmentFailed
+}
+
+// Return sets up results that will be returned by metrics.AssignmentFailed
+func (mmAssignmentFailed *mMetricsMockAssignmentFailed) Return() *MetricsMock {
+	if mmAssignmentFailed.mock.funcAssignmentFailed != nil {
+		mmAssignmentFailed.mock.t.Fatalf("MetricsMock.AssignmentFailed mock is already set by Set")
+	}
+
+	if mmAssignmentFailed.defaultExpectation == nil {
+		mmAssignmentFailed.defaultExpectation = &MetricsMockAssignmentFailedExpectation{mock: mmAssignmentFailed.mock}
+	}
+
+	mmAssignmentFailed.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAssignmentFailed.mock
+}
+
+// Set uses given function f to mock the metrics.AssignmentFailed method
+func (mmAssignmentFailed *mMetricsMockAssignmentFailed) Set(f func(err error)) *MetricsMock {
+	if mmAssignmentFailed.defaultExpectation != nil {
+		mmAssignmentFailed.mock.t.Fatalf("Default expectation is already set for the metrics.AssignmentFailed method")
+	}
+
+	if len(mmAssignmentFailed.expectations) > 0 {
+		mmAssignmentFailed.mock.t.Fatalf("Some expectations are already set for the metrics.AssignmentFailed method")
+	}
+
+	mmAssignmentFailed.mock.funcAssignmentFailed = f
+	mmAssignmentFailed.mock.funcAssignmentFailedOrigin = minimock.CallerInfo(1)
+	return mmAssignmentFailed.mock
+}
+
+// When sets expectation for the metrics.AssignmentFailed which will trigger the result defined by the following
+// Then helper
+func (mmAssignmentFailed *mMetricsMockAssignmentFailed) When(err error) *MetricsMockAssignmentFailedExpectation {
+	if mmAssignmentFailed.mock.funcAssignmentFailed != nil {
+		mmAssignmentFailed.mock.t.Fatalf("MetricsMock.AssignmentFailed mock is already set by Set")
+	}
+
+	expectation := &MetricsMockAssignmentFailedExpectation{
+		mock:               mmAssignmentFailed.mock,
+		params:             &MetricsMockAssignmentFailedParams{err},
+		expectationOrigins: MetricsMockAssignmentFailedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAssignmentFailed.expectations = append(mmAssignmentFailed.expectations, expectation)
+	return expectation
+}
+
+// Then sets up metrics.AssignmentFailed return parameters for the expectation previously defined by the When method
+
+func (e *MetricsMockAssignmentFailedExpectation) Then() *MetricsMock {
+	return e.mock
+}
+
+// Times sets number of times metrics.AssignmentFailed should be invoked
+func (mmAssignmentFailed *mMetricsMockAssignmentFailed) Times(n uint64) *mMetricsMockAssignmentFailed {
+	if n == 0 {
+		mmAssignmentFailed.mock.t.Fatalf("Times of MetricsMock.AssignmentFailed mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAssignmentFailed.expectedInvocations, n)
+	mmAssignmentFailed.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAssignmentFailed
+}
+
+func (mmAssignmentFailed *mMetricsMockAssignmentFailed) invocationsDone() bool {
+	if len(mmAssignmentFailed.expectations) == 0 && mmAssignmentFailed.defaultExpectation == nil && mmAssignmentFailed.mock.funcAssignmentFailed == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAssignmentFailed.mock.afterAssignmentFailedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAssignmentFailed.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AssignmentFailed implements metrics
+func (mmAssignmentFailed *MetricsMock) AssignmentFailed(err error) {
+	mm_atomic.AddUint64(&mmAssignmentFailed.beforeAssignmentFailedCounter, 1)
+	defer mm_atomic.AddUint64(&mmAssignmentFailed.afterAssignmentFailedCounter, 1)
+
+	mmAssignmentFailed.t.Helper()
+
+	if mmAssignmentFailed.inspectFuncAssignmentFailed != nil {
+		mmAssignmentFailed.inspectFuncAssignmentFailed(err)
+	}
+
+	mm_params := MetricsMockAssignmentFailedParams{err}
+
+	// Record call args
+	mmAssignmentFailed.AssignmentFailedMock.mutex.Lock()
+	mmAssignmentFailed.AssignmentFailedMock.callArgs = append(mmAssignmentFailed.AssignmentFailedMock.callArgs, &mm_params)
+	mmAssignmentFailed.AssignmentFailedMock.mutex.Unlock()
+
+	for _, e := range mmAssignmentFailed.AssignmentFailedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmAssignmentFailed.AssignmentFailedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAssignmentFailed.AssignmentFailedMock.defaultExpectation.Counter, 1)
+		mm_want := mmAssignmentFailed.AssignmentFailedMock.defaultExpectation.params
+		mm_want_ptrs := mmAssignmentFailed.AssignmentFailedMock.defaultExpectation.paramPtrs
+
+		mm_got := MetricsMockAssignmentFailedParams{err}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.err != nil && !minimock.Equal(*mm_want_ptrs.err, mm_got.err) {
+				mmAssignmentFailed.t.Errorf("MetricsMock.AssignmentFailed got unexpected parameter err, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAssignmentFailed.AssignmentFailedMock.defaultExpectation.expectationOrigins.originErr, *mm_want_ptrs.err, mm_got.err, minimock.Diff(*mm_want_ptrs.err, mm_got.err))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAssignmentFailed.t.Errorf("MetricsMock.AssignmentFailed got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAssignmentFailed.AssignmentFailedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmAssignmentFailed.funcAssignmentFailed != nil {
+		mmAssignmentFailed.funcAssignmentFailed(err)
+		return
+	}
+	mmAssignmentFailed.t.Fatalf("Unexpected call to MetricsMock.AssignmentFailed. %v", err)
+
+}
+
+// AssignmentFailedAfterCounter returns a count of finished MetricsMock.AssignmentFailed invocations
+func (mmAssignmentFailed *MetricsMock) AssignmentFailedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAssignmentFailed.afterAssignmentFailedCounter)
+}
+
+// AssignmentFailedBeforeCounter returns a count of MetricsMock.AssignmentFailed invocations
+func (mmAssignmentFailed *MetricsMock) AssignmentFailedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAssignmentFailed.beforeAssignmentFailedCounter)
+}
+
+// Calls returns a list of arguments used in each call to MetricsMock.AssignmentFailed.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAssignmentFailed *mMetricsMockAssignmentFailed) Calls() []*MetricsMockAssignmentFailedParams {
+	mmAssignmentFailed.mutex.RLock()
+
+	argCopy := make([]*MetricsMockAssignmentFailedParams, len(mmAssignmentFailed.callArgs))
+	copy(argCopy, mmAssignmentFailed.callArgs)
+
+	mmAssignmentFailed.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAssignmentFailedDone returns true if the count of the AssignmentFailed invocations corresponds
+// the number of defined expectations
+func (m *MetricsMock) MinimockAssignmentFailedDone() bool {
+	if m.AssignmentFailedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AssignmentFailedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AssignmentFailedMock.invocationsDone()
+}
+
+// MinimockAssignmentFailedInspect logs each unmet expectation
+func (m *MetricsMock) MinimockAssignmentFailedInspect() {
+	for _, e := range m.AssignmentFailedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MetricsMock.AssignmentFailed at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAssignmentFailedCounter := mm_atomic.LoadUint64(&m.afterAssignmentFailedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AssignmentFailedMock.defaultExpectation != nil && afterAssignmentFailedCounter < 1 {
+		if m.AssignmentFailedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MetricsMock.AssignmentFailed at\n%s", m.AssignmentFailedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MetricsMock.AssignmentFailed at\n%s with params: %#v", m.AssignmentFailedMock.defaultExpectation.expectationOrigins.origin, *m.AssignmentFailedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAssignmentFailed != nil && afterAssignmentFailedCounter < 1 {
+		m.t.Errorf("Expected call to MetricsMock.AssignmentFailed at\n%s", m.funcAssignmentFailedOrigin)
+	}
+
+	if !m.AssignmentFailedMock.invocationsDone() && afterAssignmentFailedCounter > 0 {
+		m.t.Errorf("Expected %d calls to MetricsMock.AssignmentFailed at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AssignmentFailedMock.expectedInvocations), m.AssignmentFailedMock.expectedInvocationsOrigin, afterAssignmentFailedCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *MetricsMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAssignmentFailedInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *MetricsMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *MetricsMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAssignmentFailedDone()
+}
@@ -0,0 +1,805 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package lifecycle
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/lifecycle.repository -o repository_mock_test.go -n RepositoryMock -p lifecycle
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetPullRequest          func(ctx context.Context, pullRequestID string) (p1 domain.PullRequest, err error)
+	funcGetPullRequestOrigin    string
+	inspectFuncGetPullRequest   func(ctx context.Context, pullRequestID string)
+	afterGetPullRequestCounter  uint64
+	beforeGetPullRequestCounter uint64
+	GetPullRequestMock          mRepositoryMockGetPullRequest
+
+	funcTransitionPullRequest          func(ctx context.Context, pullRequestID string, decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error)) (p1 domain.PullRequest, err error)
+	funcTransitionPullRequestOrigin    string
+	inspectFuncTransitionPullRequest   func(ctx context.Context, pullRequestID string, decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error))
+	afterTransitionPullRequestCounter  uint64
+	beforeTransitionPullRequestCounter uint64
+	TransitionPullRequestMock          mRepositoryMockTransitionPullRequest
+}
+
+// NewRepositoryMock returns a mock for repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetPullRequestMock = mRepositoryMockGetPullRequest{mock: m}
+	m.GetPullRequestMock.callArgs = []*RepositoryMockGetPullRequestParams{}
+
+	m.TransitionPullRequestMock = mRepositoryMockTransitionPullRequest{mock: m}
+	m.TransitionPullRequestMock.callArgs = []*RepositoryMockTransitionPullRequestParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockGetPullRequest struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetPullRequestExpectation
+	expectations       []*RepositoryMockGetPullRequestExpectation
+
+	callArgs []*RepositoryMockGetPullRequestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetPullRequestExpectation specifies expectation struct of the repository.GetPullRequest
+type RepositoryMockGetPullRequestExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetPullRequestParams
+	paramPtrs          *RepositoryMockGetPullRequestParamPtrs
+	expectationOrigins RepositoryMockGetPullRequestExpectationOrigins
+	results            *RepositoryMockGetPullRequestResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetPullRequestParams contains parameters of the repository.GetPullRequest
+type RepositoryMockGetPullRequestParams struct {
+	ctx           context.Context
+	pullRequestID string
+}
+
+// RepositoryMockGetPullRequestParamPtrs contains pointers to parameters of the repository.GetPullRequest
+type RepositoryMockGetPullRequestParamPtrs struct {
+	ctx           *context.Context
+	pullRequestID *string
+}
+
+// RepositoryMockGetPullRequestResults contains results of the repository.GetPullRequest
+type RepositoryMockGetPullRequestResults struct {
+	p1  domain.PullRequest
+	err error
+}
+
+// RepositoryMockGetPullRequestOrigins contains origins of expectations of the repository.GetPullRequest
+type RepositoryMockGetPullRequestExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originPullRequestID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Optional() *mRepositoryMockGetPullRequest {
+	mmGetPullRequest.optional = true
+	return mmGetPullRequest
+}
+
+// Expect sets up expected params for repository.GetPullRequest
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Expect(ctx context.Context, pullRequestID string) *mRepositoryMockGetPullRequest {
+	if mmGetPullRequest.mock.funcGetPullRequest != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Set")
+	}
+
+	if mmGetPullRequest.defaultExpectation == nil {
+		mmGetPullRequest.defaultExpectation = &RepositoryMockGetPullRequestExpectation{}
+	}
+
+	if mmGetPullRequest.defaultExpectation.paramPtrs != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by ExpectParams functions")
+	}
+
+	mmGetPullRequest.defaultExpectation.params = &RepositoryMockGetPullRequestParams{ctx, pullRequestID}
+	mmGetPullRequest.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPullRequest.expectations {
+		if minimock.Equal(e.params, mmGetPullRequest.defaultExpectation.params) {
+			mmGetPullRequest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPullRequest.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPullRequest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.GetPullRequest
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetPullRequest {
+	if mmGetPullRequest.mock.funcGetPullRequest != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Set")
+	}
+
+	if mmGetPullRequest.defaultExpectation == nil {
+		mmGetPullRequest.defaultExpectation = &RepositoryMockGetPullRequestExpectation{}
+	}
+
+	if mmGetPullRequest.defaultExpectation.params != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Expect")
+	}
+
+	if mmGetPullRequest.defaultExpectation.paramPtrs == nil {
+		mmGetPullRequest.defaultExpectation.paramPtrs = &RepositoryMockGetPullRequestParamPtrs{}
+	}
+	mmGetPullRequest.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPullRequest.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPullRequest
+}
+
+// ExpectPullRequestIDParam2 sets up expected param pullRequestID for repository.GetPullRequest
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) ExpectPullRequestIDParam2(pullRequestID string) *mRepositoryMockGetPullRequest {
+	if mmGetPullRequest.mock.funcGetPullRequest != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Set")
+	}
+
+	if mmGetPullRequest.defaultExpectation == nil {
+		mmGetPullRequest.defaultExpectation = &RepositoryMockGetPullRequestExpectation{}
+	}
+
+	if mmGetPullRequest.defaultExpectation.params != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Expect")
+	}
+
+	if mmGetPullRequest.defaultExpectation.paramPtrs == nil {
+		mmGetPullRequest.defaultExpectation.paramPtrs = &RepositoryMockGetPullRequestParamPtrs{}
+	}
+	mmGetPullRequest.defaultExpectation.paramPtrs.pullRequestID = &pullRequestID
+	mmGetPullRequest.defaultExpectation.expectationOrigins.originPullRequestID = minimock.CallerInfo(1)
+
+	return mmGetPullRequest
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.GetPullRequest
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Inspect(f func(ctx context.Context, pullRequestID string)) *mRepositoryMockGetPullRequest {
+	if mmGetPullRequest.mock.inspectFuncGetPullRequest != nil {
+		mmGetPullRequest.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetPullRequest")
+	}
+
+	mmGetPullRequest.mock.inspectFuncGetPullRequest = f
+
+	return mmGetPullRequest
+}
+
+// Return sets up results that will be returned by repository.GetPullRequest
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Return(p1 domain.PullRequest, err error) *RepositoryMock {
+	if mmGetPullRequest.mock.funcGetPullRequest != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Set")
+	}
+
+	if mmGetPullRequest.defaultExpectation == nil {
+		mmGetPullRequest.defaultExpectation = &RepositoryMockGetPullRequestExpectation{mock: mmGetPullRequest.mock}
+	}
+	mmGetPullRequest.defaultExpectation.results = &RepositoryMockGetPullRequestResults{p1, err}
+	mmGetPullRequest.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPullRequest.mock
+}
+
+// Set uses given function f to mock the repository.GetPullRequest method
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Set(f func(ctx context.Context, pullRequestID string) (p1 domain.PullRequest, err error)) *RepositoryMock {
+	if mmGetPullRequest.defaultExpectation != nil {
+		mmGetPullRequest.mock.t.Fatalf("Default expectation is already set for the repository.GetPullRequest method")
+	}
+
+	if len(mmGetPullRequest.expectations) > 0 {
+		mmGetPullRequest.mock.t.Fatalf("Some expectations are already set for the repository.GetPullRequest method")
+	}
+
+	mmGetPullRequest.mock.funcGetPullRequest = f
+	mmGetPullRequest.mock.funcGetPullRequestOrigin = minimock.CallerInfo(1)
+	return mmGetPullRequest.mock
+}
+
+// When sets expectation for the repository.GetPullRequest which will trigger the result defined by the following
+// Then helper
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) When(ctx context.Context, pullRequestID string) *RepositoryMockGetPullRequestExpectation {
+	if mmGetPullRequest.mock.funcGetPullRequest != nil {
+		mmGetPullRequest.mock.t.Fatalf("RepositoryMock.GetPullRequest mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetPullRequestExpectation{
+		mock:               mmGetPullRequest.mock,
+		params:             &RepositoryMockGetPullRequestParams{ctx, pullRequestID},
+		expectationOrigins: RepositoryMockGetPullRequestExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPullRequest.expectations = append(mmGetPullRequest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.GetPullRequest return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetPullRequestExpectation) Then(p1 domain.PullRequest, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetPullRequestResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.GetPullRequest should be invoked
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Times(n uint64) *mRepositoryMockGetPullRequest {
+	if n == 0 {
+		mmGetPullRequest.mock.t.Fatalf("Times of RepositoryMock.GetPullRequest mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPullRequest.expectedInvocations, n)
+	mmGetPullRequest.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPullRequest
+}
+
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) invocationsDone() bool {
+	if len(mmGetPullRequest.expectations) == 0 && mmGetPullRequest.defaultExpectation == nil && mmGetPullRequest.mock.funcGetPullRequest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPullRequest.mock.afterGetPullRequestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPullRequest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPullRequest implements repository
+func (mmGetPullRequest *RepositoryMock) GetPullRequest(ctx context.Context, pullRequestID string) (p1 domain.PullRequest, err error) {
+	mm_atomic.AddUint64(&mmGetPullRequest.beforeGetPullRequestCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPullRequest.afterGetPullRequestCounter, 1)
+
+	mmGetPullRequest.t.Helper()
+
+	if mmGetPullRequest.inspectFuncGetPullRequest != nil {
+		mmGetPullRequest.inspectFuncGetPullRequest(ctx, pullRequestID)
+	}
+
+	mm_params := RepositoryMockGetPullRequestParams{ctx, pullRequestID}
+
+	// Record call args
+	mmGetPullRequest.GetPullRequestMock.mutex.Lock()
+	mmGetPullRequest.GetPullRequestMock.callArgs = append(mmGetPullRequest.GetPullRequestMock.callArgs, &mm_params)
+	mmGetPullRequest.GetPullRequestMock.mutex.Unlock()
+
+	for _, e := range mmGetPullRequest.GetPullRequestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetPullRequest.GetPullRequestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPullRequest.GetPullRequestMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPullRequest.GetPullRequestMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPullRequest.GetPullRequestMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetPullRequestParams{ctx, pullRequestID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPullRequest.t.Errorf("RepositoryMock.GetPullRequest got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPullRequest.GetPullRequestMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pullRequestID != nil && !minimock.Equal(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID) {
+				mmGetPullRequest.t.Errorf("RepositoryMock.GetPullRequest got unexpected parameter pullRequestID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPullRequest.GetPullRequestMock.defaultExpectation.expectationOrigins.originPullRequestID, *mm_want_ptrs.pullRequestID, mm_got.pullRequestID, minimock.Diff(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPullRequest.t.Errorf("RepositoryMock.GetPullRequest got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPullRequest.GetPullRequestMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPullRequest.GetPullRequestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPullRequest.t.Fatal("No results are set for the RepositoryMock.GetPullRequest")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetPullRequest.funcGetPullRequest != nil {
+		return mmGetPullRequest.funcGetPullRequest(ctx, pullRequestID)
+	}
+	mmGetPullRequest.t.Fatalf("Unexpected call to RepositoryMock.GetPullRequest. %v %v", ctx, pullRequestID)
+	return
+}
+
+// GetPullRequestAfterCounter returns a count of finished RepositoryMock.GetPullRequest invocations
+func (mmGetPullRequest *RepositoryMock) GetPullRequestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPullRequest.afterGetPullRequestCounter)
+}
+
+// GetPullRequestBeforeCounter returns a count of RepositoryMock.GetPullRequest invocations
+func (mmGetPullRequest *RepositoryMock) GetPullRequestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPullRequest.beforeGetPullRequestCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetPullRequest.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPullRequest *mRepositoryMockGetPullRequest) Calls() []*RepositoryMockGetPullRequestParams {
+	mmGetPullRequest.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetPullRequestParams, len(mmGetPullRequest.callArgs))
+	copy(argCopy, mmGetPullRequest.callArgs)
+
+	mmGetPullRequest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPullRequestDone returns true if the count of the GetPullRequest invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetPullRequestDone() bool {
+	if m.GetPullRequestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPullRequestMock.invocationsDone()
+}
+
+// MinimockGetPullRequestInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetPullRequestInspect() {
+	for _, e := range m.GetPullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetPullRequest at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPullRequestCounter := mm_atomic.LoadUint64(&m.afterGetPullRequestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPullRequestMock.defaultExpectation != nil && afterGetPullRequestCounter < 1 {
+		if m.GetPullRequestMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetPullRequest at\n%s", m.GetPullRequestMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetPullRequest at\n%s with params: %#v", m.GetPullRequestMock.defaultExpectation.expectationOrigins.origin, *m.GetPullRequestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPullRequest != nil && afterGetPullRequestCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetPullRequest at\n%s", m.funcGetPullRequestOrigin)
+	}
+
+	if !m.GetPullRequestMock.invocationsDone() && afterGetPullRequestCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetPullRequest at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPullRequestMock.expectedInvocations), m.GetPullRequestMock.expectedInvocationsOrigin, afterGetPullRequestCounter)
+	}
+}
+
+type mRepositoryMockTransitionPullRequest struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockTransitionPullRequestExpectation
+	expectations       []*RepositoryMockTransitionPullRequestExpectation
+
+	callArgs []*RepositoryMockTransitionPullRequestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockTransitionPullRequestExpectation specifies expectation struct of the repository.TransitionPullRequest
+type RepositoryMockTransitionPullRequestExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockTransitionPullRequestParams
+	paramPtrs          *RepositoryMockTransitionPullRequestParamPtrs
+	expectationOrigins RepositoryMockTransitionPullRequestExpectationOrigins
+	results            *RepositoryMockTransitionPullRequestResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockTransitionPullRequestParams contains parameters of the repository.TransitionPullRequest
+type RepositoryMockTransitionPullRequestParams struct {
+	ctx           context.Context
+	pullRequestID string
+	decide        func(pr domain.PullRequest) (domain.PullRequestUpdate, error)
+}
+
+// RepositoryMockTransitionPullRequestParamPtrs contains pointers to parameters of the repository.TransitionPullRequest
+type RepositoryMockTransitionPullRequestParamPtrs struct {
+	ctx           *context.Context
+	pullRequestID *string
+	decide        *func(pr domain.PullRequest) (domain.PullRequestUpdate, error)
+}
+
+// RepositoryMockTransitionPullRequestResults contains results of the repository.TransitionPullRequest
+type RepositoryMockTransitionPullRequestResults struct {
+	p1  domain.PullRequest
+	err error
+}
+
+// RepositoryMockTransitionPullRequestOrigins contains origins of expectations of the repository.TransitionPullRequest
+type RepositoryMockTransitionPullRequestExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originPullRequestID string
+	originDecide        string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) Optional() *mRepositoryMockTransitionPullRequest {
+	mmTransitionPullRequest.optional = true
+	return mmTransitionPullRequest
+}
+
+// Expect sets up expected params for repository.TransitionPullRequest
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) Expect(ctx context.Context, pullRequestID string, decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error)) *mRepositoryMockTransitionPullRequest {
+	if mmTransitionPullRequest.mock.funcTransitionPullRequest != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by Set")
+	}
+
+	if mmTransitionPullRequest.defaultExpectation == nil {
+		mmTransitionPullRequest.defaultExpectation = &RepositoryMockTransitionPullRequestExpectation{}
+	}
+
+	if mmTransitionPullRequest.defaultExpectation.paramPtrs != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by ExpectParams functions")
+	}
+
+	mmTransitionPullRequest.defaultExpectation.params = &RepositoryMockTransitionPullRequestParams{ctx, pullRequestID, decide}
+	mmTransitionPullRequest.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTransitionPullRequest.expectations {
+		if minimock.Equal(e.params, mmTransitionPullRequest.defaultExpectation.params) {
+			mmTransitionPullRequest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTransitionPullRequest.defaultExpectation.params)
+		}
+	}
+
+	return mmTransitionPullRequest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.TransitionPullRequest
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) ExpectCtxParam1(ctx context.Context) *mRepositoryMockTransitionPullRequest {
+	if mmTransitionPullRequest.mock.funcTransitionPullRequest != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by Set")
+	}
+
+	if mmTransitionPullRequest.defaultExpectation == nil {
+		mmTransitionPullRequest.defaultExpectation = &RepositoryMockTransitionPullRequestExpectation{}
+	}
+
+	if mmTransitionPullRequest.defaultExpectation.params != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by Expect")
+	}
+
+	if mmTransitionPullRequest.defaultExpectation.paramPtrs == nil {
+		mmTransitionPullRequest.defaultExpectation.paramPtrs = &RepositoryMockTransitionPullRequestParamPtrs{}
+	}
+	mmTransitionPullRequest.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTransitionPullRequest.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmTransitionPullRequest
+}
+
+// ExpectPullRequestIDParam2 sets up expected param pullRequestID for repository.TransitionPullRequest
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) ExpectPullRequestIDParam2(pullRequestID string) *mRepositoryMockTransitionPullRequest {
+	if mmTransitionPullRequest.mock.funcTransitionPullRequest != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by Set")
+	}
+
+	if mmTransitionPullRequest.defaultExpectation == nil {
+		mmTransitionPullRequest.defaultExpectation = &RepositoryMockTransitionPullRequestExpectation{}
+	}
+
+	if mmTransitionPullRequest.defaultExpectation.params != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by Expect")
+	}
+
+	if mmTransitionPullRequest.defaultExpectation.paramPtrs == nil {
+		mmTransitionPullRequest.defaultExpectation.paramPtrs = &RepositoryMockTransitionPullRequestParamPtrs{}
+	}
+	mmTransitionPullRequest.defaultExpectation.paramPtrs.pullRequestID = &pullRequestID
+	mmTransitionPullRequest.defaultExpectation.expectationOrigins.originPullRequestID = minimock.CallerInfo(1)
+
+	return mmTransitionPullRequest
+}
+
+// ExpectDecideParam3 sets up expected param decide for repository.TransitionPullRequest
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) ExpectDecideParam3(decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error)) *mRepositoryMockTransitionPullRequest {
+	if mmTransitionPullRequest.mock.funcTransitionPullRequest != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by Set")
+	}
+
+	if mmTransitionPullRequest.defaultExpectation == nil {
+		mmTransitionPullRequest.defaultExpectation = &RepositoryMockTransitionPullRequestExpectation{}
+	}
+
+	if mmTransitionPullRequest.defaultExpectation.params != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by Expect")
+	}
+
+	if mmTransitionPullRequest.defaultExpectation.paramPtrs == nil {
+		mmTransitionPullRequest.defaultExpectation.paramPtrs = &RepositoryMockTransitionPullRequestParamPtrs{}
+	}
+	mmTransitionPullRequest.defaultExpectation.paramPtrs.decide = &decide
+	mmTransitionPullRequest.defaultExpectation.expectationOrigins.originDecide = minimock.CallerInfo(1)
+
+	return mmTransitionPullRequest
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.TransitionPullRequest
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) Inspect(f func(ctx context.Context, pullRequestID string, decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error))) *mRepositoryMockTransitionPullRequest {
+	if mmTransitionPullRequest.mock.inspectFuncTransitionPullRequest != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("Inspect function is already set for RepositoryMock.TransitionPullRequest")
+	}
+
+	mmTransitionPullRequest.mock.inspectFuncTransitionPullRequest = f
+
+	return mmTransitionPullRequest
+}
+
+// Return sets up results that will be returned by repository.TransitionPullRequest
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) Return(p1 domain.PullRequest, err error) *RepositoryMock {
+	if mmTransitionPullRequest.mock.funcTransitionPullRequest != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by Set")
+	}
+
+	if mmTransitionPullRequest.defaultExpectation == nil {
+		mmTransitionPullRequest.defaultExpectation = &RepositoryMockTransitionPullRequestExpectation{mock: mmTransitionPullRequest.mock}
+	}
+	mmTransitionPullRequest.defaultExpectation.results = &RepositoryMockTransitionPullRequestResults{p1, err}
+	mmTransitionPullRequest.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTransitionPullRequest.mock
+}
+
+// Set uses given function f to mock the repository.TransitionPullRequest method
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) Set(f func(ctx context.Context, pullRequestID string, decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error)) (p1 domain.PullRequest, err error)) *RepositoryMock {
+	if mmTransitionPullRequest.defaultExpectation != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("Default expectation is already set for the repository.TransitionPullRequest method")
+	}
+
+	if len(mmTransitionPullRequest.expectations) > 0 {
+		mmTransitionPullRequest.mock.t.Fatalf("Some expectations are already set for the repository.TransitionPullRequest method")
+	}
+
+	mmTransitionPullRequest.mock.funcTransitionPullRequest = f
+	mmTransitionPullRequest.mock.funcTransitionPullRequestOrigin = minimock.CallerInfo(1)
+	return mmTransitionPullRequest.mock
+}
+
+// When sets expectation for the repository.TransitionPullRequest which will trigger the result defined by the following
+// Then helper
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) When(ctx context.Context, pullRequestID string, decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error)) *RepositoryMockTransitionPullRequestExpectation {
+	if mmTransitionPullRequest.mock.funcTransitionPullRequest != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockTransitionPullRequestExpectation{
+		mock:               mmTransitionPullRequest.mock,
+		params:             &RepositoryMockTransitionPullRequestParams{ctx, pullRequestID, decide},
+		expectationOrigins: RepositoryMockTransitionPullRequestExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmTransitionPullRequest.expectations = append(mmTransitionPullRequest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.TransitionPullRequest return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockTransitionPullRequestExpectation) Then(p1 domain.PullRequest, err error) *RepositoryMock {
+	e.results = &RepositoryMockTransitionPullRequestResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.TransitionPullRequest should be invoked
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) Times(n uint64) *mRepositoryMockTransitionPullRequest {
+	if n == 0 {
+		mmTransitionPullRequest.mock.t.Fatalf("Times of RepositoryMock.TransitionPullRequest mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTransitionPullRequest.expectedInvocations, n)
+	mmTransitionPullRequest.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTransitionPullRequest
+}
+
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) invocationsDone() bool {
+	if len(mmTransitionPullRequest.expectations) == 0 && mmTransitionPullRequest.defaultExpectation == nil && mmTransitionPullRequest.mock.funcTransitionPullRequest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTransitionPullRequest.mock.afterTransitionPullRequestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTransitionPullRequest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// TransitionPullRequest implements repository
+func (mmTransitionPullRequest *RepositoryMock) TransitionPullRequest(ctx context.Context, pullRequestID string, decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error)) (p1 domain.PullRequest, err error) {
+	mm_atomic.AddUint64(&mmTransitionPullRequest.beforeTransitionPullRequestCounter, 1)
+	defer mm_atomic.AddUint64(&mmTransitionPullRequest.afterTransitionPullRequestCounter, 1)
+
+	mmTransitionPullRequest.t.Helper()
+
+	if mmTransitionPullRequest.inspectFuncTransitionPullRequest != nil {
+		mmTransitionPullRequest.inspectFuncTransitionPullRequest(ctx, pullRequestID, decide)
+	}
+
+	mm_params := RepositoryMockTransitionPullRequestParams{ctx, pullRequestID, decide}
+
+	// Record call args
+	mmTransitionPullRequest.TransitionPullRequestMock.mutex.Lock()
+	mmTransitionPullRequest.TransitionPullRequestMock.callArgs = append(mmTransitionPullRequest.TransitionPullRequestMock.callArgs, &mm_params)
+	mmTransitionPullRequest.TransitionPullRequestMock.mutex.Unlock()
+
+	for _, e := range mmTransitionPullRequest.TransitionPullRequestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmTransitionPullRequest.TransitionPullRequestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTransitionPullRequest.TransitionPullRequestMock.defaultExpectation.Counter, 1)
+		mm_want := mmTransitionPullRequest.TransitionPullRequestMock.defaultExpectation.params
+		mm_want_ptrs := mmTransitionPullRequest.TransitionPullRequestMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockTransitionPullRequestParams{ctx, pullRequestID, decide}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTransitionPullRequest.t.Errorf("RepositoryMock.TransitionPullRequest got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransitionPullRequest.TransitionPullRequestMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pullRequestID != nil && !minimock.Equal(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID) {
+				mmTransitionPullRequest.t.Errorf("RepositoryMock.TransitionPullRequest got unexpected parameter pullRequestID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransitionPullRequest.TransitionPullRequestMock.defaultExpectation.expectationOrigins.originPullRequestID, *mm_want_ptrs.pullRequestID, mm_got.pullRequestID, minimock.Diff(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID))
+			}
+
+			if mm_want_ptrs.decide != nil && !minimock.Equal(*mm_want_ptrs.decide, mm_got.decide) {
+				mmTransitionPullRequest.t.Errorf("RepositoryMock.TransitionPullRequest got unexpected parameter decide, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransitionPullRequest.TransitionPullRequestMock.defaultExpectation.expectationOrigins.originDecide, *mm_want_ptrs.decide, mm_got.decide, minimock.Diff(*mm_want_ptrs.decide, mm_got.decide))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTransitionPullRequest.t.Errorf("RepositoryMock.TransitionPullRequest got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTransitionPullRequest.TransitionPullRequestMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTransitionPullRequest.TransitionPullRequestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTransitionPullRequest.t.Fatal("No results are set for the RepositoryMock.TransitionPullRequest")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmTransitionPullRequest.funcTransitionPullRequest != nil {
+		return mmTransitionPullRequest.funcTransitionPullRequest(ctx, pullRequestID, decide)
+	}
+	mmTransitionPullRequest.t.Fatalf("Unexpected call to RepositoryMock.TransitionPullRequest. %v %v %v", ctx, pullRequestID, decide)
+	return
+}
+
+// TransitionPullRequestAfterCounter returns a count of finished RepositoryMock.TransitionPullRequest invocations
+func (mmTransitionPullRequest *RepositoryMock) TransitionPullRequestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransitionPullRequest.afterTransitionPullRequestCounter)
+}
+
+// TransitionPullRequestBeforeCounter returns a count of RepositoryMock.TransitionPullRequest invocations
+func (mmTransitionPullRequest *RepositoryMock) TransitionPullRequestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransitionPullRequest.beforeTransitionPullRequestCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.TransitionPullRequest.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) Calls() []*RepositoryMockTransitionPullRequestParams {
+	mmTransitionPullRequest.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockTransitionPullRequestParams, len(mmTransitionPullRequest.callArgs))
+	copy(argCopy, mmTransitionPullRequest.callArgs)
+
+	mmTransitionPullRequest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTransitionPullRequestDone returns true if the count of the TransitionPullRequest invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockTransitionPullRequestDone() bool {
+	if m.TransitionPullRequestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TransitionPullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TransitionPullRequestMock.invocationsDone()
+}
+
+// MinimockTransitionPullRequestInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockTransitionPullRequestInspect() {
+	for _, e := range m.TransitionPullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.TransitionPullRequest at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterTransitionPullRequestCounter := mm_atomic.LoadUint64(&m.afterTransitionPullRequestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TransitionPullRequestMock.defaultExpectation != nil && afterTransitionPullRequestCounter < 1 {
+		if m.TransitionPullRequestMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.TransitionPullRequest at\n%s", m.TransitionPullRequestMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.TransitionPullRequest at\n%s with params: %#v", m.TransitionPullRequestMock.defaultExpectation.expectationOrigins.origin, *m.TransitionPullRequestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTransitionPullRequest != nil && afterTransitionPullRequestCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.TransitionPullRequest at\n%s", m.funcTransitionPullRequestOrigin)
+	}
+
+	if !m.TransitionPullRequestMock.invocationsDone() && afterTransitionPullRequestCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.TransitionPullRequest at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TransitionPullRequestMock.expectedInvocations), m.TransitionPullRequestMock.expectedInvocationsOrigin, afterTransitionPullRequestCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetPullRequestInspect()
+
+			m.MinimockTransitionPullRequestInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetPullRequestDone() &&
+		m.MinimockTransitionPullRequestDone()
+}
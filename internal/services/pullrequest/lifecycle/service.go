@@ -0,0 +1,181 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		GetPullRequest(ctx context.Context, pullRequestID string) (domain.PullRequest, error)
+		TransitionPullRequest(ctx context.Context, pullRequestID string,
+			decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error),
+		) (domain.PullRequest, error)
+	}
+	selector interface {
+		Select(ctx context.Context, pr domain.CreatePullRequest) (domain.Assignment, error)
+	}
+	metrics interface {
+		AssignmentFailed(err error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo     repository
+		selector selector
+		metrics  metrics
+		logger   logger
+	}
+)
+
+func New(repo repository, selector selector, metrics metrics, logger logger) *Handler {
+	return &Handler{
+		repo:     repo,
+		selector: selector,
+		metrics:  metrics,
+		logger:   logger,
+	}
+}
+
+// ClosePullRequest abandons a draft or open PR without merging it.
+func (h *Handler) ClosePullRequest(ctx context.Context, action domain.PullRequestAction) (domain.PullRequest, error) {
+	return h.transition(ctx, "pullRequest.close", action.PullRequestID,
+		func(pr domain.PullRequest) (domain.PullRequestUpdate, error) {
+			return statusUpdate(pr, domain.TransitionClose, domain.EventClosed, action.ActorID)
+		})
+}
+
+// ReopenPullRequest moves a closed PR back to OPEN.
+func (h *Handler) ReopenPullRequest(ctx context.Context, action domain.PullRequestAction) (domain.PullRequest, error) {
+	return h.transition(ctx, "pullRequest.reopen", action.PullRequestID,
+		func(pr domain.PullRequest) (domain.PullRequestUpdate, error) {
+			return statusUpdate(pr, domain.TransitionReopen, domain.EventReopened, action.ActorID)
+		})
+}
+
+// ReadyForReview moves a draft to OPEN and assigns reviewers from the live availability of the
+// team, matching the labels of the draft and of the request. A draft that kept its reviewers when
+// it was converted keeps them.
+func (h *Handler) ReadyForReview(ctx context.Context, request domain.ReadyForReview) (domain.PullRequest, error) {
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.readyForReview"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	pr, err := h.repo.GetPullRequest(ctx, request.PullRequestID)
+	if err != nil {
+		logger.Error("repo.GetPullRequest", zap.Error(err), zap.String("pull_request_id", request.PullRequestID))
+		return domain.PullRequest{}, fmt.Errorf("repo.GetPullRequest: %w", err)
+	}
+	if _, err = pr.Status.Next(domain.TransitionReadyForReview); err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	var reviewers []domain.Reviewer
+	if len(pr.Reviewers) == 0 {
+		assignment, err := h.selector.Select(ctx, domain.CreatePullRequest{
+			PullRequestID:      pr.PullRequestID,
+			PullRequestName:    pr.PullRequestName,
+			AuthorID:           pr.AuthorID,
+			Labels:             mergeLabels(pr.Labels, request.Labels),
+			RequestedReviewers: request.RequestedReviewers,
+			AddShadow:          request.AddShadow,
+			DependsOn:          pr.DependencyIDs(),
+		})
+		if err != nil {
+			h.metrics.AssignmentFailed(err)
+			logger.Error("selector.Select", zap.Error(err), zap.String("pull_request_id", pr.PullRequestID))
+			return domain.PullRequest{}, fmt.Errorf("selector.Select: %w", err)
+		}
+		reviewers = assignment.AllReviewers()
+	}
+
+	return h.transition(ctx, "pullRequest.readyForReview", request.PullRequestID,
+		func(pr domain.PullRequest) (domain.PullRequestUpdate, error) {
+			update, err := statusUpdate(pr, domain.TransitionReadyForReview, domain.EventReady, request.ActorID)
+			if err != nil {
+				return domain.PullRequestUpdate{}, err
+			}
+			// Reviewers assigned concurrently win over the ones selected above.
+			if len(pr.Reviewers) == 0 {
+				update.AddReviewers = reviewers
+			}
+			return update, nil
+		})
+}
+
+// ConvertToDraft moves an open PR back to DRAFT, optionally releasing its reviewers.
+func (h *Handler) ConvertToDraft(ctx context.Context, request domain.ConvertToDraft) (domain.PullRequest, error) {
+	return h.transition(ctx, "pullRequest.convertToDraft", request.PullRequestID,
+		func(pr domain.PullRequest) (domain.PullRequestUpdate, error) {
+			update, err := statusUpdate(pr, domain.TransitionConvertToDraft, domain.EventDrafted, request.ActorID)
+			if err != nil {
+				return domain.PullRequestUpdate{}, err
+			}
+			if request.ReleaseReviewers {
+				released := make([]string, len(pr.Reviewers))
+				for i, reviewer := range pr.Reviewers {
+					released[i] = reviewer.UserID
+				}
+				update.ReleaseReviewers = true
+				update.Events[0].Details["released_reviewers"] = released
+			}
+			return update, nil
+		})
+}
+
+func (h *Handler) transition(ctx context.Context, service, pullRequestID string,
+	decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error),
+) (domain.PullRequest, error) {
+	logger := h.logger.With(
+		zap.String("service", service),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	pr, err := h.repo.TransitionPullRequest(ctx, pullRequestID, decide)
+	if err != nil {
+		logger.Error("repo.TransitionPullRequest", zap.Error(err), zap.String("pull_request_id", pullRequestID))
+		return domain.PullRequest{}, fmt.Errorf("repo.TransitionPullRequest: %w", err)
+	}
+
+	return pr, nil
+}
+
+// mergeLabels returns the PR labels followed by the request labels it does not have yet.
+func mergeLabels(prLabels, requestLabels []string) []string {
+	labels := slices.Clone(prLabels)
+	for _, label := range requestLabels {
+		if !slices.Contains(labels, label) {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+func statusUpdate(pr domain.PullRequest, transition domain.PRTransition, eventType domain.PullRequestEventType,
+	actorID string,
+) (domain.PullRequestUpdate, error) {
+	next, err := pr.Status.Next(transition)
+	if err != nil {
+		return domain.PullRequestUpdate{}, err
+	}
+
+	return domain.PullRequestUpdate{
+		Status: next,
+		Events: []domain.PullRequestEvent{{
+			PullRequestID: pr.PullRequestID,
+			Type:          eventType,
+			ActorID:       actorID,
+			Details:       map[string]any{"from": pr.Status},
+		}},
+	}, nil
+}
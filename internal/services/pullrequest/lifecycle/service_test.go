@@ -0,0 +1,173 @@
+package lifecycle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func reviewer(userID string, role domain.ReviewerRole) domain.Reviewer {
+	return domain.Reviewer{UserID: userID, Role: role, Source: domain.ReviewerSourceAuto}
+}
+
+// transitionOn makes TransitionPullRequest run decide on pr, as the repository does inside its
+// transaction, and keeps the decided update.
+func transitionOn(repo *RepositoryMock, pr domain.PullRequest, update *domain.PullRequestUpdate) {
+	repo.TransitionPullRequestMock.Set(func(_ context.Context, _ string,
+		decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error),
+	) (domain.PullRequest, error) {
+		decided, err := decide(pr)
+		if err != nil {
+			return domain.PullRequest{}, err
+		}
+		*update = decided
+		return pr, nil
+	})
+}
+
+func TestHandler_ReadyForReview(t *testing.T) {
+	t.Parallel()
+
+	shadow := reviewer("t1", domain.ReviewerRoleShadow)
+	assignment := domain.Assignment{
+		Reviewers: []domain.Reviewer{reviewer("u2", domain.ReviewerRoleReviewer)},
+		Shadow:    &shadow,
+	}
+
+	tests := []struct {
+		name         string
+		pr           domain.PullRequest
+		selector     func(mc *minimock.Controller) selector
+		wantStatus   domain.PRStatus
+		wantAssigned []domain.Reviewer
+		wantErr      error
+	}{
+		{
+			name: "success: draft gets reviewers on ready",
+			pr:   domain.PullRequest{PullRequestID: "pr-1", AuthorID: "u1", Status: domain.PRStatusDraft},
+			selector: func(mc *minimock.Controller) selector {
+				selector := NewSelectorMock(mc)
+				selector.SelectMock.Expect(minimock.AnyContext, domain.CreatePullRequest{
+					PullRequestID: "pr-1",
+					AuthorID:      "u1",
+				}).Return(assignment, nil)
+				return selector
+			},
+			wantStatus:   domain.PRStatusOpen,
+			wantAssigned: assignment.AllReviewers(),
+		},
+		{
+			name: "success: labelled draft is matched on its own labels",
+			pr: domain.PullRequest{
+				PullRequestID: "pr-1",
+				AuthorID:      "u1",
+				Status:        domain.PRStatusDraft,
+				Labels:        []string{"backend", "payments"},
+			},
+			selector: func(mc *minimock.Controller) selector {
+				selector := NewSelectorMock(mc)
+				selector.SelectMock.Expect(minimock.AnyContext, domain.CreatePullRequest{
+					PullRequestID: "pr-1",
+					AuthorID:      "u1",
+					Labels:        []string{"backend", "payments"},
+				}).Return(assignment, nil)
+				return selector
+			},
+			wantStatus:   domain.PRStatusOpen,
+			wantAssigned: assignment.AllReviewers(),
+		},
+		{
+			name: "success: draft keeps the reviewers it was converted with",
+			pr: domain.PullRequest{
+				PullRequestID: "pr-1",
+				Status:        domain.PRStatusDraft,
+				Reviewers:     []domain.Reviewer{reviewer("u3", domain.ReviewerRoleReviewer)},
+			},
+			selector: func(mc *minimock.Controller) selector {
+				return NewSelectorMock(mc)
+			},
+			wantStatus: domain.PRStatusOpen,
+		},
+		{
+			name: "error: open pull request is not a draft",
+			pr:   domain.PullRequest{PullRequestID: "pr-1", Status: domain.PRStatusOpen},
+			selector: func(mc *minimock.Controller) selector {
+				return NewSelectorMock(mc)
+			},
+			wantErr: domain.ErrInvalidTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			repo := NewRepositoryMock(mc)
+			repo.GetPullRequestMock.Expect(minimock.AnyContext, tt.pr.PullRequestID).Return(tt.pr, nil)
+			var update domain.PullRequestUpdate
+			if tt.wantErr == nil {
+				transitionOn(repo, tt.pr, &update)
+			}
+
+			_, err := New(repo, tt.selector(mc), NewMetricsMock(mc), zap.NewNop()).ReadyForReview(
+				context.Background(), domain.ReadyForReview{PullRequestID: tt.pr.PullRequestID})
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, update.Status)
+			assert.Equal(t, tt.wantAssigned, update.AddReviewers)
+		})
+	}
+}
+
+func TestHandler_ConvertToDraft(t *testing.T) {
+	t.Parallel()
+
+	pr := domain.PullRequest{
+		PullRequestID: "pr-1",
+		Status:        domain.PRStatusOpen,
+		Reviewers:     []domain.Reviewer{reviewer("u2", domain.ReviewerRoleReviewer)},
+	}
+
+	tests := []struct {
+		name         string
+		release      bool
+		wantReleased bool
+	}{
+		{name: "success: reviewers are kept by default"},
+		{name: "success: reviewers are released on request", release: true, wantReleased: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			repo := NewRepositoryMock(mc)
+			var update domain.PullRequestUpdate
+			transitionOn(repo, pr, &update)
+
+			_, err := New(repo, NewSelectorMock(mc), NewMetricsMock(mc), zap.NewNop()).ConvertToDraft(
+				context.Background(),
+				domain.ConvertToDraft{PullRequestID: pr.PullRequestID, ReleaseReviewers: tt.release})
+
+			require.NoError(t, err)
+			assert.Equal(t, domain.PRStatusDraft, update.Status)
+			assert.Equal(t, tt.wantReleased, update.ReleaseReviewers)
+			require.Len(t, update.Events, 1)
+			assert.Equal(t, domain.EventDrafted, update.Events[0].Type)
+		})
+	}
+}
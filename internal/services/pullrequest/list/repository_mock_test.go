@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package list
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/list.repository -o repository_mock_test.go -n RepositoryMock -p list
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcListPullRequests          func(ctx context.Context, filter domain.PullRequestFilter) (pa1 []domain.PullRequest, err error)
+	funcListPullRequestsOrigin    string
+	inspectFuncListPullRequests   func(ctx context.Context, filter domain.PullRequestFilter)
+	afterListPullRequestsCounter  uint64
+	beforeListPullRequestsCounter uint64
+	ListPullRequestsMock          mRepositoryMockListPullRequests
+}
+
+// NewRepositoryMock returns a mock for repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListPullRequestsMock = mRepositoryMockListPullRequests{mock: m}
+	m.ListPullRequestsMock.callArgs = []*RepositoryMockListPullRequestsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockListPullRequests struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListPullRequestsExpectation
+	expectations       []*RepositoryMockListPullRequestsExpectation
+
+	callArgs []*RepositoryMockListPullRequestsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockListPullRequestsExpectation specifies expectation struct of the repository.ListPullRequests
+type RepositoryMockListPullRequestsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockListPullRequestsParams
+	paramPtrs          *RepositoryMockListPullRequestsParamPtrs
+	expectationOrigins RepositoryMockListPullRequestsExpectationOrigins
+	results            *RepositoryMockListPullRequestsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockListPullRequestsParams contains parameters of the repository.ListPullRequests
+type RepositoryMockListPullRequestsParams struct {
+	ctx    context.Context
+	filter domain.PullRequestFilter
+}
+
+// RepositoryMockListPullRequestsParamPtrs contains pointers to parameters of the repository.ListPullRequests
+type RepositoryMockListPullRequestsParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.PullRequestFilter
+}
+
+// RepositoryMockListPullRequestsResults contains results of the repository.ListPullRequests
+type RepositoryMockListPullRequestsResults struct {
+	pa1 []domain.PullRequest
+	err error
+}
+
+// RepositoryMockListPullRequestsOrigins contains origins of expectations of the repository.ListPullRequests
+type RepositoryMockListPullRequestsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPullRequests *mRepositoryMockListPullRequests) Optional() *mRepositoryMockListPullRequests {
+	mmListPullRequests.optional = true
+	return mmListPullRequests
+}
+
+// Expect sets up expected params for repository.ListPullRequests
+func (mmListPullRequests *mRepositoryMockListPullRequests) Expect(ctx context.Context, filter domain.PullRequestFilter) *mRepositoryMockListPullRequests {
+	if mmListPullRequests.mock.funcListPullRequests != nil {
+		mmListPullRequests.mock.t.Fatalf("RepositoryMock.ListPullRequests mock is already set by Set")
+	}
+
+	if mmListPullRequests.defaultExpectation == nil {
+		mmListPullRequests.defaultExpectation = &RepositoryMockListPullRequestsExpectation{}
+	}
+
+	if mmListPullRequests.defaultExpectation.paramPtrs != nil {
+		mmListPullRequests.mock.t.Fatalf("RepositoryMock.ListPullRequests mock is already set by ExpectParams functions")
+	}
+
+	mmListPullRequests.defaultExpectation.params = &RepositoryMockListPullRequestsParams{ctx, filter}
+	mmListPullRequests.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPullRequests.expectations {
+		if minimock.Equal(e.params, mmListPullRequests.defaultExpectation.params) {
+			mmListPullRequests.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPullRequests.defaultExpectation.params)
+		}
+	}
+
+	return mmListPullRequests
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.ListPullRequests
+func (mmListPullRequests *mRepositoryMockListPullRequests) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListPullRequests {
+	if mmListPullRequests.mock.funcListPullRequests != nil {
+		mmListPullRequests.mock.t.Fatalf("RepositoryMock.ListPullRequests mock is already set by Set")
+	}
+
+	if mmListPullRequests.defaultExpectation == nil {
+		mmListPullRequests.defaultExpectation = &RepositoryMockListPullRequestsExpectation{}
+	}
+
+	if mmListPullRequests.defaultExpectation.params != nil {
+		mmListPullRequests.mock.t.Fatalf("RepositoryMock.ListPullRequests mock is already set by Expect")
+	}
+
+	if mmListPullRequests.defaultExpectation.paramPtrs == nil {
+		mmListPullRequests.defaultExpectation.paramPtrs = &RepositoryMockListPullRequestsParamPtrs{}
+	}
+	mmListPullRequests.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPullRequests.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPullRequests
+}
+
+// ExpectFilterParam2 sets up expected param filter for repository.ListPullRequests
+func (mmListPullRequests *mRepositoryMockListPullRequests) ExpectFilterParam2(filter domain.PullRequestFilter) *mRepositoryMockListPullRequests {
+	if mmListPullRequests.mock.funcListPullRequests != nil {
+		mmListPullRequests.mock.t.Fatalf("RepositoryMock.ListPullRequests mock is already set by Set")
+	}
+
+	if mmListPullRequests.defaultExpectation == nil {
+		mmListPullRequests.defaultExpectation = &RepositoryMockListPullRequestsExpectation{}
+	}
+
+	if mmListPullRequests.defaultExpectation.params != nil {
+		mmListPullRequests.mock.t.Fatalf("RepositoryMock.ListPullRequests mock is already set by Expect")
+	}
+
+	if mmListPullRequests.defaultExpectation.paramPtrs == nil {
+		mmListPullRequests.defaultExpectation.paramPtrs = &RepositoryMockListPullRequestsParamPtrs{}
+	}
+	mmListPullRequests.defaultExpectation.paramPtrs.filter = &filter
+	mmListPullRequests.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListPullRequests
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.ListPullRequests
+func (mmListPullRequests *mRepositoryMockListPullRequests) Inspect(f func(ctx context.Context, filter domain.PullRequestFilter)) *mRepositoryMockListPullRequests {
+	if mmListPullRequests.mock.inspectFuncListPullRequests != nil {
+		mmListPullRequests.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListPullRequests")
+	}
+
+	mmListPullRequests.mock.inspectFuncListPullRequests = f
+
+	return mmListPullRequests
+}
+
+// Return sets up results that will be returned by repository.ListPullRequests
+func (mmListPullRequests *mRepositoryMockListPullRequests) Return(pa1 []domain.PullRequest, err error) *RepositoryMock {
+	if mmListPullRequests.mock.funcListPullRequests != nil {
+		mmListPullRequests.mock.t.Fatalf("RepositoryMock.ListPullRequests mock is already set by Set")
+	}
+
+	if mmListPullRequests.defaultExpectation == nil {
+		mmListPullRequests.defaultExpectation = &RepositoryMockListPullRequestsExpectation{mock: mmListPullRequests.mock}
+	}
+	mmListPullRequests.defaultExpectation.results = &RepositoryMockListPullRequestsResults{pa1, err}
+	mmListPullRequests.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPullRequests.mock
+}
+
+// Set uses given function f to mock the repository.ListPullRequests method
+func (mmListPullRequests *mRepositoryMockListPullRequests) Set(f func(ctx context.Context, filter domain.PullRequestFilter) (pa1 []domain.PullRequest, err error)) *RepositoryMock {
+	if mmListPullRequests.defaultExpectation != nil {
+		mmListPullRequests.mock.t.Fatalf("Default expectation is already set for the repository.ListPullRequests method")
+	}
+
+	if len(mmListPullRequests.expectations) > 0 {
+		mmListPullRequests.mock.t.Fatalf("Some expectations are already set for the repository.ListPullRequests method")
+	}
+
+	mmListPullRequests.mock.funcListPullRequests = f
+	mmListPullRequests.mock.funcListPullRequestsOrigin = minimock.CallerInfo(1)
+	return mmListPullRequests.mock
+}
+
+// When sets expectation for the repository.ListPullRequests which will trigger the result defined by the following
+// Then helper
+func (mmListPullRequests *mRepositoryMockListPullRequests) When(ctx context.Context, filter domain.PullRequestFilter) *RepositoryMockListPullRequestsExpectation {
+	if mmListPullRequests.mock.funcListPullRequests != nil {
+		mmListPullRequests.mock.t.Fatalf("RepositoryMock.ListPullRequests mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListPullRequestsExpectation{
+		mock:               mmListPullRequests.mock,
+		params:             &RepositoryMockListPullRequestsParams{ctx, filter},
+		expectationOrigins: RepositoryMockListPullRequestsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPullRequests.expectations = append(mmListPullRequests.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.ListPullRequests return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListPullRequestsExpectation) Then(pa1 []domain.PullRequest, err error) *RepositoryMock {
+	e.results = &RepositoryMockListPullRequestsResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.ListPullRequests should be invoked
+func (mmListPullRequests *mRepositoryMockListPullRequests) Times(n uint64) *mRepositoryMockListPullRequests {
+	if n == 0 {
+		mmListPullRequests.mock.t.Fatalf("Times of RepositoryMock.ListPullRequests mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPullRequests.expectedInvocations, n)
+	mmListPullRequests.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPullRequests
+}
+
+func (mmListPullRequests *mRepositoryMockListPullRequests) invocationsDone() bool {
+	if len(mmListPullRequests.expectations) == 0 && mmListPullRequests.defaultExpectation == nil && mmListPullRequests.mock.funcListPullRequests == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPullRequests.mock.afterListPullRequestsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPullRequests.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPullRequests implements repository
+func (mmListPullRequests *RepositoryMock) ListPullRequests(ctx context.Context, filter domain.PullRequestFilter) (pa1 []domain.PullRequest, err error) {
+	mm_atomic.AddUint64(&mmListPullRequests.beforeListPullRequestsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPullRequests.afterListPullRequestsCounter, 1)
+
+	mmListPullRequests.t.Helper()
+
+	if mmListPullRequests.inspectFuncListPullRequests != nil {
+		mmListPullRequests.inspectFuncListPullRequests(ctx, filter)
+	}
+
+	mm_params := RepositoryMockListPullRequestsParams{ctx, filter}
+
+	// Record call args
+	mmListPullRequests.ListPullRequestsMock.mutex.Lock()
+	mmListPullRequests.ListPullRequestsMock.callArgs = append(mmListPullRequests.ListPullRequestsMock.callArgs, &mm_params)
+	mmListPullRequests.ListPullRequestsMock.mutex.Unlock()
+
+	for _, e := range mmListPullRequests.ListPullRequestsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmListPullRequests.ListPullRequestsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPullRequests.ListPullRequestsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPullRequests.ListPullRequestsMock.defaultExpectation.params
+		mm_want_ptrs := mmListPullRequests.ListPullRequestsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockListPullRequestsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPullRequests.t.Errorf("RepositoryMock.ListPullRequests got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPullRequests.ListPullRequestsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListPullRequests.t.Errorf("RepositoryMock.ListPullRequests got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPullRequests.ListPullRequestsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPullRequests.t.Errorf("RepositoryMock.ListPullRequests got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPullRequests.ListPullRequestsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPullRequests.ListPullRequestsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPullRequests.t.Fatal("No results are set for the RepositoryMock.ListPullRequests")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmListPullRequests.funcListPullRequests != nil {
+		return mmListPullRequests.funcListPullRequests(ctx, filter)
+	}
+	mmListPullRequests.t.Fatalf("Unexpected call to RepositoryMock.ListPullRequests. %v %v", ctx, filter)
+	return
+}
+
+// ListPullRequestsAfterCounter returns a count of finished RepositoryMock.ListPullRequests invocations
+func (mmListPullRequests *RepositoryMock) ListPullRequestsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPullRequests.afterListPullRequestsCounter)
+}
+
+// ListPullRequestsBeforeCounter returns a count of RepositoryMock.ListPullRequests invocations
+func (mmListPullRequests *RepositoryMock) ListPullRequestsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPullRequests.beforeListPullRequestsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ListPullRequests.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPullRequests *mRepositoryMockListPullRequests) Calls() []*RepositoryMockListPullRequestsParams {
+	mmListPullRequests.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListPullRequestsParams, len(mmListPullRequests.callArgs))
+	copy(argCopy, mmListPullRequests.callArgs)
+
+	mmListPullRequests.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPullRequestsDone returns true if the count of the ListPullRequests invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListPullRequestsDone() bool {
+	if m.ListPullRequestsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPullRequestsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPullRequestsMock.invocationsDone()
+}
+
+// MinimockListPullRequestsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListPullRequestsInspect() {
+	for _, e := range m.ListPullRequestsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ListPullRequests at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPullRequestsCounter := mm_atomic.LoadUint64(&m.afterListPullRequestsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPullRequestsMock.defaultExpectation != nil && afterListPullRequestsCounter < 1 {
+		if m.ListPullRequestsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.ListPullRequests at\n%s", m.ListPullRequestsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ListPullRequests at\n%s with params: %#v", m.ListPullRequestsMock.defaultExpectation.expectationOrigins.origin, *m.ListPullRequestsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPullRequests != nil && afterListPullRequestsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.ListPullRequests at\n%s", m.funcListPullRequestsOrigin)
+	}
+
+	if !m.ListPullRequestsMock.invocationsDone() && afterListPullRequestsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListPullRequests at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPullRequestsMock.expectedInvocations), m.ListPullRequestsMock.expectedInvocationsOrigin, afterListPullRequestsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListPullRequestsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListPullRequestsDone()
+}
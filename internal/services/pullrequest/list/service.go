@@ -0,0 +1,65 @@
+package list
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		ListPullRequests(ctx context.Context, filter domain.PullRequestFilter) ([]domain.PullRequest, error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// ListPullRequests returns one page of the PRs matching the filter, newest first. One PR more than
+// the limit is loaded to find out whether another page follows.
+func (h *Handler) ListPullRequests(ctx context.Context, filter domain.PullRequestFilter,
+) (domain.PullRequestPage, error) {
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.list"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	limit := filter.Limit
+	filter.Limit++
+
+	prs, err := h.repo.ListPullRequests(ctx, filter)
+	if err != nil {
+		logger.Error("repo.ListPullRequests", zap.Error(err))
+		return domain.PullRequestPage{}, fmt.Errorf("repo.ListPullRequests: %w", err)
+	}
+
+	page := domain.PullRequestPage{PullRequests: prs}
+	if len(prs) > limit {
+		page.PullRequests = prs[:limit]
+
+		last := page.PullRequests[limit-1]
+		page.NextCursor = domain.PullRequestCursor{
+			CreatedAt:     *last.CreatedAt,
+			PullRequestID: last.PullRequestID,
+		}.Encode()
+	}
+
+	return page, nil
+}
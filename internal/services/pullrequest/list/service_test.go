@@ -0,0 +1,103 @@
+package list
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func TestHandler_ListPullRequests(t *testing.T) {
+	t.Parallel()
+
+	// The middle two PRs are created at the same moment, the cursor tells them apart by id.
+	base := time.Date(2025, 3, 5, 10, 0, 0, 0, time.UTC)
+	later := base.Add(time.Hour)
+	prs := []domain.PullRequest{
+		{PullRequestID: "pr-9", CreatedAt: &later},
+		{PullRequestID: "pr-8", CreatedAt: &base},
+		{PullRequestID: "pr-7", CreatedAt: &base},
+	}
+	after := &domain.PullRequestCursor{CreatedAt: later, PullRequestID: "pr-9"}
+
+	tests := []struct {
+		name    string
+		filter  domain.PullRequestFilter
+		repo    func(mc *minimock.Controller) repository
+		want    domain.PullRequestPage
+		wantErr error
+	}{
+		{
+			name:   "success: one more PR than the limit gives a cursor to the last PR of the page",
+			filter: domain.PullRequestFilter{Label: "backend", Limit: 2},
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.ListPullRequestsMock.Expect(minimock.AnyContext, domain.PullRequestFilter{Label: "backend", Limit: 3}).
+					Return(prs, nil)
+				return repo
+			},
+			want: domain.PullRequestPage{
+				PullRequests: prs[:2],
+				NextCursor:   domain.PullRequestCursor{CreatedAt: base, PullRequestID: "pr-8"}.Encode(),
+			},
+		},
+		{
+			name:   "success: last page has no cursor",
+			filter: domain.PullRequestFilter{After: after, Limit: 2},
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.ListPullRequestsMock.Expect(minimock.AnyContext, domain.PullRequestFilter{After: after, Limit: 3}).
+					Return(prs[1:], nil)
+				return repo
+			},
+			want: domain.PullRequestPage{PullRequests: prs[1:]},
+		},
+		{
+			name:   "success: no PRs",
+			filter: domain.PullRequestFilter{Limit: 2},
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.ListPullRequestsMock.Return(nil, nil)
+				return repo
+			},
+			want: domain.PullRequestPage{},
+		},
+		{
+			name:   "error: repository error",
+			filter: domain.PullRequestFilter{Limit: 2},
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.ListPullRequestsMock.Return(nil, errors.New("database connection failed"))
+				return repo
+			},
+			wantErr: errors.New("repo.ListPullRequests: database connection failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			handler := New(tt.repo(mc), zap.NewNop())
+
+			got, err := handler.ListPullRequests(context.Background(), tt.filter)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,377 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package merge
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/merge.metrics -o metrics_mock_test.go -n MetricsMock -p merge
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// MetricsMock implements metrics
+type MetricsMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcPullRequestMerged          func(forced bool)
+	funcPullRequestMergedOrigin    string
+	inspectFuncPullRequestMerged   func(forced bool)
+	afterPullRequestMergedCounter  uint64
+	beforePullRequestMergedCounter uint64
+	PullRequestMergedMock          mMetricsMockPullRequestMerged
+}
+
+// NewMetricsMock returns a mock for metrics
+func NewMetricsMock(t minimock.Tester) *MetricsMock {
+	m := &MetricsMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.PullRequestMergedMock = mMetricsMockPullRequestMerged{mock: m}
+	m.PullRequestMergedMock.callArgs = []*MetricsMockPullRequestMergedParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mMetricsMockPullRequestMerged struct {
+	optional           bool
+	mock               *MetricsMock
+	defaultExpectation *MetricsMockPullRequestMergedExpectation
+	expectations       []*MetricsMockPullRequestMergedExpectation
+
+	callArgs []*MetricsMockPullRequestMergedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MetricsMockPullRequestMergedExpectation specifies expectation struct of the metrics.PullRequestMerged
+type MetricsMockPullRequestMergedExpectation struct {
+	mock               *MetricsMock
+	params             *MetricsMockPullRequestMergedParams
+	paramPtrs          *MetricsMockPullRequestMergedParamPtrs
+	expectationOrigins MetricsMockPullRequestMergedExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// MetricsMockPullRequestMergedParams contains parameters of the metrics.PullRequestMerged
+type MetricsMockPullRequestMergedParams struct {
+	forced bool
+}
+
+// MetricsMockPullRequestMergedParamPtrs contains pointers to parameters of the metrics.PullRequestMerged
+type MetricsMockPullRequestMergedParamPtrs struct {
+	forced *bool
+}
+
+// MetricsMockPullRequestMergedOrigins contains origins of expectations of the metrics.PullRequestMerged
+type MetricsMockPullRequestMergedExpectationOrigins struct {
+	origin       string
+	originForced string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPullRequestMerged *mMetricsMockPullRequestMerged) Optional() *mMetricsMockPullRequestMerged {
+	mmPullRequestMerged.optional = true
+	return mmPullRequestMerged
+}
+
+// Expect sets up expected params for metrics.PullRequestMerged
+func (mmPullRequestMerged *mMetricsMockPullRequestMerged) Expect(forced bool) *mMetricsMockPullRequestMerged {
+	if mmPullRequestMerged.mock.funcPullRequestMerged != nil {
+		mmPullRequestMerged.mock.t.Fatalf("MetricsMock.PullRequestMerged mock is already set by Set")
+	}
+
+	if mmPullRequestMerged.defaultExpectation == nil {
+		mmPullRequestMerged.defaultExpectation = &MetricsMockPullRequestMergedExpectation{}
+	}
+
+	if mmPullRequestMerged.defaultExpectation.paramPtrs != nil {
+		mmPullRequestMerged.mock.t.Fatalf("MetricsMock.PullRequestMerged mock is already set by ExpectParams functions")
+	}
+
+	mmPullRequestMerged.defaultExpectation.params = &MetricsMockPullRequestMergedParams{forced}
+	mmPullRequestMerged.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPullRequestMerged.expectations {
+		if minimock.Equal(e.params, mmPullRequestMerged.defaultExpectation.params) {
+			mmPullRequestMerged.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPullRequestMerged.defaultExpectation.params)
+		}
+	}
+
+	return mmPullRequestMerged
+}
+
+// ExpectForcedParam1 sets up expected param forced for metrics.PullRequestMerged
+func (mmPullRequestMerged *mMetricsMockPullRequestMerged) ExpectForcedParam1(forced bool) *mMetricsMockPullRequestMerged {
+	if mmPullRequestMerged.mock.funcPullRequestMerged != nil {
+		mmPullRequestMerged.mock.t.Fatalf("MetricsMock.PullRequestMerged mock is already set by Set")
+	}
+
+	if mmPullRequestMerged.defaultExpectation == nil {
+		mmPullRequestMerged.defaultExpectation = &MetricsMockPullRequestMergedExpectation{}
+	}
+
+	if mmPullRequestMerged.defaultExpectation.params != nil {
+		mmPullRequestMerged.mock.t.Fatalf("MetricsMock.PullRequestMerged mock is already set by Expect")
+	}
+
+	if mmPullRequestMerged.defaultExpectation.paramPtrs == nil {
+		mmPullRequestMerged.defaultExpectation.paramPtrs = &MetricsMockPullRequestMergedParamPtrs{}
+	}
+	mmPullRequestMerged.defaultExpectation.paramPtrs.forced = &forced
+	mmPullRequestMerged.defaultExpectation.expectationOrigins.originForced = minimock.CallerInfo(1)
+
+	return mmPullRequestMerged
+}
+
+// Inspect accepts an inspector function that has same arguments as the metrics.PullRequestMerged
+func (mmPullRequestMerged *mMetricsMockPullRequestMerged) Inspect(f func(forced bool)) *mMetricsMockPullRequestMerged {
+	if mmPullRequestMerged.mock.inspectFuncPullRequestMerged != nil {
+		mmPullRequestMerged.mock.t.Fatalf("Inspect function is already set for MetricsMock.PullRequestMerged")
+	}
+
+	mmPullRequestMerged.mock.inspectFuncPullRequestMerged = f
+
+	return mmPullRequestMerged
+}
+
+// Return sets up results that will be returned by metrics.PullRequestMerged
+func (mmPullRequestMerged *mMetricsMockPullRequestMerged) Return() *MetricsMock {
+	if mmPullRequestMerged.mock.funcPullRequestMerged != nil {
+		mmPullRequestMerged.mock.t.Fatalf("MetricsMock.PullRequestMerged mock is already set by Set")
+	}
+
+	if mmPullRequestMerged.defaultExpectation == nil {
+		mmPullRequestMerged.defaultExpectation = &MetricsMockPullRequestMergedExpectation{mock: mmPullRequestMerged.mock}
+	}
+
+	mmPullRequestMerged.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPullRequestMerged.mock
+}
+
+// Set uses given function f to mock the metrics.PullRequestMerged method
+func (mmPullRequestMerged *mMetricsMockPullRequestMerged) Set(f func(forced bool)) *MetricsMock {
+	if mmPullRequestMerged.defaultExpectation != nil {
+		mmPullRequestMerged.mock.t.Fatalf("Default expectation is already set for the metrics.PullRequestMerged method")
+	}
+
+	if len(mmPullRequestMerged.expectations) > 0 {
+		mmPullRequestMerged.mock.t.Fatalf("Some expectations are already set for the metrics.PullRequestMerged method")
+	}
+
+	mmPullRequestMerged.mock.funcPullRequestMerged = f
+	mmPullRequestMerged.mock.funcPullRequestMergedOrigin = minimock.CallerInfo(1)
+	return mmPullRequestMerged.mock
+}
+
+// When sets expectation for the metrics.PullRequestMerged which will trigger the result defined by the following
+// Then helper
+func (mmPullRequestMerged *mMetricsMockPullRequestMerged) When(forced bool) *MetricsMockPullRequestMergedExpectation {
+	if mmPullRequestMerged.mock.funcPullRequestMerged != nil {
+		mmPullRequestMerged.mock.t.Fatalf("MetricsMock.PullRequestMerged mock is already set by Set")
+	}
+
+	expectation := &MetricsMockPullRequestMergedExpectation{
+		mock:               mmPullRequestMerged.mock,
+		params:             &MetricsMockPullRequestMergedParams{forced},
+		expectationOrigins: MetricsMockPullRequestMergedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPullRequestMerged.expectations = append(mmPullRequestMerged.expectations, expectation)
+	return expectation
+}
+
+// Then sets up metrics.PullRequestMerged return parameters for the expectation previously defined by the When method
+
+func (e *MetricsMockPullRequestMergedExpectation) Then() *MetricsMock {
+	return e.mock
+}
+
+// Times sets number of times metrics.PullRequestMerged should be invoked
+func (mmPullRequestMerged *mMetricsMockPullRequestMerged) Times(n uint64) *mMetricsMockPullRequestMerged {
+	if n == 0 {
+		mmPullRequestMerged.mock.t.Fatalf("Times of MetricsMock.PullRequestMerged mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPullRequestMerged.expectedInvocations, n)
+	mmPullRequestMerged.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPullRequestMerged
+}
+
+func (mmPullRequestMerged *mMetricsMockPullRequestMerged) invocationsDone() bool {
+	if len(mmPullRequestMerged.expectations) == 0 && mmPullRequestMerged.defaultExpectation == nil && mmPullRequestMerged.mock.funcPullRequestMerged == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPullRequestMerged.mock.afterPullRequestMergedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPullRequestMerged.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PullRequestMerged implements metrics
+func (mmPullRequestMerged *MetricsMock) PullRequestMerged(forced bool) {
+	mm_atomic.AddUint64(&mmPullRequestMerged.beforePullRequestMergedCounter, 1)
+	defer mm_atomic.AddUint64(&mmPullRequestMerged.afterPullRequestMergedCounter, 1)
+
+	mmPullRequestMerged.t.Helper()
+
+	if mmPullRequestMerged.inspectFuncPullRequestMerged != nil {
+		mmPullRequestMerged.inspectFuncPullRequestMerged(forced)
+	}
+
+	mm_params := MetricsMockPullRequestMergedParams{forced}
+
+	// Record call args
+	mmPullRequestMerged.PullRequestMergedMock.mutex.Lock()
+	mmPullRequestMerged.PullRequestMergedMock.callArgs = append(mmPullRequestMerged.PullRequestMergedMock.callArgs, &mm_params)
+	mmPullRequestMerged.PullRequestMergedMock.mutex.Unlock()
+
+	for _, e := range mmPullRequestMerged.PullRequestMergedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmPullRequestMerged.PullRequestMergedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPullRequestMerged.PullRequestMergedMock.defaultExpectation.Counter, 1)
+		mm_want := mmPullRequestMerged.PullRequestMergedMock.defaultExpectation.params
+		mm_want_ptrs := mmPullRequestMerged.PullRequestMergedMock.defaultExpectation.paramPtrs
+
+		mm_got := MetricsMockPullRequestMergedParams{forced}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.forced != nil && !minimock.Equal(*mm_want_ptrs.forced, mm_got.forced) {
+				mmPullRequestMerged.t.Errorf("MetricsMock.PullRequestMerged got unexpected parameter forced, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPullRequestMerged.PullRequestMergedMock.defaultExpectation.expectationOrigins.originForced, *mm_want_ptrs.forced, mm_got.forced, minimock.Diff(*mm_want_ptrs.forced, mm_got.forced))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPullRequestMerged.t.Errorf("MetricsMock.PullRequestMerged got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPullRequestMerged.PullRequestMergedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmPullRequestMerged.funcPullRequestMerged != nil {
+		mmPullRequestMerged.funcPullRequestMerged(forced)
+		return
+	}
+	mmPullRequestMerged.t.Fatalf("Unexpected call to MetricsMock.PullRequestMerged. %v", forced)
+
+}
+
+// PullRequestMergedAfterCounter returns a count of finished MetricsMock.PullRequestMerged invocations
+func (mmPullRequestMerged *MetricsMock) PullRequestMergedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPullRequestMerged.afterPullRequestMergedCounter)
+}
+
+// PullRequestMergedBeforeCounter returns a count of MetricsMock.PullRequestMerged invocations
+func (mmPullRequestMerged *MetricsMock) PullRequestMergedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPullRequestMerged.beforePullRequestMergedCounter)
+}
+
+// Calls returns a list of arguments used in each call to MetricsMock.PullRequestMerged.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPullRequestMerged *mMetricsMockPullRequestMerged) Calls() []*MetricsMockPullRequestMergedParams {
+	mmPullRequestMerged.mutex.RLock()
+
+	argCopy := make([]*MetricsMockPullRequestMergedParams, len(mmPullRequestMerged.callArgs))
+	copy(argCopy, mmPullRequestMerged.callArgs)
+
+	mmPullRequestMerged.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPullRequestMergedDone returns true if the count of the PullRequestMerged invocations corresponds
+// the number of defined expectations
+func (m *MetricsMock) MinimockPullRequestMergedDone() bool {
+	if m.PullRequestMergedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PullRequestMergedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PullRequestMergedMock.invocationsDone()
+}
+
+// MinimockPullRequestMergedInspect logs each unmet expectation
+func (m *MetricsMock) MinimockPullRequestMergedInspect() {
+	for _, e := range m.PullRequestMergedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MetricsMock.PullRequestMerged at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPullRequestMergedCounter := mm_atomic.LoadUint64(&m.afterPullRequestMergedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PullRequestMergedMock.defaultExpectation != nil && afterPullRequestMergedCounter < 1 {
+		if m.PullRequestMergedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MetricsMock.PullRequestMerged at\n%s", m.PullRequestMergedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MetricsMock.PullRequestMerged at\n%s with params: %#v", m.PullRequestMergedMock.defaultExpectation.expectationOrigins.origin, *m.PullRequestMergedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPullRequestMerged != nil && afterPullRequestMergedCounter < 1 {
+		m.t.Errorf("Expected call to MetricsMock.PullRequestMerged at\n%s", m.funcPullRequestMergedOrigin)
+	}
+
+	if !m.PullRequestMergedMock.invocationsDone() && afterPullRequestMergedCounter > 0 {
+		m.t.Errorf("Expected %d calls to MetricsMock.PullRequestMerged at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PullRequestMergedMock.expectedInvocations), m.PullRequestMergedMock.expectedInvocationsOrigin, afterPullRequestMergedCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *MetricsMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockPullRequestMergedInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *MetricsMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *MetricsMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockPullRequestMergedDone()
+}
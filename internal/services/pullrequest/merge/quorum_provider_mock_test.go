@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package merge
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/merge.quorumProvider -o quorum_provider_mock_test.go -n QuorumProviderMock -p merge
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// QuorumProviderMock implements quorumProvider
+type QuorumProviderMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcQuorum          func(ctx context.Context, pullRequestID string) (q1 domain.QuorumPolicy, err error)
+	funcQuorumOrigin    string
+	inspectFuncQuorum   func(ctx context.Context, pullRequestID string)
+	afterQuorumCounter  uint64
+	beforeQuorumCounter uint64
+	QuorumMock          mQuorumProviderMockQuorum
+}
+
+// NewQuorumProviderMock returns a mock for quorumProvider
+func NewQuorumProviderMock(t minimock.Tester) *QuorumProviderMock {
+	m := &QuorumProviderMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.QuorumMock = mQuorumProviderMockQuorum{mock: m}
+	m.QuorumMock.callArgs = []*QuorumProviderMockQuorumParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mQuorumProviderMockQuorum struct {
+	optional           bool
+	mock               *QuorumProviderMock
+	defaultExpectation *QuorumProviderMockQuorumExpectation
+	expectations       []*QuorumProviderMockQuorumExpectation
+
+	callArgs []*QuorumProviderMockQuorumParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// QuorumProviderMockQuorumExpectation specifies expectation struct of the quorumProvider.Quorum
+type QuorumProviderMockQuorumExpectation struct {
+	mock               *QuorumProviderMock
+	params             *QuorumProviderMockQuorumParams
+	paramPtrs          *QuorumProviderMockQuorumParamPtrs
+	expectationOrigins QuorumProviderMockQuorumExpectationOrigins
+	results            *QuorumProviderMockQuorumResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// QuorumProviderMockQuorumParams contains parameters of the quorumProvider.Quorum
+type QuorumProviderMockQuorumParams struct {
+	ctx           context.Context
+	pullRequestID string
+}
+
+// QuorumProviderMockQuorumParamPtrs contains pointers to parameters of the quorumProvider.Quorum
+type QuorumProviderMockQuorumParamPtrs struct {
+	ctx           *context.Context
+	pullRequestID *string
+}
+
+// QuorumProviderMockQuorumResults contains results of the quorumProvider.Quorum
+type QuorumProviderMockQuorumResults struct {
+	q1  domain.QuorumPolicy
+	err error
+}
+
+// QuorumProviderMockQuorumOrigins contains origins of expectations of the quorumProvider.Quorum
+type QuorumProviderMockQuorumExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originPullRequestID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmQuorum *mQuorumProviderMockQuorum) Optional() *mQuorumProviderMockQuorum {
+	mmQuorum.optional = true
+	return mmQuorum
+}
+
+// Expect sets up expected params for quorumProvider.Quorum
+func (mmQuorum *mQuorumProviderMockQuorum) Expect(ctx context.Context, pullRequestID string) *mQuorumProviderMockQuorum {
+	if mmQuorum.mock.funcQuorum != nil {
+		mmQuorum.mock.t.Fatalf("QuorumProviderMock.Quorum mock is already set by Set")
+	}
+
+	if mmQuorum.defaultExpectation == nil {
+		mmQuorum.defaultExpectation = &QuorumProviderMockQuorumExpectation{}
+	}
+
+	if mmQuorum.defaultExpectation.paramPtrs != nil {
+		mmQuorum.mock.t.Fatalf("QuorumProviderMock.Quorum mock is already set by ExpectParams functions")
+	}
+
+	mmQuorum.defaultExpectation.params = &QuorumProviderMockQuorumParams{ctx, pullRequestID}
+	mmQuorum.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmQuorum.expectations {
+		if minimock.Equal(e.params, mmQuorum.defaultExpectation.params) {
+			mmQuorum.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmQuorum.defaultExpectation.params)
+		}
+	}
+
+	return mmQuorum
+}
+
+// ExpectCtxParam1 sets up expected param ctx for quorumProvider.Quorum
+func (mmQuorum *mQuorumProviderMockQuorum) ExpectCtxParam1(ctx context.Context) *mQuorumProviderMockQuorum {
+	if mmQuorum.mock.funcQuorum != nil {
+		mmQuorum.mock.t.Fatalf("QuorumProviderMock.Quorum mock is already set by Set")
+	}
+
+	if mmQuorum.defaultExpectation == nil {
+		mmQuorum.defaultExpectation = &QuorumProviderMockQuorumExpectation{}
+	}
+
+	if mmQuorum.defaultExpectation.params != nil {
+		mmQuorum.mock.t.Fatalf("QuorumProviderMock.Quorum mock is already set by Expect")
+	}
+
+	if mmQuorum.defaultExpectation.paramPtrs == nil {
+		mmQuorum.defaultExpectation.paramPtrs = &QuorumProviderMockQuorumParamPtrs{}
+	}
+	mmQuorum.defaultExpectation.paramPtrs.ctx = &ctx
+	mmQuorum.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmQuorum
+}
+
+// ExpectPullRequestIDParam2 sets up expected param pullRequestID for quorumProvider.Quorum
+func (mmQuorum *mQuorumProviderMockQuorum) ExpectPullRequestIDParam2(pullRequestID string) *mQuorumProviderMockQuorum {
+	if mmQuorum.mock.funcQuorum != nil {
+		mmQuorum.mock.t.Fatalf("QuorumProviderMock.Quorum mock is already set by Set")
+	}
+
+	if mmQuorum.defaultExpectation == nil {
+		mmQuorum.defaultExpectation = &QuorumProviderMockQuorumExpectation{}
+	}
+
+	if mmQuorum.defaultExpectation.params != nil {
+		mmQuorum.mock.t.Fatalf("QuorumProviderMock.Quorum mock is already set by Expect")
+	}
+
+	if mmQuorum.defaultExpectation.paramPtrs == nil {
+		mmQuorum.defaultExpectation.paramPtrs = &QuorumProviderMockQuorumParamPtrs{}
+	}
+	mmQuorum.defaultExpectation.paramPtrs.pullRequestID = &pullRequestID
+	mmQuorum.defaultExpectation.expectationOrigins.originPullRequestID = minimock.CallerInfo(1)
+
+	return mmQuorum
+}
+
+// Inspect accepts an inspector function that has same arguments as the quorumProvider.Quorum
+func (mmQuorum *mQuorumProviderMockQuorum) Inspect(f func(ctx context.Context, pullRequestID string)) *mQuorumProviderMockQuorum {
+	if mmQuorum.mock.inspectFuncQuorum != nil {
+		mmQuorum.mock.t.Fatalf("Inspect function is already set for QuorumProviderMock.Quorum")
+	}
+
+	mmQuorum.mock.inspectFuncQuorum = f
+
+	return mmQuorum
+}
+
+// Return sets up results that will be returned by quorumProvider.Quorum
+func (mmQuorum *mQuorumProviderMockQuorum) Return(q1 domain.QuorumPolicy, err error) *QuorumProviderMock {
+	if mmQuorum.mock.funcQuorum != nil {
+		mmQuorum.mock.t.Fatalf("QuorumProviderMock.Quorum mock is already set by Set")
+	}
+
+	if mmQuorum.defaultExpectation == nil {
+		mmQuorum.defaultExpectation = &QuorumProviderMockQuorumExpectation{mock: mmQuorum.mock}
+	}
+	mmQuorum.defaultExpectation.results = &QuorumProviderMockQuorumResults{q1, err}
+	mmQuorum.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmQuorum.mock
+}
+
+// Set uses given function f to mock the quorumProvider.Quorum method
+func (mmQuorum *mQuorumProviderMockQuorum) Set(f func(ctx context.Context, pullRequestID string) (q1 domain.QuorumPolicy, err error)) *QuorumProviderMock {
+	if mmQuorum.defaultExpectation != nil {
+		mmQuorum.mock.t.Fatalf("Default expectation is already set for the quorumProvider.Quorum method")
+	}
+
+	if len(mmQuorum.expectations) > 0 {
+		mmQuorum.mock.t.Fatalf("Some expectations are already set for the quorumProvider.Quorum method")
+	}
+
+	mmQuorum.mock.funcQuorum = f
+	mmQuorum.mock.funcQuorumOrigin = minimock.CallerInfo(1)
+	return mmQuorum.mock
+}
+
+// When sets expectation for the quorumProvider.Quorum which will trigger the result defined by the following
+// Then helper
+func (mmQuorum *mQuorumProviderMockQuorum) When(ctx context.Context, pullRequestID string) *QuorumProviderMockQuorumExpectation {
+	if mmQuorum.mock.funcQuorum != nil {
+		mmQuorum.mock.t.Fatalf("QuorumProviderMock.Quorum mock is already set by Set")
+	}
+
+	expectation := &QuorumProviderMockQuorumExpectation{
+		mock:               mmQuorum.mock,
+		params:             &QuorumProviderMockQuorumParams{ctx, pullRequestID},
+		expectationOrigins: QuorumProviderMockQuorumExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmQuorum.expectations = append(mmQuorum.expectations, expectation)
+	return expectation
+}
+
+// Then sets up quorumProvider.Quorum return parameters for the expectation previously defined by the When method
+func (e *QuorumProviderMockQuorumExpectation) Then(q1 domain.QuorumPolicy, err error) *QuorumProviderMock {
+	e.results = &QuorumProviderMockQuorumResults{q1, err}
+	return e.mock
+}
+
+// Times sets number of times quorumProvider.Quorum should be invoked
+func (mmQuorum *mQuorumProviderMockQuorum) Times(n uint64) *mQuorumProviderMockQuorum {
+	if n == 0 {
+		mmQuorum.mock.t.Fatalf("Times of QuorumProviderMock.Quorum mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmQuorum.expectedInvocations, n)
+	mmQuorum.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmQuorum
+}
+
+func (mmQuorum *mQuorumProviderMockQuorum) invocationsDone() bool {
+	if len(mmQuorum.expectations) == 0 && mmQuorum.defaultExpectation == nil && mmQuorum.mock.funcQuorum == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmQuorum.mock.afterQuorumCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmQuorum.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Quorum implements quorumProvider
+func (mmQuorum *QuorumProviderMock) Quorum(ctx context.Context, pullRequestID string) (q1 domain.QuorumPolicy, err error) {
+	mm_atomic.AddUint64(&mmQuorum.beforeQuorumCounter, 1)
+	defer mm_atomic.AddUint64(&mmQuorum.afterQuorumCounter, 1)
+
+	mmQuorum.t.Helper()
+
+	if mmQuorum.inspectFuncQuorum != nil {
+		mmQuorum.inspectFuncQuorum(ctx, pullRequestID)
+	}
+
+	mm_params := QuorumProviderMockQuorumParams{ctx, pullRequestID}
+
+	// Record call args
+	mmQuorum.QuorumMock.mutex.Lock()
+	mmQuorum.QuorumMock.callArgs = append(mmQuorum.QuorumMock.callArgs, &mm_params)
+	mmQuorum.QuorumMock.mutex.Unlock()
+
+	for _, e := range mmQuorum.QuorumMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.q1, e.results.err
+		}
+	}
+
+	if mmQuorum.QuorumMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmQuorum.QuorumMock.defaultExpectation.Counter, 1)
+		mm_want := mmQuorum.QuorumMock.defaultExpectation.params
+		mm_want_ptrs := mmQuorum.QuorumMock.defaultExpectation.paramPtrs
+
+		mm_got := QuorumProviderMockQuorumParams{ctx, pullRequestID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmQuorum.t.Errorf("QuorumProviderMock.Quorum got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmQuorum.QuorumMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pullRequestID != nil && !minimock.Equal(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID) {
+				mmQuorum.t.Errorf("QuorumProviderMock.Quorum got unexpected parameter pullRequestID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmQuorum.QuorumMock.defaultExpectation.expectationOrigins.originPullRequestID, *mm_want_ptrs.pullRequestID, mm_got.pullRequestID, minimock.Diff(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmQuorum.t.Errorf("QuorumProviderMock.Quorum got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmQuorum.QuorumMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmQuorum.QuorumMock.defaultExpectation.results
+		if mm_results == nil {
+			mmQuorum.t.Fatal("No results are set for the QuorumProviderMock.Quorum")
+		}
+		return (*mm_results).q1, (*mm_results).err
+	}
+	if mmQuorum.funcQuorum != nil {
+		return mmQuorum.funcQuorum(ctx, pullRequestID)
+	}
+	mmQuorum.t.Fatalf("Unexpected call to QuorumProviderMock.Quorum. %v %v", ctx, pullRequestID)
+	return
+}
+
+// QuorumAfterCounter returns a count of finished QuorumProviderMock.Quorum invocations
+func (mmQuorum *QuorumProviderMock) QuorumAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQuorum.afterQuorumCounter)
+}
+
+// QuorumBeforeCounter returns a count of QuorumProviderMock.Quorum invocations
+func (mmQuorum *QuorumProviderMock) QuorumBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQuorum.beforeQuorumCounter)
+}
+
+// Calls returns a list of arguments used in each call to QuorumProviderMock.Quorum.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmQuorum *mQuorumProviderMockQuorum) Calls() []*QuorumProviderMockQuorumParams {
+	mmQuorum.mutex.RLock()
+
+	argCopy := make([]*QuorumProviderMockQuorumParams, len(mmQuorum.callArgs))
+	copy(argCopy, mmQuorum.callArgs)
+
+	mmQuorum.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockQuorumDone returns true if the count of the Quorum invocations corresponds
+// the number of defined expectations
+func (m *QuorumProviderMock) MinimockQuorumDone() bool {
+	if m.QuorumMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.QuorumMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.QuorumMock.invocationsDone()
+}
+
+// MinimockQuorumInspect logs each unmet expectation
+func (m *QuorumProviderMock) MinimockQuorumInspect() {
+	for _, e := range m.QuorumMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to QuorumProviderMock.Quorum at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterQuorumCounter := mm_atomic.LoadUint64(&m.afterQuorumCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.QuorumMock.defaultExpectation != nil && afterQuorumCounter < 1 {
+		if m.QuorumMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to QuorumProviderMock.Quorum at\n%s", m.QuorumMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to QuorumProviderMock.Quorum at\n%s with params: %#v", m.QuorumMock.defaultExpectation.expectationOrigins.origin, *m.QuorumMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcQuorum != nil && afterQuorumCounter < 1 {
+		m.t.Errorf("Expected call to QuorumProviderMock.Quorum at\n%s", m.funcQuorumOrigin)
+	}
+
+	if !m.QuorumMock.invocationsDone() && afterQuorumCounter > 0 {
+		m.t.Errorf("Expected %d calls to QuorumProviderMock.Quorum at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.QuorumMock.expectedInvocations), m.QuorumMock.expectedInvocationsOrigin, afterQuorumCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *QuorumProviderMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockQuorumInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *QuorumProviderMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *QuorumProviderMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockQuorumDone()
+}
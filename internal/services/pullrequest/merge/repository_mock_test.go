@@ -0,0 +1,805 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package merge
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/merge.repository -o repository_mock_test.go -n RepositoryMock -p merge
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcListDependents          func(ctx context.Context, pullRequestID string) (sa1 []string, err error)
+	funcListDependentsOrigin    string
+	inspectFuncListDependents   func(ctx context.Context, pullRequestID string)
+	afterListDependentsCounter  uint64
+	beforeListDependentsCounter uint64
+	ListDependentsMock          mRepositoryMockListDependents
+
+	funcTransitionPullRequest          func(ctx context.Context, pullRequestID string, decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error)) (p1 domain.PullRequest, err error)
+	funcTransitionPullRequestOrigin    string
+	inspectFuncTransitionPullRequest   func(ctx context.Context, pullRequestID string, decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error))
+	afterTransitionPullRequestCounter  uint64
+	beforeTransitionPullRequestCounter uint64
+	TransitionPullRequestMock          mRepositoryMockTransitionPullRequest
+}
+
+// NewRepositoryMock returns a mock for repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListDependentsMock = mRepositoryMockListDependents{mock: m}
+	m.ListDependentsMock.callArgs = []*RepositoryMockListDependentsParams{}
+
+	m.TransitionPullRequestMock = mRepositoryMockTransitionPullRequest{mock: m}
+	m.TransitionPullRequestMock.callArgs = []*RepositoryMockTransitionPullRequestParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockListDependents struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListDependentsExpectation
+	expectations       []*RepositoryMockListDependentsExpectation
+
+	callArgs []*RepositoryMockListDependentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockListDependentsExpectation specifies expectation struct of the repository.ListDependents
+type RepositoryMockListDependentsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockListDependentsParams
+	paramPtrs          *RepositoryMockListDependentsParamPtrs
+	expectationOrigins RepositoryMockListDependentsExpectationOrigins
+	results            *RepositoryMockListDependentsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockListDependentsParams contains parameters of the repository.ListDependents
+type RepositoryMockListDependentsParams struct {
+	ctx           context.Context
+	pullRequestID string
+}
+
+// RepositoryMockListDependentsParamPtrs contains pointers to parameters of the repository.ListDependents
+type RepositoryMockListDependentsParamPtrs struct {
+	ctx           *context.Context
+	pullRequestID *string
+}
+
+// RepositoryMockListDependentsResults contains results of the repository.ListDependents
+type RepositoryMockListDependentsResults struct {
+	sa1 []string
+	err error
+}
+
+// RepositoryMockListDependentsOrigins contains origins of expectations of the repository.ListDependents
+type RepositoryMockListDependentsExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originPullRequestID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListDependents *mRepositoryMockListDependents) Optional() *mRepositoryMockListDependents {
+	mmListDependents.optional = true
+	return mmListDependents
+}
+
+// Expect sets up expected params for repository.ListDependents
+func (mmListDependents *mRepositoryMockListDependents) Expect(ctx context.Context, pullRequestID string) *mRepositoryMockListDependents {
+	if mmListDependents.mock.funcListDependents != nil {
+		mmListDependents.mock.t.Fatalf("RepositoryMock.ListDependents mock is already set by Set")
+	}
+
+	if mmListDependents.defaultExpectation == nil {
+		mmListDependents.defaultExpectation = &RepositoryMockListDependentsExpectation{}
+	}
+
+	if mmListDependents.defaultExpectation.paramPtrs != nil {
+		mmListDependents.mock.t.Fatalf("RepositoryMock.ListDependents mock is already set by ExpectParams functions")
+	}
+
+	mmListDependents.defaultExpectation.params = &RepositoryMockListDependentsParams{ctx, pullRequestID}
+	mmListDependents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListDependents.expectations {
+		if minimock.Equal(e.params, mmListDependents.defaultExpectation.params) {
+			mmListDependents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListDependents.defaultExpectation.params)
+		}
+	}
+
+	return mmListDependents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.ListDependents
+func (mmListDependents *mRepositoryMockListDependents) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListDependents {
+	if mmListDependents.mock.funcListDependents != nil {
+		mmListDependents.mock.t.Fatalf("RepositoryMock.ListDependents mock is already set by Set")
+	}
+
+	if mmListDependents.defaultExpectation == nil {
+		mmListDependents.defaultExpectation = &RepositoryMockListDependentsExpectation{}
+	}
+
+	if mmListDependents.defaultExpectation.params != nil {
+		mmListDependents.mock.t.Fatalf("RepositoryMock.ListDependents mock is already set by Expect")
+	}
+
+	if mmListDependents.defaultExpectation.paramPtrs == nil {
+		mmListDependents.defaultExpectation.paramPtrs = &RepositoryMockListDependentsParamPtrs{}
+	}
+	mmListDependents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListDependents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListDependents
+}
+
+// ExpectPullRequestIDParam2 sets up expected param pullRequestID for repository.ListDependents
+func (mmListDependents *mRepositoryMockListDependents) ExpectPullRequestIDParam2(pullRequestID string) *mRepositoryMockListDependents {
+	if mmListDependents.mock.funcListDependents != nil {
+		mmListDependents.mock.t.Fatalf("RepositoryMock.ListDependents mock is already set by Set")
+	}
+
+	if mmListDependents.defaultExpectation == nil {
+		mmListDependents.defaultExpectation = &RepositoryMockListDependentsExpectation{}
+	}
+
+	if mmListDependents.defaultExpectation.params != nil {
+		mmListDependents.mock.t.Fatalf("RepositoryMock.ListDependents mock is already set by Expect")
+	}
+
+	if mmListDependents.defaultExpectation.paramPtrs == nil {
+		mmListDependents.defaultExpectation.paramPtrs = &RepositoryMockListDependentsParamPtrs{}
+	}
+	mmListDependents.defaultExpectation.paramPtrs.pullRequestID = &pullRequestID
+	mmListDependents.defaultExpectation.expectationOrigins.originPullRequestID = minimock.CallerInfo(1)
+
+	return mmListDependents
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.ListDependents
+func (mmListDependents *mRepositoryMockListDependents) Inspect(f func(ctx context.Context, pullRequestID string)) *mRepositoryMockListDependents {
+	if mmListDependents.mock.inspectFuncListDependents != nil {
+		mmListDependents.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListDependents")
+	}
+
+	mmListDependents.mock.inspectFuncListDependents = f
+
+	return mmListDependents
+}
+
+// Return sets up results that will be returned by repository.ListDependents
+func (mmListDependents *mRepositoryMockListDependents) Return(sa1 []string, err error) *RepositoryMock {
+	if mmListDependents.mock.funcListDependents != nil {
+		mmListDependents.mock.t.Fatalf("RepositoryMock.ListDependents mock is already set by Set")
+	}
+
+	if mmListDependents.defaultExpectation == nil {
+		mmListDependents.defaultExpectation = &RepositoryMockListDependentsExpectation{mock: mmListDependents.mock}
+	}
+	mmListDependents.defaultExpectation.results = &RepositoryMockListDependentsResults{sa1, err}
+	mmListDependents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListDependents.mock
+}
+
+// Set uses given function f to mock the repository.ListDependents method
+func (mmListDependents *mRepositoryMockListDependents) Set(f func(ctx context.Context, pullRequestID string) (sa1 []string, err error)) *RepositoryMock {
+	if mmListDependents.defaultExpectation != nil {
+		mmListDependents.mock.t.Fatalf("Default expectation is already set for the repository.ListDependents method")
+	}
+
+	if len(mmListDependents.expectations) > 0 {
+		mmListDependents.mock.t.Fatalf("Some expectations are already set for the repository.ListDependents method")
+	}
+
+	mmListDependents.mock.funcListDependents = f
+	mmListDependents.mock.funcListDependentsOrigin = minimock.CallerInfo(1)
+	return mmListDependents.mock
+}
+
+// When sets expectation for the repository.ListDependents which will trigger the result defined by the following
+// Then helper
+func (mmListDependents *mRepositoryMockListDependents) When(ctx context.Context, pullRequestID string) *RepositoryMockListDependentsExpectation {
+	if mmListDependents.mock.funcListDependents != nil {
+		mmListDependents.mock.t.Fatalf("RepositoryMock.ListDependents mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListDependentsExpectation{
+		mock:               mmListDependents.mock,
+		params:             &RepositoryMockListDependentsParams{ctx, pullRequestID},
+		expectationOrigins: RepositoryMockListDependentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListDependents.expectations = append(mmListDependents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.ListDependents return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListDependentsExpectation) Then(sa1 []string, err error) *RepositoryMock {
+	e.results = &RepositoryMockListDependentsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.ListDependents should be invoked
+func (mmListDependents *mRepositoryMockListDependents) Times(n uint64) *mRepositoryMockListDependents {
+	if n == 0 {
+		mmListDependents.mock.t.Fatalf("Times of RepositoryMock.ListDependents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListDependents.expectedInvocations, n)
+	mmListDependents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListDependents
+}
+
+func (mmListDependents *mRepositoryMockListDependents) invocationsDone() bool {
+	if len(mmListDependents.expectations) == 0 && mmListDependents.defaultExpectation == nil && mmListDependents.mock.funcListDependents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListDependents.mock.afterListDependentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListDependents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListDependents implements repository
+func (mmListDependents *RepositoryMock) ListDependents(ctx context.Context, pullRequestID string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmListDependents.beforeListDependentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListDependents.afterListDependentsCounter, 1)
+
+	mmListDependents.t.Helper()
+
+	if mmListDependents.inspectFuncListDependents != nil {
+		mmListDependents.inspectFuncListDependents(ctx, pullRequestID)
+	}
+
+	mm_params := RepositoryMockListDependentsParams{ctx, pullRequestID}
+
+	// Record call args
+	mmListDependents.ListDependentsMock.mutex.Lock()
+	mmListDependents.ListDependentsMock.callArgs = append(mmListDependents.ListDependentsMock.callArgs, &mm_params)
+	mmListDependents.ListDependentsMock.mutex.Unlock()
+
+	for _, e := range mmListDependents.ListDependentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListDependents.ListDependentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListDependents.ListDependentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListDependents.ListDependentsMock.defaultExpectation.params
+		mm_want_ptrs := mmListDependents.ListDependentsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockListDependentsParams{ctx, pullRequestID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListDependents.t.Errorf("RepositoryMock.ListDependents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDependents.ListDependentsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pullRequestID != nil && !minimock.Equal(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID) {
+				mmListDependents.t.Errorf("RepositoryMock.ListDependents got unexpected parameter pullRequestID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDependents.ListDependentsMock.defaultExpectation.expectationOrigins.originPullRequestID, *mm_want_ptrs.pullRequestID, mm_got.pullRequestID, minimock.Diff(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListDependents.t.Errorf("RepositoryMock.ListDependents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListDependents.ListDependentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListDependents.ListDependentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListDependents.t.Fatal("No results are set for the RepositoryMock.ListDependents")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListDependents.funcListDependents != nil {
+		return mmListDependents.funcListDependents(ctx, pullRequestID)
+	}
+	mmListDependents.t.Fatalf("Unexpected call to RepositoryMock.ListDependents. %v %v", ctx, pullRequestID)
+	return
+}
+
+// ListDependentsAfterCounter returns a count of finished RepositoryMock.ListDependents invocations
+func (mmListDependents *RepositoryMock) ListDependentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDependents.afterListDependentsCounter)
+}
+
+// ListDependentsBeforeCounter returns a count of RepositoryMock.ListDependents invocations
+func (mmListDependents *RepositoryMock) ListDependentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDependents.beforeListDependentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ListDependents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListDependents *mRepositoryMockListDependents) Calls() []*RepositoryMockListDependentsParams {
+	mmListDependents.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListDependentsParams, len(mmListDependents.callArgs))
+	copy(argCopy, mmListDependents.callArgs)
+
+	mmListDependents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDependentsDone returns true if the count of the ListDependents invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListDependentsDone() bool {
+	if m.ListDependentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListDependentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListDependentsMock.invocationsDone()
+}
+
+// MinimockListDependentsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListDependentsInspect() {
+	for _, e := range m.ListDependentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ListDependents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListDependentsCounter := mm_atomic.LoadUint64(&m.afterListDependentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListDependentsMock.defaultExpectation != nil && afterListDependentsCounter < 1 {
+		if m.ListDependentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.ListDependents at\n%s", m.ListDependentsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ListDependents at\n%s with params: %#v", m.ListDependentsMock.defaultExpectation.expectationOrigins.origin, *m.ListDependentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListDependents != nil && afterListDependentsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.ListDependents at\n%s", m.funcListDependentsOrigin)
+	}
+
+	if !m.ListDependentsMock.invocationsDone() && afterListDependentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListDependents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListDependentsMock.expectedInvocations), m.ListDependentsMock.expectedInvocationsOrigin, afterListDependentsCounter)
+	}
+}
+
+type mRepositoryMockTransitionPullRequest struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockTransitionPullRequestExpectation
+	expectations       []*RepositoryMockTransitionPullRequestExpectation
+
+	callArgs []*RepositoryMockTransitionPullRequestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockTransitionPullRequestExpectation specifies expectation struct of the repository.TransitionPullRequest
+type RepositoryMockTransitionPullRequestExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockTransitionPullRequestParams
+	paramPtrs          *RepositoryMockTransitionPullRequestParamPtrs
+	expectationOrigins RepositoryMockTransitionPullRequestExpectationOrigins
+	results            *RepositoryMockTransitionPullRequestResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockTransitionPullRequestParams contains parameters of the repository.TransitionPullRequest
+type RepositoryMockTransitionPullRequestParams struct {
+	ctx           context.Context
+	pullRequestID string
+	decide        func(pr domain.PullRequest) (domain.PullRequestUpdate, error)
+}
+
+// RepositoryMockTransitionPullRequestParamPtrs contains pointers to parameters of the repository.TransitionPullRequest
+type RepositoryMockTransitionPullRequestParamPtrs struct {
+	ctx           *context.Context
+	pullRequestID *string
+	decide        *func(pr domain.PullRequest) (domain.PullRequestUpdate, error)
+}
+
+// RepositoryMockTransitionPullRequestResults contains results of the repository.TransitionPullRequest
+type RepositoryMockTransitionPullRequestResults struct {
+	p1  domain.PullRequest
+	err error
+}
+
+// RepositoryMockTransitionPullRequestOrigins contains origins of expectations of the repository.TransitionPullRequest
+type RepositoryMockTransitionPullRequestExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originPullRequestID string
+	originDecide        string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) Optional() *mRepositoryMockTransitionPullRequest {
+	mmTransitionPullRequest.optional = true
+	return mmTransitionPullRequest
+}
+
+// Expect sets up expected params for repository.TransitionPullRequest
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) Expect(ctx context.Context, pullRequestID string, decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error)) *mRepositoryMockTransitionPullRequest {
+	if mmTransitionPullRequest.mock.funcTransitionPullRequest != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by Set")
+	}
+
+	if mmTransitionPullRequest.defaultExpectation == nil {
+		mmTransitionPullRequest.defaultExpectation = &RepositoryMockTransitionPullRequestExpectation{}
+	}
+
+	if mmTransitionPullRequest.defaultExpectation.paramPtrs != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by ExpectParams functions")
+	}
+
+	mmTransitionPullRequest.defaultExpectation.params = &RepositoryMockTransitionPullRequestParams{ctx, pullRequestID, decide}
+	mmTransitionPullRequest.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTransitionPullRequest.expectations {
+		if minimock.Equal(e.params, mmTransitionPullRequest.defaultExpectation.params) {
+			mmTransitionPullRequest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTransitionPullRequest.defaultExpectation.params)
+		}
+	}
+
+	return mmTransitionPullRequest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.TransitionPullRequest
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) ExpectCtxParam1(ctx context.Context) *mRepositoryMockTransitionPullRequest {
+	if mmTransitionPullRequest.mock.funcTransitionPullRequest != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by Set")
+	}
+
+	if mmTransitionPullRequest.defaultExpectation == nil {
+		mmTransitionPullRequest.defaultExpectation = &RepositoryMockTransitionPullRequestExpectation{}
+	}
+
+	if mmTransitionPullRequest.defaultExpectation.params != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by Expect")
+	}
+
+	if mmTransitionPullRequest.defaultExpectation.paramPtrs == nil {
+		mmTransitionPullRequest.defaultExpectation.paramPtrs = &RepositoryMockTransitionPullRequestParamPtrs{}
+	}
+	mmTransitionPullRequest.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTransitionPullRequest.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmTransitionPullRequest
+}
+
+// ExpectPullRequestIDParam2 sets up expected param pullRequestID for repository.TransitionPullRequest
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) ExpectPullRequestIDParam2(pullRequestID string) *mRepositoryMockTransitionPullRequest {
+	if mmTransitionPullRequest.mock.funcTransitionPullRequest != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by Set")
+	}
+
+	if mmTransitionPullRequest.defaultExpectation == nil {
+		mmTransitionPullRequest.defaultExpectation = &RepositoryMockTransitionPullRequestExpectation{}
+	}
+
+	if mmTransitionPullRequest.defaultExpectation.params != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by Expect")
+	}
+
+	if mmTransitionPullRequest.defaultExpectation.paramPtrs == nil {
+		mmTransitionPullRequest.defaultExpectation.paramPtrs = &RepositoryMockTransitionPullRequestParamPtrs{}
+	}
+	mmTransitionPullRequest.defaultExpectation.paramPtrs.pullRequestID = &pullRequestID
+	mmTransitionPullRequest.defaultExpectation.expectationOrigins.originPullRequestID = minimock.CallerInfo(1)
+
+	return mmTransitionPullRequest
+}
+
+// ExpectDecideParam3 sets up expected param decide for repository.TransitionPullRequest
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) ExpectDecideParam3(decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error)) *mRepositoryMockTransitionPullRequest {
+	if mmTransitionPullRequest.mock.funcTransitionPullRequest != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by Set")
+	}
+
+	if mmTransitionPullRequest.defaultExpectation == nil {
+		mmTransitionPullRequest.defaultExpectation = &RepositoryMockTransitionPullRequestExpectation{}
+	}
+
+	if mmTransitionPullRequest.defaultExpectation.params != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by Expect")
+	}
+
+	if mmTransitionPullRequest.defaultExpectation.paramPtrs == nil {
+		mmTransitionPullRequest.defaultExpectation.paramPtrs = &RepositoryMockTransitionPullRequestParamPtrs{}
+	}
+	mmTransitionPullRequest.defaultExpectation.paramPtrs.decide = &decide
+	mmTransitionPullRequest.defaultExpectation.expectationOrigins.originDecide = minimock.CallerInfo(1)
+
+	return mmTransitionPullRequest
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.TransitionPullRequest
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) Inspect(f func(ctx context.Context, pullRequestID string, decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error))) *mRepositoryMockTransitionPullRequest {
+	if mmTransitionPullRequest.mock.inspectFuncTransitionPullRequest != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("Inspect function is already set for RepositoryMock.TransitionPullRequest")
+	}
+
+	mmTransitionPullRequest.mock.inspectFuncTransitionPullRequest = f
+
+	return mmTransitionPullRequest
+}
+
+// Return sets up results that will be returned by repository.TransitionPullRequest
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) Return(p1 domain.PullRequest, err error) *RepositoryMock {
+	if mmTransitionPullRequest.mock.funcTransitionPullRequest != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by Set")
+	}
+
+	if mmTransitionPullRequest.defaultExpectation == nil {
+		mmTransitionPullRequest.defaultExpectation = &RepositoryMockTransitionPullRequestExpectation{mock: mmTransitionPullRequest.mock}
+	}
+	mmTransitionPullRequest.defaultExpectation.results = &RepositoryMockTransitionPullRequestResults{p1, err}
+	mmTransitionPullRequest.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTransitionPullRequest.mock
+}
+
+// Set uses given function f to mock the repository.TransitionPullRequest method
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) Set(f func(ctx context.Context, pullRequestID string, decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error)) (p1 domain.PullRequest, err error)) *RepositoryMock {
+	if mmTransitionPullRequest.defaultExpectation != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("Default expectation is already set for the repository.TransitionPullRequest method")
+	}
+
+	if len(mmTransitionPullRequest.expectations) > 0 {
+		mmTransitionPullRequest.mock.t.Fatalf("Some expectations are already set for the repository.TransitionPullRequest method")
+	}
+
+	mmTransitionPullRequest.mock.funcTransitionPullRequest = f
+	mmTransitionPullRequest.mock.funcTransitionPullRequestOrigin = minimock.CallerInfo(1)
+	return mmTransitionPullRequest.mock
+}
+
+// When sets expectation for the repository.TransitionPullRequest which will trigger the result defined by the following
+// Then helper
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) When(ctx context.Context, pullRequestID string, decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error)) *RepositoryMockTransitionPullRequestExpectation {
+	if mmTransitionPullRequest.mock.funcTransitionPullRequest != nil {
+		mmTransitionPullRequest.mock.t.Fatalf("RepositoryMock.TransitionPullRequest mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockTransitionPullRequestExpectation{
+		mock:               mmTransitionPullRequest.mock,
+		params:             &RepositoryMockTransitionPullRequestParams{ctx, pullRequestID, decide},
+		expectationOrigins: RepositoryMockTransitionPullRequestExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmTransitionPullRequest.expectations = append(mmTransitionPullRequest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.TransitionPullRequest return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockTransitionPullRequestExpectation) Then(p1 domain.PullRequest, err error) *RepositoryMock {
+	e.results = &RepositoryMockTransitionPullRequestResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.TransitionPullRequest should be invoked
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) Times(n uint64) *mRepositoryMockTransitionPullRequest {
+	if n == 0 {
+		mmTransitionPullRequest.mock.t.Fatalf("Times of RepositoryMock.TransitionPullRequest mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTransitionPullRequest.expectedInvocations, n)
+	mmTransitionPullRequest.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTransitionPullRequest
+}
+
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) invocationsDone() bool {
+	if len(mmTransitionPullRequest.expectations) == 0 && mmTransitionPullRequest.defaultExpectation == nil && mmTransitionPullRequest.mock.funcTransitionPullRequest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTransitionPullRequest.mock.afterTransitionPullRequestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTransitionPullRequest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// TransitionPullRequest implements repository
+func (mmTransitionPullRequest *RepositoryMock) TransitionPullRequest(ctx context.Context, pullRequestID string, decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error)) (p1 domain.PullRequest, err error) {
+	mm_atomic.AddUint64(&mmTransitionPullRequest.beforeTransitionPullRequestCounter, 1)
+	defer mm_atomic.AddUint64(&mmTransitionPullRequest.afterTransitionPullRequestCounter, 1)
+
+	mmTransitionPullRequest.t.Helper()
+
+	if mmTransitionPullRequest.inspectFuncTransitionPullRequest != nil {
+		mmTransitionPullRequest.inspectFuncTransitionPullRequest(ctx, pullRequestID, decide)
+	}
+
+	mm_params := RepositoryMockTransitionPullRequestParams{ctx, pullRequestID, decide}
+
+	// Record call args
+	mmTransitionPullRequest.TransitionPullRequestMock.mutex.Lock()
+	mmTransitionPullRequest.TransitionPullRequestMock.callArgs = append(mmTransitionPullRequest.TransitionPullRequestMock.callArgs, &mm_params)
+	mmTransitionPullRequest.TransitionPullRequestMock.mutex.Unlock()
+
+	for _, e := range mmTransitionPullRequest.TransitionPullRequestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmTransitionPullRequest.TransitionPullRequestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTransitionPullRequest.TransitionPullRequestMock.defaultExpectation.Counter, 1)
+		mm_want := mmTransitionPullRequest.TransitionPullRequestMock.defaultExpectation.params
+		mm_want_ptrs := mmTransitionPullRequest.TransitionPullRequestMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockTransitionPullRequestParams{ctx, pullRequestID, decide}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTransitionPullRequest.t.Errorf("RepositoryMock.TransitionPullRequest got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransitionPullRequest.TransitionPullRequestMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pullRequestID != nil && !minimock.Equal(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID) {
+				mmTransitionPullRequest.t.Errorf("RepositoryMock.TransitionPullRequest got unexpected parameter pullRequestID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransitionPullRequest.TransitionPullRequestMock.defaultExpectation.expectationOrigins.originPullRequestID, *mm_want_ptrs.pullRequestID, mm_got.pullRequestID, minimock.Diff(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID))
+			}
+
+			if mm_want_ptrs.decide != nil && !minimock.Equal(*mm_want_ptrs.decide, mm_got.decide) {
+				mmTransitionPullRequest.t.Errorf("RepositoryMock.TransitionPullRequest got unexpected parameter decide, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransitionPullRequest.TransitionPullRequestMock.defaultExpectation.expectationOrigins.originDecide, *mm_want_ptrs.decide, mm_got.decide, minimock.Diff(*mm_want_ptrs.decide, mm_got.decide))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTransitionPullRequest.t.Errorf("RepositoryMock.TransitionPullRequest got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTransitionPullRequest.TransitionPullRequestMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTransitionPullRequest.TransitionPullRequestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTransitionPullRequest.t.Fatal("No results are set for the RepositoryMock.TransitionPullRequest")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmTransitionPullRequest.funcTransitionPullRequest != nil {
+		return mmTransitionPullRequest.funcTransitionPullRequest(ctx, pullRequestID, decide)
+	}
+	mmTransitionPullRequest.t.Fatalf("Unexpected call to RepositoryMock.TransitionPullRequest. %v %v %v", ctx, pullRequestID, decide)
+	return
+}
+
+// TransitionPullRequestAfterCounter returns a count of finished RepositoryMock.TransitionPullRequest invocations
+func (mmTransitionPullRequest *RepositoryMock) TransitionPullRequestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransitionPullRequest.afterTransitionPullRequestCounter)
+}
+
+// TransitionPullRequestBeforeCounter returns a count of RepositoryMock.TransitionPullRequest invocations
+func (mmTransitionPullRequest *RepositoryMock) TransitionPullRequestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransitionPullRequest.beforeTransitionPullRequestCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.TransitionPullRequest.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTransitionPullRequest *mRepositoryMockTransitionPullRequest) Calls() []*RepositoryMockTransitionPullRequestParams {
+	mmTransitionPullRequest.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockTransitionPullRequestParams, len(mmTransitionPullRequest.callArgs))
+	copy(argCopy, mmTransitionPullRequest.callArgs)
+
+	mmTransitionPullRequest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTransitionPullRequestDone returns true if the count of the TransitionPullRequest invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockTransitionPullRequestDone() bool {
+	if m.TransitionPullRequestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TransitionPullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TransitionPullRequestMock.invocationsDone()
+}
+
+// MinimockTransitionPullRequestInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockTransitionPullRequestInspect() {
+	for _, e := range m.TransitionPullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.TransitionPullRequest at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterTransitionPullRequestCounter := mm_atomic.LoadUint64(&m.afterTransitionPullRequestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TransitionPullRequestMock.defaultExpectation != nil && afterTransitionPullRequestCounter < 1 {
+		if m.TransitionPullRequestMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.TransitionPullRequest at\n%s", m.TransitionPullRequestMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.TransitionPullRequest at\n%s with params: %#v", m.TransitionPullRequestMock.defaultExpectation.expectationOrigins.origin, *m.TransitionPullRequestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTransitionPullRequest != nil && afterTransitionPullRequestCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.TransitionPullRequest at\n%s", m.funcTransitionPullRequestOrigin)
+	}
+
+	if !m.TransitionPullRequestMock.invocationsDone() && afterTransitionPullRequestCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.TransitionPullRequest at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TransitionPullRequestMock.expectedInvocations), m.TransitionPullRequestMock.expectedInvocationsOrigin, afterTransitionPullRequestCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListDependentsInspect()
+
+			m.MinimockTransitionPullRequestInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListDependentsDone() &&
+		m.MinimockTransitionPullRequestDone()
+}
@@ -0,0 +1,167 @@
+package merge
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/mergeability"
+)
+
+type (
+	repository interface {
+		TransitionPullRequest(ctx context.Context, pullRequestID string,
+			decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error),
+		) (domain.PullRequest, error)
+		ListDependents(ctx context.Context, pullRequestID string) ([]string, error)
+	}
+	quorumProvider interface {
+		Quorum(ctx context.Context, pullRequestID string) (domain.QuorumPolicy, error)
+	}
+	metrics interface {
+		PullRequestMerged(forced bool)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo    repository
+		quorum  quorumProvider
+		metrics metrics
+		logger  logger
+	}
+)
+
+func New(repo repository, quorum quorumProvider, metrics metrics, logger logger) *Handler {
+	return &Handler{
+		repo:    repo,
+		quorum:  quorum,
+		metrics: metrics,
+		logger:  logger,
+	}
+}
+
+// MergePullRequest merges the PR when it satisfies the quorum of its team. With Force set the
+// blockers are overridden and the override is recorded as a FORCE_MERGED event. The PRs stacked on
+// the merged PR are re-evaluated afterwards.
+func (h *Handler) MergePullRequest(ctx context.Context, request domain.MergePullRequest) (domain.PullRequest, error) {
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.merge"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	quorum, err := h.quorum.Quorum(ctx, request.PullRequestID)
+	if err != nil {
+		logger.Error("quorum.Quorum", zap.Error(err), zap.String("pull_request_id", request.PullRequestID))
+		return domain.PullRequest{}, err
+	}
+
+	var merged, forced bool
+	pr, err := h.repo.TransitionPullRequest(ctx, request.PullRequestID, func(pr domain.PullRequest,
+	) (domain.PullRequestUpdate, error) {
+		update, err := decide(pr, quorum, request)
+		merged = err == nil && update.Status != pr.Status
+		forced = merged && len(update.Events) > 0
+		return update, err
+	})
+	if err != nil {
+		logger.Error("repo.TransitionPullRequest", zap.Error(err),
+			zap.String("pull_request_id", request.PullRequestID))
+		return domain.PullRequest{}, fmt.Errorf("repo.TransitionPullRequest: %w", err)
+	}
+
+	if merged {
+		h.metrics.PullRequestMerged(forced)
+		h.reevaluateDependents(ctx, logger, pr.PullRequestID)
+	}
+
+	return pr, nil
+}
+
+// reevaluateDependents records the mergeability of every open PR stacked on the merged PR as a
+// DEPENDENCY_MERGED event. The merge is already stored, so failures are only logged.
+func (h *Handler) reevaluateDependents(ctx context.Context, logger *zap.Logger, pullRequestID string) {
+	dependents, err := h.repo.ListDependents(ctx, pullRequestID)
+	if err != nil {
+		logger.Error("repo.ListDependents", zap.Error(err), zap.String("pull_request_id", pullRequestID))
+		return
+	}
+
+	for _, dependentID := range dependents {
+		quorum, err := h.quorum.Quorum(ctx, dependentID)
+		if err != nil {
+			logger.Error("quorum.Quorum", zap.Error(err), zap.String("pull_request_id", dependentID))
+			continue
+		}
+
+		var result domain.Mergeability
+		_, err = h.repo.TransitionPullRequest(ctx, dependentID, func(pr domain.PullRequest,
+		) (domain.PullRequestUpdate, error) {
+			result = mergeability.Evaluate(pr, quorum)
+			return dependencyMerged(pr, pullRequestID, result), nil
+		})
+		if err != nil {
+			logger.Error("repo.TransitionPullRequest", zap.Error(err), zap.String("pull_request_id", dependentID))
+			continue
+		}
+
+		logger.Info("dependent pull request re-evaluated", zap.String("pull_request_id", dependentID),
+			zap.String("merged_dependency", pullRequestID), zap.Bool("mergeable", result.Mergeable))
+	}
+}
+
+// dependencyMerged keeps the status of the dependent PR and records its mergeability.
+func dependencyMerged(pr domain.PullRequest, mergedID string, result domain.Mergeability) domain.PullRequestUpdate {
+	return domain.PullRequestUpdate{
+		Status: pr.Status,
+		Events: []domain.PullRequestEvent{{
+			PullRequestID: pr.PullRequestID,
+			Type:          domain.EventDependencyMerged,
+			Details: map[string]any{
+				"dependency": mergedID,
+				"mergeable":  result.Mergeable,
+				"blockers":   result.Blockers,
+			},
+		}},
+	}
+}
+
+// decide merges an already merged PR idempotently. The state machine is never overridden,
+// force only overrides the quorum.
+func decide(pr domain.PullRequest, quorum domain.QuorumPolicy, request domain.MergePullRequest,
+) (domain.PullRequestUpdate, error) {
+	if pr.Status == domain.PRStatusMerged {
+		return domain.PullRequestUpdate{Status: pr.Status}, nil
+	}
+
+	next, err := pr.Status.Next(domain.TransitionMerge)
+	if err != nil {
+		return domain.PullRequestUpdate{}, err
+	}
+
+	result := mergeability.Evaluate(pr, quorum)
+	if result.Mergeable {
+		return domain.PullRequestUpdate{Status: next}, nil
+	}
+	if !request.Force {
+		return domain.PullRequestUpdate{}, &domain.MergeBlockedError{Blockers: result.Blockers}
+	}
+
+	return domain.PullRequestUpdate{
+		Status: next,
+		Events: []domain.PullRequestEvent{{
+			PullRequestID: pr.PullRequestID,
+			Type:          domain.EventForceMerged,
+			ActorID:       request.ActorID,
+			Details: map[string]any{
+				"reason":   request.Reason,
+				"blockers": result.Blockers,
+			},
+		}},
+	}, nil
+}
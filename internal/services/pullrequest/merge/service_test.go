@@ -0,0 +1,273 @@
+package merge
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// transitionOn makes TransitionPullRequest run decide on the stored PR, as the repository does
+// inside its transaction, and keeps the decided updates by PR id.
+func transitionOn(repo *RepositoryMock, prs map[string]domain.PullRequest,
+	updates map[string]domain.PullRequestUpdate,
+) {
+	repo.TransitionPullRequestMock.Set(func(_ context.Context, pullRequestID string,
+		decide func(pr domain.PullRequest) (domain.PullRequestUpdate, error),
+	) (domain.PullRequest, error) {
+		pr, ok := prs[pullRequestID]
+		if !ok {
+			return domain.PullRequest{}, domain.ErrPRNotFound
+		}
+		update, err := decide(pr)
+		if err != nil {
+			return domain.PullRequest{}, err
+		}
+		updates[pullRequestID] = update
+		pr.Status = update.Status
+		return pr, nil
+	})
+}
+
+func approved(userID string) domain.Reviewer {
+	return domain.Reviewer{UserID: userID, Role: domain.ReviewerRoleReviewer, Verdict: domain.VerdictApproved}
+}
+
+func TestHandler_MergePullRequest(t *testing.T) {
+	t.Parallel()
+
+	quorum := domain.QuorumPolicy{Approvals: 2}
+	approvedPR := domain.PullRequest{
+		PullRequestID: "pr-1",
+		Status:        domain.PRStatusOpen,
+		Reviewers:     []domain.Reviewer{approved("u2"), approved("u3")},
+	}
+	blockedPR := domain.PullRequest{
+		PullRequestID: "pr-1",
+		Status:        domain.PRStatusOpen,
+		Reviewers:     []domain.Reviewer{approved("u2")},
+	}
+	notEnoughApprovals := []domain.MergeBlocker{
+		{Code: domain.BlockerNotEnoughApprovals, Message: "1 of 2 required approvals"},
+	}
+	withStatus := func(status domain.PRStatus) domain.PullRequest {
+		pr := approvedPR
+		pr.Status = status
+		return pr
+	}
+	// dependent is stacked on pr-1 and approved, so it becomes mergeable once pr-1 is merged.
+	dependent := domain.PullRequest{
+		PullRequestID: "pr-2",
+		Status:        domain.PRStatusOpen,
+		Reviewers:     []domain.Reviewer{approved("u2"), approved("u3")},
+		DependsOn:     []domain.PullRequestDependency{{PullRequestID: "pr-1", Status: domain.PRStatusMerged}},
+	}
+
+	type fields struct {
+		dependents func(repo *RepositoryMock)
+		quorum     func(mc *minimock.Controller) quorumProvider
+		metrics    func(mc *minimock.Controller) metrics
+	}
+	quorumOf := func(mc *minimock.Controller) quorumProvider {
+		provider := NewQuorumProviderMock(mc)
+		provider.QuorumMock.Expect(minimock.AnyContext, "pr-1").Return(quorum, nil)
+		return provider
+	}
+	noDependents := func(repo *RepositoryMock) {
+		repo.ListDependentsMock.Expect(minimock.AnyContext, "pr-1").Return(nil, nil)
+	}
+	notMerged := func(*RepositoryMock) {}
+	merged := func(forced bool) func(mc *minimock.Controller) metrics {
+		return func(mc *minimock.Controller) metrics {
+			metrics := NewMetricsMock(mc)
+			metrics.PullRequestMergedMock.Expect(forced).Return()
+			return metrics
+		}
+	}
+	noMetrics := func(mc *minimock.Controller) metrics { return NewMetricsMock(mc) }
+
+	tests := []struct {
+		name        string
+		prs         []domain.PullRequest
+		request     domain.MergePullRequest
+		fields      fields
+		wantUpdates map[string]domain.PullRequestUpdate
+		wantErr     error
+		wantBlocked []domain.MergeBlocker
+	}{
+		{
+			name:    "success: quorum reached",
+			prs:     []domain.PullRequest{approvedPR},
+			request: domain.MergePullRequest{PullRequestID: "pr-1"},
+			fields: fields{
+				dependents: noDependents,
+				quorum:     quorumOf,
+				metrics:    merged(false),
+			},
+			wantUpdates: map[string]domain.PullRequestUpdate{"pr-1": {Status: domain.PRStatusMerged}},
+		},
+		{
+			name:    "error: blockers without force",
+			prs:     []domain.PullRequest{blockedPR},
+			request: domain.MergePullRequest{PullRequestID: "pr-1"},
+			fields: fields{
+				dependents: notMerged,
+				quorum:     quorumOf,
+				metrics:    noMetrics,
+			},
+			wantErr:     domain.ErrMergeBlocked,
+			wantBlocked: notEnoughApprovals,
+		},
+		{
+			name:    "success: force overrides the blockers and records the override",
+			prs:     []domain.PullRequest{blockedPR},
+			request: domain.MergePullRequest{PullRequestID: "pr-1", Force: true, ActorID: "u9", Reason: "hotfix"},
+			fields: fields{
+				dependents: noDependents,
+				quorum:     quorumOf,
+				metrics:    merged(true),
+			},
+			wantUpdates: map[string]domain.PullRequestUpdate{"pr-1": {
+				Status: domain.PRStatusMerged,
+				Events: []domain.PullRequestEvent{{
+					PullRequestID: "pr-1",
+					Type:          domain.EventForceMerged,
+					ActorID:       "u9",
+					Details:       map[string]any{"reason": "hotfix", "blockers": notEnoughApprovals},
+				}},
+			}},
+		},
+		{
+			name:    "success: force without blockers is a regular merge",
+			prs:     []domain.PullRequest{approvedPR},
+			request: domain.MergePullRequest{PullRequestID: "pr-1", Force: true, ActorID: "u9"},
+			fields: fields{
+				dependents: noDependents,
+				quorum:     quorumOf,
+				metrics:    merged(false),
+			},
+			wantUpdates: map[string]domain.PullRequestUpdate{"pr-1": {Status: domain.PRStatusMerged}},
+		},
+		{
+			name:    "success: merging a merged PR again changes nothing",
+			prs:     []domain.PullRequest{withStatus(domain.PRStatusMerged)},
+			request: domain.MergePullRequest{PullRequestID: "pr-1"},
+			fields: fields{
+				dependents: notMerged,
+				quorum:     quorumOf,
+				metrics:    noMetrics,
+			},
+			wantUpdates: map[string]domain.PullRequestUpdate{"pr-1": {Status: domain.PRStatusMerged}},
+		},
+		{
+			name:    "error: draft cannot be merged, not even with force",
+			prs:     []domain.PullRequest{withStatus(domain.PRStatusDraft)},
+			request: domain.MergePullRequest{PullRequestID: "pr-1", Force: true, ActorID: "u9"},
+			fields: fields{
+				dependents: notMerged,
+				quorum:     quorumOf,
+				metrics:    noMetrics,
+			},
+			wantErr: domain.ErrInvalidTransition,
+		},
+		{
+			name:    "error: closed PR cannot be merged",
+			prs:     []domain.PullRequest{withStatus(domain.PRStatusClosed)},
+			request: domain.MergePullRequest{PullRequestID: "pr-1"},
+			fields: fields{
+				dependents: notMerged,
+				quorum:     quorumOf,
+				metrics:    noMetrics,
+			},
+			wantErr: domain.ErrInvalidTransition,
+		},
+		{
+			name:    "success: stacked PRs are re-evaluated after the merge",
+			prs:     []domain.PullRequest{approvedPR, dependent},
+			request: domain.MergePullRequest{PullRequestID: "pr-1"},
+			fields: fields{
+				dependents: func(repo *RepositoryMock) {
+					repo.ListDependentsMock.Expect(minimock.AnyContext, "pr-1").Return([]string{"pr-2"}, nil)
+				},
+				quorum: func(mc *minimock.Controller) quorumProvider {
+					provider := NewQuorumProviderMock(mc)
+					provider.QuorumMock.When(minimock.AnyContext, "pr-1").Then(quorum, nil)
+					provider.QuorumMock.When(minimock.AnyContext, "pr-2").Then(quorum, nil)
+					return provider
+				},
+				metrics: merged(false),
+			},
+			wantUpdates: map[string]domain.PullRequestUpdate{
+				"pr-1": {Status: domain.PRStatusMerged},
+				"pr-2": {
+					Status: domain.PRStatusOpen,
+					Events: []domain.PullRequestEvent{{
+						PullRequestID: "pr-2",
+						Type:          domain.EventDependencyMerged,
+						Details: map[string]any{
+							"dependency": "pr-1",
+							"mergeable":  true,
+							"blockers":   []domain.MergeBlocker{},
+						},
+					}},
+				},
+			},
+		},
+		{
+			name:    "error: quorum cannot be loaded",
+			request: domain.MergePullRequest{PullRequestID: "pr-1"},
+			fields: fields{
+				dependents: notMerged,
+				quorum: func(mc *minimock.Controller) quorumProvider {
+					provider := NewQuorumProviderMock(mc)
+					provider.QuorumMock.Return(domain.QuorumPolicy{}, errors.New("database connection failed"))
+					return provider
+				},
+				metrics: noMetrics,
+			},
+			wantErr: errors.New("database connection failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			prs := make(map[string]domain.PullRequest, len(tt.prs))
+			for _, pr := range tt.prs {
+				prs[pr.PullRequestID] = pr
+			}
+			updates := make(map[string]domain.PullRequestUpdate)
+			repo := NewRepositoryMock(mc)
+			if len(prs) > 0 {
+				transitionOn(repo, prs, updates)
+			}
+			tt.fields.dependents(repo)
+
+			_, err := New(repo, tt.fields.quorum(mc), tt.fields.metrics(mc), zap.NewNop()).MergePullRequest(
+				context.Background(), tt.request)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr.Error())
+				if tt.wantBlocked != nil {
+					var blocked *domain.MergeBlockedError
+					require.ErrorAs(t, err, &blocked)
+					assert.Equal(t, tt.wantBlocked, blocked.Blockers)
+				}
+				assert.Empty(t, updates)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantUpdates, updates)
+		})
+	}
+}
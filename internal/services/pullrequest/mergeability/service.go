@@ -0,0 +1,133 @@
+package mergeability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		GetPullRequest(ctx context.Context, pullRequestID string) (domain.PullRequest, error)
+		GetPullRequestTeam(ctx context.Context, pullRequestID string) (string, error)
+		GetTeamPolicy(ctx context.Context, teamName string, version int) (domain.TeamPolicy, error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// CheckMergeability reports whether the PR satisfies the quorum of its team and what is missing otherwise.
+func (h *Handler) CheckMergeability(ctx context.Context, pullRequestID string) (domain.Mergeability, error) {
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.mergeability"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	pr, err := h.repo.GetPullRequest(ctx, pullRequestID)
+	if err != nil {
+		logger.Error("repo.GetPullRequest", zap.Error(err), zap.String("pull_request_id", pullRequestID))
+		return domain.Mergeability{}, fmt.Errorf("repo.GetPullRequest: %w", err)
+	}
+
+	quorum, err := h.Quorum(ctx, pullRequestID)
+	if err != nil {
+		logger.Error("Quorum", zap.Error(err), zap.String("pull_request_id", pullRequestID))
+		return domain.Mergeability{}, err
+	}
+
+	return Evaluate(pr, quorum), nil
+}
+
+// Quorum returns the merge quorum from the active policy of the PR author's team.
+func (h *Handler) Quorum(ctx context.Context, pullRequestID string) (domain.QuorumPolicy, error) {
+	teamName, err := h.repo.GetPullRequestTeam(ctx, pullRequestID)
+	if err != nil {
+		return domain.QuorumPolicy{}, fmt.Errorf("repo.GetPullRequestTeam: %w", err)
+	}
+
+	teamPolicy, err := h.repo.GetTeamPolicy(ctx, teamName, 0)
+	if err != nil {
+		if errors.Is(err, domain.ErrPolicyNotFound) {
+			return domain.DefaultQuorumPolicy(), nil
+		}
+		return domain.QuorumPolicy{}, fmt.Errorf("repo.GetTeamPolicy: %w", err)
+	}
+
+	return teamPolicy.Policy.MergeQuorum(), nil
+}
+
+// Evaluate checks the PR against the quorum. Only the latest verdicts of current reviewers count,
+// shadow reviewers are ignored. A PR stacked on a draft or open PR is blocked until that PR is
+// merged or closed.
+func Evaluate(pr domain.PullRequest, quorum domain.QuorumPolicy) domain.Mergeability {
+	result := domain.Mergeability{
+		PullRequestID:      pr.PullRequestID,
+		Status:             pr.Status,
+		RequiredApprovals:  quorum.Approvals,
+		ChangesRequestedBy: []string{},
+		Blockers:           []domain.MergeBlocker{},
+	}
+
+	for _, reviewer := range pr.Reviewers {
+		if reviewer.Role != domain.ReviewerRoleReviewer {
+			continue
+		}
+		switch reviewer.Verdict {
+		case domain.VerdictApproved:
+			result.Approvals++
+		case domain.VerdictChangesRequested:
+			result.ChangesRequestedBy = append(result.ChangesRequestedBy, reviewer.UserID)
+		}
+	}
+
+	if pr.Status != domain.PRStatusOpen {
+		result.Blockers = append(result.Blockers, domain.MergeBlocker{
+			Code:    domain.BlockerNotOpen,
+			Message: fmt.Sprintf("pull request is %s", pr.Status),
+		})
+	}
+	if result.Approvals < quorum.Approvals {
+		result.Blockers = append(result.Blockers, domain.MergeBlocker{
+			Code:    domain.BlockerNotEnoughApprovals,
+			Message: fmt.Sprintf("%d of %d required approvals", result.Approvals, quorum.Approvals),
+		})
+	}
+	if len(result.ChangesRequestedBy) > 0 && !quorum.AllowChangesRequested {
+		result.Blockers = append(result.Blockers, domain.MergeBlocker{
+			Code:    domain.BlockerChangesRequested,
+			Message: fmt.Sprintf("changes requested by %v", result.ChangesRequestedBy),
+		})
+	}
+
+	for _, dependency := range pr.DependsOn {
+		if dependency.Blocks() {
+			result.Blockers = append(result.Blockers, domain.MergeBlocker{
+				Code:    domain.BlockerDependencyOpen,
+				Message: fmt.Sprintf("depends on %s which is %s", dependency.PullRequestID, dependency.Status),
+			})
+		}
+	}
+
+	result.Mergeable = len(result.Blockers) == 0
+
+	return result
+}
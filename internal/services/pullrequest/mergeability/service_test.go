@@ -0,0 +1,143 @@
+package mergeability
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func reviewer(userID string, verdict domain.Verdict) domain.Reviewer {
+	return domain.Reviewer{UserID: userID, Role: domain.ReviewerRoleReviewer, Verdict: verdict}
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		pr     domain.PullRequest
+		quorum domain.QuorumPolicy
+		want   domain.Mergeability
+	}{
+		{
+			name: "success: quorum reached",
+			pr: domain.PullRequest{
+				PullRequestID: "pr-1",
+				Status:        domain.PRStatusOpen,
+				Reviewers: []domain.Reviewer{
+					reviewer("u2", domain.VerdictApproved),
+					reviewer("u3", domain.VerdictApproved),
+				},
+			},
+			quorum: domain.QuorumPolicy{Approvals: 2},
+			want: domain.Mergeability{
+				PullRequestID:      "pr-1",
+				Status:             domain.PRStatusOpen,
+				Mergeable:          true,
+				Approvals:          2,
+				RequiredApprovals:  2,
+				ChangesRequestedBy: []string{},
+				Blockers:           []domain.MergeBlocker{},
+			},
+		},
+		{
+			name: "blocked: shadow approvals do not count and changes are requested",
+			pr: domain.PullRequest{
+				PullRequestID: "pr-1",
+				Status:        domain.PRStatusOpen,
+				Reviewers: []domain.Reviewer{
+					reviewer("u2", domain.VerdictApproved),
+					reviewer("u3", domain.VerdictChangesRequested),
+					{UserID: "t1", Role: domain.ReviewerRoleShadow, Verdict: domain.VerdictApproved},
+				},
+			},
+			quorum: domain.QuorumPolicy{Approvals: 2},
+			want: domain.Mergeability{
+				PullRequestID:      "pr-1",
+				Status:             domain.PRStatusOpen,
+				Approvals:          1,
+				RequiredApprovals:  2,
+				ChangesRequestedBy: []string{"u3"},
+				Blockers: []domain.MergeBlocker{
+					{Code: domain.BlockerNotEnoughApprovals, Message: "1 of 2 required approvals"},
+					{Code: domain.BlockerChangesRequested, Message: "changes requested by [u3]"},
+				},
+			},
+		},
+		{
+			name: "success: changes requested are allowed by the quorum",
+			pr: domain.PullRequest{
+				PullRequestID: "pr-1",
+				Status:        domain.PRStatusOpen,
+				Reviewers: []domain.Reviewer{
+					reviewer("u2", domain.VerdictApproved),
+					reviewer("u3", domain.VerdictChangesRequested),
+				},
+			},
+			quorum: domain.QuorumPolicy{Approvals: 1, AllowChangesRequested: true},
+			want: domain.Mergeability{
+				PullRequestID:      "pr-1",
+				Status:             domain.PRStatusOpen,
+				Mergeable:          true,
+				Approvals:          1,
+				RequiredApprovals:  1,
+				ChangesRequestedBy: []string{"u3"},
+				Blockers:           []domain.MergeBlocker{},
+			},
+		},
+		{
+			name: "blocked: depends on an open pull request, merged and closed ones do not block",
+			pr: domain.PullRequest{
+				PullRequestID: "pr-3",
+				Status:        domain.PRStatusOpen,
+				Reviewers:     []domain.Reviewer{reviewer("u2", domain.VerdictApproved)},
+				DependsOn: []domain.PullRequestDependency{
+					{PullRequestID: "pr-1", Status: domain.PRStatusMerged},
+					{PullRequestID: "pr-2", Status: domain.PRStatusOpen},
+					{PullRequestID: "pr-4", Status: domain.PRStatusClosed},
+				},
+			},
+			quorum: domain.QuorumPolicy{Approvals: 1},
+			want: domain.Mergeability{
+				PullRequestID:      "pr-3",
+				Status:             domain.PRStatusOpen,
+				Approvals:          1,
+				RequiredApprovals:  1,
+				ChangesRequestedBy: []string{},
+				Blockers: []domain.MergeBlocker{
+					{Code: domain.BlockerDependencyOpen, Message: "depends on pr-2 which is OPEN"},
+				},
+			},
+		},
+		{
+			name: "blocked: merged pull request",
+			pr: domain.PullRequest{
+				PullRequestID: "pr-1",
+				Status:        domain.PRStatusMerged,
+				Reviewers:     []domain.Reviewer{reviewer("u2", domain.VerdictCommented)},
+			},
+			quorum: domain.QuorumPolicy{},
+			want: domain.Mergeability{
+				PullRequestID:      "pr-1",
+				Status:             domain.PRStatusMerged,
+				ChangesRequestedBy: []string{},
+				Blockers: []domain.MergeBlocker{
+					{Code: domain.BlockerNotOpen, Message: "pull request is MERGED"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Evaluate(tt.pr, tt.quorum)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
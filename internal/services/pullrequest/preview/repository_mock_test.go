@@ -0,0 +1,417 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package preview
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/preview.repository -o repository_mock_test.go -n RepositoryMock -p preview
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcPullRequestExists          func(ctx context.Context, pullRequestID string) (b1 bool, err error)
+	funcPullRequestExistsOrigin    string
+	inspectFuncPullRequestExists   func(ctx context.Context, pullRequestID string)
+	afterPullRequestExistsCounter  uint64
+	beforePullRequestExistsCounter uint64
+	PullRequestExistsMock          mRepositoryMockPullRequestExists
+}
+
+// NewRepositoryMock returns a mock for repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.PullRequestExistsMock = mRepositoryMockPullRequestExists{mock: m}
+	m.PullRequestExistsMock.callArgs = []*RepositoryMockPullRequestExistsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockPullRequestExists struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockPullRequestExistsExpectation
+	expectations       []*RepositoryMockPullRequestExistsExpectation
+
+	callArgs []*RepositoryMockPullRequestExistsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockPullRequestExistsExpectation specifies expectation struct of the repository.PullRequestExists
+type RepositoryMockPullRequestExistsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockPullRequestExistsParams
+	paramPtrs          *RepositoryMockPullRequestExistsParamPtrs
+	expectationOrigins RepositoryMockPullRequestExistsExpectationOrigins
+	results            *RepositoryMockPullRequestExistsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockPullRequestExistsParams contains parameters of the repository.PullRequestExists
+type RepositoryMockPullRequestExistsParams struct {
+	ctx           context.Context
+	pullRequestID string
+}
+
+// RepositoryMockPullRequestExistsParamPtrs contains pointers to parameters of the repository.PullRequestExists
+type RepositoryMockPullRequestExistsParamPtrs struct {
+	ctx           *context.Context
+	pullRequestID *string
+}
+
+// RepositoryMockPullRequestExistsResults contains results of the repository.PullRequestExists
+type RepositoryMockPullRequestExistsResults struct {
+	b1  bool
+	err error
+}
+
+// RepositoryMockPullRequestExistsOrigins contains origins of expectations of the repository.PullRequestExists
+type RepositoryMockPullRequestExistsExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originPullRequestID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPullRequestExists *mRepositoryMockPullRequestExists) Optional() *mRepositoryMockPullRequestExists {
+	mmPullRequestExists.optional = true
+	return mmPullRequestExists
+}
+
+// Expect sets up expected params for repository.PullRequestExists
+func (mmPullRequestExists *mRepositoryMockPullRequestExists) Expect(ctx context.Context, pullRequestID string) *mRepositoryMockPullRequestExists {
+	if mmPullRequestExists.mock.funcPullRequestExists != nil {
+		mmPullRequestExists.mock.t.Fatalf("RepositoryMock.PullRequestExists mock is already set by Set")
+	}
+
+	if mmPullRequestExists.defaultExpectation == nil {
+		mmPullRequestExists.defaultExpectation = &RepositoryMockPullRequestExistsExpectation{}
+	}
+
+	if mmPullRequestExists.defaultExpectation.paramPtrs != nil {
+		mmPullRequestExists.mock.t.Fatalf("RepositoryMock.PullRequestExists mock is already set by ExpectParams functions")
+	}
+
+	mmPullRequestExists.defaultExpectation.params = &RepositoryMockPullRequestExistsParams{ctx, pullRequestID}
+	mmPullRequestExists.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPullRequestExists.expectations {
+		if minimock.Equal(e.params, mmPullRequestExists.defaultExpectation.params) {
+			mmPullRequestExists.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPullRequestExists.defaultExpectation.params)
+		}
+	}
+
+	return mmPullRequestExists
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.PullRequestExists
+func (mmPullRequestExists *mRepositoryMockPullRequestExists) ExpectCtxParam1(ctx context.Context) *mRepositoryMockPullRequestExists {
+	if mmPullRequestExists.mock.funcPullRequestExists != nil {
+		mmPullRequestExists.mock.t.Fatalf("RepositoryMock.PullRequestExists mock is already set by Set")
+	}
+
+	if mmPullRequestExists.defaultExpectation == nil {
+		mmPullRequestExists.defaultExpectation = &RepositoryMockPullRequestExistsExpectation{}
+	}
+
+	if mmPullRequestExists.defaultExpectation.params != nil {
+		mmPullRequestExists.mock.t.Fatalf("RepositoryMock.PullRequestExists mock is already set by Expect")
+	}
+
+	if mmPullRequestExists.defaultExpectation.paramPtrs == nil {
+		mmPullRequestExists.defaultExpectation.paramPtrs = &RepositoryMockPullRequestExistsParamPtrs{}
+	}
+	mmPullRequestExists.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPullRequestExists.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPullRequestExists
+}
+
+// ExpectPullRequestIDParam2 sets up expected param pullRequestID for repository.PullRequestExists
+func (mmPullRequestExists *mRepositoryMockPullRequestExists) ExpectPullRequestIDParam2(pullRequestID string) *mRepositoryMockPullRequestExists {
+	if mmPullRequestExists.mock.funcPullRequestExists != nil {
+		mmPullRequestExists.mock.t.Fatalf("RepositoryMock.PullRequestExists mock is already set by Set")
+	}
+
+	if mmPullRequestExists.defaultExpectation == nil {
+		mmPullRequestExists.defaultExpectation = &RepositoryMockPullRequestExistsExpectation{}
+	}
+
+	if mmPullRequestExists.defaultExpectation.params != nil {
+		mmPullRequestExists.mock.t.Fatalf("RepositoryMock.PullRequestExists mock is already set by Expect")
+	}
+
+	if mmPullRequestExists.defaultExpectation.paramPtrs == nil {
+		mmPullRequestExists.defaultExpectation.paramPtrs = &RepositoryMockPullRequestExistsParamPtrs{}
+	}
+	mmPullRequestExists.defaultExpectation.paramPtrs.pullRequestID = &pullRequestID
+	mmPullRequestExists.defaultExpectation.expectationOrigins.originPullRequestID = minimock.CallerInfo(1)
+
+	return mmPullRequestExists
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.PullRequestExists
+func (mmPullRequestExists *mRepositoryMockPullRequestExists) Inspect(f func(ctx context.Context, pullRequestID string)) *mRepositoryMockPullRequestExists {
+	if mmPullRequestExists.mock.inspectFuncPullRequestExists != nil {
+		mmPullRequestExists.mock.t.Fatalf("Inspect function is already set for RepositoryMock.PullRequestExists")
+	}
+
+	mmPullRequestExists.mock.inspectFuncPullRequestExists = f
+
+	return mmPullRequestExists
+}
+
+// Return sets up results that will be returned by repository.PullRequestExists
+func (mmPullRequestExists *mRepositoryMockPullRequestExists) Return(b1 bool, err error) *RepositoryMock {
+	if mmPullRequestExists.mock.funcPullRequestExists != nil {
+		mmPullRequestExists.mock.t.Fatalf("RepositoryMock.PullRequestExists mock is already set by Set")
+	}
+
+	if mmPullRequestExists.defaultExpectation == nil {
+		mmPullRequestExists.defaultExpectation = &RepositoryMockPullRequestExistsExpectation{mock: mmPullRequestExists.mock}
+	}
+	mmPullRequestExists.defaultExpectation.results = &RepositoryMockPullRequestExistsResults{b1, err}
+	mmPullRequestExists.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPullRequestExists.mock
+}
+
+// Set uses given function f to mock the repository.PullRequestExists method
+func (mmPullRequestExists *mRepositoryMockPullRequestExists) Set(f func(ctx context.Context, pullRequestID string) (b1 bool, err error)) *RepositoryMock {
+	if mmPullRequestExists.defaultExpectation != nil {
+		mmPullRequestExists.mock.t.Fatalf("Default expectation is already set for the repository.PullRequestExists method")
+	}
+
+	if len(mmPullRequestExists.expectations) > 0 {
+		mmPullRequestExists.mock.t.Fatalf("Some expectations are already set for the repository.PullRequestExists method")
+	}
+
+	mmPullRequestExists.mock.funcPullRequestExists = f
+	mmPullRequestExists.mock.funcPullRequestExistsOrigin = minimock.CallerInfo(1)
+	return mmPullRequestExists.mock
+}
+
+// When sets expectation for the repository.PullRequestExists which will trigger the result defined by the following
+// Then helper
+func (mmPullRequestExists *mRepositoryMockPullRequestExists) When(ctx context.Context, pullRequestID string) *RepositoryMockPullRequestExistsExpectation {
+	if mmPullRequestExists.mock.funcPullRequestExists != nil {
+		mmPullRequestExists.mock.t.Fatalf("RepositoryMock.PullRequestExists mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockPullRequestExistsExpectation{
+		mock:               mmPullRequestExists.mock,
+		params:             &RepositoryMockPullRequestExistsParams{ctx, pullRequestID},
+		expectationOrigins: RepositoryMockPullRequestExistsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPullRequestExists.expectations = append(mmPullRequestExists.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.PullRequestExists return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockPullRequestExistsExpectation) Then(b1 bool, err error) *RepositoryMock {
+	e.results = &RepositoryMockPullRequestExistsResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.PullRequestExists should be invoked
+func (mmPullRequestExists *mRepositoryMockPullRequestExists) Times(n uint64) *mRepositoryMockPullRequestExists {
+	if n == 0 {
+		mmPullRequestExists.mock.t.Fatalf("Times of RepositoryMock.PullRequestExists mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPullRequestExists.expectedInvocations, n)
+	mmPullRequestExists.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPullRequestExists
+}
+
+func (mmPullRequestExists *mRepositoryMockPullRequestExists) invocationsDone() bool {
+	if len(mmPullRequestExists.expectations) == 0 && mmPullRequestExists.defaultExpectation == nil && mmPullRequestExists.mock.funcPullRequestExists == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPullRequestExists.mock.afterPullRequestExistsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPullRequestExists.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PullRequestExists implements repository
+func (mmPullRequestExists *RepositoryMock) PullRequestExists(ctx context.Context, pullRequestID string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmPullRequestExists.beforePullRequestExistsCounter, 1)
+	defer mm_atomic.AddUint64(&mmPullRequestExists.afterPullRequestExistsCounter, 1)
+
+	mmPullRequestExists.t.Helper()
+
+	if mmPullRequestExists.inspectFuncPullRequestExists != nil {
+		mmPullRequestExists.inspectFuncPullRequestExists(ctx, pullRequestID)
+	}
+
+	mm_params := RepositoryMockPullRequestExistsParams{ctx, pullRequestID}
+
+	// Record call args
+	mmPullRequestExists.PullRequestExistsMock.mutex.Lock()
+	mmPullRequestExists.PullRequestExistsMock.callArgs = append(mmPullRequestExists.PullRequestExistsMock.callArgs, &mm_params)
+	mmPullRequestExists.PullRequestExistsMock.mutex.Unlock()
+
+	for _, e := range mmPullRequestExists.PullRequestExistsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmPullRequestExists.PullRequestExistsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPullRequestExists.PullRequestExistsMock.defaultExpectation.Counter, 1)
+		mm_want := mmPullRequestExists.PullRequestExistsMock.defaultExpectation.params
+		mm_want_ptrs := mmPullRequestExists.PullRequestExistsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockPullRequestExistsParams{ctx, pullRequestID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPullRequestExists.t.Errorf("RepositoryMock.PullRequestExists got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPullRequestExists.PullRequestExistsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pullRequestID != nil && !minimock.Equal(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID) {
+				mmPullRequestExists.t.Errorf("RepositoryMock.PullRequestExists got unexpected parameter pullRequestID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPullRequestExists.PullRequestExistsMock.defaultExpectation.expectationOrigins.originPullRequestID, *mm_want_ptrs.pullRequestID, mm_got.pullRequestID, minimock.Diff(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPullRequestExists.t.Errorf("RepositoryMock.PullRequestExists got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPullRequestExists.PullRequestExistsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPullRequestExists.PullRequestExistsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPullRequestExists.t.Fatal("No results are set for the RepositoryMock.PullRequestExists")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmPullRequestExists.funcPullRequestExists != nil {
+		return mmPullRequestExists.funcPullRequestExists(ctx, pullRequestID)
+	}
+	mmPullRequestExists.t.Fatalf("Unexpected call to RepositoryMock.PullRequestExists. %v %v", ctx, pullRequestID)
+	return
+}
+
+// PullRequestExistsAfterCounter returns a count of finished RepositoryMock.PullRequestExists invocations
+func (mmPullRequestExists *RepositoryMock) PullRequestExistsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPullRequestExists.afterPullRequestExistsCounter)
+}
+
+// PullRequestExistsBeforeCounter returns a count of RepositoryMock.PullRequestExists invocations
+func (mmPullRequestExists *RepositoryMock) PullRequestExistsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPullRequestExists.beforePullRequestExistsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.PullRequestExists.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPullRequestExists *mRepositoryMockPullRequestExists) Calls() []*RepositoryMockPullRequestExistsParams {
+	mmPullRequestExists.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockPullRequestExistsParams, len(mmPullRequestExists.callArgs))
+	copy(argCopy, mmPullRequestExists.callArgs)
+
+	mmPullRequestExists.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPullRequestExistsDone returns true if the count of the PullRequestExists invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockPullRequestExistsDone() bool {
+	if m.PullRequestExistsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PullRequestExistsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PullRequestExistsMock.invocationsDone()
+}
+
+// MinimockPullRequestExistsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockPullRequestExistsInspect() {
+	for _, e := range m.PullRequestExistsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.PullRequestExists at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPullRequestExistsCounter := mm_atomic.LoadUint64(&m.afterPullRequestExistsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PullRequestExistsMock.defaultExpectation != nil && afterPullRequestExistsCounter < 1 {
+		if m.PullRequestExistsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.PullRequestExists at\n%s", m.PullRequestExistsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.PullRequestExists at\n%s with params: %#v", m.PullRequestExistsMock.defaultExpectation.expectationOrigins.origin, *m.PullRequestExistsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPullRequestExists != nil && afterPullRequestExistsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.PullRequestExists at\n%s", m.funcPullRequestExistsOrigin)
+	}
+
+	if !m.PullRequestExistsMock.invocationsDone() && afterPullRequestExistsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.PullRequestExists at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PullRequestExistsMock.expectedInvocations), m.PullRequestExistsMock.expectedInvocationsOrigin, afterPullRequestExistsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockPullRequestExistsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockPullRequestExistsDone()
+}
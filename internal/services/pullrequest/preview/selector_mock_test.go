@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package preview
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/preview.selector -o selector_mock_test.go -n SelectorMock -p preview
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// SelectorMock implements selector
+type SelectorMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcSelect          func(ctx context.Context, pr domain.CreatePullRequest) (a1 domain.Assignment, err error)
+	funcSelectOrigin    string
+	inspectFuncSelect   func(ctx context.Context, pr domain.CreatePullRequest)
+	afterSelectCounter  uint64
+	beforeSelectCounter uint64
+	SelectMock          mSelectorMockSelect
+}
+
+// NewSelectorMock returns a mock for selector
+func NewSelectorMock(t minimock.Tester) *SelectorMock {
+	m := &SelectorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SelectMock = mSelectorMockSelect{mock: m}
+	m.SelectMock.callArgs = []*SelectorMockSelectParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mSelectorMockSelect struct {
+	optional           bool
+	mock               *SelectorMock
+	defaultExpectation *SelectorMockSelectExpectation
+	expectations       []*SelectorMockSelectExpectation
+
+	callArgs []*SelectorMockSelectParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SelectorMockSelectExpectation specifies expectation struct of the selector.Select
+type SelectorMockSelectExpectation struct {
+	mock               *SelectorMock
+	params             *SelectorMockSelectParams
+	paramPtrs          *SelectorMockSelectParamPtrs
+	expectationOrigins SelectorMockSelectExpectationOrigins
+	results            *SelectorMockSelectResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SelectorMockSelectParams contains parameters of the selector.Select
+type SelectorMockSelectParams struct {
+	ctx context.Context
+	pr  domain.CreatePullRequest
+}
+
+// SelectorMockSelectParamPtrs contains pointers to parameters of the selector.Select
+type SelectorMockSelectParamPtrs struct {
+	ctx *context.Context
+	pr  *domain.CreatePullRequest
+}
+
+// SelectorMockSelectResults contains results of the selector.Select
+type SelectorMockSelectResults struct {
+	a1  domain.Assignment
+	err error
+}
+
+// SelectorMockSelectOrigins contains origins of expectations of the selector.Select
+type SelectorMockSelectExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originPr  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSelect *mSelectorMockSelect) Optional() *mSelectorMockSelect {
+	mmSelect.optional = true
+	return mmSelect
+}
+
+// Expect sets up expected params for selector.Select
+func (mmSelect *mSelectorMockSelect) Expect(ctx context.Context, pr domain.CreatePullRequest) *mSelectorMockSelect {
+	if mmSelect.mock.funcSelect != nil {
+		mmSelect.mock.t.Fatalf("SelectorMock.Select mock is already set by Set")
+	}
+
+	if mmSelect.defaultExpectation == nil {
+		mmSelect.defaultExpectation = &SelectorMockSelectExpectation{}
+	}
+
+	if mmSelect.defaultExpectation.paramPtrs != nil {
+		mmSelect.mock.t.Fatalf("SelectorMock.Select mock is already set by ExpectParams functions")
+	}
+
+	mmSelect.defaultExpectation.params = &SelectorMockSelectParams{ctx, pr}
+	mmSelect.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSelect.expectations {
+		if minimock.Equal(e.params, mmSelect.defaultExpectation.params) {
+			mmSelect.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSelect.defaultExpectation.params)
+		}
+	}
+
+	return mmSelect
+}
+
+// ExpectCtxParam1 sets up expected param ctx for selector.Select
+func (mmSelect *mSelectorMockSelect) ExpectCtxParam1(ctx context.Context) *mSelectorMockSelect {
+	if mmSelect.mock.funcSelect != nil {
+		mmSelect.mock.t.Fatalf("SelectorMock.Select mock is already set by Set")
+	}
+
+	if mmSelect.defaultExpectation == nil {
+		mmSelect.defaultExpectation = &SelectorMockSelectExpectation{}
+	}
+
+	if mmSelect.defaultExpectation.params != nil {
+		mmSelect.mock.t.Fatalf("SelectorMock.Select mock is already set by Expect")
+	}
+
+	if mmSelect.defaultExpectation.paramPtrs == nil {
+		mmSelect.defaultExpectation.paramPtrs = &SelectorMockSelectParamPtrs{}
+	}
+	mmSelect.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSelect.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSelect
+}
+
+// ExpectPrParam2 sets up expected param pr for selector.Select
+func (mmSelect *mSelectorMockSelect) ExpectPrParam2(pr domain.CreatePullRequest) *mSelectorMockSelect {
+	if mmSelect.mock.funcSelect != nil {
+		mmSelect.mock.t.Fatalf("SelectorMock.Select mock is already set by Set")
+	}
+
+	if mmSelect.defaultExpectation == nil {
+		mmSelect.defaultExpectation = &SelectorMockSelectExpectation{}
+	}
+
+	if mmSelect.defaultExpectation.params != nil {
+		mmSelect.mock.t.Fatalf("SelectorMock.Select mock is already set by Expect")
+	}
+
+	if mmSelect.defaultExpectation.paramPtrs == nil {
+		mmSelect.defaultExpectation.paramPtrs = &SelectorMockSelectParamPtrs{}
+	}
+	mmSelect.defaultExpectation.paramPtrs.pr = &pr
+	mmSelect.defaultExpectation.expectationOrigins.originPr = minimock.CallerInfo(1)
+
+	return mmSelect
+}
+
+// Inspect accepts an inspector function that has same arguments as the selector.Select
+func (mmSelect *mSelectorMockSelect) Inspect(f func(ctx context.Context, pr domain.CreatePullRequest)) *mSelectorMockSelect {
+	if mmSelect.mock.inspectFuncSelect != nil {
+		mmSelect.mock.t.Fatalf("Inspect function is already set for SelectorMock.Select")
+	}
+
+	mmSelect.mock.inspectFuncSelect = f
+
+	return mmSelect
+}
+
+// Return sets up results that will be returned by selector.Select
+func (mmSelect *mSelectorMockSelect) Return(a1 domain.Assignment, err error) *SelectorMock {
+	if mmSelect.mock.funcSelect != nil {
+		mmSelect.mock.t.Fatalf("SelectorMock.Select mock is already set by Set")
+	}
+
+	if mmSelect.defaultExpectation == nil {
+		mmSelect.defaultExpectation = &SelectorMockSelectExpectation{mock: mmSelect.mock}
+	}
+	mmSelect.defaultExpectation.results = &SelectorMockSelectResults{a1, err}
+	mmSelect.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSelect.mock
+}
+
+// Set uses given function f to mock the selector.Select method
+func (mmSelect *mSelectorMockSelect) Set(f func(ctx context.Context, pr domain.CreatePullRequest) (a1 domain.Assignment, err error)) *SelectorMock {
+	if mmSelect.defaultExpectation != nil {
+		mmSelect.mock.t.Fatalf("Default expectation is already set for the selector.Select method")
+	}
+
+	if len(mmSelect.expectations) > 0 {
+		mmSelect.mock.t.Fatalf("Some expectations are already set for the selector.Select method")
+	}
+
+	mmSelect.mock.funcSelect = f
+	mmSelect.mock.funcSelectOrigin = minimock.CallerInfo(1)
+	return mmSelect.mock
+}
+
+// When sets expectation for the selector.Select which will trigger the result defined by the following
+// Then helper
+func (mmSelect *mSelectorMockSelect) When(ctx context.Context, pr domain.CreatePullRequest) *SelectorMockSelectExpectation {
+	if mmSelect.mock.funcSelect != nil {
+		mmSelect.mock.t.Fatalf("SelectorMock.Select mock is already set by Set")
+	}
+
+	expectation := &SelectorMockSelectExpectation{
+		mock:               mmSelect.mock,
+		params:             &SelectorMockSelectParams{ctx, pr},
+		expectationOrigins: SelectorMockSelectExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSelect.expectations = append(mmSelect.expectations, expectation)
+	return expectation
+}
+
+// Then sets up selector.Select return parameters for the expectation previously defined by the When method
+func (e *SelectorMockSelectExpectation) Then(a1 domain.Assignment, err error) *SelectorMock {
+	e.results = &SelectorMockSelectResults{a1, err}
+	return e.mock
+}
+
+// Times sets number of times selector.Select should be invoked
+func (mmSelect *mSelectorMockSelect) Times(n uint64) *mSelectorMockSelect {
+	if n == 0 {
+		mmSelect.mock.t.Fatalf("Times of SelectorMock.Select mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSelect.expectedInvocations, n)
+	mmSelect.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSelect
+}
+
+func (mmSelect *mSelectorMockSelect) invocationsDone() bool {
+	if len(mmSelect.expectations) == 0 && mmSelect.defaultExpectation == nil && mmSelect.mock.funcSelect == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSelect.mock.afterSelectCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSelect.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Select implements selector
+func (mmSelect *SelectorMock) Select(ctx context.Context, pr domain.CreatePullRequest) (a1 domain.Assignment, err error) {
+	mm_atomic.AddUint64(&mmSelect.beforeSelectCounter, 1)
+	defer mm_atomic.AddUint64(&mmSelect.afterSelectCounter, 1)
+
+	mmSelect.t.Helper()
+
+	if mmSelect.inspectFuncSelect != nil {
+		mmSelect.inspectFuncSelect(ctx, pr)
+	}
+
+	mm_params := SelectorMockSelectParams{ctx, pr}
+
+	// Record call args
+	mmSelect.SelectMock.mutex.Lock()
+	mmSelect.SelectMock.callArgs = append(mmSelect.SelectMock.callArgs, &mm_params)
+	mmSelect.SelectMock.mutex.Unlock()
+
+	for _, e := range mmSelect.SelectMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmSelect.SelectMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSelect.SelectMock.defaultExpectation.Counter, 1)
+		mm_want := mmSelect.SelectMock.defaultExpectation.params
+		mm_want_ptrs := mmSelect.SelectMock.defaultExpectation.paramPtrs
+
+		mm_got := SelectorMockSelectParams{ctx, pr}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSelect.t.Errorf("SelectorMock.Select got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSelect.SelectMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pr != nil && !minimock.Equal(*mm_want_ptrs.pr, mm_got.pr) {
+				mmSelect.t.Errorf("SelectorMock.Select got unexpected parameter pr, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSelect.SelectMock.defaultExpectation.expectationOrigins.originPr, *mm_want_ptrs.pr, mm_got.pr, minimock.Diff(*mm_want_ptrs.pr, mm_got.pr))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSelect.t.Errorf("SelectorMock.Select got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSelect.SelectMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSelect.SelectMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSelect.t.Fatal("No results are set for the SelectorMock.Select")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmSelect.funcSelect != nil {
+		return mmSelect.funcSelect(ctx, pr)
+	}
+	mmSelect.t.Fatalf("Unexpected call to SelectorMock.Select. %v %v", ctx, pr)
+	return
+}
+
+// SelectAfterCounter returns a count of finished SelectorMock.Select invocations
+func (mmSelect *SelectorMock) SelectAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSelect.afterSelectCounter)
+}
+
+// SelectBeforeCounter returns a count of SelectorMock.Select invocations
+func (mmSelect *SelectorMock) SelectBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSelect.beforeSelectCounter)
+}
+
+// Calls returns a list of arguments used in each call to SelectorMock.Select.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSelect *mSelectorMockSelect) Calls() []*SelectorMockSelectParams {
+	mmSelect.mutex.RLock()
+
+	argCopy := make([]*SelectorMockSelectParams, len(mmSelect.callArgs))
+	copy(argCopy, mmSelect.callArgs)
+
+	mmSelect.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSelectDone returns true if the count of the Select invocations corresponds
+// the number of defined expectations
+func (m *SelectorMock) MinimockSelectDone() bool {
+	if m.SelectMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SelectMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SelectMock.invocationsDone()
+}
+
+// MinimockSelectInspect logs each unmet expectation
+func (m *SelectorMock) MinimockSelectInspect() {
+	for _, e := range m.SelectMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SelectorMock.Select at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSelectCounter := mm_atomic.LoadUint64(&m.afterSelectCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SelectMock.defaultExpectation != nil && afterSelectCounter < 1 {
+		if m.SelectMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SelectorMock.Select at\n%s", m.SelectMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SelectorMock.Select at\n%s with params: %#v", m.SelectMock.defaultExpectation.expectationOrigins.origin, *m.SelectMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSelect != nil && afterSelectCounter < 1 {
+		m.t.Errorf("Expected call to SelectorMock.Select at\n%s", m.funcSelectOrigin)
+	}
+
+	if !m.SelectMock.invocationsDone() && afterSelectCounter > 0 {
+		m.t.Errorf("Expected %d calls to SelectorMock.Select at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SelectMock.expectedInvocations), m.SelectMock.expectedInvocationsOrigin, afterSelectCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SelectorMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockSelectInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *SelectorMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *SelectorMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockSelectDone()
+}
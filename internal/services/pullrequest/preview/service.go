@@ -0,0 +1,69 @@
+package preview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		PullRequestExists(ctx context.Context, pullRequestID string) (bool, error)
+	}
+	selector interface {
+		Select(ctx context.Context, pr domain.CreatePullRequest) (domain.Assignment, error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo     repository
+		selector selector
+		logger   logger
+	}
+)
+
+func New(repo repository, selector selector, logger logger) *Handler {
+	return &Handler{
+		repo:     repo,
+		selector: selector,
+		logger:   logger,
+	}
+}
+
+// PreviewAssignment runs the same selection as creation without writing anything.
+// Unlike creation it does not fail when the policy minimum cannot be met, the caller
+// sees it from the returned assignment instead. Like creation it looks the PR up under
+// its namespaced key.
+func (h *Handler) PreviewAssignment(ctx context.Context, pr domain.CreatePullRequest) (domain.Assignment, error) {
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.previewAssignment"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	pr.PullRequestID = pr.Key()
+
+	exists, err := h.repo.PullRequestExists(ctx, pr.PullRequestID)
+	if err != nil {
+		logger.Error("repo.PullRequestExists", zap.Error(err), zap.String("pull_request_id", pr.PullRequestID))
+		return domain.Assignment{}, fmt.Errorf("repo.PullRequestExists: %w", err)
+	}
+	if exists {
+		return domain.Assignment{}, domain.ErrPRExists
+	}
+
+	assignment, err := h.selector.Select(ctx, pr)
+	if err != nil && !errors.Is(err, domain.ErrNotEnoughReviewers) {
+		logger.Error("selector.Select", zap.Error(err), zap.String("author_id", pr.AuthorID))
+		return domain.Assignment{}, fmt.Errorf("selector.Select: %w", err)
+	}
+
+	return assignment, nil
+}
@@ -0,0 +1,154 @@
+package preview
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func TestHandler_PreviewAssignment(t *testing.T) {
+	t.Parallel()
+
+	request := domain.CreatePullRequest{PullRequestID: "pr-1", PullRequestName: "Add rate limiting", AuthorID: "u1"}
+	assignment := domain.Assignment{
+		TeamName:     "backend",
+		MinReviewers: 2,
+		Reviewers: []domain.Reviewer{
+			{UserID: "u2", Role: domain.ReviewerRoleReviewer, Source: domain.ReviewerSourceAuto},
+		},
+		Rejected: []domain.RejectedCandidate{{UserID: "u3", Reason: domain.RejectionInactive}},
+	}
+
+	type fields struct {
+		repo     func(mc *minimock.Controller) repository
+		selector func(mc *minimock.Controller) selector
+	}
+	unknown := func(mc *minimock.Controller) repository {
+		repo := NewRepositoryMock(mc)
+		repo.PullRequestExistsMock.Expect(minimock.AnyContext, "pr-1").Return(false, nil)
+		return repo
+	}
+
+	tests := []struct {
+		name    string
+		request domain.CreatePullRequest
+		fields  fields
+		want    domain.Assignment
+		wantErr error
+	}{
+		{
+			name:    "success: selection is returned",
+			request: request,
+			fields: fields{
+				repo: unknown,
+				selector: func(mc *minimock.Controller) selector {
+					selector := NewSelectorMock(mc)
+					selector.SelectMock.Expect(minimock.AnyContext, request).Return(assignment, nil)
+					return selector
+				},
+			},
+			want: assignment,
+		},
+		{
+			name:    "success: PR of a repository is looked up under its namespaced key",
+			request: domain.CreatePullRequest{PullRequestID: "42", AuthorID: "u1", Repository: "acme/api"},
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.PullRequestExistsMock.Expect(minimock.AnyContext, "acme/api#42").Return(false, nil)
+					return repo
+				},
+				selector: func(mc *minimock.Controller) selector {
+					selector := NewSelectorMock(mc)
+					selector.SelectMock.Expect(minimock.AnyContext, domain.CreatePullRequest{
+						PullRequestID: "acme/api#42",
+						AuthorID:      "u1",
+						Repository:    "acme/api",
+					}).Return(assignment, nil)
+					return selector
+				},
+			},
+			want: assignment,
+		},
+		{
+			name:    "success: unmet minimum is returned, not an error",
+			request: request,
+			fields: fields{
+				repo: unknown,
+				selector: func(mc *minimock.Controller) selector {
+					selector := NewSelectorMock(mc)
+					selector.SelectMock.Return(assignment, domain.ErrNotEnoughReviewers)
+					return selector
+				},
+			},
+			want: assignment,
+		},
+		{
+			name:    "error: PR already exists",
+			request: request,
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.PullRequestExistsMock.Return(true, nil)
+					return repo
+				},
+				selector: func(mc *minimock.Controller) selector { return NewSelectorMock(mc) },
+			},
+			wantErr: domain.ErrPRExists,
+		},
+		{
+			name:    "error: author not found",
+			request: request,
+			fields: fields{
+				repo: unknown,
+				selector: func(mc *minimock.Controller) selector {
+					selector := NewSelectorMock(mc)
+					selector.SelectMock.Return(domain.Assignment{}, domain.ErrAuthorNotFound)
+					return selector
+				},
+			},
+			wantErr: domain.ErrAuthorNotFound,
+		},
+		{
+			name:    "error: database failure",
+			request: request,
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.PullRequestExistsMock.Return(false, errors.New("database connection failed"))
+					return repo
+				},
+				selector: func(mc *minimock.Controller) selector { return NewSelectorMock(mc) },
+			},
+			wantErr: errors.New("database connection failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			handler := New(tt.fields.repo(mc), tt.fields.selector(mc), zap.NewNop())
+
+			got, err := handler.PreviewAssignment(context.Background(), tt.request)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr.Error())
+				assert.Equal(t, domain.Assignment{}, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,1548 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package review
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/review.repository -o repository_mock_test.go -n RepositoryMock -p review
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetPullRequestTeam          func(ctx context.Context, pullRequestID string) (s1 string, err error)
+	funcGetPullRequestTeamOrigin    string
+	inspectFuncGetPullRequestTeam   func(ctx context.Context, pullRequestID string)
+	afterGetPullRequestTeamCounter  uint64
+	beforeGetPullRequestTeamCounter uint64
+	GetPullRequestTeamMock          mRepositoryMockGetPullRequestTeam
+
+	funcGetTeamPolicy          func(ctx context.Context, teamName string, version int) (t1 domain.TeamPolicy, err error)
+	funcGetTeamPolicyOrigin    string
+	inspectFuncGetTeamPolicy   func(ctx context.Context, teamName string, version int)
+	afterGetTeamPolicyCounter  uint64
+	beforeGetTeamPolicyCounter uint64
+	GetTeamPolicyMock          mRepositoryMockGetTeamPolicy
+
+	funcReRequestReview          func(ctx context.Context, request domain.ReRequestReview, dismissApprovals bool) (p1 domain.PullRequest, err error)
+	funcReRequestReviewOrigin    string
+	inspectFuncReRequestReview   func(ctx context.Context, request domain.ReRequestReview, dismissApprovals bool)
+	afterReRequestReviewCounter  uint64
+	beforeReRequestReviewCounter uint64
+	ReRequestReviewMock          mRepositoryMockReRequestReview
+
+	funcSubmitReview          func(ctx context.Context, review domain.SubmitReview) (p1 domain.PullRequest, err error)
+	funcSubmitReviewOrigin    string
+	inspectFuncSubmitReview   func(ctx context.Context, review domain.SubmitReview)
+	afterSubmitReviewCounter  uint64
+	beforeSubmitReviewCounter uint64
+	SubmitReviewMock          mRepositoryMockSubmitReview
+}
+
+// NewRepositoryMock returns a mock for repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetPullRequestTeamMock = mRepositoryMockGetPullRequestTeam{mock: m}
+	m.GetPullRequestTeamMock.callArgs = []*RepositoryMockGetPullRequestTeamParams{}
+
+	m.GetTeamPolicyMock = mRepositoryMockGetTeamPolicy{mock: m}
+	m.GetTeamPolicyMock.callArgs = []*RepositoryMockGetTeamPolicyParams{}
+
+	m.ReRequestReviewMock = mRepositoryMockReRequestReview{mock: m}
+	m.ReRequestReviewMock.callArgs = []*RepositoryMockReRequestReviewParams{}
+
+	m.SubmitReviewMock = mRepositoryMockSubmitReview{mock: m}
+	m.SubmitReviewMock.callArgs = []*RepositoryMockSubmitReviewParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockGetPullRequestTeam struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetPullRequestTeamExpectation
+	expectations       []*RepositoryMockGetPullRequestTeamExpectation
+
+	callArgs []*RepositoryMockGetPullRequestTeamParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetPullRequestTeamExpectation specifies expectation struct of the repository.GetPullRequestTeam
+type RepositoryMockGetPullRequestTeamExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetPullRequestTeamParams
+	paramPtrs          *RepositoryMockGetPullRequestTeamParamPtrs
+	expectationOrigins RepositoryMockGetPullRequestTeamExpectationOrigins
+	results            *RepositoryMockGetPullRequestTeamResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetPullRequestTeamParams contains parameters of the repository.GetPullRequestTeam
+type RepositoryMockGetPullRequestTeamParams struct {
+	ctx           context.Context
+	pullRequestID string
+}
+
+// RepositoryMockGetPullRequestTeamParamPtrs contains pointers to parameters of the repository.GetPullRequestTeam
+type RepositoryMockGetPullRequestTeamParamPtrs struct {
+	ctx           *context.Context
+	pullRequestID *string
+}
+
+// RepositoryMockGetPullRequestTeamResults contains results of the repository.GetPullRequestTeam
+type RepositoryMockGetPullRequestTeamResults struct {
+	s1  string
+	err error
+}
+
+// RepositoryMockGetPullRequestTeamOrigins contains origins of expectations of the repository.GetPullRequestTeam
+type RepositoryMockGetPullRequestTeamExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originPullRequestID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPullRequestTeam *mRepositoryMockGetPullRequestTeam) Optional() *mRepositoryMockGetPullRequestTeam {
+	mmGetPullRequestTeam.optional = true
+	return mmGetPullRequestTeam
+}
+
+// Expect sets up expected params for repository.GetPullRequestTeam
+func (mmGetPullRequestTeam *mRepositoryMockGetPullRequestTeam) Expect(ctx context.Context, pullRequestID string) *mRepositoryMockGetPullRequestTeam {
+	if mmGetPullRequestTeam.mock.funcGetPullRequestTeam != nil {
+		mmGetPullRequestTeam.mock.t.Fatalf("RepositoryMock.GetPullRequestTeam mock is already set by Set")
+	}
+
+	if mmGetPullRequestTeam.defaultExpectation == nil {
+		mmGetPullRequestTeam.defaultExpectation = &RepositoryMockGetPullRequestTeamExpectation{}
+	}
+
+	if mmGetPullRequestTeam.defaultExpectation.paramPtrs != nil {
+		mmGetPullRequestTeam.mock.t.Fatalf("RepositoryMock.GetPullRequestTeam mock is already set by ExpectParams functions")
+	}
+
+	mmGetPullRequestTeam.defaultExpectation.params = &RepositoryMockGetPullRequestTeamParams{ctx, pullRequestID}
+	mmGetPullRequestTeam.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPullRequestTeam.expectations {
+		if minimock.Equal(e.params, mmGetPullRequestTeam.defaultExpectation.params) {
+			mmGetPullRequestTeam.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPullRequestTeam.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPullRequestTeam
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.GetPullRequestTeam
+func (mmGetPullRequestTeam *mRepositoryMockGetPullRequestTeam) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetPullRequestTeam {
+	if mmGetPullRequestTeam.mock.funcGetPullRequestTeam != nil {
+		mmGetPullRequestTeam.mock.t.Fatalf("RepositoryMock.GetPullRequestTeam mock is already set by Set")
+	}
+
+	if mmGetPullRequestTeam.defaultExpectation == nil {
+		mmGetPullRequestTeam.defaultExpectation = &RepositoryMockGetPullRequestTeamExpectation{}
+	}
+
+	if mmGetPullRequestTeam.defaultExpectation.params != nil {
+		mmGetPullRequestTeam.mock.t.Fatalf("RepositoryMock.GetPullRequestTeam mock is already set by Expect")
+	}
+
+	if mmGetPullRequestTeam.defaultExpectation.paramPtrs == nil {
+		mmGetPullRequestTeam.defaultExpectation.paramPtrs = &RepositoryMockGetPullRequestTeamParamPtrs{}
+	}
+	mmGetPullRequestTeam.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPullRequestTeam.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPullRequestTeam
+}
+
+// ExpectPullRequestIDParam2 sets up expected param pullRequestID for repository.GetPullRequestTeam
+func (mmGetPullRequestTeam *mRepositoryMockGetPullRequestTeam) ExpectPullRequestIDParam2(pullRequestID string) *mRepositoryMockGetPullRequestTeam {
+	if mmGetPullRequestTeam.mock.funcGetPullRequestTeam != nil {
+		mmGetPullRequestTeam.mock.t.Fatalf("RepositoryMock.GetPullRequestTeam mock is already set by Set")
+	}
+
+	if mmGetPullRequestTeam.defaultExpectation == nil {
+		mmGetPullRequestTeam.defaultExpectation = &RepositoryMockGetPullRequestTeamExpectation{}
+	}
+
+	if mmGetPullRequestTeam.defaultExpectation.params != nil {
+		mmGetPullRequestTeam.mock.t.Fatalf("RepositoryMock.GetPullRequestTeam mock is already set by Expect")
+	}
+
+	if mmGetPullRequestTeam.defaultExpectation.paramPtrs == nil {
+		mmGetPullRequestTeam.defaultExpectation.paramPtrs = &RepositoryMockGetPullRequestTeamParamPtrs{}
+	}
+	mmGetPullRequestTeam.defaultExpectation.paramPtrs.pullRequestID = &pullRequestID
+	mmGetPullRequestTeam.defaultExpectation.expectationOrigins.originPullRequestID = minimock.CallerInfo(1)
+
+	return mmGetPullRequestTeam
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.GetPullRequestTeam
+func (mmGetPullRequestTeam *mRepositoryMockGetPullRequestTeam) Inspect(f func(ctx context.Context, pullRequestID string)) *mRepositoryMockGetPullRequestTeam {
+	if mmGetPullRequestTeam.mock.inspectFuncGetPullRequestTeam != nil {
+		mmGetPullRequestTeam.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetPullRequestTeam")
+	}
+
+	mmGetPullRequestTeam.mock.inspectFuncGetPullRequestTeam = f
+
+	return mmGetPullRequestTeam
+}
+
+// Return sets up results that will be returned by repository.GetPullRequestTeam
+func (mmGetPullRequestTeam *mRepositoryMockGetPullRequestTeam) Return(s1 string, err error) *RepositoryMock {
+	if mmGetPullRequestTeam.mock.funcGetPullRequestTeam != nil {
+		mmGetPullRequestTeam.mock.t.Fatalf("RepositoryMock.GetPullRequestTeam mock is already set by Set")
+	}
+
+	if mmGetPullRequestTeam.defaultExpectation == nil {
+		mmGetPullRequestTeam.defaultExpectation = &RepositoryMockGetPullRequestTeamExpectation{mock: mmGetPullRequestTeam.mock}
+	}
+	mmGetPullRequestTeam.defaultExpectation.results = &RepositoryMockGetPullRequestTeamResults{s1, err}
+	mmGetPullRequestTeam.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPullRequestTeam.mock
+}
+
+// Set uses given function f to mock the repository.GetPullRequestTeam method
+func (mmGetPullRequestTeam *mRepositoryMockGetPullRequestTeam) Set(f func(ctx context.Context, pullRequestID string) (s1 string, err error)) *RepositoryMock {
+	if mmGetPullRequestTeam.defaultExpectation != nil {
+		mmGetPullRequestTeam.mock.t.Fatalf("Default expectation is already set for the repository.GetPullRequestTeam method")
+	}
+
+	if len(mmGetPullRequestTeam.expectations) > 0 {
+		mmGetPullRequestTeam.mock.t.Fatalf("Some expectations are already set for the repository.GetPullRequestTeam method")
+	}
+
+	mmGetPullRequestTeam.mock.funcGetPullRequestTeam = f
+	mmGetPullRequestTeam.mock.funcGetPullRequestTeamOrigin = minimock.CallerInfo(1)
+	return mmGetPullRequestTeam.mock
+}
+
+// When sets expectation for the repository.GetPullRequestTeam which will trigger the result defined by the following
+// Then helper
+func (mmGetPullRequestTeam *mRepositoryMockGetPullRequestTeam) When(ctx context.Context, pullRequestID string) *RepositoryMockGetPullRequestTeamExpectation {
+	if mmGetPullRequestTeam.mock.funcGetPullRequestTeam != nil {
+		mmGetPullRequestTeam.mock.t.Fatalf("RepositoryMock.GetPullRequestTeam mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetPullRequestTeamExpectation{
+		mock:               mmGetPullRequestTeam.mock,
+		params:             &RepositoryMockGetPullRequestTeamParams{ctx, pullRequestID},
+		expectationOrigins: RepositoryMockGetPullRequestTeamExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPullRequestTeam.expectations = append(mmGetPullRequestTeam.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.GetPullRequestTeam return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetPullRequestTeamExpectation) Then(s1 string, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetPullRequestTeamResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.GetPullRequestTeam should be invoked
+func (mmGetPullRequestTeam *mRepositoryMockGetPullRequestTeam) Times(n uint64) *mRepositoryMockGetPullRequestTeam {
+	if n == 0 {
+		mmGetPullRequestTeam.mock.t.Fatalf("Times of RepositoryMock.GetPullRequestTeam mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPullRequestTeam.expectedInvocations, n)
+	mmGetPullRequestTeam.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPullRequestTeam
+}
+
+func (mmGetPullRequestTeam *mRepositoryMockGetPullRequestTeam) invocationsDone() bool {
+	if len(mmGetPullRequestTeam.expectations) == 0 && mmGetPullRequestTeam.defaultExpectation == nil && mmGetPullRequestTeam.mock.funcGetPullRequestTeam == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPullRequestTeam.mock.afterGetPullRequestTeamCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPullRequestTeam.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPullRequestTeam implements repository
+func (mmGetPullRequestTeam *RepositoryMock) GetPullRequestTeam(ctx context.Context, pullRequestID string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGetPullRequestTeam.beforeGetPullRequestTeamCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPullRequestTeam.afterGetPullRequestTeamCounter, 1)
+
+	mmGetPullRequestTeam.t.Helper()
+
+	if mmGetPullRequestTeam.inspectFuncGetPullRequestTeam != nil {
+		mmGetPullRequestTeam.inspectFuncGetPullRequestTeam(ctx, pullRequestID)
+	}
+
+	mm_params := RepositoryMockGetPullRequestTeamParams{ctx, pullRequestID}
+
+	// Record call args
+	mmGetPullRequestTeam.GetPullRequestTeamMock.mutex.Lock()
+	mmGetPullRequestTeam.GetPullRequestTeamMock.callArgs = append(mmGetPullRequestTeam.GetPullRequestTeamMock.callArgs, &mm_params)
+	mmGetPullRequestTeam.GetPullRequestTeamMock.mutex.Unlock()
+
+	for _, e := range mmGetPullRequestTeam.GetPullRequestTeamMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetPullRequestTeam.GetPullRequestTeamMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPullRequestTeam.GetPullRequestTeamMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPullRequestTeam.GetPullRequestTeamMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPullRequestTeam.GetPullRequestTeamMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetPullRequestTeamParams{ctx, pullRequestID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPullRequestTeam.t.Errorf("RepositoryMock.GetPullRequestTeam got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPullRequestTeam.GetPullRequestTeamMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pullRequestID != nil && !minimock.Equal(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID) {
+				mmGetPullRequestTeam.t.Errorf("RepositoryMock.GetPullRequestTeam got unexpected parameter pullRequestID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPullRequestTeam.GetPullRequestTeamMock.defaultExpectation.expectationOrigins.originPullRequestID, *mm_want_ptrs.pullRequestID, mm_got.pullRequestID, minimock.Diff(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPullRequestTeam.t.Errorf("RepositoryMock.GetPullRequestTeam got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPullRequestTeam.GetPullRequestTeamMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPullRequestTeam.GetPullRequestTeamMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPullRequestTeam.t.Fatal("No results are set for the RepositoryMock.GetPullRequestTeam")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetPullRequestTeam.funcGetPullRequestTeam != nil {
+		return mmGetPullRequestTeam.funcGetPullRequestTeam(ctx, pullRequestID)
+	}
+	mmGetPullRequestTeam.t.Fatalf("Unexpected call to RepositoryMock.GetPullRequestTeam. %v %v", ctx, pullRequestID)
+	return
+}
+
+// GetPullRequestTeamAfterCounter returns a count of finished RepositoryMock.GetPullRequestTeam invocations
+func (mmGetPullRequestTeam *RepositoryMock) GetPullRequestTeamAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPullRequestTeam.afterGetPullRequestTeamCounter)
+}
+
+// GetPullRequestTeamBeforeCounter returns a count of RepositoryMock.GetPullRequestTeam invocations
+func (mmGetPullRequestTeam *RepositoryMock) GetPullRequestTeamBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPullRequestTeam.beforeGetPullRequestTeamCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetPullRequestTeam.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPullRequestTeam *mRepositoryMockGetPullRequestTeam) Calls() []*RepositoryMockGetPullRequestTeamParams {
+	mmGetPullRequestTeam.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetPullRequestTeamParams, len(mmGetPullRequestTeam.callArgs))
+	copy(argCopy, mmGetPullRequestTeam.callArgs)
+
+	mmGetPullRequestTeam.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPullRequestTeamDone returns true if the count of the GetPullRequestTeam invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetPullRequestTeamDone() bool {
+	if m.GetPullRequestTeamMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPullRequestTeamMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPullRequestTeamMock.invocationsDone()
+}
+
+// MinimockGetPullRequestTeamInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetPullRequestTeamInspect() {
+	for _, e := range m.GetPullRequestTeamMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetPullRequestTeam at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPullRequestTeamCounter := mm_atomic.LoadUint64(&m.afterGetPullRequestTeamCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPullRequestTeamMock.defaultExpectation != nil && afterGetPullRequestTeamCounter < 1 {
+		if m.GetPullRequestTeamMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetPullRequestTeam at\n%s", m.GetPullRequestTeamMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetPullRequestTeam at\n%s with params: %#v", m.GetPullRequestTeamMock.defaultExpectation.expectationOrigins.origin, *m.GetPullRequestTeamMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPullRequestTeam != nil && afterGetPullRequestTeamCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetPullRequestTeam at\n%s", m.funcGetPullRequestTeamOrigin)
+	}
+
+	if !m.GetPullRequestTeamMock.invocationsDone() && afterGetPullRequestTeamCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetPullRequestTeam at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPullRequestTeamMock.expectedInvocations), m.GetPullRequestTeamMock.expectedInvocationsOrigin, afterGetPullRequestTeamCounter)
+	}
+}
+
+type mRepositoryMockGetTeamPolicy struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetTeamPolicyExpectation
+	expectations       []*RepositoryMockGetTeamPolicyExpectation
+
+	callArgs []*RepositoryMockGetTeamPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetTeamPolicyExpectation specifies expectation struct of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetTeamPolicyParams
+	paramPtrs          *RepositoryMockGetTeamPolicyParamPtrs
+	expectationOrigins RepositoryMockGetTeamPolicyExpectationOrigins
+	results            *RepositoryMockGetTeamPolicyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetTeamPolicyParams contains parameters of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyParams struct {
+	ctx      context.Context
+	teamName string
+	version  int
+}
+
+// RepositoryMockGetTeamPolicyParamPtrs contains pointers to parameters of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyParamPtrs struct {
+	ctx      *context.Context
+	teamName *string
+	version  *int
+}
+
+// RepositoryMockGetTeamPolicyResults contains results of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyResults struct {
+	t1  domain.TeamPolicy
+	err error
+}
+
+// RepositoryMockGetTeamPolicyOrigins contains origins of expectations of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originTeamName string
+	originVersion  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Optional() *mRepositoryMockGetTeamPolicy {
+	mmGetTeamPolicy.optional = true
+	return mmGetTeamPolicy
+}
+
+// Expect sets up expected params for repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Expect(ctx context.Context, teamName string, version int) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{}
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.paramPtrs != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmGetTeamPolicy.defaultExpectation.params = &RepositoryMockGetTeamPolicyParams{ctx, teamName, version}
+	mmGetTeamPolicy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTeamPolicy.expectations {
+		if minimock.Equal(e.params, mmGetTeamPolicy.defaultExpectation.params) {
+			mmGetTeamPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTeamPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTeamPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{}
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.params != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Expect")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetTeamPolicy.defaultExpectation.paramPtrs = &RepositoryMockGetTeamPolicyParamPtrs{}
+	}
+	mmGetTeamPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTeamPolicy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTeamPolicy
+}
+
+// ExpectTeamNameParam2 sets up expected param teamName for repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) ExpectTeamNameParam2(teamName string) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{}
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.params != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Expect")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetTeamPolicy.defaultExpectation.paramPtrs = &RepositoryMockGetTeamPolicyParamPtrs{}
+	}
+	mmGetTeamPolicy.defaultExpectation.paramPtrs.teamName = &teamName
+	mmGetTeamPolicy.defaultExpectation.expectationOrigins.originTeamName = minimock.CallerInfo(1)
+
+	return mmGetTeamPolicy
+}
+
+// ExpectVersionParam3 sets up expected param version for repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) ExpectVersionParam3(version int) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{}
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.params != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Expect")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetTeamPolicy.defaultExpectation.paramPtrs = &RepositoryMockGetTeamPolicyParamPtrs{}
+	}
+	mmGetTeamPolicy.defaultExpectation.paramPtrs.version = &version
+	mmGetTeamPolicy.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmGetTeamPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Inspect(f func(ctx context.Context, teamName string, version int)) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.inspectFuncGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetTeamPolicy")
+	}
+
+	mmGetTeamPolicy.mock.inspectFuncGetTeamPolicy = f
+
+	return mmGetTeamPolicy
+}
+
+// Return sets up results that will be returned by repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Return(t1 domain.TeamPolicy, err error) *RepositoryMock {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{mock: mmGetTeamPolicy.mock}
+	}
+	mmGetTeamPolicy.defaultExpectation.results = &RepositoryMockGetTeamPolicyResults{t1, err}
+	mmGetTeamPolicy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTeamPolicy.mock
+}
+
+// Set uses given function f to mock the repository.GetTeamPolicy method
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Set(f func(ctx context.Context, teamName string, version int) (t1 domain.TeamPolicy, err error)) *RepositoryMock {
+	if mmGetTeamPolicy.defaultExpectation != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("Default expectation is already set for the repository.GetTeamPolicy method")
+	}
+
+	if len(mmGetTeamPolicy.expectations) > 0 {
+		mmGetTeamPolicy.mock.t.Fatalf("Some expectations are already set for the repository.GetTeamPolicy method")
+	}
+
+	mmGetTeamPolicy.mock.funcGetTeamPolicy = f
+	mmGetTeamPolicy.mock.funcGetTeamPolicyOrigin = minimock.CallerInfo(1)
+	return mmGetTeamPolicy.mock
+}
+
+// When sets expectation for the repository.GetTeamPolicy which will trigger the result defined by the following
+// Then helper
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) When(ctx context.Context, teamName string, version int) *RepositoryMockGetTeamPolicyExpectation {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetTeamPolicyExpectation{
+		mock:               mmGetTeamPolicy.mock,
+		params:             &RepositoryMockGetTeamPolicyParams{ctx, teamName, version},
+		expectationOrigins: RepositoryMockGetTeamPolicyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTeamPolicy.expectations = append(mmGetTeamPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.GetTeamPolicy return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetTeamPolicyExpectation) Then(t1 domain.TeamPolicy, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetTeamPolicyResults{t1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.GetTeamPolicy should be invoked
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Times(n uint64) *mRepositoryMockGetTeamPolicy {
+	if n == 0 {
+		mmGetTeamPolicy.mock.t.Fatalf("Times of RepositoryMock.GetTeamPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTeamPolicy.expectedInvocations, n)
+	mmGetTeamPolicy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTeamPolicy
+}
+
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) invocationsDone() bool {
+	if len(mmGetTeamPolicy.expectations) == 0 && mmGetTeamPolicy.defaultExpectation == nil && mmGetTeamPolicy.mock.funcGetTeamPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTeamPolicy.mock.afterGetTeamPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTeamPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTeamPolicy implements repository
+func (mmGetTeamPolicy *RepositoryMock) GetTeamPolicy(ctx context.Context, teamName string, version int) (t1 domain.TeamPolicy, err error) {
+	mm_atomic.AddUint64(&mmGetTeamPolicy.beforeGetTeamPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTeamPolicy.afterGetTeamPolicyCounter, 1)
+
+	mmGetTeamPolicy.t.Helper()
+
+	if mmGetTeamPolicy.inspectFuncGetTeamPolicy != nil {
+		mmGetTeamPolicy.inspectFuncGetTeamPolicy(ctx, teamName, version)
+	}
+
+	mm_params := RepositoryMockGetTeamPolicyParams{ctx, teamName, version}
+
+	// Record call args
+	mmGetTeamPolicy.GetTeamPolicyMock.mutex.Lock()
+	mmGetTeamPolicy.GetTeamPolicyMock.callArgs = append(mmGetTeamPolicy.GetTeamPolicyMock.callArgs, &mm_params)
+	mmGetTeamPolicy.GetTeamPolicyMock.mutex.Unlock()
+
+	for _, e := range mmGetTeamPolicy.GetTeamPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.err
+		}
+	}
+
+	if mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetTeamPolicyParams{ctx, teamName, version}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTeamPolicy.t.Errorf("RepositoryMock.GetTeamPolicy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.teamName != nil && !minimock.Equal(*mm_want_ptrs.teamName, mm_got.teamName) {
+				mmGetTeamPolicy.t.Errorf("RepositoryMock.GetTeamPolicy got unexpected parameter teamName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.expectationOrigins.originTeamName, *mm_want_ptrs.teamName, mm_got.teamName, minimock.Diff(*mm_want_ptrs.teamName, mm_got.teamName))
+			}
+
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmGetTeamPolicy.t.Errorf("RepositoryMock.GetTeamPolicy got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTeamPolicy.t.Errorf("RepositoryMock.GetTeamPolicy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTeamPolicy.t.Fatal("No results are set for the RepositoryMock.GetTeamPolicy")
+		}
+		return (*mm_results).t1, (*mm_results).err
+	}
+	if mmGetTeamPolicy.funcGetTeamPolicy != nil {
+		return mmGetTeamPolicy.funcGetTeamPolicy(ctx, teamName, version)
+	}
+	mmGetTeamPolicy.t.Fatalf("Unexpected call to RepositoryMock.GetTeamPolicy. %v %v %v", ctx, teamName, version)
+	return
+}
+
+// GetTeamPolicyAfterCounter returns a count of finished RepositoryMock.GetTeamPolicy invocations
+func (mmGetTeamPolicy *RepositoryMock) GetTeamPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTeamPolicy.afterGetTeamPolicyCounter)
+}
+
+// GetTeamPolicyBeforeCounter returns a count of RepositoryMock.GetTeamPolicy invocations
+func (mmGetTeamPolicy *RepositoryMock) GetTeamPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTeamPolicy.beforeGetTeamPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetTeamPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Calls() []*RepositoryMockGetTeamPolicyParams {
+	mmGetTeamPolicy.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetTeamPolicyParams, len(mmGetTeamPolicy.callArgs))
+	copy(argCopy, mmGetTeamPolicy.callArgs)
+
+	mmGetTeamPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTeamPolicyDone returns true if the count of the GetTeamPolicy invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetTeamPolicyDone() bool {
+	if m.GetTeamPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTeamPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTeamPolicyMock.invocationsDone()
+}
+
+// MinimockGetTeamPolicyInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetTeamPolicyInspect() {
+	for _, e := range m.GetTeamPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetTeamPolicy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTeamPolicyCounter := mm_atomic.LoadUint64(&m.afterGetTeamPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTeamPolicyMock.defaultExpectation != nil && afterGetTeamPolicyCounter < 1 {
+		if m.GetTeamPolicyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetTeamPolicy at\n%s", m.GetTeamPolicyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetTeamPolicy at\n%s with params: %#v", m.GetTeamPolicyMock.defaultExpectation.expectationOrigins.origin, *m.GetTeamPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTeamPolicy != nil && afterGetTeamPolicyCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetTeamPolicy at\n%s", m.funcGetTeamPolicyOrigin)
+	}
+
+	if !m.GetTeamPolicyMock.invocationsDone() && afterGetTeamPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetTeamPolicy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTeamPolicyMock.expectedInvocations), m.GetTeamPolicyMock.expectedInvocationsOrigin, afterGetTeamPolicyCounter)
+	}
+}
+
+type mRepositoryMockReRequestReview struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockReRequestReviewExpectation
+	expectations       []*RepositoryMockReRequestReviewExpectation
+
+	callArgs []*RepositoryMockReRequestReviewParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockReRequestReviewExpectation specifies expectation struct of the repository.ReRequestReview
+type RepositoryMockReRequestReviewExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockReRequestReviewParams
+	paramPtrs          *RepositoryMockReRequestReviewParamPtrs
+	expectationOrigins RepositoryMockReRequestReviewExpectationOrigins
+	results            *RepositoryMockReRequestReviewResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockReRequestReviewParams contains parameters of the repository.ReRequestReview
+type RepositoryMockReRequestReviewParams struct {
+	ctx              context.Context
+	request          domain.ReRequestReview
+	dismissApprovals bool
+}
+
+// RepositoryMockReRequestReviewParamPtrs contains pointers to parameters of the repository.ReRequestReview
+type RepositoryMockReRequestReviewParamPtrs struct {
+	ctx              *context.Context
+	request          *domain.ReRequestReview
+	dismissApprovals *bool
+}
+
+// RepositoryMockReRequestReviewResults contains results of the repository.ReRequestReview
+type RepositoryMockReRequestReviewResults struct {
+	p1  domain.PullRequest
+	err error
+}
+
+// RepositoryMockReRequestReviewOrigins contains origins of expectations of the repository.ReRequestReview
+type RepositoryMockReRequestReviewExpectationOrigins struct {
+	origin                 string
+	originCtx              string
+	originRequest          string
+	originDismissApprovals string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReRequestReview *mRepositoryMockReRequestReview) Optional() *mRepositoryMockReRequestReview {
+	mmReRequestReview.optional = true
+	return mmReRequestReview
+}
+
+// Expect sets up expected params for repository.ReRequestReview
+func (mmReRequestReview *mRepositoryMockReRequestReview) Expect(ctx context.Context, request domain.ReRequestReview, dismissApprovals bool) *mRepositoryMockReRequestReview {
+	if mmReRequestReview.mock.funcReRequestReview != nil {
+		mmReRequestReview.mock.t.Fatalf("RepositoryMock.ReRequestReview mock is already set by Set")
+	}
+
+	if mmReRequestReview.defaultExpectation == nil {
+		mmReRequestReview.defaultExpectation = &RepositoryMockReRequestReviewExpectation{}
+	}
+
+	if mmReRequestReview.defaultExpectation.paramPtrs != nil {
+		mmReRequestReview.mock.t.Fatalf("RepositoryMock.ReRequestReview mock is already set by ExpectParams functions")
+	}
+
+	mmReRequestReview.defaultExpectation.params = &RepositoryMockReRequestReviewParams{ctx, request, dismissApprovals}
+	mmReRequestReview.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReRequestReview.expectations {
+		if minimock.Equal(e.params, mmReRequestReview.defaultExpectation.params) {
+			mmReRequestReview.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReRequestReview.defaultExpectation.params)
+		}
+	}
+
+	return mmReRequestReview
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.ReRequestReview
+func (mmReRequestReview *mRepositoryMockReRequestReview) ExpectCtxParam1(ctx context.Context) *mRepositoryMockReRequestReview {
+	if mmReRequestReview.mock.funcReRequestReview != nil {
+		mmReRequestReview.mock.t.Fatalf("RepositoryMock.ReRequestReview mock is already set by Set")
+	}
+
+	if mmReRequestReview.defaultExpectation == nil {
+		mmReRequestReview.defaultExpectation = &RepositoryMockReRequestReviewExpectation{}
+	}
+
+	if mmReRequestReview.defaultExpectation.params != nil {
+		mmReRequestReview.mock.t.Fatalf("RepositoryMock.ReRequestReview mock is already set by Expect")
+	}
+
+	if mmReRequestReview.defaultExpectation.paramPtrs == nil {
+		mmReRequestReview.defaultExpectation.paramPtrs = &RepositoryMockReRequestReviewParamPtrs{}
+	}
+	mmReRequestReview.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReRequestReview.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReRequestReview
+}
+
+// ExpectRequestParam2 sets up expected param request for repository.ReRequestReview
+func (mmReRequestReview *mRepositoryMockReRequestReview) ExpectRequestParam2(request domain.ReRequestReview) *mRepositoryMockReRequestReview {
+	if mmReRequestReview.mock.funcReRequestReview != nil {
+		mmReRequestReview.mock.t.Fatalf("RepositoryMock.ReRequestReview mock is already set by Set")
+	}
+
+	if mmReRequestReview.defaultExpectation == nil {
+		mmReRequestReview.defaultExpectation = &RepositoryMockReRequestReviewExpectation{}
+	}
+
+	if mmReRequestReview.defaultExpectation.params != nil {
+		mmReRequestReview.mock.t.Fatalf("RepositoryMock.ReRequestReview mock is already set by Expect")
+	}
+
+	if mmReRequestReview.defaultExpectation.paramPtrs == nil {
+		mmReRequestReview.defaultExpectation.paramPtrs = &RepositoryMockReRequestReviewParamPtrs{}
+	}
+	mmReRequestReview.defaultExpectation.paramPtrs.request = &request
+	mmReRequestReview.defaultExpectation.expectationOrigins.originRequest = minimock.CallerInfo(1)
+
+	return mmReRequestReview
+}
+
+// ExpectDismissApprovalsParam3 sets up expected param dismissApprovals for repository.ReRequestReview
+func (mmReRequestReview *mRepositoryMockReRequestReview) ExpectDismissApprovalsParam3(dismissApprovals bool) *mRepositoryMockReRequestReview {
+	if mmReRequestReview.mock.funcReRequestReview != nil {
+		mmReRequestReview.mock.t.Fatalf("RepositoryMock.ReRequestReview mock is already set by Set")
+	}
+
+	if mmReRequestReview.defaultExpectation == nil {
+		mmReRequestReview.defaultExpectation = &RepositoryMockReRequestReviewExpectation{}
+	}
+
+	if mmReRequestReview.defaultExpectation.params != nil {
+		mmReRequestReview.mock.t.Fatalf("RepositoryMock.ReRequestReview mock is already set by Expect")
+	}
+
+	if mmReRequestReview.defaultExpectation.paramPtrs == nil {
+		mmReRequestReview.defaultExpectation.paramPtrs = &RepositoryMockReRequestReviewParamPtrs{}
+	}
+	mmReRequestReview.defaultExpectation.paramPtrs.dismissApprovals = &dismissApprovals
+	mmReRequestReview.defaultExpectation.expectationOrigins.originDismissApprovals = minimock.CallerInfo(1)
+
+	return mmReRequestReview
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.ReRequestReview
+func (mmReRequestReview *mRepositoryMockReRequestReview) Inspect(f func(ctx context.Context, request domain.ReRequestReview, dismissApprovals bool)) *mRepositoryMockReRequestReview {
+	if mmReRequestReview.mock.inspectFuncReRequestReview != nil {
+		mmReRequestReview.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ReRequestReview")
+	}
+
+	mmReRequestReview.mock.inspectFuncReRequestReview = f
+
+	return mmReRequestReview
+}
+
+// Return sets up results that will be returned by repository.ReRequestReview
+func (mmReRequestReview *mRepositoryMockReRequestReview) Return(p1 domain.PullRequest, err error) *RepositoryMock {
+	if mmReRequestReview.mock.funcReRequestReview != nil {
+		mmReRequestReview.mock.t.Fatalf("RepositoryMock.ReRequestReview mock is already set by Set")
+	}
+
+	if mmReRequestReview.defaultExpectation == nil {
+		mmReRequestReview.defaultExpectation = &RepositoryMockReRequestReviewExpectation{mock: mmReRequestReview.mock}
+	}
+	mmReRequestReview.defaultExpectation.results = &RepositoryMockReRequestReviewResults{p1, err}
+	mmReRequestReview.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReRequestReview.mock
+}
+
+// Set uses given function f to mock the repository.ReRequestReview method
+func (mmReRequestReview *mRepositoryMockReRequestReview) Set(f func(ctx context.Context, request domain.ReRequestReview, dismissApprovals bool) (p1 domain.PullRequest, err error)) *RepositoryMock {
+	if mmReRequestReview.defaultExpectation != nil {
+		mmReRequestReview.mock.t.Fatalf("Default expectation is already set for the repository.ReRequestReview method")
+	}
+
+	if len(mmReRequestReview.expectations) > 0 {
+		mmReRequestReview.mock.t.Fatalf("Some expectations are already set for the repository.ReRequestReview method")
+	}
+
+	mmReRequestReview.mock.funcReRequestReview = f
+	mmReRequestReview.mock.funcReRequestReviewOrigin = minimock.CallerInfo(1)
+	return mmReRequestReview.mock
+}
+
+// When sets expectation for the repository.ReRequestReview which will trigger the result defined by the following
+// Then helper
+func (mmReRequestReview *mRepositoryMockReRequestReview) When(ctx context.Context, request domain.ReRequestReview, dismissApprovals bool) *RepositoryMockReRequestReviewExpectation {
+	if mmReRequestReview.mock.funcReRequestReview != nil {
+		mmReRequestReview.mock.t.Fatalf("RepositoryMock.ReRequestReview mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockReRequestReviewExpectation{
+		mock:               mmReRequestReview.mock,
+		params:             &RepositoryMockReRequestReviewParams{ctx, request, dismissApprovals},
+		expectationOrigins: RepositoryMockReRequestReviewExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReRequestReview.expectations = append(mmReRequestReview.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.ReRequestReview return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockReRequestReviewExpectation) Then(p1 domain.PullRequest, err error) *RepositoryMock {
+	e.results = &RepositoryMockReRequestReviewResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.ReRequestReview should be invoked
+func (mmReRequestReview *mRepositoryMockReRequestReview) Times(n uint64) *mRepositoryMockReRequestReview {
+	if n == 0 {
+		mmReRequestReview.mock.t.Fatalf("Times of RepositoryMock.ReRequestReview mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReRequestReview.expectedInvocations, n)
+	mmReRequestReview.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReRequestReview
+}
+
+func (mmReRequestReview *mRepositoryMockReRequestReview) invocationsDone() bool {
+	if len(mmReRequestReview.expectations) == 0 && mmReRequestReview.defaultExpectation == nil && mmReRequestReview.mock.funcReRequestReview == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReRequestReview.mock.afterReRequestReviewCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReRequestReview.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReRequestReview implements repository
+func (mmReRequestReview *RepositoryMock) ReRequestReview(ctx context.Context, request domain.ReRequestReview, dismissApprovals bool) (p1 domain.PullRequest, err error) {
+	mm_atomic.AddUint64(&mmReRequestReview.beforeReRequestReviewCounter, 1)
+	defer mm_atomic.AddUint64(&mmReRequestReview.afterReRequestReviewCounter, 1)
+
+	mmReRequestReview.t.Helper()
+
+	if mmReRequestReview.inspectFuncReRequestReview != nil {
+		mmReRequestReview.inspectFuncReRequestReview(ctx, request, dismissApprovals)
+	}
+
+	mm_params := RepositoryMockReRequestReviewParams{ctx, request, dismissApprovals}
+
+	// Record call args
+	mmReRequestReview.ReRequestReviewMock.mutex.Lock()
+	mmReRequestReview.ReRequestReviewMock.callArgs = append(mmReRequestReview.ReRequestReviewMock.callArgs, &mm_params)
+	mmReRequestReview.ReRequestReviewMock.mutex.Unlock()
+
+	for _, e := range mmReRequestReview.ReRequestReviewMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmReRequestReview.ReRequestReviewMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReRequestReview.ReRequestReviewMock.defaultExpectation.Counter, 1)
+		mm_want := mmReRequestReview.ReRequestReviewMock.defaultExpectation.params
+		mm_want_ptrs := mmReRequestReview.ReRequestReviewMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockReRequestReviewParams{ctx, request, dismissApprovals}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReRequestReview.t.Errorf("RepositoryMock.ReRequestReview got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReRequestReview.ReRequestReviewMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmReRequestReview.t.Errorf("RepositoryMock.ReRequestReview got unexpected parameter request, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReRequestReview.ReRequestReviewMock.defaultExpectation.expectationOrigins.originRequest, *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+			if mm_want_ptrs.dismissApprovals != nil && !minimock.Equal(*mm_want_ptrs.dismissApprovals, mm_got.dismissApprovals) {
+				mmReRequestReview.t.Errorf("RepositoryMock.ReRequestReview got unexpected parameter dismissApprovals, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReRequestReview.ReRequestReviewMock.defaultExpectation.expectationOrigins.originDismissApprovals, *mm_want_ptrs.dismissApprovals, mm_got.dismissApprovals, minimock.Diff(*mm_want_ptrs.dismissApprovals, mm_got.dismissApprovals))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReRequestReview.t.Errorf("RepositoryMock.ReRequestReview got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReRequestReview.ReRequestReviewMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReRequestReview.ReRequestReviewMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReRequestReview.t.Fatal("No results are set for the RepositoryMock.ReRequestReview")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmReRequestReview.funcReRequestReview != nil {
+		return mmReRequestReview.funcReRequestReview(ctx, request, dismissApprovals)
+	}
+	mmReRequestReview.t.Fatalf("Unexpected call to RepositoryMock.ReRequestReview. %v %v %v", ctx, request, dismissApprovals)
+	return
+}
+
+// ReRequestReviewAfterCounter returns a count of finished RepositoryMock.ReRequestReview invocations
+func (mmReRequestReview *RepositoryMock) ReRequestReviewAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReRequestReview.afterReRequestReviewCounter)
+}
+
+// ReRequestReviewBeforeCounter returns a count of RepositoryMock.ReRequestReview invocations
+func (mmReRequestReview *RepositoryMock) ReRequestReviewBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReRequestReview.beforeReRequestReviewCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ReRequestReview.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReRequestReview *mRepositoryMockReRequestReview) Calls() []*RepositoryMockReRequestReviewParams {
+	mmReRequestReview.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockReRequestReviewParams, len(mmReRequestReview.callArgs))
+	copy(argCopy, mmReRequestReview.callArgs)
+
+	mmReRequestReview.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReRequestReviewDone returns true if the count of the ReRequestReview invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockReRequestReviewDone() bool {
+	if m.ReRequestReviewMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReRequestReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReRequestReviewMock.invocationsDone()
+}
+
+// MinimockReRequestReviewInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockReRequestReviewInspect() {
+	for _, e := range m.ReRequestReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ReRequestReview at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReRequestReviewCounter := mm_atomic.LoadUint64(&m.afterReRequestReviewCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReRequestReviewMock.defaultExpectation != nil && afterReRequestReviewCounter < 1 {
+		if m.ReRequestReviewMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.ReRequestReview at\n%s", m.ReRequestReviewMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ReRequestReview at\n%s with params: %#v", m.ReRequestReviewMock.defaultExpectation.expectationOrigins.origin, *m.ReRequestReviewMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReRequestReview != nil && afterReRequestReviewCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.ReRequestReview at\n%s", m.funcReRequestReviewOrigin)
+	}
+
+	if !m.ReRequestReviewMock.invocationsDone() && afterReRequestReviewCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ReRequestReview at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReRequestReviewMock.expectedInvocations), m.ReRequestReviewMock.expectedInvocationsOrigin, afterReRequestReviewCounter)
+	}
+}
+
+type mRepositoryMockSubmitReview struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockSubmitReviewExpectation
+	expectations       []*RepositoryMockSubmitReviewExpectation
+
+	callArgs []*RepositoryMockSubmitReviewParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockSubmitReviewExpectation specifies expectation struct of the repository.SubmitReview
+type RepositoryMockSubmitReviewExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockSubmitReviewParams
+	paramPtrs          *RepositoryMockSubmitReviewParamPtrs
+	expectationOrigins RepositoryMockSubmitReviewExpectationOrigins
+	results            *RepositoryMockSubmitReviewResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockSubmitReviewParams contains parameters of the repository.SubmitReview
+type RepositoryMockSubmitReviewParams struct {
+	ctx    context.Context
+	review domain.SubmitReview
+}
+
+// RepositoryMockSubmitReviewParamPtrs contains pointers to parameters of the repository.SubmitReview
+type RepositoryMockSubmitReviewParamPtrs struct {
+	ctx    *context.Context
+	review *domain.SubmitReview
+}
+
+// RepositoryMockSubmitReviewResults contains results of the repository.SubmitReview
+type RepositoryMockSubmitReviewResults struct {
+	p1  domain.PullRequest
+	err error
+}
+
+// RepositoryMockSubmitReviewOrigins contains origins of expectations of the repository.SubmitReview
+type RepositoryMockSubmitReviewExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originReview string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSubmitReview *mRepositoryMockSubmitReview) Optional() *mRepositoryMockSubmitReview {
+	mmSubmitReview.optional = true
+	return mmSubmitReview
+}
+
+// Expect sets up expected params for repository.SubmitReview
+func (mmSubmitReview *mRepositoryMockSubmitReview) Expect(ctx context.Context, review domain.SubmitReview) *mRepositoryMockSubmitReview {
+	if mmSubmitReview.mock.funcSubmitReview != nil {
+		mmSubmitReview.mock.t.Fatalf("RepositoryMock.SubmitReview mock is already set by Set")
+	}
+
+	if mmSubmitReview.defaultExpectation == nil {
+		mmSubmitReview.defaultExpectation = &RepositoryMockSubmitReviewExpectation{}
+	}
+
+	if mmSubmitReview.defaultExpectation.paramPtrs != nil {
+		mmSubmitReview.mock.t.Fatalf("RepositoryMock.SubmitReview mock is already set by ExpectParams functions")
+	}
+
+	mmSubmitReview.defaultExpectation.params = &RepositoryMockSubmitReviewParams{ctx, review}
+	mmSubmitReview.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSubmitReview.expectations {
+		if minimock.Equal(e.params, mmSubmitReview.defaultExpectation.params) {
+			mmSubmitReview.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSubmitReview.defaultExpectation.params)
+		}
+	}
+
+	return mmSubmitReview
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.SubmitReview
+func (mmSubmitReview *mRepositoryMockSubmitReview) ExpectCtxParam1(ctx context.Context) *mRepositoryMockSubmitReview {
+	if mmSubmitReview.mock.funcSubmitReview != nil {
+		mmSubmitReview.mock.t.Fatalf("RepositoryMock.SubmitReview mock is already set by Set")
+	}
+
+	if mmSubmitReview.defaultExpectation == nil {
+		mmSubmitReview.defaultExpectation = &RepositoryMockSubmitReviewExpectation{}
+	}
+
+	if mmSubmitReview.defaultExpectation.params != nil {
+		mmSubmitReview.mock.t.Fatalf("RepositoryMock.SubmitReview mock is already set by Expect")
+	}
+
+	if mmSubmitReview.defaultExpectation.paramPtrs == nil {
+		mmSubmitReview.defaultExpectation.paramPtrs = &RepositoryMockSubmitReviewParamPtrs{}
+	}
+	mmSubmitReview.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSubmitReview.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSubmitReview
+}
+
+// ExpectReviewParam2 sets up expected param review for repository.SubmitReview
+func (mmSubmitReview *mRepositoryMockSubmitReview) ExpectReviewParam2(review domain.SubmitReview) *mRepositoryMockSubmitReview {
+	if mmSubmitReview.mock.funcSubmitReview != nil {
+		mmSubmitReview.mock.t.Fatalf("RepositoryMock.SubmitReview mock is already set by Set")
+	}
+
+	if mmSubmitReview.defaultExpectation == nil {
+		mmSubmitReview.defaultExpectation = &RepositoryMockSubmitReviewExpectation{}
+	}
+
+	if mmSubmitReview.defaultExpectation.params != nil {
+		mmSubmitReview.mock.t.Fatalf("RepositoryMock.SubmitReview mock is already set by Expect")
+	}
+
+	if mmSubmitReview.defaultExpectation.paramPtrs == nil {
+		mmSubmitReview.defaultExpectation.paramPtrs = &RepositoryMockSubmitReviewParamPtrs{}
+	}
+	mmSubmitReview.defaultExpectation.paramPtrs.review = &review
+	mmSubmitReview.defaultExpectation.expectationOrigins.originReview = minimock.CallerInfo(1)
+
+	return mmSubmitReview
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.SubmitReview
+func (mmSubmitReview *mRepositoryMockSubmitReview) Inspect(f func(ctx context.Context, review domain.SubmitReview)) *mRepositoryMockSubmitReview {
+	if mmSubmitReview.mock.inspectFuncSubmitReview != nil {
+		mmSubmitReview.mock.t.Fatalf("Inspect function is already set for RepositoryMock.SubmitReview")
+	}
+
+	mmSubmitReview.mock.inspectFuncSubmitReview = f
+
+	return mmSubmitReview
+}
+
+// Return sets up results that will be returned by repository.SubmitReview
+func (mmSubmitReview *mRepositoryMockSubmitReview) Return(p1 domain.PullRequest, err error) *RepositoryMock {
+	if mmSubmitReview.mock.funcSubmitReview != nil {
+		mmSubmitReview.mock.t.Fatalf("RepositoryMock.SubmitReview mock is already set by Set")
+	}
+
+	if mmSubmitReview.defaultExpectation == nil {
+		mmSubmitReview.defaultExpectation = &RepositoryMockSubmitReviewExpectation{mock: mmSubmitReview.mock}
+	}
+	mmSubmitReview.defaultExpectation.results = &RepositoryMockSubmitReviewResults{p1, err}
+	mmSubmitReview.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSubmitReview.mock
+}
+
+// Set uses given function f to mock the repository.SubmitReview method
+func (mmSubmitReview *mRepositoryMockSubmitReview) Set(f func(ctx context.Context, review domain.SubmitReview) (p1 domain.PullRequest, err error)) *RepositoryMock {
+	if mmSubmitReview.defaultExpectation != nil {
+		mmSubmitReview.mock.t.Fatalf("Default expectation is already set for the repository.SubmitReview method")
+	}
+
+	if len(mmSubmitReview.expectations) > 0 {
+		mmSubmitReview.mock.t.Fatalf("Some expectations are already set for the repository.SubmitReview method")
+	}
+
+	mmSubmitReview.mock.funcSubmitReview = f
+	mmSubmitReview.mock.funcSubmitReviewOrigin = minimock.CallerInfo(1)
+	return mmSubmitReview.mock
+}
+
+// When sets expectation for the repository.SubmitReview which will trigger the result defined by the following
+// Then helper
+func (mmSubmitReview *mRepositoryMockSubmitReview) When(ctx context.Context, review domain.SubmitReview) *RepositoryMockSubmitReviewExpectation {
+	if mmSubmitReview.mock.funcSubmitReview != nil {
+		mmSubmitReview.mock.t.Fatalf("RepositoryMock.SubmitReview mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockSubmitReviewExpectation{
+		mock:               mmSubmitReview.mock,
+		params:             &RepositoryMockSubmitReviewParams{ctx, review},
+		expectationOrigins: RepositoryMockSubmitReviewExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSubmitReview.expectations = append(mmSubmitReview.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.SubmitReview return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockSubmitReviewExpectation) Then(p1 domain.PullRequest, err error) *RepositoryMock {
+	e.results = &RepositoryMockSubmitReviewResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.SubmitReview should be invoked
+func (mmSubmitReview *mRepositoryMockSubmitReview) Times(n uint64) *mRepositoryMockSubmitReview {
+	if n == 0 {
+		mmSubmitReview.mock.t.Fatalf("Times of RepositoryMock.SubmitReview mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSubmitReview.expectedInvocations, n)
+	mmSubmitReview.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSubmitReview
+}
+
+func (mmSubmitReview *mRepositoryMockSubmitReview) invocationsDone() bool {
+	if len(mmSubmitReview.expectations) == 0 && mmSubmitReview.defaultExpectation == nil && mmSubmitReview.mock.funcSubmitReview == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSubmitReview.mock.afterSubmitReviewCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSubmitReview.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SubmitReview implements repository
+func (mmSubmitReview *RepositoryMock) SubmitReview(ctx context.Context, review domain.SubmitReview) (p1 domain.PullRequest, err error) {
+	mm_atomic.AddUint64(&mmSubmitReview.beforeSubmitReviewCounter, 1)
+	defer mm_atomic.AddUint64(&mmSubmitReview.afterSubmitReviewCounter, 1)
+
+	mmSubmitReview.t.Helper()
+
+	if mmSubmitReview.inspectFuncSubmitReview != nil {
+		mmSubmitReview.inspectFuncSubmitReview(ctx, review)
+	}
+
+	mm_params := RepositoryMockSubmitReviewParams{ctx, review}
+
+	// Record call args
+	mmSubmitReview.SubmitReviewMock.mutex.Lock()
+	mmSubmitReview.SubmitReviewMock.callArgs = append(mmSubmitReview.SubmitReviewMock.callArgs, &mm_params)
+	mmSubmitReview.SubmitReviewMock.mutex.Unlock()
+
+	for _, e := range mmSubmitReview.SubmitReviewMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmSubmitReview.SubmitReviewMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSubmitReview.SubmitReviewMock.defaultExpectation.Counter, 1)
+		mm_want := mmSubmitReview.SubmitReviewMock.defaultExpectation.params
+		mm_want_ptrs := mmSubmitReview.SubmitReviewMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockSubmitReviewParams{ctx, review}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSubmitReview.t.Errorf("RepositoryMock.SubmitReview got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSubmitReview.SubmitReviewMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.review != nil && !minimock.Equal(*mm_want_ptrs.review, mm_got.review) {
+				mmSubmitReview.t.Errorf("RepositoryMock.SubmitReview got unexpected parameter review, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSubmitReview.SubmitReviewMock.defaultExpectation.expectationOrigins.originReview, *mm_want_ptrs.review, mm_got.review, minimock.Diff(*mm_want_ptrs.review, mm_got.review))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSubmitReview.t.Errorf("RepositoryMock.SubmitReview got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSubmitReview.SubmitReviewMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSubmitReview.SubmitReviewMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSubmitReview.t.Fatal("No results are set for the RepositoryMock.SubmitReview")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmSubmitReview.funcSubmitReview != nil {
+		return mmSubmitReview.funcSubmitReview(ctx, review)
+	}
+	mmSubmitReview.t.Fatalf("Unexpected call to RepositoryMock.SubmitReview. %v %v", ctx, review)
+	return
+}
+
+// SubmitReviewAfterCounter returns a count of finished RepositoryMock.SubmitReview invocations
+func (mmSubmitReview *RepositoryMock) SubmitReviewAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSubmitReview.afterSubmitReviewCounter)
+}
+
+// SubmitReviewBeforeCounter returns a count of RepositoryMock.SubmitReview invocations
+func (mmSubmitReview *RepositoryMock) SubmitReviewBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSubmitReview.beforeSubmitReviewCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.SubmitReview.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSubmitReview *mRepositoryMockSubmitReview) Calls() []*RepositoryMockSubmitReviewParams {
+	mmSubmitReview.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockSubmitReviewParams, len(mmSubmitReview.callArgs))
+	copy(argCopy, mmSubmitReview.callArgs)
+
+	mmSubmitReview.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSubmitReviewDone returns true if the count of the SubmitReview invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockSubmitReviewDone() bool {
+	if m.SubmitReviewMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SubmitReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SubmitReviewMock.invocationsDone()
+}
+
+// MinimockSubmitReviewInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockSubmitReviewInspect() {
+	for _, e := range m.SubmitReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.SubmitReview at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSubmitReviewCounter := mm_atomic.LoadUint64(&m.afterSubmitReviewCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SubmitReviewMock.defaultExpectation != nil && afterSubmitReviewCounter < 1 {
+		if m.SubmitReviewMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.SubmitReview at\n%s", m.SubmitReviewMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.SubmitReview at\n%s with params: %#v", m.SubmitReviewMock.defaultExpectation.expectationOrigins.origin, *m.SubmitReviewMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSubmitReview != nil && afterSubmitReviewCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.SubmitReview at\n%s", m.funcSubmitReviewOrigin)
+	}
+
+	if !m.SubmitReviewMock.invocationsDone() && afterSubmitReviewCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.SubmitReview at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SubmitReviewMock.expectedInvocations), m.SubmitReviewMock.expectedInvocationsOrigin, afterSubmitReviewCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetPullRequestTeamInspect()
+
+			m.MinimockGetTeamPolicyInspect()
+
+			m.MinimockReRequestReviewInspect()
+
+			m.MinimockSubmitReviewInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetPullRequestTeamDone() &&
+		m.MinimockGetTeamPolicyDone() &&
+		m.MinimockReRequestReviewDone() &&
+		m.MinimockSubmitReviewDone()
+}
@@ -0,0 +1,101 @@
+package review
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		SubmitReview(ctx context.Context, review domain.SubmitReview) (domain.PullRequest, error)
+		ReRequestReview(ctx context.Context, request domain.ReRequestReview, dismissApprovals bool) (domain.PullRequest, error)
+		GetPullRequestTeam(ctx context.Context, pullRequestID string) (string, error)
+		GetTeamPolicy(ctx context.Context, teamName string, version int) (domain.TeamPolicy, error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// SubmitReview records the verdict of a current reviewer and returns the PR with the latest verdicts.
+func (h *Handler) SubmitReview(ctx context.Context, review domain.SubmitReview) (domain.PullRequest, error) {
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.review"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	pr, err := h.repo.SubmitReview(ctx, review)
+	if err != nil {
+		logger.Error("repo.SubmitReview", zap.Error(err), zap.String("pull_request_id", review.PullRequestID),
+			zap.String("user_id", review.UserID))
+		return domain.PullRequest{}, fmt.Errorf("repo.SubmitReview: %w", err)
+	}
+
+	logger.Info("review submitted", zap.String("pull_request_id", review.PullRequestID),
+		zap.String("user_id", review.UserID), zap.String("verdict", string(review.Verdict)))
+
+	return pr, nil
+}
+
+// ReRequestReview puts the reviewer back to pending. Earlier approvals of the other reviewers are
+// dismissed when the quorum of the author's team asks for it.
+func (h *Handler) ReRequestReview(ctx context.Context, request domain.ReRequestReview) (domain.PullRequest, error) {
+	logger := h.logger.With(
+		zap.String("service", "pullRequest.reRequestReview"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	quorum, err := h.quorum(ctx, request.PullRequestID)
+	if err != nil {
+		logger.Error("quorum", zap.Error(err), zap.String("pull_request_id", request.PullRequestID))
+		return domain.PullRequest{}, err
+	}
+
+	pr, err := h.repo.ReRequestReview(ctx, request, quorum.DismissStaleApprovals)
+	if err != nil {
+		logger.Error("repo.ReRequestReview", zap.Error(err), zap.String("pull_request_id", request.PullRequestID),
+			zap.String("user_id", request.UserID))
+		return domain.PullRequest{}, fmt.Errorf("repo.ReRequestReview: %w", err)
+	}
+
+	logger.Info("review re-requested", zap.String("pull_request_id", request.PullRequestID),
+		zap.String("user_id", request.UserID), zap.Bool("dismiss_stale_approvals", quorum.DismissStaleApprovals))
+
+	return pr, nil
+}
+
+func (h *Handler) quorum(ctx context.Context, pullRequestID string) (domain.QuorumPolicy, error) {
+	teamName, err := h.repo.GetPullRequestTeam(ctx, pullRequestID)
+	if err != nil {
+		return domain.QuorumPolicy{}, fmt.Errorf("repo.GetPullRequestTeam: %w", err)
+	}
+
+	teamPolicy, err := h.repo.GetTeamPolicy(ctx, teamName, 0)
+	if err != nil {
+		if errors.Is(err, domain.ErrPolicyNotFound) {
+			return domain.DefaultQuorumPolicy(), nil
+		}
+		return domain.QuorumPolicy{}, fmt.Errorf("repo.GetTeamPolicy: %w", err)
+	}
+
+	return teamPolicy.Policy.MergeQuorum(), nil
+}
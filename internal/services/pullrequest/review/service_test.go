@@ -0,0 +1,160 @@
+package review
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func TestHandler_SubmitReview(t *testing.T) {
+	t.Parallel()
+
+	review := domain.SubmitReview{PullRequestID: "pr-1", UserID: "u2", Verdict: domain.VerdictApproved}
+	approved := domain.PullRequest{
+		PullRequestID: "pr-1",
+		Status:        domain.PRStatusOpen,
+		Reviewers: []domain.Reviewer{
+			{UserID: "u2", Role: domain.ReviewerRoleReviewer, Verdict: domain.VerdictApproved},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		repo    func(mc *minimock.Controller) repository
+		want    domain.PullRequest
+		wantErr error
+	}{
+		{
+			name: "success: verdict on an open PR",
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.SubmitReviewMock.Expect(minimock.AnyContext, review).Return(approved, nil)
+				return repo
+			},
+			want: approved,
+		},
+		{
+			name: "error: PR that is not open takes no verdicts",
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.SubmitReviewMock.Expect(minimock.AnyContext, review).
+					Return(domain.PullRequest{}, domain.ErrPRNotOpen)
+				return repo
+			},
+			wantErr: domain.ErrPRNotOpen,
+		},
+		{
+			name: "error: merged PR takes no verdicts",
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.SubmitReviewMock.Expect(minimock.AnyContext, review).
+					Return(domain.PullRequest{}, domain.ErrPRMerged)
+				return repo
+			},
+			wantErr: domain.ErrPRMerged,
+		},
+		{
+			name: "error: not a current reviewer",
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.SubmitReviewMock.Expect(minimock.AnyContext, review).
+					Return(domain.PullRequest{}, domain.ErrNotAssigned)
+				return repo
+			},
+			wantErr: domain.ErrNotAssigned,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			handler := New(tt.repo(mc), zap.NewNop())
+
+			got, err := handler.SubmitReview(context.Background(), review)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, domain.PullRequest{}, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHandler_ReRequestReview(t *testing.T) {
+	t.Parallel()
+
+	withQuorum := func(quorum domain.QuorumPolicy) domain.TeamPolicy {
+		policy := domain.DefaultAssignmentPolicy()
+		policy.Quorum = &quorum
+		return domain.TeamPolicy{Policy: policy}
+	}
+	request := domain.ReRequestReview{PullRequestID: "pr-1", UserID: "u2"}
+
+	tests := []struct {
+		name string
+		repo func(mc *minimock.Controller) repository
+	}{
+		{
+			name: "default policy keeps approvals",
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.GetPullRequestTeamMock.Expect(minimock.AnyContext, "pr-1").Return("backend", nil)
+				repo.GetTeamPolicyMock.Expect(minimock.AnyContext, "backend", 0).
+					Return(domain.TeamPolicy{}, domain.ErrPolicyNotFound)
+				repo.ReRequestReviewMock.Expect(minimock.AnyContext, request, false).
+					Return(domain.PullRequest{PullRequestID: "pr-1"}, nil)
+				return repo
+			},
+		},
+		{
+			name: "quorum without dismissal keeps approvals",
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.GetPullRequestTeamMock.Return("backend", nil)
+				repo.GetTeamPolicyMock.Return(withQuorum(domain.QuorumPolicy{Approvals: 2}), nil)
+				repo.ReRequestReviewMock.Expect(minimock.AnyContext, request, false).
+					Return(domain.PullRequest{PullRequestID: "pr-1"}, nil)
+				return repo
+			},
+		},
+		{
+			name: "stale approvals are dismissed",
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.GetPullRequestTeamMock.Return("backend", nil)
+				repo.GetTeamPolicyMock.Return(
+					withQuorum(domain.QuorumPolicy{Approvals: 2, DismissStaleApprovals: true}), nil)
+				repo.ReRequestReviewMock.Expect(minimock.AnyContext, request, true).
+					Return(domain.PullRequest{PullRequestID: "pr-1"}, nil)
+				return repo
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			handler := New(tt.repo(mc), zap.NewNop())
+
+			pr, err := handler.ReRequestReview(context.Background(), request)
+
+			require.NoError(t, err)
+			assert.Equal(t, "pr-1", pr.PullRequestID)
+		})
+	}
+}
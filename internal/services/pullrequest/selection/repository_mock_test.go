@@ -0,0 +1,2229 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package selection
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/pullrequest/selection.repository -o repository_mock_test.go -n RepositoryMock -p selection
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetAssignmentPool          func(ctx context.Context, authorID string) (a1 domain.AssignmentPool, err error)
+	funcGetAssignmentPoolOrigin    string
+	inspectFuncGetAssignmentPool   func(ctx context.Context, authorID string)
+	afterGetAssignmentPoolCounter  uint64
+	beforeGetAssignmentPoolCounter uint64
+	GetAssignmentPoolMock          mRepositoryMockGetAssignmentPool
+
+	funcGetCandidates          func(ctx context.Context, userIDs []string) (ca1 []domain.Candidate, err error)
+	funcGetCandidatesOrigin    string
+	inspectFuncGetCandidates   func(ctx context.Context, userIDs []string)
+	afterGetCandidatesCounter  uint64
+	beforeGetCandidatesCounter uint64
+	GetCandidatesMock          mRepositoryMockGetCandidates
+
+	funcGetReviewCounts          func(ctx context.Context, filter domain.KnowledgeFilter) (ra1 []domain.ReviewCount, err error)
+	funcGetReviewCountsOrigin    string
+	inspectFuncGetReviewCounts   func(ctx context.Context, filter domain.KnowledgeFilter)
+	afterGetReviewCountsCounter  uint64
+	beforeGetReviewCountsCounter uint64
+	GetReviewCountsMock          mRepositoryMockGetReviewCounts
+
+	funcGetStackReviewers          func(ctx context.Context, pullRequestIDs []string) (sa1 []string, err error)
+	funcGetStackReviewersOrigin    string
+	inspectFuncGetStackReviewers   func(ctx context.Context, pullRequestIDs []string)
+	afterGetStackReviewersCounter  uint64
+	beforeGetStackReviewersCounter uint64
+	GetStackReviewersMock          mRepositoryMockGetStackReviewers
+
+	funcGetTeamCandidates          func(ctx context.Context, teamName string) (ca1 []domain.Candidate, err error)
+	funcGetTeamCandidatesOrigin    string
+	inspectFuncGetTeamCandidates   func(ctx context.Context, teamName string)
+	afterGetTeamCandidatesCounter  uint64
+	beforeGetTeamCandidatesCounter uint64
+	GetTeamCandidatesMock          mRepositoryMockGetTeamCandidates
+
+	funcGetTeamPolicy          func(ctx context.Context, teamName string, version int) (t1 domain.TeamPolicy, err error)
+	funcGetTeamPolicyOrigin    string
+	inspectFuncGetTeamPolicy   func(ctx context.Context, teamName string, version int)
+	afterGetTeamPolicyCounter  uint64
+	beforeGetTeamPolicyCounter uint64
+	GetTeamPolicyMock          mRepositoryMockGetTeamPolicy
+}
+
+// NewRepositoryMock returns a mock for repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetAssignmentPoolMock = mRepositoryMockGetAssignmentPool{mock: m}
+	m.GetAssignmentPoolMock.callArgs = []*RepositoryMockGetAssignmentPoolParams{}
+
+	m.GetCandidatesMock = mRepositoryMockGetCandidates{mock: m}
+	m.GetCandidatesMock.callArgs = []*RepositoryMockGetCandidatesParams{}
+
+	m.GetReviewCountsMock = mRepositoryMockGetReviewCounts{mock: m}
+	m.GetReviewCountsMock.callArgs = []*RepositoryMockGetReviewCountsParams{}
+
+	m.GetStackReviewersMock = mRepositoryMockGetStackReviewers{mock: m}
+	m.GetStackReviewersMock.callArgs = []*RepositoryMockGetStackReviewersParams{}
+
+	m.GetTeamCandidatesMock = mRepositoryMockGetTeamCandidates{mock: m}
+	m.GetTeamCandidatesMock.callArgs = []*RepositoryMockGetTeamCandidatesParams{}
+
+	m.GetTeamPolicyMock = mRepositoryMockGetTeamPolicy{mock: m}
+	m.GetTeamPolicyMock.callArgs = []*RepositoryMockGetTeamPolicyParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockGetAssignmentPool struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetAssignmentPoolExpectation
+	expectations       []*RepositoryMockGetAssignmentPoolExpectation
+
+	callArgs []*RepositoryMockGetAssignmentPoolParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetAssignmentPoolExpectation specifies expectation struct of the repository.GetAssignmentPool
+type RepositoryMockGetAssignmentPoolExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetAssignmentPoolParams
+	paramPtrs          *RepositoryMockGetAssignmentPoolParamPtrs
+	expectationOrigins RepositoryMockGetAssignmentPoolExpectationOrigins
+	results            *RepositoryMockGetAssignmentPoolResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetAssignmentPoolParams contains parameters of the repository.GetAssignmentPool
+type RepositoryMockGetAssignmentPoolParams struct {
+	ctx      context.Context
+	authorID string
+}
+
+// RepositoryMockGetAssignmentPoolParamPtrs contains pointers to parameters of the repository.GetAssignmentPool
+type RepositoryMockGetAssignmentPoolParamPtrs struct {
+	ctx      *context.Context
+	authorID *string
+}
+
+// RepositoryMockGetAssignmentPoolResults contains results of the repository.GetAssignmentPool
+type RepositoryMockGetAssignmentPoolResults struct {
+	a1  domain.AssignmentPool
+	err error
+}
+
+// RepositoryMockGetAssignmentPoolOrigins contains origins of expectations of the repository.GetAssignmentPool
+type RepositoryMockGetAssignmentPoolExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originAuthorID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAssignmentPool *mRepositoryMockGetAssignmentPool) Optional() *mRepositoryMockGetAssignmentPool {
+	mmGetAssignmentPool.optional = true
+	return mmGetAssignmentPool
+}
+
+// Expect sets up expected params for repository.GetAssignmentPool
+func (mmGetAssignmentPool *mRepositoryMockGetAssignmentPool) Expect(ctx context.Context, authorID string) *mRepositoryMockGetAssignmentPool {
+	if mmGetAssignmentPool.mock.funcGetAssignmentPool != nil {
+		mmGetAssignmentPool.mock.t.Fatalf("RepositoryMock.GetAssignmentPool mock is already set by Set")
+	}
+
+	if mmGetAssignmentPool.defaultExpectation == nil {
+		mmGetAssignmentPool.defaultExpectation = &RepositoryMockGetAssignmentPoolExpectation{}
+	}
+
+	if mmGetAssignmentPool.defaultExpectation.paramPtrs != nil {
+		mmGetAssignmentPool.mock.t.Fatalf("RepositoryMock.GetAssignmentPool mock is already set by ExpectParams functions")
+	}
+
+	mmGetAssignmentPool.defaultExpectation.params = &RepositoryMockGetAssignmentPoolParams{ctx, authorID}
+	mmGetAssignmentPool.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAssignmentPool.expectations {
+		if minimock.Equal(e.params, mmGetAssignmentPool.defaultExpectation.params) {
+			mmGetAssignmentPool.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAssignmentPool.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAssignmentPool
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.GetAssignmentPool
+func (mmGetAssignmentPool *mRepositoryMockGetAssignmentPool) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetAssignmentPool {
+	if mmGetAssignmentPool.mock.funcGetAssignmentPool != nil {
+		mmGetAssignmentPool.mock.t.Fatalf("RepositoryMock.GetAssignmentPool mock is already set by Set")
+	}
+
+	if mmGetAssignmentPool.defaultExpectation == nil {
+		mmGetAssignmentPool.defaultExpectation = &RepositoryMockGetAssignmentPoolExpectation{}
+	}
+
+	if mmGetAssignmentPool.defaultExpectation.params != nil {
+		mmGetAssignmentPool.mock.t.Fatalf("RepositoryMock.GetAssignmentPool mock is already set by Expect")
+	}
+
+	if mmGetAssignmentPool.defaultExpectation.paramPtrs == nil {
+		mmGetAssignmentPool.defaultExpectation.paramPtrs = &RepositoryMockGetAssignmentPoolParamPtrs{}
+	}
+	mmGetAssignmentPool.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAssignmentPool.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAssignmentPool
+}
+
+// ExpectAuthorIDParam2 sets up expected param authorID for repository.GetAssignmentPool
+func (mmGetAssignmentPool *mRepositoryMockGetAssignmentPool) ExpectAuthorIDParam2(authorID string) *mRepositoryMockGetAssignmentPool {
+	if mmGetAssignmentPool.mock.funcGetAssignmentPool != nil {
+		mmGetAssignmentPool.mock.t.Fatalf("RepositoryMock.GetAssignmentPool mock is already set by Set")
+	}
+
+	if mmGetAssignmentPool.defaultExpectation == nil {
+		mmGetAssignmentPool.defaultExpectation = &RepositoryMockGetAssignmentPoolExpectation{}
+	}
+
+	if mmGetAssignmentPool.defaultExpectation.params != nil {
+		mmGetAssignmentPool.mock.t.Fatalf("RepositoryMock.GetAssignmentPool mock is already set by Expect")
+	}
+
+	if mmGetAssignmentPool.defaultExpectation.paramPtrs == nil {
+		mmGetAssignmentPool.defaultExpectation.paramPtrs = &RepositoryMockGetAssignmentPoolParamPtrs{}
+	}
+	mmGetAssignmentPool.defaultExpectation.paramPtrs.authorID = &authorID
+	mmGetAssignmentPool.defaultExpectation.expectationOrigins.originAuthorID = minimock.CallerInfo(1)
+
+	return mmGetAssignmentPool
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.GetAssignmentPool
+func (mmGetAssignmentPool *mRepositoryMockGetAssignmentPool) Inspect(f func(ctx context.Context, authorID string)) *mRepositoryMockGetAssignmentPool {
+	if mmGetAssignmentPool.mock.inspectFuncGetAssignmentPool != nil {
+		mmGetAssignmentPool.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetAssignmentPool")
+	}
+
+	mmGetAssignmentPool.mock.inspectFuncGetAssignmentPool = f
+
+	return mmGetAssignmentPool
+}
+
+// Return sets up results that will be returned by repository.GetAssignmentPool
+func (mmGetAssignmentPool *mRepositoryMockGetAssignmentPool) Return(a1 domain.AssignmentPool, err error) *RepositoryMock {
+	if mmGetAssignmentPool.mock.funcGetAssignmentPool != nil {
+		mmGetAssignmentPool.mock.t.Fatalf("RepositoryMock.GetAssignmentPool mock is already set by Set")
+	}
+
+	if mmGetAssignmentPool.defaultExpectation == nil {
+		mmGetAssignmentPool.defaultExpectation = &RepositoryMockGetAssignmentPoolExpectation{mock: mmGetAssignmentPool.mock}
+	}
+	mmGetAssignmentPool.defaultExpectation.results = &RepositoryMockGetAssignmentPoolResults{a1, err}
+	mmGetAssignmentPool.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAssignmentPool.mock
+}
+
+// Set uses given function f to mock the repository.GetAssignmentPool method
+func (mmGetAssignmentPool *mRepositoryMockGetAssignmentPool) Set(f func(ctx context.Context, authorID string) (a1 domain.AssignmentPool, err error)) *RepositoryMock {
+	if mmGetAssignmentPool.defaultExpectation != nil {
+		mmGetAssignmentPool.mock.t.Fatalf("Default expectation is already set for the repository.GetAssignmentPool method")
+	}
+
+	if len(mmGetAssignmentPool.expectations) > 0 {
+		mmGetAssignmentPool.mock.t.Fatalf("Some expectations are already set for the repository.GetAssignmentPool method")
+	}
+
+	mmGetAssignmentPool.mock.funcGetAssignmentPool = f
+	mmGetAssignmentPool.mock.funcGetAssignmentPoolOrigin = minimock.CallerInfo(1)
+	return mmGetAssignmentPool.mock
+}
+
+// When sets expectation for the repository.GetAssignmentPool which will trigger the result defined by the following
+// Then helper
+func (mmGetAssignmentPool *mRepositoryMockGetAssignmentPool) When(ctx context.Context, authorID string) *RepositoryMockGetAssignmentPoolExpectation {
+	if mmGetAssignmentPool.mock.funcGetAssignmentPool != nil {
+		mmGetAssignmentPool.mock.t.Fatalf("RepositoryMock.GetAssignmentPool mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetAssignmentPoolExpectation{
+		mock:               mmGetAssignmentPool.mock,
+		params:             &RepositoryMockGetAssignmentPoolParams{ctx, authorID},
+		expectationOrigins: RepositoryMockGetAssignmentPoolExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAssignmentPool.expectations = append(mmGetAssignmentPool.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.GetAssignmentPool return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetAssignmentPoolExpectation) Then(a1 domain.AssignmentPool, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetAssignmentPoolResults{a1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.GetAssignmentPool should be invoked
+func (mmGetAssignmentPool *mRepositoryMockGetAssignmentPool) Times(n uint64) *mRepositoryMockGetAssignmentPool {
+	if n == 0 {
+		mmGetAssignmentPool.mock.t.Fatalf("Times of RepositoryMock.GetAssignmentPool mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAssignmentPool.expectedInvocations, n)
+	mmGetAssignmentPool.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAssignmentPool
+}
+
+func (mmGetAssignmentPool *mRepositoryMockGetAssignmentPool) invocationsDone() bool {
+	if len(mmGetAssignmentPool.expectations) == 0 && mmGetAssignmentPool.defaultExpectation == nil && mmGetAssignmentPool.mock.funcGetAssignmentPool == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAssignmentPool.mock.afterGetAssignmentPoolCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAssignmentPool.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAssignmentPool implements repository
+func (mmGetAssignmentPool *RepositoryMock) GetAssignmentPool(ctx context.Context, authorID string) (a1 domain.AssignmentPool, err error) {
+	mm_atomic.AddUint64(&mmGetAssignmentPool.beforeGetAssignmentPoolCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAssignmentPool.afterGetAssignmentPoolCounter, 1)
+
+	mmGetAssignmentPool.t.Helper()
+
+	if mmGetAssignmentPool.inspectFuncGetAssignmentPool != nil {
+		mmGetAssignmentPool.inspectFuncGetAssignmentPool(ctx, authorID)
+	}
+
+	mm_params := RepositoryMockGetAssignmentPoolParams{ctx, authorID}
+
+	// Record call args
+	mmGetAssignmentPool.GetAssignmentPoolMock.mutex.Lock()
+	mmGetAssignmentPool.GetAssignmentPoolMock.callArgs = append(mmGetAssignmentPool.GetAssignmentPoolMock.callArgs, &mm_params)
+	mmGetAssignmentPool.GetAssignmentPoolMock.mutex.Unlock()
+
+	for _, e := range mmGetAssignmentPool.GetAssignmentPoolMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmGetAssignmentPool.GetAssignmentPoolMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAssignmentPool.GetAssignmentPoolMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAssignmentPool.GetAssignmentPoolMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAssignmentPool.GetAssignmentPoolMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetAssignmentPoolParams{ctx, authorID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAssignmentPool.t.Errorf("RepositoryMock.GetAssignmentPool got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAssignmentPool.GetAssignmentPoolMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.authorID != nil && !minimock.Equal(*mm_want_ptrs.authorID, mm_got.authorID) {
+				mmGetAssignmentPool.t.Errorf("RepositoryMock.GetAssignmentPool got unexpected parameter authorID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAssignmentPool.GetAssignmentPoolMock.defaultExpectation.expectationOrigins.originAuthorID, *mm_want_ptrs.authorID, mm_got.authorID, minimock.Diff(*mm_want_ptrs.authorID, mm_got.authorID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAssignmentPool.t.Errorf("RepositoryMock.GetAssignmentPool got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAssignmentPool.GetAssignmentPoolMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAssignmentPool.GetAssignmentPoolMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAssignmentPool.t.Fatal("No results are set for the RepositoryMock.GetAssignmentPool")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmGetAssignmentPool.funcGetAssignmentPool != nil {
+		return mmGetAssignmentPool.funcGetAssignmentPool(ctx, authorID)
+	}
+	mmGetAssignmentPool.t.Fatalf("Unexpected call to RepositoryMock.GetAssignmentPool. %v %v", ctx, authorID)
+	return
+}
+
+// GetAssignmentPoolAfterCounter returns a count of finished RepositoryMock.GetAssignmentPool invocations
+func (mmGetAssignmentPool *RepositoryMock) GetAssignmentPoolAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAssignmentPool.afterGetAssignmentPoolCounter)
+}
+
+// GetAssignmentPoolBeforeCounter returns a count of RepositoryMock.GetAssignmentPool invocations
+func (mmGetAssignmentPool *RepositoryMock) GetAssignmentPoolBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAssignmentPool.beforeGetAssignmentPoolCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetAssignmentPool.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAssignmentPool *mRepositoryMockGetAssignmentPool) Calls() []*RepositoryMockGetAssignmentPoolParams {
+	mmGetAssignmentPool.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetAssignmentPoolParams, len(mmGetAssignmentPool.callArgs))
+	copy(argCopy, mmGetAssignmentPool.callArgs)
+
+	mmGetAssignmentPool.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAssignmentPoolDone returns true if the count of the GetAssignmentPool invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetAssignmentPoolDone() bool {
+	if m.GetAssignmentPoolMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAssignmentPoolMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAssignmentPoolMock.invocationsDone()
+}
+
+// MinimockGetAssignmentPoolInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetAssignmentPoolInspect() {
+	for _, e := range m.GetAssignmentPoolMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetAssignmentPool at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAssignmentPoolCounter := mm_atomic.LoadUint64(&m.afterGetAssignmentPoolCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAssignmentPoolMock.defaultExpectation != nil && afterGetAssignmentPoolCounter < 1 {
+		if m.GetAssignmentPoolMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetAssignmentPool at\n%s", m.GetAssignmentPoolMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetAssignmentPool at\n%s with params: %#v", m.GetAssignmentPoolMock.defaultExpectation.expectationOrigins.origin, *m.GetAssignmentPoolMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAssignmentPool != nil && afterGetAssignmentPoolCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetAssignmentPool at\n%s", m.funcGetAssignmentPoolOrigin)
+	}
+
+	if !m.GetAssignmentPoolMock.invocationsDone() && afterGetAssignmentPoolCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetAssignmentPool at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAssignmentPoolMock.expectedInvocations), m.GetAssignmentPoolMock.expectedInvocationsOrigin, afterGetAssignmentPoolCounter)
+	}
+}
+
+type mRepositoryMockGetCandidates struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetCandidatesExpectation
+	expectations       []*RepositoryMockGetCandidatesExpectation
+
+	callArgs []*RepositoryMockGetCandidatesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetCandidatesExpectation specifies expectation struct of the repository.GetCandidates
+type RepositoryMockGetCandidatesExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetCandidatesParams
+	paramPtrs          *RepositoryMockGetCandidatesParamPtrs
+	expectationOrigins RepositoryMockGetCandidatesExpectationOrigins
+	results            *RepositoryMockGetCandidatesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetCandidatesParams contains parameters of the repository.GetCandidates
+type RepositoryMockGetCandidatesParams struct {
+	ctx     context.Context
+	userIDs []string
+}
+
+// RepositoryMockGetCandidatesParamPtrs contains pointers to parameters of the repository.GetCandidates
+type RepositoryMockGetCandidatesParamPtrs struct {
+	ctx     *context.Context
+	userIDs *[]string
+}
+
+// RepositoryMockGetCandidatesResults contains results of the repository.GetCandidates
+type RepositoryMockGetCandidatesResults struct {
+	ca1 []domain.Candidate
+	err error
+}
+
+// RepositoryMockGetCandidatesOrigins contains origins of expectations of the repository.GetCandidates
+type RepositoryMockGetCandidatesExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCandidates *mRepositoryMockGetCandidates) Optional() *mRepositoryMockGetCandidates {
+	mmGetCandidates.optional = true
+	return mmGetCandidates
+}
+
+// Expect sets up expected params for repository.GetCandidates
+func (mmGetCandidates *mRepositoryMockGetCandidates) Expect(ctx context.Context, userIDs []string) *mRepositoryMockGetCandidates {
+	if mmGetCandidates.mock.funcGetCandidates != nil {
+		mmGetCandidates.mock.t.Fatalf("RepositoryMock.GetCandidates mock is already set by Set")
+	}
+
+	if mmGetCandidates.defaultExpectation == nil {
+		mmGetCandidates.defaultExpectation = &RepositoryMockGetCandidatesExpectation{}
+	}
+
+	if mmGetCandidates.defaultExpectation.paramPtrs != nil {
+		mmGetCandidates.mock.t.Fatalf("RepositoryMock.GetCandidates mock is already set by ExpectParams functions")
+	}
+
+	mmGetCandidates.defaultExpectation.params = &RepositoryMockGetCandidatesParams{ctx, userIDs}
+	mmGetCandidates.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCandidates.expectations {
+		if minimock.Equal(e.params, mmGetCandidates.defaultExpectation.params) {
+			mmGetCandidates.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCandidates.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCandidates
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.GetCandidates
+func (mmGetCandidates *mRepositoryMockGetCandidates) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetCandidates {
+	if mmGetCandidates.mock.funcGetCandidates != nil {
+		mmGetCandidates.mock.t.Fatalf("RepositoryMock.GetCandidates mock is already set by Set")
+	}
+
+	if mmGetCandidates.defaultExpectation == nil {
+		mmGetCandidates.defaultExpectation = &RepositoryMockGetCandidatesExpectation{}
+	}
+
+	if mmGetCandidates.defaultExpectation.params != nil {
+		mmGetCandidates.mock.t.Fatalf("RepositoryMock.GetCandidates mock is already set by Expect")
+	}
+
+	if mmGetCandidates.defaultExpectation.paramPtrs == nil {
+		mmGetCandidates.defaultExpectation.paramPtrs = &RepositoryMockGetCandidatesParamPtrs{}
+	}
+	mmGetCandidates.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCandidates.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCandidates
+}
+
+// ExpectUserIDsParam2 sets up expected param userIDs for repository.GetCandidates
+func (mmGetCandidates *mRepositoryMockGetCandidates) ExpectUserIDsParam2(userIDs []string) *mRepositoryMockGetCandidates {
+	if mmGetCandidates.mock.funcGetCandidates != nil {
+		mmGetCandidates.mock.t.Fatalf("RepositoryMock.GetCandidates mock is already set by Set")
+	}
+
+	if mmGetCandidates.defaultExpectation == nil {
+		mmGetCandidates.defaultExpectation = &RepositoryMockGetCandidatesExpectation{}
+	}
+
+	if mmGetCandidates.defaultExpectation.params != nil {
+		mmGetCandidates.mock.t.Fatalf("RepositoryMock.GetCandidates mock is already set by Expect")
+	}
+
+	if mmGetCandidates.defaultExpectation.paramPtrs == nil {
+		mmGetCandidates.defaultExpectation.paramPtrs = &RepositoryMockGetCandidatesParamPtrs{}
+	}
+	mmGetCandidates.defaultExpectation.paramPtrs.userIDs = &userIDs
+	mmGetCandidates.defaultExpectation.expectationOrigins.originUserIDs = minimock.CallerInfo(1)
+
+	return mmGetCandidates
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.GetCandidates
+func (mmGetCandidates *mRepositoryMockGetCandidates) Inspect(f func(ctx context.Context, userIDs []string)) *mRepositoryMockGetCandidates {
+	if mmGetCandidates.mock.inspectFuncGetCandidates != nil {
+		mmGetCandidates.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetCandidates")
+	}
+
+	mmGetCandidates.mock.inspectFuncGetCandidates = f
+
+	return mmGetCandidates
+}
+
+// Return sets up results that will be returned by repository.GetCandidates
+func (mmGetCandidates *mRepositoryMockGetCandidates) Return(ca1 []domain.Candidate, err error) *RepositoryMock {
+	if mmGetCandidates.mock.funcGetCandidates != nil {
+		mmGetCandidates.mock.t.Fatalf("RepositoryMock.GetCandidates mock is already set by Set")
+	}
+
+	if mmGetCandidates.defaultExpectation == nil {
+		mmGetCandidates.defaultExpectation = &RepositoryMockGetCandidatesExpectation{mock: mmGetCandidates.mock}
+	}
+	mmGetCandidates.defaultExpectation.results = &RepositoryMockGetCandidatesResults{ca1, err}
+	mmGetCandidates.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCandidates.mock
+}
+
+// Set uses given function f to mock the repository.GetCandidates method
+func (mmGetCandidates *mRepositoryMockGetCandidates) Set(f func(ctx context.Context, userIDs []string) (ca1 []domain.Candidate, err error)) *RepositoryMock {
+	if mmGetCandidates.defaultExpectation != nil {
+		mmGetCandidates.mock.t.Fatalf("Default expectation is already set for the repository.GetCandidates method")
+	}
+
+	if len(mmGetCandidates.expectations) > 0 {
+		mmGetCandidates.mock.t.Fatalf("Some expectations are already set for the repository.GetCandidates method")
+	}
+
+	mmGetCandidates.mock.funcGetCandidates = f
+	mmGetCandidates.mock.funcGetCandidatesOrigin = minimock.CallerInfo(1)
+	return mmGetCandidates.mock
+}
+
+// When sets expectation for the repository.GetCandidates which will trigger the result defined by the following
+// Then helper
+func (mmGetCandidates *mRepositoryMockGetCandidates) When(ctx context.Context, userIDs []string) *RepositoryMockGetCandidatesExpectation {
+	if mmGetCandidates.mock.funcGetCandidates != nil {
+		mmGetCandidates.mock.t.Fatalf("RepositoryMock.GetCandidates mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetCandidatesExpectation{
+		mock:               mmGetCandidates.mock,
+		params:             &RepositoryMockGetCandidatesParams{ctx, userIDs},
+		expectationOrigins: RepositoryMockGetCandidatesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCandidates.expectations = append(mmGetCandidates.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.GetCandidates return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetCandidatesExpectation) Then(ca1 []domain.Candidate, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetCandidatesResults{ca1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.GetCandidates should be invoked
+func (mmGetCandidates *mRepositoryMockGetCandidates) Times(n uint64) *mRepositoryMockGetCandidates {
+	if n == 0 {
+		mmGetCandidates.mock.t.Fatalf("Times of RepositoryMock.GetCandidates mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCandidates.expectedInvocations, n)
+	mmGetCandidates.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCandidates
+}
+
+func (mmGetCandidates *mRepositoryMockGetCandidates) invocationsDone() bool {
+	if len(mmGetCandidates.expectations) == 0 && mmGetCandidates.defaultExpectation == nil && mmGetCandidates.mock.funcGetCandidates == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCandidates.mock.afterGetCandidatesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCandidates.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCandidates implements repository
+func (mmGetCandidates *RepositoryMock) GetCandidates(ctx context.Context, userIDs []string) (ca1 []domain.Candidate, err error) {
+	mm_atomic.AddUint64(&mmGetCandidates.beforeGetCandidatesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCandidates.afterGetCandidatesCounter, 1)
+
+	mmGetCandidates.t.Helper()
+
+	if mmGetCandidates.inspectFuncGetCandidates != nil {
+		mmGetCandidates.inspectFuncGetCandidates(ctx, userIDs)
+	}
+
+	mm_params := RepositoryMockGetCandidatesParams{ctx, userIDs}
+
+	// Record call args
+	mmGetCandidates.GetCandidatesMock.mutex.Lock()
+	mmGetCandidates.GetCandidatesMock.callArgs = append(mmGetCandidates.GetCandidatesMock.callArgs, &mm_params)
+	mmGetCandidates.GetCandidatesMock.mutex.Unlock()
+
+	for _, e := range mmGetCandidates.GetCandidatesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1, e.results.err
+		}
+	}
+
+	if mmGetCandidates.GetCandidatesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCandidates.GetCandidatesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCandidates.GetCandidatesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCandidates.GetCandidatesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetCandidatesParams{ctx, userIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCandidates.t.Errorf("RepositoryMock.GetCandidates got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCandidates.GetCandidatesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userIDs != nil && !minimock.Equal(*mm_want_ptrs.userIDs, mm_got.userIDs) {
+				mmGetCandidates.t.Errorf("RepositoryMock.GetCandidates got unexpected parameter userIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCandidates.GetCandidatesMock.defaultExpectation.expectationOrigins.originUserIDs, *mm_want_ptrs.userIDs, mm_got.userIDs, minimock.Diff(*mm_want_ptrs.userIDs, mm_got.userIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCandidates.t.Errorf("RepositoryMock.GetCandidates got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCandidates.GetCandidatesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCandidates.GetCandidatesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCandidates.t.Fatal("No results are set for the RepositoryMock.GetCandidates")
+		}
+		return (*mm_results).ca1, (*mm_results).err
+	}
+	if mmGetCandidates.funcGetCandidates != nil {
+		return mmGetCandidates.funcGetCandidates(ctx, userIDs)
+	}
+	mmGetCandidates.t.Fatalf("Unexpected call to RepositoryMock.GetCandidates. %v %v", ctx, userIDs)
+	return
+}
+
+// GetCandidatesAfterCounter returns a count of finished RepositoryMock.GetCandidates invocations
+func (mmGetCandidates *RepositoryMock) GetCandidatesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCandidates.afterGetCandidatesCounter)
+}
+
+// GetCandidatesBeforeCounter returns a count of RepositoryMock.GetCandidates invocations
+func (mmGetCandidates *RepositoryMock) GetCandidatesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCandidates.beforeGetCandidatesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetCandidates.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCandidates *mRepositoryMockGetCandidates) Calls() []*RepositoryMockGetCandidatesParams {
+	mmGetCandidates.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetCandidatesParams, len(mmGetCandidates.callArgs))
+	copy(argCopy, mmGetCandidates.callArgs)
+
+	mmGetCandidates.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCandidatesDone returns true if the count of the GetCandidates invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetCandidatesDone() bool {
+	if m.GetCandidatesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCandidatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCandidatesMock.invocationsDone()
+}
+
+// MinimockGetCandidatesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetCandidatesInspect() {
+	for _, e := range m.GetCandidatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetCandidates at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCandidatesCounter := mm_atomic.LoadUint64(&m.afterGetCandidatesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCandidatesMock.defaultExpectation != nil && afterGetCandidatesCounter < 1 {
+		if m.GetCandidatesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetCandidates at\n%s", m.GetCandidatesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetCandidates at\n%s with params: %#v", m.GetCandidatesMock.defaultExpectation.expectationOrigins.origin, *m.GetCandidatesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCandidates != nil && afterGetCandidatesCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetCandidates at\n%s", m.funcGetCandidatesOrigin)
+	}
+
+	if !m.GetCandidatesMock.invocationsDone() && afterGetCandidatesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetCandidates at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCandidatesMock.expectedInvocations), m.GetCandidatesMock.expectedInvocationsOrigin, afterGetCandidatesCounter)
+	}
+}
+
+type mRepositoryMockGetReviewCounts struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetReviewCountsExpectation
+	expectations       []*RepositoryMockGetReviewCountsExpectation
+
+	callArgs []*RepositoryMockGetReviewCountsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetReviewCountsExpectation specifies expectation struct of the repository.GetReviewCounts
+type RepositoryMockGetReviewCountsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetReviewCountsParams
+	paramPtrs          *RepositoryMockGetReviewCountsParamPtrs
+	expectationOrigins RepositoryMockGetReviewCountsExpectationOrigins
+	results            *RepositoryMockGetReviewCountsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetReviewCountsParams contains parameters of the repository.GetReviewCounts
+type RepositoryMockGetReviewCountsParams struct {
+	ctx    context.Context
+	filter domain.KnowledgeFilter
+}
+
+// RepositoryMockGetReviewCountsParamPtrs contains pointers to parameters of the repository.GetReviewCounts
+type RepositoryMockGetReviewCountsParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.KnowledgeFilter
+}
+
+// RepositoryMockGetReviewCountsResults contains results of the repository.GetReviewCounts
+type RepositoryMockGetReviewCountsResults struct {
+	ra1 []domain.ReviewCount
+	err error
+}
+
+// RepositoryMockGetReviewCountsOrigins contains origins of expectations of the repository.GetReviewCounts
+type RepositoryMockGetReviewCountsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetReviewCounts *mRepositoryMockGetReviewCounts) Optional() *mRepositoryMockGetReviewCounts {
+	mmGetReviewCounts.optional = true
+	return mmGetReviewCounts
+}
+
+// Expect sets up expected params for repository.GetReviewCounts
+func (mmGetReviewCounts *mRepositoryMockGetReviewCounts) Expect(ctx context.Context, filter domain.KnowledgeFilter) *mRepositoryMockGetReviewCounts {
+	if mmGetReviewCounts.mock.funcGetReviewCounts != nil {
+		mmGetReviewCounts.mock.t.Fatalf("RepositoryMock.GetReviewCounts mock is already set by Set")
+	}
+
+	if mmGetReviewCounts.defaultExpectation == nil {
+		mmGetReviewCounts.defaultExpectation = &RepositoryMockGetReviewCountsExpectation{}
+	}
+
+	if mmGetReviewCounts.defaultExpectation.paramPtrs != nil {
+		mmGetReviewCounts.mock.t.Fatalf("RepositoryMock.GetReviewCounts mock is already set by ExpectParams functions")
+	}
+
+	mmGetReviewCounts.defaultExpectation.params = &RepositoryMockGetReviewCountsParams{ctx, filter}
+	mmGetReviewCounts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetReviewCounts.expectations {
+		if minimock.Equal(e.params, mmGetReviewCounts.defaultExpectation.params) {
+			mmGetReviewCounts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReviewCounts.defaultExpectation.params)
+		}
+	}
+
+	return mmGetReviewCounts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.GetReviewCounts
+func (mmGetReviewCounts *mRepositoryMockGetReviewCounts) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetReviewCounts {
+	if mmGetReviewCounts.mock.funcGetReviewCounts != nil {
+		mmGetReviewCounts.mock.t.Fatalf("RepositoryMock.GetReviewCounts mock is already set by Set")
+	}
+
+	if mmGetReviewCounts.defaultExpectation == nil {
+		mmGetReviewCounts.defaultExpectation = &RepositoryMockGetReviewCountsExpectation{}
+	}
+
+	if mmGetReviewCounts.defaultExpectation.params != nil {
+		mmGetReviewCounts.mock.t.Fatalf("RepositoryMock.GetReviewCounts mock is already set by Expect")
+	}
+
+	if mmGetReviewCounts.defaultExpectation.paramPtrs == nil {
+		mmGetReviewCounts.defaultExpectation.paramPtrs = &RepositoryMockGetReviewCountsParamPtrs{}
+	}
+	mmGetReviewCounts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetReviewCounts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetReviewCounts
+}
+
+// ExpectFilterParam2 sets up expected param filter for repository.GetReviewCounts
+func (mmGetReviewCounts *mRepositoryMockGetReviewCounts) ExpectFilterParam2(filter domain.KnowledgeFilter) *mRepositoryMockGetReviewCounts {
+	if mmGetReviewCounts.mock.funcGetReviewCounts != nil {
+		mmGetReviewCounts.mock.t.Fatalf("RepositoryMock.GetReviewCounts mock is already set by Set")
+	}
+
+	if mmGetReviewCounts.defaultExpectation == nil {
+		mmGetReviewCounts.defaultExpectation = &RepositoryMockGetReviewCountsExpectation{}
+	}
+
+	if mmGetReviewCounts.defaultExpectation.params != nil {
+		mmGetReviewCounts.mock.t.Fatalf("RepositoryMock.GetReviewCounts mock is already set by Expect")
+	}
+
+	if mmGetReviewCounts.defaultExpectation.paramPtrs == nil {
+		mmGetReviewCounts.defaultExpectation.paramPtrs = &RepositoryMockGetReviewCountsParamPtrs{}
+	}
+	mmGetReviewCounts.defaultExpectation.paramPtrs.filter = &filter
+	mmGetReviewCounts.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetReviewCounts
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.GetReviewCounts
+func (mmGetReviewCounts *mRepositoryMockGetReviewCounts) Inspect(f func(ctx context.Context, filter domain.KnowledgeFilter)) *mRepositoryMockGetReviewCounts {
+	if mmGetReviewCounts.mock.inspectFuncGetReviewCounts != nil {
+		mmGetReviewCounts.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetReviewCounts")
+	}
+
+	mmGetReviewCounts.mock.inspectFuncGetReviewCounts = f
+
+	return mmGetReviewCounts
+}
+
+// Return sets up results that will be returned by repository.GetReviewCounts
+func (mmGetReviewCounts *mRepositoryMockGetReviewCounts) Return(ra1 []domain.ReviewCount, err error) *RepositoryMock {
+	if mmGetReviewCounts.mock.funcGetReviewCounts != nil {
+		mmGetReviewCounts.mock.t.Fatalf("RepositoryMock.GetReviewCounts mock is already set by Set")
+	}
+
+	if mmGetReviewCounts.defaultExpectation == nil {
+		mmGetReviewCounts.defaultExpectation = &RepositoryMockGetReviewCountsExpectation{mock: mmGetReviewCounts.mock}
+	}
+	mmGetReviewCounts.defaultExpectation.results = &RepositoryMockGetReviewCountsResults{ra1, err}
+	mmGetReviewCounts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetReviewCounts.mock
+}
+
+// Set uses given function f to mock the repository.GetReviewCounts method
+func (mmGetReviewCounts *mRepositoryMockGetReviewCounts) Set(f func(ctx context.Context, filter domain.KnowledgeFilter) (ra1 []domain.ReviewCount, err error)) *RepositoryMock {
+	if mmGetReviewCounts.defaultExpectation != nil {
+		mmGetReviewCounts.mock.t.Fatalf("Default expectation is already set for the repository.GetReviewCounts method")
+	}
+
+	if len(mmGetReviewCounts.expectations) > 0 {
+		mmGetReviewCounts.mock.t.Fatalf("Some expectations are already set for the repository.GetReviewCounts method")
+	}
+
+	mmGetReviewCounts.mock.funcGetReviewCounts = f
+	mmGetReviewCounts.mock.funcGetReviewCountsOrigin = minimock.CallerInfo(1)
+	return mmGetReviewCounts.mock
+}
+
+// When sets expectation for the repository.GetReviewCounts which will trigger the result defined by the following
+// Then helper
+func (mmGetReviewCounts *mRepositoryMockGetReviewCounts) When(ctx context.Context, filter domain.KnowledgeFilter) *RepositoryMockGetReviewCountsExpectation {
+	if mmGetReviewCounts.mock.funcGetReviewCounts != nil {
+		mmGetReviewCounts.mock.t.Fatalf("RepositoryMock.GetReviewCounts mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetReviewCountsExpectation{
+		mock:               mmGetReviewCounts.mock,
+		params:             &RepositoryMockGetReviewCountsParams{ctx, filter},
+		expectationOrigins: RepositoryMockGetReviewCountsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetReviewCounts.expectations = append(mmGetReviewCounts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.GetReviewCounts return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetReviewCountsExpectation) Then(ra1 []domain.ReviewCount, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetReviewCountsResults{ra1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.GetReviewCounts should be invoked
+func (mmGetReviewCounts *mRepositoryMockGetReviewCounts) Times(n uint64) *mRepositoryMockGetReviewCounts {
+	if n == 0 {
+		mmGetReviewCounts.mock.t.Fatalf("Times of RepositoryMock.GetReviewCounts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetReviewCounts.expectedInvocations, n)
+	mmGetReviewCounts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetReviewCounts
+}
+
+func (mmGetReviewCounts *mRepositoryMockGetReviewCounts) invocationsDone() bool {
+	if len(mmGetReviewCounts.expectations) == 0 && mmGetReviewCounts.defaultExpectation == nil && mmGetReviewCounts.mock.funcGetReviewCounts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetReviewCounts.mock.afterGetReviewCountsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetReviewCounts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetReviewCounts implements repository
+func (mmGetReviewCounts *RepositoryMock) GetReviewCounts(ctx context.Context, filter domain.KnowledgeFilter) (ra1 []domain.ReviewCount, err error) {
+	mm_atomic.AddUint64(&mmGetReviewCounts.beforeGetReviewCountsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReviewCounts.afterGetReviewCountsCounter, 1)
+
+	mmGetReviewCounts.t.Helper()
+
+	if mmGetReviewCounts.inspectFuncGetReviewCounts != nil {
+		mmGetReviewCounts.inspectFuncGetReviewCounts(ctx, filter)
+	}
+
+	mm_params := RepositoryMockGetReviewCountsParams{ctx, filter}
+
+	// Record call args
+	mmGetReviewCounts.GetReviewCountsMock.mutex.Lock()
+	mmGetReviewCounts.GetReviewCountsMock.callArgs = append(mmGetReviewCounts.GetReviewCountsMock.callArgs, &mm_params)
+	mmGetReviewCounts.GetReviewCountsMock.mutex.Unlock()
+
+	for _, e := range mmGetReviewCounts.GetReviewCountsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmGetReviewCounts.GetReviewCountsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReviewCounts.GetReviewCountsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetReviewCounts.GetReviewCountsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetReviewCounts.GetReviewCountsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetReviewCountsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetReviewCounts.t.Errorf("RepositoryMock.GetReviewCounts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReviewCounts.GetReviewCountsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetReviewCounts.t.Errorf("RepositoryMock.GetReviewCounts got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReviewCounts.GetReviewCountsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetReviewCounts.t.Errorf("RepositoryMock.GetReviewCounts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetReviewCounts.GetReviewCountsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetReviewCounts.GetReviewCountsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReviewCounts.t.Fatal("No results are set for the RepositoryMock.GetReviewCounts")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmGetReviewCounts.funcGetReviewCounts != nil {
+		return mmGetReviewCounts.funcGetReviewCounts(ctx, filter)
+	}
+	mmGetReviewCounts.t.Fatalf("Unexpected call to RepositoryMock.GetReviewCounts. %v %v", ctx, filter)
+	return
+}
+
+// GetReviewCountsAfterCounter returns a count of finished RepositoryMock.GetReviewCounts invocations
+func (mmGetReviewCounts *RepositoryMock) GetReviewCountsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReviewCounts.afterGetReviewCountsCounter)
+}
+
+// GetReviewCountsBeforeCounter returns a count of RepositoryMock.GetReviewCounts invocations
+func (mmGetReviewCounts *RepositoryMock) GetReviewCountsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReviewCounts.beforeGetReviewCountsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetReviewCounts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReviewCounts *mRepositoryMockGetReviewCounts) Calls() []*RepositoryMockGetReviewCountsParams {
+	mmGetReviewCounts.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetReviewCountsParams, len(mmGetReviewCounts.callArgs))
+	copy(argCopy, mmGetReviewCounts.callArgs)
+
+	mmGetReviewCounts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetReviewCountsDone returns true if the count of the GetReviewCounts invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetReviewCountsDone() bool {
+	if m.GetReviewCountsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetReviewCountsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetReviewCountsMock.invocationsDone()
+}
+
+// MinimockGetReviewCountsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetReviewCountsInspect() {
+	for _, e := range m.GetReviewCountsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetReviewCounts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetReviewCountsCounter := mm_atomic.LoadUint64(&m.afterGetReviewCountsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReviewCountsMock.defaultExpectation != nil && afterGetReviewCountsCounter < 1 {
+		if m.GetReviewCountsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetReviewCounts at\n%s", m.GetReviewCountsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetReviewCounts at\n%s with params: %#v", m.GetReviewCountsMock.defaultExpectation.expectationOrigins.origin, *m.GetReviewCountsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReviewCounts != nil && afterGetReviewCountsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetReviewCounts at\n%s", m.funcGetReviewCountsOrigin)
+	}
+
+	if !m.GetReviewCountsMock.invocationsDone() && afterGetReviewCountsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetReviewCounts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetReviewCountsMock.expectedInvocations), m.GetReviewCountsMock.expectedInvocationsOrigin, afterGetReviewCountsCounter)
+	}
+}
+
+type mRepositoryMockGetStackReviewers struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetStackReviewersExpectation
+	expectations       []*RepositoryMockGetStackReviewersExpectation
+
+	callArgs []*RepositoryMockGetStackReviewersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetStackReviewersExpectation specifies expectation struct of the repository.GetStackReviewers
+type RepositoryMockGetStackReviewersExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetStackReviewersParams
+	paramPtrs          *RepositoryMockGetStackReviewersParamPtrs
+	expectationOrigins RepositoryMockGetStackReviewersExpectationOrigins
+	results            *RepositoryMockGetStackReviewersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetStackReviewersParams contains parameters of the repository.GetStackReviewers
+type RepositoryMockGetStackReviewersParams struct {
+	ctx            context.Context
+	pullRequestIDs []string
+}
+
+// RepositoryMockGetStackReviewersParamPtrs contains pointers to parameters of the repository.GetStackReviewers
+type RepositoryMockGetStackReviewersParamPtrs struct {
+	ctx            *context.Context
+	pullRequestIDs *[]string
+}
+
+// RepositoryMockGetStackReviewersResults contains results of the repository.GetStackReviewers
+type RepositoryMockGetStackReviewersResults struct {
+	sa1 []string
+	err error
+}
+
+// RepositoryMockGetStackReviewersOrigins contains origins of expectations of the repository.GetStackReviewers
+type RepositoryMockGetStackReviewersExpectationOrigins struct {
+	origin               string
+	originCtx            string
+	originPullRequestIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetStackReviewers *mRepositoryMockGetStackReviewers) Optional() *mRepositoryMockGetStackReviewers {
+	mmGetStackReviewers.optional = true
+	return mmGetStackReviewers
+}
+
+// Expect sets up expected params for repository.GetStackReviewers
+func (mmGetStackReviewers *mRepositoryMockGetStackReviewers) Expect(ctx context.Context, pullRequestIDs []string) *mRepositoryMockGetStackReviewers {
+	if mmGetStackReviewers.mock.funcGetStackReviewers != nil {
+		mmGetStackReviewers.mock.t.Fatalf("RepositoryMock.GetStackReviewers mock is already set by Set")
+	}
+
+	if mmGetStackReviewers.defaultExpectation == nil {
+		mmGetStackReviewers.defaultExpectation = &RepositoryMockGetStackReviewersExpectation{}
+	}
+
+	if mmGetStackReviewers.defaultExpectation.paramPtrs != nil {
+		mmGetStackReviewers.mock.t.Fatalf("RepositoryMock.GetStackReviewers mock is already set by ExpectParams functions")
+	}
+
+	mmGetStackReviewers.defaultExpectation.params = &RepositoryMockGetStackReviewersParams{ctx, pullRequestIDs}
+	mmGetStackReviewers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetStackReviewers.expectations {
+		if minimock.Equal(e.params, mmGetStackReviewers.defaultExpectation.params) {
+			mmGetStackReviewers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetStackReviewers.defaultExpectation.params)
+		}
+	}
+
+	return mmGetStackReviewers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.GetStackReviewers
+func (mmGetStackReviewers *mRepositoryMockGetStackReviewers) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetStackReviewers {
+	if mmGetStackReviewers.mock.funcGetStackReviewers != nil {
+		mmGetStackReviewers.mock.t.Fatalf("RepositoryMock.GetStackReviewers mock is already set by Set")
+	}
+
+	if mmGetStackReviewers.defaultExpectation == nil {
+		mmGetStackReviewers.defaultExpectation = &RepositoryMockGetStackReviewersExpectation{}
+	}
+
+	if mmGetStackReviewers.defaultExpectation.params != nil {
+		mmGetStackReviewers.mock.t.Fatalf("RepositoryMock.GetStackReviewers mock is already set by Expect")
+	}
+
+	if mmGetStackReviewers.defaultExpectation.paramPtrs == nil {
+		mmGetStackReviewers.defaultExpectation.paramPtrs = &RepositoryMockGetStackReviewersParamPtrs{}
+	}
+	mmGetStackReviewers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetStackReviewers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetStackReviewers
+}
+
+// ExpectPullRequestIDsParam2 sets up expected param pullRequestIDs for repository.GetStackReviewers
+func (mmGetStackReviewers *mRepositoryMockGetStackReviewers) ExpectPullRequestIDsParam2(pullRequestIDs []string) *mRepositoryMockGetStackReviewers {
+	if mmGetStackReviewers.mock.funcGetStackReviewers != nil {
+		mmGetStackReviewers.mock.t.Fatalf("RepositoryMock.GetStackReviewers mock is already set by Set")
+	}
+
+	if mmGetStackReviewers.defaultExpectation == nil {
+		mmGetStackReviewers.defaultExpectation = &RepositoryMockGetStackReviewersExpectation{}
+	}
+
+	if mmGetStackReviewers.defaultExpectation.params != nil {
+		mmGetStackReviewers.mock.t.Fatalf("RepositoryMock.GetStackReviewers mock is already set by Expect")
+	}
+
+	if mmGetStackReviewers.defaultExpectation.paramPtrs == nil {
+		mmGetStackReviewers.defaultExpectation.paramPtrs = &RepositoryMockGetStackReviewersParamPtrs{}
+	}
+	mmGetStackReviewers.defaultExpectation.paramPtrs.pullRequestIDs = &pullRequestIDs
+	mmGetStackReviewers.defaultExpectation.expectationOrigins.originPullRequestIDs = minimock.CallerInfo(1)
+
+	return mmGetStackReviewers
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.GetStackReviewers
+func (mmGetStackReviewers *mRepositoryMockGetStackReviewers) Inspect(f func(ctx context.Context, pullRequestIDs []string)) *mRepositoryMockGetStackReviewers {
+	if mmGetStackReviewers.mock.inspectFuncGetStackReviewers != nil {
+		mmGetStackReviewers.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetStackReviewers")
+	}
+
+	mmGetStackReviewers.mock.inspectFuncGetStackReviewers = f
+
+	return mmGetStackReviewers
+}
+
+// Return sets up results that will be returned by repository.GetStackReviewers
+func (mmGetStackReviewers *mRepositoryMockGetStackReviewers) Return(sa1 []string, err error) *RepositoryMock {
+	if mmGetStackReviewers.mock.funcGetStackReviewers != nil {
+		mmGetStackReviewers.mock.t.Fatalf("RepositoryMock.GetStackReviewers mock is already set by Set")
+	}
+
+	if mmGetStackReviewers.defaultExpectation == nil {
+		mmGetStackReviewers.defaultExpectation = &RepositoryMockGetStackReviewersExpectation{mock: mmGetStackReviewers.mock}
+	}
+	mmGetStackReviewers.defaultExpectation.results = &RepositoryMockGetStackReviewersResults{sa1, err}
+	mmGetStackReviewers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetStackReviewers.mock
+}
+
+// Set uses given function f to mock the repository.GetStackReviewers method
+func (mmGetStackReviewers *mRepositoryMockGetStackReviewers) Set(f func(ctx context.Context, pullRequestIDs []string) (sa1 []string, err error)) *RepositoryMock {
+	if mmGetStackReviewers.defaultExpectation != nil {
+		mmGetStackReviewers.mock.t.Fatalf("Default expectation is already set for the repository.GetStackReviewers method")
+	}
+
+	if len(mmGetStackReviewers.expectations) > 0 {
+		mmGetStackReviewers.mock.t.Fatalf("Some expectations are already set for the repository.GetStackReviewers method")
+	}
+
+	mmGetStackReviewers.mock.funcGetStackReviewers = f
+	mmGetStackReviewers.mock.funcGetStackReviewersOrigin = minimock.CallerInfo(1)
+	return mmGetStackReviewers.mock
+}
+
+// When sets expectation for the repository.GetStackReviewers which will trigger the result defined by the following
+// Then helper
+func (mmGetStackReviewers *mRepositoryMockGetStackReviewers) When(ctx context.Context, pullRequestIDs []string) *RepositoryMockGetStackReviewersExpectation {
+	if mmGetStackReviewers.mock.funcGetStackReviewers != nil {
+		mmGetStackReviewers.mock.t.Fatalf("RepositoryMock.GetStackReviewers mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetStackReviewersExpectation{
+		mock:               mmGetStackReviewers.mock,
+		params:             &RepositoryMockGetStackReviewersParams{ctx, pullRequestIDs},
+		expectationOrigins: RepositoryMockGetStackReviewersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetStackReviewers.expectations = append(mmGetStackReviewers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.GetStackReviewers return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetStackReviewersExpectation) Then(sa1 []string, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetStackReviewersResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.GetStackReviewers should be invoked
+func (mmGetStackReviewers *mRepositoryMockGetStackReviewers) Times(n uint64) *mRepositoryMockGetStackReviewers {
+	if n == 0 {
+		mmGetStackReviewers.mock.t.Fatalf("Times of RepositoryMock.GetStackReviewers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetStackReviewers.expectedInvocations, n)
+	mmGetStackReviewers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetStackReviewers
+}
+
+func (mmGetStackReviewers *mRepositoryMockGetStackReviewers) invocationsDone() bool {
+	if len(mmGetStackReviewers.expectations) == 0 && mmGetStackReviewers.defaultExpectation == nil && mmGetStackReviewers.mock.funcGetStackReviewers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetStackReviewers.mock.afterGetStackReviewersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetStackReviewers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetStackReviewers implements repository
+func (mmGetStackReviewers *RepositoryMock) GetStackReviewers(ctx context.Context, pullRequestIDs []string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmGetStackReviewers.beforeGetStackReviewersCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetStackReviewers.afterGetStackReviewersCounter, 1)
+
+	mmGetStackReviewers.t.Helper()
+
+	if mmGetStackReviewers.inspectFuncGetStackReviewers != nil {
+		mmGetStackReviewers.inspectFuncGetStackReviewers(ctx, pullRequestIDs)
+	}
+
+	mm_params := RepositoryMockGetStackReviewersParams{ctx, pullRequestIDs}
+
+	// Record call args
+	mmGetStackReviewers.GetStackReviewersMock.mutex.Lock()
+	mmGetStackReviewers.GetStackReviewersMock.callArgs = append(mmGetStackReviewers.GetStackReviewersMock.callArgs, &mm_params)
+	mmGetStackReviewers.GetStackReviewersMock.mutex.Unlock()
+
+	for _, e := range mmGetStackReviewers.GetStackReviewersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmGetStackReviewers.GetStackReviewersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetStackReviewers.GetStackReviewersMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetStackReviewers.GetStackReviewersMock.defaultExpectation.params
+		mm_want_ptrs := mmGetStackReviewers.GetStackReviewersMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetStackReviewersParams{ctx, pullRequestIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetStackReviewers.t.Errorf("RepositoryMock.GetStackReviewers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStackReviewers.GetStackReviewersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pullRequestIDs != nil && !minimock.Equal(*mm_want_ptrs.pullRequestIDs, mm_got.pullRequestIDs) {
+				mmGetStackReviewers.t.Errorf("RepositoryMock.GetStackReviewers got unexpected parameter pullRequestIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStackReviewers.GetStackReviewersMock.defaultExpectation.expectationOrigins.originPullRequestIDs, *mm_want_ptrs.pullRequestIDs, mm_got.pullRequestIDs, minimock.Diff(*mm_want_ptrs.pullRequestIDs, mm_got.pullRequestIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetStackReviewers.t.Errorf("RepositoryMock.GetStackReviewers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetStackReviewers.GetStackReviewersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetStackReviewers.GetStackReviewersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetStackReviewers.t.Fatal("No results are set for the RepositoryMock.GetStackReviewers")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmGetStackReviewers.funcGetStackReviewers != nil {
+		return mmGetStackReviewers.funcGetStackReviewers(ctx, pullRequestIDs)
+	}
+	mmGetStackReviewers.t.Fatalf("Unexpected call to RepositoryMock.GetStackReviewers. %v %v", ctx, pullRequestIDs)
+	return
+}
+
+// GetStackReviewersAfterCounter returns a count of finished RepositoryMock.GetStackReviewers invocations
+func (mmGetStackReviewers *RepositoryMock) GetStackReviewersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStackReviewers.afterGetStackReviewersCounter)
+}
+
+// GetStackReviewersBeforeCounter returns a count of RepositoryMock.GetStackReviewers invocations
+func (mmGetStackReviewers *RepositoryMock) GetStackReviewersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStackReviewers.beforeGetStackReviewersCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetStackReviewers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetStackReviewers *mRepositoryMockGetStackReviewers) Calls() []*RepositoryMockGetStackReviewersParams {
+	mmGetStackReviewers.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetStackReviewersParams, len(mmGetStackReviewers.callArgs))
+	copy(argCopy, mmGetStackReviewers.callArgs)
+
+	mmGetStackReviewers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetStackReviewersDone returns true if the count of the GetStackReviewers invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetStackReviewersDone() bool {
+	if m.GetStackReviewersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetStackReviewersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetStackReviewersMock.invocationsDone()
+}
+
+// MinimockGetStackReviewersInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetStackReviewersInspect() {
+	for _, e := range m.GetStackReviewersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetStackReviewers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetStackReviewersCounter := mm_atomic.LoadUint64(&m.afterGetStackReviewersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetStackReviewersMock.defaultExpectation != nil && afterGetStackReviewersCounter < 1 {
+		if m.GetStackReviewersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetStackReviewers at\n%s", m.GetStackReviewersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetStackReviewers at\n%s with params: %#v", m.GetStackReviewersMock.defaultExpectation.expectationOrigins.origin, *m.GetStackReviewersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetStackReviewers != nil && afterGetStackReviewersCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetStackReviewers at\n%s", m.funcGetStackReviewersOrigin)
+	}
+
+	if !m.GetStackReviewersMock.invocationsDone() && afterGetStackReviewersCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetStackReviewers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetStackReviewersMock.expectedInvocations), m.GetStackReviewersMock.expectedInvocationsOrigin, afterGetStackReviewersCounter)
+	}
+}
+
+type mRepositoryMockGetTeamCandidates struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetTeamCandidatesExpectation
+	expectations       []*RepositoryMockGetTeamCandidatesExpectation
+
+	callArgs []*RepositoryMockGetTeamCandidatesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetTeamCandidatesExpectation specifies expectation struct of the repository.GetTeamCandidates
+type RepositoryMockGetTeamCandidatesExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetTeamCandidatesParams
+	paramPtrs          *RepositoryMockGetTeamCandidatesParamPtrs
+	expectationOrigins RepositoryMockGetTeamCandidatesExpectationOrigins
+	results            *RepositoryMockGetTeamCandidatesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetTeamCandidatesParams contains parameters of the repository.GetTeamCandidates
+type RepositoryMockGetTeamCandidatesParams struct {
+	ctx      context.Context
+	teamName string
+}
+
+// RepositoryMockGetTeamCandidatesParamPtrs contains pointers to parameters of the repository.GetTeamCandidates
+type RepositoryMockGetTeamCandidatesParamPtrs struct {
+	ctx      *context.Context
+	teamName *string
+}
+
+// RepositoryMockGetTeamCandidatesResults contains results of the repository.GetTeamCandidates
+type RepositoryMockGetTeamCandidatesResults struct {
+	ca1 []domain.Candidate
+	err error
+}
+
+// RepositoryMockGetTeamCandidatesOrigins contains origins of expectations of the repository.GetTeamCandidates
+type RepositoryMockGetTeamCandidatesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originTeamName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTeamCandidates *mRepositoryMockGetTeamCandidates) Optional() *mRepositoryMockGetTeamCandidates {
+	mmGetTeamCandidates.optional = true
+	return mmGetTeamCandidates
+}
+
+// Expect sets up expected params for repository.GetTeamCandidates
+func (mmGetTeamCandidates *mRepositoryMockGetTeamCandidates) Expect(ctx context.Context, teamName string) *mRepositoryMockGetTeamCandidates {
+	if mmGetTeamCandidates.mock.funcGetTeamCandidates != nil {
+		mmGetTeamCandidates.mock.t.Fatalf("RepositoryMock.GetTeamCandidates mock is already set by Set")
+	}
+
+	if mmGetTeamCandidates.defaultExpectation == nil {
+		mmGetTeamCandidates.defaultExpectation = &RepositoryMockGetTeamCandidatesExpectation{}
+	}
+
+	if mmGetTeamCandidates.defaultExpectation.paramPtrs != nil {
+		mmGetTeamCandidates.mock.t.Fatalf("RepositoryMock.GetTeamCandidates mock is already set by ExpectParams functions")
+	}
+
+	mmGetTeamCandidates.defaultExpectation.params = &RepositoryMockGetTeamCandidatesParams{ctx, teamName}
+	mmGetTeamCandidates.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTeamCandidates.expectations {
+		if minimock.Equal(e.params, mmGetTeamCandidates.defaultExpectation.params) {
+			mmGetTeamCandidates.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTeamCandidates.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTeamCandidates
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.GetTeamCandidates
+func (mmGetTeamCandidates *mRepositoryMockGetTeamCandidates) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetTeamCandidates {
+	if mmGetTeamCandidates.mock.funcGetTeamCandidates != nil {
+		mmGetTeamCandidates.mock.t.Fatalf("RepositoryMock.GetTeamCandidates mock is already set by Set")
+	}
+
+	if mmGetTeamCandidates.defaultExpectation == nil {
+		mmGetTeamCandidates.defaultExpectation = &RepositoryMockGetTeamCandidatesExpectation{}
+	}
+
+	if mmGetTeamCandidates.defaultExpectation.params != nil {
+		mmGetTeamCandidates.mock.t.Fatalf("RepositoryMock.GetTeamCandidates mock is already set by Expect")
+	}
+
+	if mmGetTeamCandidates.defaultExpectation.paramPtrs == nil {
+		mmGetTeamCandidates.defaultExpectation.paramPtrs = &RepositoryMockGetTeamCandidatesParamPtrs{}
+	}
+	mmGetTeamCandidates.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTeamCandidates.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTeamCandidates
+}
+
+// ExpectTeamNameParam2 sets up expected param teamName for repository.GetTeamCandidates
+func (mmGetTeamCandidates *mRepositoryMockGetTeamCandidates) ExpectTeamNameParam2(teamName string) *mRepositoryMockGetTeamCandidates {
+	if mmGetTeamCandidates.mock.funcGetTeamCandidates != nil {
+		mmGetTeamCandidates.mock.t.Fatalf("RepositoryMock.GetTeamCandidates mock is already set by Set")
+	}
+
+	if mmGetTeamCandidates.defaultExpectation == nil {
+		mmGetTeamCandidates.defaultExpectation = &RepositoryMockGetTeamCandidatesExpectation{}
+	}
+
+	if mmGetTeamCandidates.defaultExpectation.params != nil {
+		mmGetTeamCandidates.mock.t.Fatalf("RepositoryMock.GetTeamCandidates mock is already set by Expect")
+	}
+
+	if mmGetTeamCandidates.defaultExpectation.paramPtrs == nil {
+		mmGetTeamCandidates.defaultExpectation.paramPtrs = &RepositoryMockGetTeamCandidatesParamPtrs{}
+	}
+	mmGetTeamCandidates.defaultExpectation.paramPtrs.teamName = &teamName
+	mmGetTeamCandidates.defaultExpectation.expectationOrigins.originTeamName = minimock.CallerInfo(1)
+
+	return mmGetTeamCandidates
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.GetTeamCandidates
+func (mmGetTeamCandidates *mRepositoryMockGetTeamCandidates) Inspect(f func(ctx context.Context, teamName string)) *mRepositoryMockGetTeamCandidates {
+	if mmGetTeamCandidates.mock.inspectFuncGetTeamCandidates != nil {
+		mmGetTeamCandidates.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetTeamCandidates")
+	}
+
+	mmGetTeamCandidates.mock.inspectFuncGetTeamCandidates = f
+
+	return mmGetTeamCandidates
+}
+
+// Return sets up results that will be returned by repository.GetTeamCandidates
+func (mmGetTeamCandidates *mRepositoryMockGetTeamCandidates) Return(ca1 []domain.Candidate, err error) *RepositoryMock {
+	if mmGetTeamCandidates.mock.funcGetTeamCandidates != nil {
+		mmGetTeamCandidates.mock.t.Fatalf("RepositoryMock.GetTeamCandidates mock is already set by Set")
+	}
+
+	if mmGetTeamCandidates.defaultExpectation == nil {
+		mmGetTeamCandidates.defaultExpectation = &RepositoryMockGetTeamCandidatesExpectation{mock: mmGetTeamCandidates.mock}
+	}
+	mmGetTeamCandidates.defaultExpectation.results = &RepositoryMockGetTeamCandidatesResults{ca1, err}
+	mmGetTeamCandidates.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTeamCandidates.mock
+}
+
+// Set uses given function f to mock the repository.GetTeamCandidates method
+func (mmGetTeamCandidates *mRepositoryMockGetTeamCandidates) Set(f func(ctx context.Context, teamName string) (ca1 []domain.Candidate, err error)) *RepositoryMock {
+	if mmGetTeamCandidates.defaultExpectation != nil {
+		mmGetTeamCandidates.mock.t.Fatalf("Default expectation is already set for the repository.GetTeamCandidates method")
+	}
+
+	if len(mmGetTeamCandidates.expectations) > 0 {
+		mmGetTeamCandidates.mock.t.Fatalf("Some expectations are already set for the repository.GetTeamCandidates method")
+	}
+
+	mmGetTeamCandidates.mock.funcGetTeamCandidates = f
+	mmGetTeamCandidates.mock.funcGetTeamCandidatesOrigin = minimock.CallerInfo(1)
+	return mmGetTeamCandidates.mock
+}
+
+// When sets expectation for the repository.GetTeamCandidates which will trigger the result defined by the following
+// Then helper
+func (mmGetTeamCandidates *mRepositoryMockGetTeamCandidates) When(ctx context.Context, teamName string) *RepositoryMockGetTeamCandidatesExpectation {
+	if mmGetTeamCandidates.mock.funcGetTeamCandidates != nil {
+		mmGetTeamCandidates.mock.t.Fatalf("RepositoryMock.GetTeamCandidates mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetTeamCandidatesExpectation{
+		mock:               mmGetTeamCandidates.mock,
+		params:             &RepositoryMockGetTeamCandidatesParams{ctx, teamName},
+		expectationOrigins: RepositoryMockGetTeamCandidatesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTeamCandidates.expectations = append(mmGetTeamCandidates.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.GetTeamCandidates return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetTeamCandidatesExpectation) Then(ca1 []domain.Candidate, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetTeamCandidatesResults{ca1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.GetTeamCandidates should be invoked
+func (mmGetTeamCandidates *mRepositoryMockGetTeamCandidates) Times(n uint64) *mRepositoryMockGetTeamCandidates {
+	if n == 0 {
+		mmGetTeamCandidates.mock.t.Fatalf("Times of RepositoryMock.GetTeamCandidates mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTeamCandidates.expectedInvocations, n)
+	mmGetTeamCandidates.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTeamCandidates
+}
+
+func (mmGetTeamCandidates *mRepositoryMockGetTeamCandidates) invocationsDone() bool {
+	if len(mmGetTeamCandidates.expectations) == 0 && mmGetTeamCandidates.defaultExpectation == nil && mmGetTeamCandidates.mock.funcGetTeamCandidates == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTeamCandidates.mock.afterGetTeamCandidatesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTeamCandidates.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTeamCandidates implements repository
+func (mmGetTeamCandidates *RepositoryMock) GetTeamCandidates(ctx context.Context, teamName string) (ca1 []domain.Candidate, err error) {
+	mm_atomic.AddUint64(&mmGetTeamCandidates.beforeGetTeamCandidatesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTeamCandidates.afterGetTeamCandidatesCounter, 1)
+
+	mmGetTeamCandidates.t.Helper()
+
+	if mmGetTeamCandidates.inspectFuncGetTeamCandidates != nil {
+		mmGetTeamCandidates.inspectFuncGetTeamCandidates(ctx, teamName)
+	}
+
+	mm_params := RepositoryMockGetTeamCandidatesParams{ctx, teamName}
+
+	// Record call args
+	mmGetTeamCandidates.GetTeamCandidatesMock.mutex.Lock()
+	mmGetTeamCandidates.GetTeamCandidatesMock.callArgs = append(mmGetTeamCandidates.GetTeamCandidatesMock.callArgs, &mm_params)
+	mmGetTeamCandidates.GetTeamCandidatesMock.mutex.Unlock()
+
+	for _, e := range mmGetTeamCandidates.GetTeamCandidatesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1, e.results.err
+		}
+	}
+
+	if mmGetTeamCandidates.GetTeamCandidatesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTeamCandidates.GetTeamCandidatesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTeamCandidates.GetTeamCandidatesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTeamCandidates.GetTeamCandidatesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetTeamCandidatesParams{ctx, teamName}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTeamCandidates.t.Errorf("RepositoryMock.GetTeamCandidates got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTeamCandidates.GetTeamCandidatesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.teamName != nil && !minimock.Equal(*mm_want_ptrs.teamName, mm_got.teamName) {
+				mmGetTeamCandidates.t.Errorf("RepositoryMock.GetTeamCandidates got unexpected parameter teamName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTeamCandidates.GetTeamCandidatesMock.defaultExpectation.expectationOrigins.originTeamName, *mm_want_ptrs.teamName, mm_got.teamName, minimock.Diff(*mm_want_ptrs.teamName, mm_got.teamName))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTeamCandidates.t.Errorf("RepositoryMock.GetTeamCandidates got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTeamCandidates.GetTeamCandidatesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTeamCandidates.GetTeamCandidatesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTeamCandidates.t.Fatal("No results are set for the RepositoryMock.GetTeamCandidates")
+		}
+		return (*mm_results).ca1, (*mm_results).err
+	}
+	if mmGetTeamCandidates.funcGetTeamCandidates != nil {
+		return mmGetTeamCandidates.funcGetTeamCandidates(ctx, teamName)
+	}
+	mmGetTeamCandidates.t.Fatalf("Unexpected call to RepositoryMock.GetTeamCandidates. %v %v", ctx, teamName)
+	return
+}
+
+// GetTeamCandidatesAfterCounter returns a count of finished RepositoryMock.GetTeamCandidates invocations
+func (mmGetTeamCandidates *RepositoryMock) GetTeamCandidatesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTeamCandidates.afterGetTeamCandidatesCounter)
+}
+
+// GetTeamCandidatesBeforeCounter returns a count of RepositoryMock.GetTeamCandidates invocations
+func (mmGetTeamCandidates *RepositoryMock) GetTeamCandidatesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTeamCandidates.beforeGetTeamCandidatesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetTeamCandidates.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTeamCandidates *mRepositoryMockGetTeamCandidates) Calls() []*RepositoryMockGetTeamCandidatesParams {
+	mmGetTeamCandidates.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetTeamCandidatesParams, len(mmGetTeamCandidates.callArgs))
+	copy(argCopy, mmGetTeamCandidates.callArgs)
+
+	mmGetTeamCandidates.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTeamCandidatesDone returns true if the count of the GetTeamCandidates invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetTeamCandidatesDone() bool {
+	if m.GetTeamCandidatesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTeamCandidatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTeamCandidatesMock.invocationsDone()
+}
+
+// MinimockGetTeamCandidatesInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetTeamCandidatesInspect() {
+	for _, e := range m.GetTeamCandidatesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetTeamCandidates at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTeamCandidatesCounter := mm_atomic.LoadUint64(&m.afterGetTeamCandidatesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTeamCandidatesMock.defaultExpectation != nil && afterGetTeamCandidatesCounter < 1 {
+		if m.GetTeamCandidatesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetTeamCandidates at\n%s", m.GetTeamCandidatesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetTeamCandidates at\n%s with params: %#v", m.GetTeamCandidatesMock.defaultExpectation.expectationOrigins.origin, *m.GetTeamCandidatesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTeamCandidates != nil && afterGetTeamCandidatesCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetTeamCandidates at\n%s", m.funcGetTeamCandidatesOrigin)
+	}
+
+	if !m.GetTeamCandidatesMock.invocationsDone() && afterGetTeamCandidatesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetTeamCandidates at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTeamCandidatesMock.expectedInvocations), m.GetTeamCandidatesMock.expectedInvocationsOrigin, afterGetTeamCandidatesCounter)
+	}
+}
+
+type mRepositoryMockGetTeamPolicy struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetTeamPolicyExpectation
+	expectations       []*RepositoryMockGetTeamPolicyExpectation
+
+	callArgs []*RepositoryMockGetTeamPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetTeamPolicyExpectation specifies expectation struct of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetTeamPolicyParams
+	paramPtrs          *RepositoryMockGetTeamPolicyParamPtrs
+	expectationOrigins RepositoryMockGetTeamPolicyExpectationOrigins
+	results            *RepositoryMockGetTeamPolicyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetTeamPolicyParams contains parameters of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyParams struct {
+	ctx      context.Context
+	teamName string
+	version  int
+}
+
+// RepositoryMockGetTeamPolicyParamPtrs contains pointers to parameters of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyParamPtrs struct {
+	ctx      *context.Context
+	teamName *string
+	version  *int
+}
+
+// RepositoryMockGetTeamPolicyResults contains results of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyResults struct {
+	t1  domain.TeamPolicy
+	err error
+}
+
+// RepositoryMockGetTeamPolicyOrigins contains origins of expectations of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originTeamName string
+	originVersion  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Optional() *mRepositoryMockGetTeamPolicy {
+	mmGetTeamPolicy.optional = true
+	return mmGetTeamPolicy
+}
+
+// Expect sets up expected params for repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Expect(ctx context.Context, teamName string, version int) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{}
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.paramPtrs != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmGetTeamPolicy.defaultExpectation.params = &RepositoryMockGetTeamPolicyParams{ctx, teamName, version}
+	mmGetTeamPolicy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTeamPolicy.expectations {
+		if minimock.Equal(e.params, mmGetTeamPolicy.defaultExpectation.params) {
+			mmGetTeamPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTeamPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTeamPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{}
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.params != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Expect")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetTeamPolicy.defaultExpectation.paramPtrs = &RepositoryMockGetTeamPolicyParamPtrs{}
+	}
+	mmGetTeamPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTeamPolicy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTeamPolicy
+}
+
+// ExpectTeamNameParam2 sets up expected param teamName for repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) ExpectTeamNameParam2(teamName string) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{}
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.params != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Expect")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetTeamPolicy.defaultExpectation.paramPtrs = &RepositoryMockGetTeamPolicyParamPtrs{}
+	}
+	mmGetTeamPolicy.defaultExpectation.paramPtrs.teamName = &teamName
+	mmGetTeamPolicy.defaultExpectation.expectationOrigins.originTeamName = minimock.CallerInfo(1)
+
+	return mmGetTeamPolicy
+}
+
+// ExpectVersionParam3 sets up expected param version for repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) ExpectVersionParam3(version int) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{}
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.params != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Expect")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetTeamPolicy.defaultExpectation.paramPtrs = &RepositoryMockGetTeamPolicyParamPtrs{}
+	}
+	mmGetTeamPolicy.defaultExpectation.paramPtrs.version = &version
+	mmGetTeamPolicy.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmGetTeamPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Inspect(f func(ctx context.Context, teamName string, version int)) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.inspectFuncGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetTeamPolicy")
+	}
+
+	mmGetTeamPolicy.mock.inspectFuncGetTeamPolicy = f
+
+	return mmGetTeamPolicy
+}
+
+// Return sets up results that will be returned by repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Return(t1 domain.TeamPolicy, err error) *RepositoryMock {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{mock: mmGetTeamPolicy.mock}
+	}
+	mmGetTeamPolicy.defaultExpectation.results = &RepositoryMockGetTeamPolicyResults{t1, err}
+	mmGetTeamPolicy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTeamPolicy.mock
+}
+
+// Set uses given function f to mock the repository.GetTeamPolicy method
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Set(f func(ctx context.Context, teamName string, version int) (t1 domain.TeamPolicy, err error)) *RepositoryMock {
+	if mmGetTeamPolicy.defaultExpectation != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("Default expectation is already set for the repository.GetTeamPolicy method")
+	}
+
+	if len(mmGetTeamPolicy.expectations) > 0 {
+		mmGetTeamPolicy.mock.t.Fatalf("Some expectations are already set for the repository.GetTeamPolicy method")
+	}
+
+	mmGetTeamPolicy.mock.funcGetTeamPolicy = f
+	mmGetTeamPolicy.mock.funcGetTeamPolicyOrigin = minimock.CallerInfo(1)
+	return mmGetTeamPolicy.mock
+}
+
+// When sets expectation for the repository.GetTeamPolicy which will trigger the result defined by the following
+// Then helper
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) When(ctx context.Context, teamName string, version int) *RepositoryMockGetTeamPolicyExpectation {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetTeamPolicyExpectation{
+		mock:               mmGetTeamPolicy.mock,
+		params:             &RepositoryMockGetTeamPolicyParams{ctx, teamName, version},
+		expectationOrigins: RepositoryMockGetTeamPolicyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTeamPolicy.expectations = append(mmGetTeamPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.GetTeamPolicy return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetTeamPolicyExpectation) Then(t1 domain.TeamPolicy, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetTeamPolicyResults{t1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.GetTeamPolicy should be invoked
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Times(n uint64) *mRepositoryMockGetTeamPolicy {
+	if n == 0 {
+		mmGetTeamPolicy.mock.t.Fatalf("Times of RepositoryMock.GetTeamPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTeamPolicy.expectedInvocations, n)
+	mmGetTeamPolicy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTeamPolicy
+}
+
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) invocationsDone() bool {
+	if len(mmGetTeamPolicy.expectations) == 0 && mmGetTeamPolicy.defaultExpectation == nil && mmGetTeamPolicy.mock.funcGetTeamPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTeamPolicy.mock.afterGetTeamPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTeamPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTeamPolicy implements repository
+func (mmGetTeamPolicy *RepositoryMock) GetTeamPolicy(ctx context.Context, teamName string, version int) (t1 domain.TeamPolicy, err error) {
+	mm_atomic.AddUint64(&mmGetTeamPolicy.beforeGetTeamPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTeamPolicy.afterGetTeamPolicyCounter, 1)
+
+	mmGetTeamPolicy.t.Helper()
+
+	if mmGetTeamPolicy.inspectFuncGetTeamPolicy != nil {
+		mmGetTeamPolicy.inspectFuncGetTeamPolicy(ctx, teamName, version)
+	}
+
+	mm_params := RepositoryMockGetTeamPolicyParams{ctx, teamName, version}
+
+	// Record call args
+	mmGetTeamPolicy.GetTeamPolicyMock.mutex.Lock()
+	mmGetTeamPolicy.GetTeamPolicyMock.callArgs = append(mmGetTeamPolicy.GetTeamPolicyMock.callArgs, &mm_params)
+	mmGetTeamPolicy.GetTeamPolicyMock.mutex.Unlock()
+
+	for _, e := range mmGetTeamPolicy.GetTeamPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.err
+		}
+	}
+
+	if mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetTeamPolicyParams{ctx, teamName, version}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTeamPolicy.t.Errorf("RepositoryMock.GetTeamPolicy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.teamName != nil && !minimock.Equal(*mm_want_ptrs.teamName, mm_got.teamName) {
+				mmGetTeamPolicy.t.Errorf("RepositoryMock.GetTeamPolicy got unexpected parameter teamName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.expectationOrigins.originTeamName, *mm_want_ptrs.teamName, mm_got.teamName, minimock.Diff(*mm_want_ptrs.teamName, mm_got.teamName))
+			}
+
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmGetTeamPolicy.t.Errorf("RepositoryMock.GetTeamPolicy got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTeamPolicy.t.Errorf("RepositoryMock.GetTeamPolicy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTeamPolicy.t.Fatal("No results are set for the RepositoryMock.GetTeamPolicy")
+		}
+		return (*mm_results).t1, (*mm_results).err
+	}
+	if mmGetTeamPolicy.funcGetTeamPolicy != nil {
+		return mmGetTeamPolicy.funcGetTeamPolicy(ctx, teamName, version)
+	}
+	mmGetTeamPolicy.t.Fatalf("Unexpected call to RepositoryMock.GetTeamPolicy. %v %v %v", ctx, teamName, version)
+	return
+}
+
+// GetTeamPolicyAfterCounter returns a count of finished RepositoryMock.GetTeamPolicy invocations
+func (mmGetTeamPolicy *RepositoryMock) GetTeamPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTeamPolicy.afterGetTeamPolicyCounter)
+}
+
+// GetTeamPolicyBeforeCounter returns a count of RepositoryMock.GetTeamPolicy invocations
+func (mmGetTeamPolicy *RepositoryMock) GetTeamPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTeamPolicy.beforeGetTeamPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetTeamPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Calls() []*RepositoryMockGetTeamPolicyParams {
+	mmGetTeamPolicy.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetTeamPolicyParams, len(mmGetTeamPolicy.callArgs))
+	copy(argCopy, mmGetTeamPolicy.callArgs)
+
+	mmGetTeamPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTeamPolicyDone returns true if the count of the GetTeamPolicy invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetTeamPolicyDone() bool {
+	if m.GetTeamPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTeamPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTeamPolicyMock.invocationsDone()
+}
+
+// MinimockGetTeamPolicyInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetTeamPolicyInspect() {
+	for _, e := range m.GetTeamPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetTeamPolicy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTeamPolicyCounter := mm_atomic.LoadUint64(&m.afterGetTeamPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTeamPolicyMock.defaultExpectation != nil && afterGetTeamPolicyCounter < 1 {
+		if m.GetTeamPolicyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetTeamPolicy at\n%s", m.GetTeamPolicyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetTeamPolicy at\n%s with params: %#v", m.GetTeamPolicyMock.defaultExpectation.expectationOrigins.origin, *m.GetTeamPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTeamPolicy != nil && afterGetTeamPolicyCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetTeamPolicy at\n%s", m.funcGetTeamPolicyOrigin)
+	}
+
+	if !m.GetTeamPolicyMock.invocationsDone() && afterGetTeamPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetTeamPolicy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTeamPolicyMock.expectedInvocations), m.GetTeamPolicyMock.expectedInvocationsOrigin, afterGetTeamPolicyCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetAssignmentPoolInspect()
+
+			m.MinimockGetCandidatesInspect()
+
+			m.MinimockGetReviewCountsInspect()
+
+			m.MinimockGetStackReviewersInspect()
+
+			m.MinimockGetTeamCandidatesInspect()
+
+			m.MinimockGetTeamPolicyInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetAssignmentPoolDone() &&
+		m.MinimockGetCandidatesDone() &&
+		m.MinimockGetReviewCountsDone() &&
+		m.MinimockGetStackReviewersDone() &&
+		m.MinimockGetTeamCandidatesDone() &&
+		m.MinimockGetTeamPolicyDone()
+}
@@ -0,0 +1,565 @@
+// Package selection implements reviewer selection shared by every code path that assigns reviewers.
+//
+// Creation and the dry-run preview both go through Selector.Select, so the preview
+// always reflects what creation would actually do.
+package selection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		GetAssignmentPool(ctx context.Context, authorID string) (domain.AssignmentPool, error)
+		GetTeamCandidates(ctx context.Context, teamName string) ([]domain.Candidate, error)
+		GetCandidates(ctx context.Context, userIDs []string) ([]domain.Candidate, error)
+		GetTeamPolicy(ctx context.Context, teamName string, version int) (domain.TeamPolicy, error)
+		GetStackReviewers(ctx context.Context, pullRequestIDs []string) ([]string, error)
+		GetReviewCounts(ctx context.Context, filter domain.KnowledgeFilter) ([]domain.ReviewCount, error)
+	}
+
+	Selector struct {
+		repo repository
+	}
+)
+
+func New(repo repository) *Selector {
+	return &Selector{
+		repo: repo,
+	}
+}
+
+// Select loads the candidates and the active policy of the author's team and chooses reviewers.
+// Mandatory reviewers are assigned first, then the reviewers requested by the author and, when the
+// policy prefers them, the reviewers of the PRs it is stacked on. The strategy fills the remaining
+// slots from the author's team and then from the fallback teams. When the policy minimum cannot be
+// met, the assignment is returned together with ErrNotEnoughReviewers.
+func (s *Selector) Select(ctx context.Context, pr domain.CreatePullRequest) (domain.Assignment, error) {
+	pool, err := s.repo.GetAssignmentPool(ctx, pr.AuthorID)
+	if err != nil {
+		return domain.Assignment{}, fmt.Errorf("repo.GetAssignmentPool: %w", err)
+	}
+
+	teamPolicy, err := s.teamPolicy(ctx, pool.TeamName)
+	if err != nil {
+		return domain.Assignment{}, err
+	}
+	policy := teamPolicy.Policy
+
+	assignment := newAssignment(pool.TeamName, policy)
+	assignment.PolicyVersion = teamPolicy.Version
+
+	if err = s.assignMandatory(ctx, &assignment, pool.AuthorID, policy, pr.Labels); err != nil {
+		return domain.Assignment{}, err
+	}
+
+	if err = s.assignRequested(ctx, &assignment, pool, policy, pr.RequestedReviewers); err != nil {
+		return domain.Assignment{}, err
+	}
+
+	if policy.PreferStackReviewers {
+		if err = s.assignStack(ctx, &assignment, pool, policy, pr.DependencyKeys()); err != nil {
+			return domain.Assignment{}, err
+		}
+	}
+
+	if err = s.fillWithFallback(ctx, &assignment, pool, policy, policy.Reviewers.Count); err != nil {
+		return domain.Assignment{}, err
+	}
+
+	if pr.AddShadow {
+		assignment.Shadow = chooseShadow(pool, assignment)
+	}
+
+	if len(assignment.Reviewers) < assignment.MinReviewers {
+		return assignment, domain.ErrNotEnoughReviewers
+	}
+
+	return assignment, nil
+}
+
+// Additional picks count more reviewers for an existing PR of authorID with the same rules and
+// strategy as Select. The taken users, usually the current reviewers, are never picked. Only the
+// new reviewers are returned, together with ErrNotEnoughReviewers when fewer than count are found.
+func (s *Selector) Additional(ctx context.Context, authorID string, taken []string, count int,
+) (domain.Assignment, error) {
+	pool, err := s.repo.GetAssignmentPool(ctx, authorID)
+	if err != nil {
+		return domain.Assignment{}, fmt.Errorf("repo.GetAssignmentPool: %w", err)
+	}
+
+	teamPolicy, err := s.teamPolicy(ctx, pool.TeamName)
+	if err != nil {
+		return domain.Assignment{}, err
+	}
+
+	// Taken users are seeded as reviewers, so fill skips them, and cut off afterwards.
+	assignment := newAssignment(pool.TeamName, teamPolicy.Policy)
+	assignment.PolicyVersion = teamPolicy.Version
+	for _, userID := range taken {
+		assignment.Reviewers = append(assignment.Reviewers, domain.Reviewer{UserID: userID})
+	}
+
+	if err = s.fillWithFallback(ctx, &assignment, pool, teamPolicy.Policy, len(taken)+count); err != nil {
+		return domain.Assignment{}, err
+	}
+	assignment.Reviewers = assignment.Reviewers[len(taken):]
+	assignment.MinReviewers = count
+
+	if len(assignment.Reviewers) < count {
+		return assignment, domain.ErrNotEnoughReviewers
+	}
+
+	return assignment, nil
+}
+
+// teamPolicy returns the active policy of the team or the default one when it has none.
+func (s *Selector) teamPolicy(ctx context.Context, teamName string) (domain.TeamPolicy, error) {
+	teamPolicy, err := s.repo.GetTeamPolicy(ctx, teamName, 0)
+	switch {
+	case errors.Is(err, domain.ErrPolicyNotFound):
+		return domain.TeamPolicy{Policy: domain.DefaultAssignmentPolicy()}, nil
+	case err != nil:
+		return domain.TeamPolicy{}, fmt.Errorf("repo.GetTeamPolicy: %w", err)
+	}
+	return teamPolicy, nil
+}
+
+// fillWithFallback fills the assignment up to total from the author's team, then from the fallback teams.
+func (s *Selector) fillWithFallback(ctx context.Context, assignment *domain.Assignment, pool domain.AssignmentPool,
+	policy domain.AssignmentPolicy, total int,
+) error {
+	reviews, err := s.authorReviews(ctx, pool.AuthorID, policy)
+	if err != nil {
+		return err
+	}
+
+	fill(assignment, withAuthorReviews(pool, reviews), policy, total)
+
+	for _, teamName := range policy.FallbackTeams {
+		if len(assignment.Reviewers) >= total {
+			break
+		}
+
+		candidates, err := s.repo.GetTeamCandidates(ctx, teamName)
+		if err != nil {
+			return fmt.Errorf("repo.GetTeamCandidates: %w", err)
+		}
+
+		fill(assignment, withAuthorReviews(domain.AssignmentPool{
+			AuthorID:   pool.AuthorID,
+			TeamName:   teamName,
+			Candidates: candidates,
+		}, reviews), policy, total)
+	}
+
+	return nil
+}
+
+// authorReviews returns how many PRs of the author each user reviewed within the knowledge window
+// of the policy. The counts are only needed, and only loaded, for the knowledge_spread strategy.
+func (s *Selector) authorReviews(ctx context.Context, authorID string, policy domain.AssignmentPolicy,
+) (map[string]int, error) {
+	if policy.Strategy != domain.StrategyKnowledgeSpread {
+		return nil, nil
+	}
+
+	counts, err := s.repo.GetReviewCounts(ctx, domain.KnowledgeFilter{
+		AuthorID: authorID,
+		Since:    time.Now().AddDate(0, 0, -7*policy.KnowledgeWindow()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("repo.GetReviewCounts: %w", err)
+	}
+	return domain.ReviewsByReviewer(counts), nil
+}
+
+// withAuthorReviews returns the pool with the review counts set on a copy of its candidates.
+func withAuthorReviews(pool domain.AssignmentPool, reviews map[string]int) domain.AssignmentPool {
+	if reviews == nil {
+		return pool
+	}
+	candidates := slices.Clone(pool.Candidates)
+	for i := range candidates {
+		candidates[i].AuthorReviews = reviews[candidates[i].UserID]
+	}
+	pool.Candidates = candidates
+	return pool
+}
+
+// chooseShadow picks the active trainee of the author's team who is not absent and has the fewest open
+// shadow reviews.
+func chooseShadow(pool domain.AssignmentPool, assignment domain.Assignment) *domain.Reviewer {
+	var chosen *domain.Candidate
+	for i, candidate := range pool.Candidates {
+		if !candidate.IsTrainee || !candidate.IsActive || candidate.IsAbsent ||
+			candidate.UserID == pool.AuthorID || assignment.HasReviewer(candidate.UserID) {
+			continue
+		}
+		if chosen == nil || candidate.OpenShadows < chosen.OpenShadows ||
+			(candidate.OpenShadows == chosen.OpenShadows && candidate.UserID < chosen.UserID) {
+			chosen = &pool.Candidates[i]
+		}
+	}
+
+	if chosen == nil {
+		return nil
+	}
+	return &domain.Reviewer{
+		UserID: chosen.UserID,
+		Role:   domain.ReviewerRoleShadow,
+		Source: domain.ReviewerSourceAuto,
+	}
+}
+
+// Choose picks reviewers from the pool according to the policy. Candidates are filtered
+// by eligibility first, the remaining ones are ranked by the policy strategy
+// (user id breaks ties), so the result is deterministic for the same input.
+func Choose(pool domain.AssignmentPool, policy domain.AssignmentPolicy) domain.Assignment {
+	assignment := newAssignment(pool.TeamName, policy)
+	fill(&assignment, pool, policy, policy.Reviewers.Count)
+	return assignment
+}
+
+// assignMandatory applies the mandatory reviewer rules matching the PR labels. An unavailable
+// mandatory user is replaced by the deputy, if there is no usable deputy the selection fails.
+func (s *Selector) assignMandatory(ctx context.Context, assignment *domain.Assignment, authorID string,
+	policy domain.AssignmentPolicy, labels []string,
+) error {
+	for _, rule := range policy.MandatoryReviewers {
+		if !rule.AppliesTo(labels) || assignment.HasReviewer(rule.UserID) {
+			continue
+		}
+
+		if rule.TeamName != "" {
+			if err := s.assignMandatoryTeam(ctx, assignment, authorID, policy, rule.TeamName); err != nil {
+				return err
+			}
+			continue
+		}
+
+		candidates, err := s.repo.GetCandidates(ctx, []string{rule.UserID, rule.DeputyID})
+		if err != nil {
+			return fmt.Errorf("repo.GetCandidates: %w", err)
+		}
+
+		reason, unavailable := mandatoryUnavailable(authorID, rule.UserID, candidates)
+		if !unavailable {
+			assignment.Reviewers = append(assignment.Reviewers, domain.Reviewer{
+				UserID: rule.UserID,
+				Role:   domain.ReviewerRoleReviewer,
+				Source: domain.ReviewerSourceMandatory,
+			})
+			continue
+		}
+		assignment.Rejected = append(assignment.Rejected, domain.RejectedCandidate{
+			UserID: rule.UserID,
+			Reason: reason,
+		})
+
+		if rule.DeputyID == "" || assignment.HasReviewer(rule.DeputyID) {
+			return fmt.Errorf("%w: %s", domain.ErrMandatoryReviewerUnavailable, rule.UserID)
+		}
+		if reason, unavailable = mandatoryUnavailable(authorID, rule.DeputyID, candidates); unavailable {
+			assignment.Rejected = append(assignment.Rejected, domain.RejectedCandidate{
+				UserID: rule.DeputyID,
+				Reason: reason,
+			})
+			return fmt.Errorf("%w: %s", domain.ErrMandatoryReviewerUnavailable, rule.UserID)
+		}
+
+		assignment.Reviewers = append(assignment.Reviewers, domain.Reviewer{
+			UserID:    rule.DeputyID,
+			Role:      domain.ReviewerRoleReviewer,
+			Source:    domain.ReviewerSourceDeputy,
+			DeputyFor: rule.UserID,
+		})
+	}
+
+	return nil
+}
+
+// assignMandatoryTeam assigns one member of the team chosen by the policy strategy.
+func (s *Selector) assignMandatoryTeam(ctx context.Context, assignment *domain.Assignment, authorID string,
+	policy domain.AssignmentPolicy, teamName string,
+) error {
+	candidates, err := s.repo.GetTeamCandidates(ctx, teamName)
+	if err != nil {
+		return fmt.Errorf("repo.GetTeamCandidates: %w", err)
+	}
+
+	for _, candidate := range candidates {
+		if candidate.UserID != authorID && assignment.HasReviewer(candidate.UserID) {
+			return nil
+		}
+	}
+
+	reviews, err := s.authorReviews(ctx, authorID, policy)
+	if err != nil {
+		return err
+	}
+
+	group := newAssignment(teamName, policy)
+	group.Reviewers = slices.Clone(assignment.Reviewers)
+	fill(&group, withAuthorReviews(domain.AssignmentPool{
+		AuthorID:   authorID,
+		TeamName:   teamName,
+		Candidates: candidates,
+	}, reviews), policy, len(group.Reviewers)+1)
+
+	if len(group.Reviewers) == len(assignment.Reviewers) {
+		return fmt.Errorf("%w: team %s", domain.ErrMandatoryReviewerUnavailable, teamName)
+	}
+
+	chosen := group.Reviewers[len(group.Reviewers)-1]
+	chosen.Source = domain.ReviewerSourceMandatory
+	assignment.Reviewers = append(assignment.Reviewers, chosen)
+
+	return nil
+}
+
+// assignRequested honours the reviewers requested by the author as long as team rules allow them
+// and free slots remain. Requests that cannot be honoured are recorded with the reason.
+func (s *Selector) assignRequested(ctx context.Context, assignment *domain.Assignment, pool domain.AssignmentPool,
+	policy domain.AssignmentPolicy, requested []string,
+) error {
+	if len(requested) == 0 {
+		return nil
+	}
+
+	candidates, err := s.repo.GetCandidates(ctx, requested)
+	if err != nil {
+		return fmt.Errorf("repo.GetCandidates: %w", err)
+	}
+
+	for _, userID := range requested {
+		if assignment.HasReviewer(userID) {
+			continue
+		}
+
+		reason, rejected := requestRejectionReason(pool, policy, userID, candidates)
+		if !rejected && len(assignment.Reviewers) >= policy.Reviewers.Count {
+			reason, rejected = domain.RejectionNoFreeSlot, true
+		}
+		if rejected {
+			assignment.RejectedRequests = append(assignment.RejectedRequests, domain.RejectedCandidate{
+				UserID: userID,
+				Reason: reason,
+			})
+			continue
+		}
+
+		assignment.Reviewers = append(assignment.Reviewers, domain.Reviewer{
+			UserID: userID,
+			Role:   domain.ReviewerRoleReviewer,
+			Source: domain.ReviewerSourceRequested,
+		})
+	}
+
+	return nil
+}
+
+// assignStack prefers the reviewers of the base PRs, who already know the changes the PR builds on.
+// They must pass the same rules as requested reviewers; the ones that do not are left to the
+// strategy, which records them as rejected if they are part of the pool.
+func (s *Selector) assignStack(ctx context.Context, assignment *domain.Assignment, pool domain.AssignmentPool,
+	policy domain.AssignmentPolicy, dependsOn []string,
+) error {
+	if len(dependsOn) == 0 {
+		return nil
+	}
+
+	userIDs, err := s.repo.GetStackReviewers(ctx, dependsOn)
+	if err != nil {
+		return fmt.Errorf("repo.GetStackReviewers: %w", err)
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	candidates, err := s.repo.GetCandidates(ctx, userIDs)
+	if err != nil {
+		return fmt.Errorf("repo.GetCandidates: %w", err)
+	}
+
+	for _, userID := range userIDs {
+		if len(assignment.Reviewers) >= policy.Reviewers.Count {
+			break
+		}
+		if assignment.HasReviewer(userID) {
+			continue
+		}
+		if _, rejected := requestRejectionReason(pool, policy, userID, candidates); rejected {
+			continue
+		}
+
+		assignment.Reviewers = append(assignment.Reviewers, domain.Reviewer{
+			UserID: userID,
+			Role:   domain.ReviewerRoleReviewer,
+			Source: domain.ReviewerSourceStack,
+		})
+	}
+
+	return nil
+}
+
+func newAssignment(teamName string, policy domain.AssignmentPolicy) domain.Assignment {
+	return domain.Assignment{
+		TeamName:         teamName,
+		Strategy:         policy.Strategy,
+		MinReviewers:     policy.Reviewers.Min,
+		Reviewers:        []domain.Reviewer{},
+		Rejected:         []domain.RejectedCandidate{},
+		RejectedRequests: []domain.RejectedCandidate{},
+	}
+}
+
+// fill adds candidates of the pool to the assignment until it has total reviewers.
+// Candidates already assigned are skipped, every other candidate left out is recorded as rejected.
+func fill(assignment *domain.Assignment, pool domain.AssignmentPool, policy domain.AssignmentPolicy, total int) {
+	eligible := make([]domain.Candidate, 0, len(pool.Candidates))
+	for _, candidate := range pool.Candidates {
+		if assignment.HasReviewer(candidate.UserID) {
+			continue
+		}
+		if reason, ok := rejectionReason(pool.AuthorID, candidate, policy); ok {
+			assignment.Rejected = append(assignment.Rejected, domain.RejectedCandidate{
+				UserID: candidate.UserID,
+				Reason: reason,
+			})
+			continue
+		}
+		eligible = append(eligible, candidate)
+	}
+
+	sort.SliceStable(eligible, less(eligible, policy.Strategy))
+
+	for _, candidate := range eligible {
+		if len(assignment.Reviewers) < total {
+			assignment.Reviewers = append(assignment.Reviewers, domain.Reviewer{
+				UserID: candidate.UserID,
+				Role:   domain.ReviewerRoleReviewer,
+				Source: domain.ReviewerSourceAuto,
+			})
+			continue
+		}
+		assignment.Rejected = append(assignment.Rejected, domain.RejectedCandidate{
+			UserID: candidate.UserID,
+			Reason: domain.RejectionLowerScore,
+		})
+	}
+}
+
+func less(candidates []domain.Candidate, strategy domain.AssignmentStrategy) func(i, j int) bool {
+	return func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+
+		switch strategy {
+		case domain.StrategyRoundRobin:
+			if !sameTime(a, b) {
+				if a.LastAssignedAt == nil || b.LastAssignedAt == nil {
+					return a.LastAssignedAt == nil
+				}
+				return a.LastAssignedAt.Before(*b.LastAssignedAt)
+			}
+		case domain.StrategyKnowledgeSpread:
+			if a.AuthorReviews != b.AuthorReviews {
+				return a.AuthorReviews < b.AuthorReviews
+			}
+			if a.OpenReviews != b.OpenReviews {
+				return a.OpenReviews < b.OpenReviews
+			}
+		default:
+			if a.OpenReviews != b.OpenReviews {
+				return a.OpenReviews < b.OpenReviews
+			}
+		}
+		return a.UserID < b.UserID
+	}
+}
+
+func sameTime(a, b domain.Candidate) bool {
+	if a.LastAssignedAt == nil || b.LastAssignedAt == nil {
+		return a.LastAssignedAt == b.LastAssignedAt
+	}
+	return a.LastAssignedAt.Equal(*b.LastAssignedAt)
+}
+
+func rejectionReason(authorID string, candidate domain.Candidate, policy domain.AssignmentPolicy,
+) (domain.RejectionReason, bool) {
+	switch {
+	case candidate.UserID == authorID:
+		return domain.RejectionAuthor, true
+	case !candidate.IsActive:
+		return domain.RejectionInactive, true
+	case candidate.IsAbsent:
+		return domain.RejectionAbsent, true
+	case candidate.IsTrainee:
+		return domain.RejectionTrainee, true
+	case policy.IsExcluded(authorID, candidate.UserID):
+		return domain.RejectionExcludedByRule, true
+	case !hasSkills(candidate, policy.RequiredSkills):
+		return domain.RejectionMissingSkill, true
+	case policy.MaxOpenReviews > 0 && candidate.OpenReviews >= policy.MaxOpenReviews:
+		return domain.RejectionAtCapacity, true
+	default:
+		return "", false
+	}
+}
+
+// requestRejectionReason applies the same rules as the strategy and additionally requires
+// the requested reviewer to belong to the author's team or one of its fallback teams.
+func requestRejectionReason(pool domain.AssignmentPool, policy domain.AssignmentPolicy, userID string,
+	candidates []domain.Candidate,
+) (domain.RejectionReason, bool) {
+	idx := slices.IndexFunc(candidates, func(c domain.Candidate) bool { return c.UserID == userID })
+	if idx < 0 {
+		return domain.RejectionUnknownUser, true
+	}
+	candidate := candidates[idx]
+
+	if reason, rejected := rejectionReason(pool.AuthorID, candidate, policy); rejected {
+		return reason, true
+	}
+	if candidate.TeamName != pool.TeamName && !slices.Contains(policy.FallbackTeams, candidate.TeamName) {
+		return domain.RejectionNotInTeam, true
+	}
+	return "", false
+}
+
+// mandatoryUnavailable checks only what a mandatory reviewer cannot bypass:
+// being the author, being inactive or absent, or not existing at all. Team rules such as
+// exclusions, skills and capacity do not apply to mandatory reviewers.
+func mandatoryUnavailable(authorID, userID string, candidates []domain.Candidate) (domain.RejectionReason, bool) {
+	if userID == authorID {
+		return domain.RejectionAuthor, true
+	}
+	for _, candidate := range candidates {
+		if candidate.UserID == userID {
+			if !candidate.IsActive {
+				return domain.RejectionInactive, true
+			}
+			if candidate.IsAbsent {
+				return domain.RejectionAbsent, true
+			}
+			return "", false
+		}
+	}
+	return domain.RejectionUnknownUser, true
+}
+
+func hasSkills(candidate domain.Candidate, required []string) bool {
+	for _, skill := range required {
+		if !slices.Contains(candidate.Skills, skill) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,682 @@
+package selection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// poolOf expects the assignment pool of u1 and the active policy of its team. A team without a
+// policy is given domain.ErrPolicyNotFound, so the default policy applies.
+func poolOf(mc *minimock.Controller, pool domain.AssignmentPool, policy domain.TeamPolicy, err error,
+) *RepositoryMock {
+	repo := NewRepositoryMock(mc)
+	repo.GetAssignmentPoolMock.Expect(minimock.AnyContext, "u1").Return(pool, nil)
+	repo.GetTeamPolicyMock.Expect(minimock.AnyContext, pool.TeamName, 0).Return(policy, err)
+	return repo
+}
+
+func auto(userIDs ...string) []domain.Reviewer {
+	reviewers := []domain.Reviewer{}
+	for _, userID := range userIDs {
+		reviewers = append(reviewers, domain.Reviewer{
+			UserID: userID,
+			Role:   domain.ReviewerRoleReviewer,
+			Source: domain.ReviewerSourceAuto,
+		})
+	}
+	return reviewers
+}
+
+func TestChoose(t *testing.T) {
+	t.Parallel()
+
+	earlier := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Hour)
+
+	tests := []struct {
+		name   string
+		pool   domain.AssignmentPool
+		policy domain.AssignmentPolicy
+		want   domain.Assignment
+	}{
+		{
+			name: "success: least loaded reviewers are chosen",
+			pool: domain.AssignmentPool{
+				AuthorID: "u1",
+				TeamName: "backend",
+				Candidates: []domain.Candidate{
+					{UserID: "u1", IsActive: true},
+					{UserID: "u2", IsActive: true, OpenReviews: 3},
+					{UserID: "u3", IsActive: true, OpenReviews: 1},
+					{UserID: "u4", IsActive: true, OpenReviews: 0},
+				},
+			},
+			policy: domain.DefaultAssignmentPolicy(),
+			want: domain.Assignment{
+				TeamName:  "backend",
+				Strategy:  domain.StrategyLeastLoaded,
+				Reviewers: auto("u4", "u3"),
+				Rejected: []domain.RejectedCandidate{
+					{UserID: "u1", Reason: domain.RejectionAuthor},
+					{UserID: "u2", Reason: domain.RejectionLowerScore},
+				},
+				RejectedRequests: []domain.RejectedCandidate{},
+			},
+		},
+		{
+			name: "success: equal load is ordered by user id",
+			pool: domain.AssignmentPool{
+				AuthorID: "u1",
+				TeamName: "backend",
+				Candidates: []domain.Candidate{
+					{UserID: "u3", IsActive: true},
+					{UserID: "u2", IsActive: true},
+				},
+			},
+			policy: domain.AssignmentPolicy{
+				Strategy:  domain.StrategyLeastLoaded,
+				Reviewers: domain.ReviewersPolicy{Count: 1},
+			},
+			want: domain.Assignment{
+				TeamName:  "backend",
+				Strategy:  domain.StrategyLeastLoaded,
+				Reviewers: auto("u2"),
+				Rejected: []domain.RejectedCandidate{
+					{UserID: "u3", Reason: domain.RejectionLowerScore},
+				},
+				RejectedRequests: []domain.RejectedCandidate{},
+			},
+		},
+		{
+			name: "success: round robin prefers never and least recently assigned",
+			pool: domain.AssignmentPool{
+				AuthorID: "u1",
+				TeamName: "backend",
+				Candidates: []domain.Candidate{
+					{UserID: "u2", IsActive: true, LastAssignedAt: &earlier},
+					{UserID: "u3", IsActive: true, LastAssignedAt: &later},
+					{UserID: "u4", IsActive: true, OpenReviews: 4},
+				},
+			},
+			policy: domain.AssignmentPolicy{
+				Strategy:  domain.StrategyRoundRobin,
+				Reviewers: domain.ReviewersPolicy{Count: 2},
+			},
+			want: domain.Assignment{
+				TeamName:  "backend",
+				Strategy:  domain.StrategyRoundRobin,
+				Reviewers: auto("u4", "u2"),
+				Rejected: []domain.RejectedCandidate{
+					{UserID: "u3", Reason: domain.RejectionLowerScore},
+				},
+				RejectedRequests: []domain.RejectedCandidate{},
+			},
+		},
+		{
+			name: "success: ineligible candidates are rejected with reasons",
+			pool: domain.AssignmentPool{
+				AuthorID: "u1",
+				TeamName: "backend",
+				Candidates: []domain.Candidate{
+					{UserID: "u1", IsActive: true, Skills: []string{"go"}},
+					{UserID: "u2", IsActive: false, Skills: []string{"go"}},
+					{UserID: "u3", IsActive: true, Skills: []string{"go"}},
+					{UserID: "u4", IsActive: true, Skills: []string{"go"}, OpenReviews: 5},
+					{UserID: "u5", IsActive: true, Skills: []string{"go"}, OpenReviews: 1},
+					{UserID: "u6", IsActive: true, Skills: []string{"sql"}},
+				},
+			},
+			policy: domain.AssignmentPolicy{
+				Strategy:       domain.StrategyLeastLoaded,
+				Reviewers:      domain.ReviewersPolicy{Count: 2},
+				MaxOpenReviews: 5,
+				RequiredSkills: []string{"go"},
+				Exclusions:     []domain.PolicyExclusion{{UserID: "u3", AuthorID: "u1"}},
+			},
+			want: domain.Assignment{
+				TeamName:  "backend",
+				Strategy:  domain.StrategyLeastLoaded,
+				Reviewers: auto("u5"),
+				Rejected: []domain.RejectedCandidate{
+					{UserID: "u1", Reason: domain.RejectionAuthor},
+					{UserID: "u2", Reason: domain.RejectionInactive},
+					{UserID: "u3", Reason: domain.RejectionExcludedByRule},
+					{UserID: "u4", Reason: domain.RejectionAtCapacity},
+					{UserID: "u6", Reason: domain.RejectionMissingSkill},
+				},
+				RejectedRequests: []domain.RejectedCandidate{},
+			},
+		},
+		{
+			name: "success: absent candidate is rejected",
+			pool: domain.AssignmentPool{
+				AuthorID: "u1",
+				TeamName: "backend",
+				Candidates: []domain.Candidate{
+					{UserID: "u2", IsActive: true, IsAbsent: true},
+					{UserID: "u3", IsActive: true, OpenReviews: 4},
+				},
+			},
+			policy: domain.DefaultAssignmentPolicy(),
+			want: domain.Assignment{
+				TeamName:  "backend",
+				Strategy:  domain.StrategyLeastLoaded,
+				Reviewers: auto("u3"),
+				Rejected: []domain.RejectedCandidate{
+					{UserID: "u2", Reason: domain.RejectionAbsent},
+				},
+				RejectedRequests: []domain.RejectedCandidate{},
+			},
+		},
+		{
+			name: "success: author alone in team gets no reviewers",
+			pool: domain.AssignmentPool{
+				AuthorID:   "u1",
+				TeamName:   "solo",
+				Candidates: []domain.Candidate{{UserID: "u1", IsActive: true}},
+			},
+			policy: domain.DefaultAssignmentPolicy(),
+			want: domain.Assignment{
+				TeamName:  "solo",
+				Strategy:  domain.StrategyLeastLoaded,
+				Reviewers: auto(),
+				Rejected: []domain.RejectedCandidate{
+					{UserID: "u1", Reason: domain.RejectionAuthor},
+				},
+				RejectedRequests: []domain.RejectedCandidate{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Choose(tt.pool, tt.policy)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSelector_Select(t *testing.T) {
+	t.Parallel()
+
+	pool := domain.AssignmentPool{
+		AuthorID: "u1",
+		TeamName: "backend",
+		Candidates: []domain.Candidate{
+			{UserID: "u1", TeamName: "backend", IsActive: true},
+			{UserID: "u2", TeamName: "backend", IsActive: true},
+			{UserID: "u3", TeamName: "backend", IsActive: false},
+		},
+	}
+	withPolicy := func(version int, policy domain.AssignmentPolicy) domain.TeamPolicy {
+		return domain.TeamPolicy{Version: version, Policy: policy}
+	}
+	securityRule := withPolicy(2, domain.AssignmentPolicy{
+		Strategy:  domain.StrategyLeastLoaded,
+		Reviewers: domain.ReviewersPolicy{Count: 2},
+		MandatoryReviewers: []domain.MandatoryReviewer{
+			{UserID: "s1", Labels: []string{"security"}},
+		},
+	})
+
+	tests := []struct {
+		name      string
+		repo      func(mc *minimock.Controller) repository
+		labels    []string
+		requested []string
+		shadow    bool
+		dependsOn []string
+		want      domain.Assignment
+		wantErr   error
+	}{
+		{
+			name: "success: default policy when team has none",
+			repo: func(mc *minimock.Controller) repository {
+				return poolOf(mc, pool, domain.TeamPolicy{}, domain.ErrPolicyNotFound)
+			},
+			want: domain.Assignment{
+				TeamName:  "backend",
+				Strategy:  domain.StrategyLeastLoaded,
+				Reviewers: auto("u2"),
+				Rejected: []domain.RejectedCandidate{
+					{UserID: "u1", Reason: domain.RejectionAuthor},
+					{UserID: "u3", Reason: domain.RejectionInactive},
+				},
+				RejectedRequests: []domain.RejectedCandidate{},
+			},
+		},
+		{
+			name: "success: remaining slots are filled from fallback teams",
+			repo: func(mc *minimock.Controller) repository {
+				repo := poolOf(mc, pool, withPolicy(3, domain.AssignmentPolicy{
+					Strategy:      domain.StrategyLeastLoaded,
+					Reviewers:     domain.ReviewersPolicy{Count: 2, Min: 2},
+					FallbackTeams: []string{"platform"},
+				}), nil)
+				repo.GetTeamCandidatesMock.Expect(minimock.AnyContext, "platform").
+					Return([]domain.Candidate{{UserID: "p1", IsActive: true}}, nil)
+				return repo
+			},
+			want: domain.Assignment{
+				TeamName:      "backend",
+				PolicyVersion: 3,
+				Strategy:      domain.StrategyLeastLoaded,
+				MinReviewers:  2,
+				Reviewers:     auto("u2", "p1"),
+				Rejected: []domain.RejectedCandidate{
+					{UserID: "u1", Reason: domain.RejectionAuthor},
+					{UserID: "u3", Reason: domain.RejectionInactive},
+				},
+				RejectedRequests: []domain.RejectedCandidate{},
+			},
+		},
+		{
+			name: "success: mandatory reviewer is assigned before the strategy",
+			repo: func(mc *minimock.Controller) repository {
+				repo := poolOf(mc, pool, securityRule, nil)
+				repo.GetCandidatesMock.Expect(minimock.AnyContext, []string{"s1", ""}).
+					Return([]domain.Candidate{{UserID: "s1", IsActive: true}}, nil)
+				return repo
+			},
+			labels: []string{"security"},
+			want: domain.Assignment{
+				TeamName:      "backend",
+				PolicyVersion: 2,
+				Strategy:      domain.StrategyLeastLoaded,
+				Reviewers: []domain.Reviewer{
+					{UserID: "s1", Role: domain.ReviewerRoleReviewer, Source: domain.ReviewerSourceMandatory},
+					{UserID: "u2", Role: domain.ReviewerRoleReviewer, Source: domain.ReviewerSourceAuto},
+				},
+				Rejected: []domain.RejectedCandidate{
+					{UserID: "u1", Reason: domain.RejectionAuthor},
+					{UserID: "u3", Reason: domain.RejectionInactive},
+				},
+				RejectedRequests: []domain.RejectedCandidate{},
+			},
+		},
+		{
+			name: "success: rule for another label is not applied",
+			repo: func(mc *minimock.Controller) repository {
+				return poolOf(mc, pool, securityRule, nil)
+			},
+			labels: []string{"docs"},
+			want: domain.Assignment{
+				TeamName:      "backend",
+				PolicyVersion: 2,
+				Strategy:      domain.StrategyLeastLoaded,
+				Reviewers:     auto("u2"),
+				Rejected: []domain.RejectedCandidate{
+					{UserID: "u1", Reason: domain.RejectionAuthor},
+					{UserID: "u3", Reason: domain.RejectionInactive},
+				},
+				RejectedRequests: []domain.RejectedCandidate{},
+			},
+		},
+		{
+			name: "success: inactive mandatory reviewer is replaced by deputy",
+			repo: func(mc *minimock.Controller) repository {
+				repo := poolOf(mc, pool, withPolicy(2, domain.AssignmentPolicy{
+					Strategy:  domain.StrategyLeastLoaded,
+					Reviewers: domain.ReviewersPolicy{Count: 2},
+					MandatoryReviewers: []domain.MandatoryReviewer{
+						{UserID: "s1", DeputyID: "s2"},
+					},
+				}), nil)
+				repo.GetCandidatesMock.Expect(minimock.AnyContext, []string{"s1", "s2"}).
+					Return([]domain.Candidate{
+						{UserID: "s1", IsActive: false},
+						{UserID: "s2", IsActive: true},
+					}, nil)
+				return repo
+			},
+			want: domain.Assignment{
+				TeamName:      "backend",
+				PolicyVersion: 2,
+				Strategy:      domain.StrategyLeastLoaded,
+				Reviewers: []domain.Reviewer{
+					{UserID: "s2", Role: domain.ReviewerRoleReviewer, Source: domain.ReviewerSourceDeputy, DeputyFor: "s1"},
+					{UserID: "u2", Role: domain.ReviewerRoleReviewer, Source: domain.ReviewerSourceAuto},
+				},
+				Rejected: []domain.RejectedCandidate{
+					{UserID: "s1", Reason: domain.RejectionInactive},
+					{UserID: "u1", Reason: domain.RejectionAuthor},
+					{UserID: "u3", Reason: domain.RejectionInactive},
+				},
+				RejectedRequests: []domain.RejectedCandidate{},
+			},
+		},
+		{
+			name: "success: absent mandatory reviewer is replaced by deputy",
+			repo: func(mc *minimock.Controller) repository {
+				repo := poolOf(mc, pool, withPolicy(2, domain.AssignmentPolicy{
+					Strategy:  domain.StrategyLeastLoaded,
+					Reviewers: domain.ReviewersPolicy{Count: 2},
+					MandatoryReviewers: []domain.MandatoryReviewer{
+						{UserID: "s1", DeputyID: "s2"},
+					},
+				}), nil)
+				repo.GetCandidatesMock.Expect(minimock.AnyContext, []string{"s1", "s2"}).
+					Return([]domain.Candidate{
+						{UserID: "s1", IsActive: true, IsAbsent: true},
+						{UserID: "s2", IsActive: true},
+					}, nil)
+				return repo
+			},
+			want: domain.Assignment{
+				TeamName:      "backend",
+				PolicyVersion: 2,
+				Strategy:      domain.StrategyLeastLoaded,
+				Reviewers: []domain.Reviewer{
+					{UserID: "s2", Role: domain.ReviewerRoleReviewer, Source: domain.ReviewerSourceDeputy, DeputyFor: "s1"},
+					{UserID: "u2", Role: domain.ReviewerRoleReviewer, Source: domain.ReviewerSourceAuto},
+				},
+				Rejected: []domain.RejectedCandidate{
+					{UserID: "s1", Reason: domain.RejectionAbsent},
+					{UserID: "u1", Reason: domain.RejectionAuthor},
+					{UserID: "u3", Reason: domain.RejectionInactive},
+				},
+				RejectedRequests: []domain.RejectedCandidate{},
+			},
+		},
+		{
+			name: "success: mandatory group contributes one member",
+			repo: func(mc *minimock.Controller) repository {
+				repo := poolOf(mc, pool, withPolicy(2, domain.AssignmentPolicy{
+					Strategy:  domain.StrategyLeastLoaded,
+					Reviewers: domain.ReviewersPolicy{Count: 2},
+					MandatoryReviewers: []domain.MandatoryReviewer{
+						{TeamName: "security"},
+					},
+				}), nil)
+				repo.GetTeamCandidatesMock.Expect(minimock.AnyContext, "security").
+					Return([]domain.Candidate{
+						{UserID: "s1", IsActive: true, OpenReviews: 2},
+						{UserID: "s2", IsActive: true},
+					}, nil)
+				return repo
+			},
+			want: domain.Assignment{
+				TeamName:      "backend",
+				PolicyVersion: 2,
+				Strategy:      domain.StrategyLeastLoaded,
+				Reviewers: []domain.Reviewer{
+					{UserID: "s2", Role: domain.ReviewerRoleReviewer, Source: domain.ReviewerSourceMandatory},
+					{UserID: "u2", Role: domain.ReviewerRoleReviewer, Source: domain.ReviewerSourceAuto},
+				},
+				Rejected: []domain.RejectedCandidate{
+					{UserID: "u1", Reason: domain.RejectionAuthor},
+					{UserID: "u3", Reason: domain.RejectionInactive},
+				},
+				RejectedRequests: []domain.RejectedCandidate{},
+			},
+		},
+		{
+			name: "error: mandatory reviewer without deputy is unavailable",
+			repo: func(mc *minimock.Controller) repository {
+				repo := poolOf(mc, pool, withPolicy(0, domain.AssignmentPolicy{
+					Strategy:  domain.StrategyLeastLoaded,
+					Reviewers: domain.ReviewersPolicy{Count: 2},
+					MandatoryReviewers: []domain.MandatoryReviewer{
+						{UserID: "s1"},
+					},
+				}), nil)
+				repo.GetCandidatesMock.Expect(minimock.AnyContext, []string{"s1", ""}).
+					Return([]domain.Candidate{{UserID: "s1", IsActive: false}}, nil)
+				return repo
+			},
+			want:    domain.Assignment{},
+			wantErr: domain.ErrMandatoryReviewerUnavailable,
+		},
+		{
+			name: "success: valid requested reviewers are honoured, invalid ones reported",
+			repo: func(mc *minimock.Controller) repository {
+				repo := poolOf(mc, pool, domain.TeamPolicy{}, domain.ErrPolicyNotFound)
+				repo.GetCandidatesMock.Expect(minimock.AnyContext, []string{"u3", "p1", "ghost", "u2"}).
+					Return([]domain.Candidate{
+						{UserID: "p1", TeamName: "platform", IsActive: true},
+						{UserID: "u2", TeamName: "backend", IsActive: true},
+						{UserID: "u3", TeamName: "backend", IsActive: false},
+					}, nil)
+				return repo
+			},
+			requested: []string{"u3", "p1", "ghost", "u2"},
+			want: domain.Assignment{
+				TeamName: "backend",
+				Strategy: domain.StrategyLeastLoaded,
+				Reviewers: []domain.Reviewer{
+					{UserID: "u2", Role: domain.ReviewerRoleReviewer, Source: domain.ReviewerSourceRequested},
+				},
+				Rejected: []domain.RejectedCandidate{
+					{UserID: "u1", Reason: domain.RejectionAuthor},
+					{UserID: "u3", Reason: domain.RejectionInactive},
+				},
+				RejectedRequests: []domain.RejectedCandidate{
+					{UserID: "u3", Reason: domain.RejectionInactive},
+					{UserID: "p1", Reason: domain.RejectionNotInTeam},
+					{UserID: "ghost", Reason: domain.RejectionUnknownUser},
+				},
+			},
+		},
+		{
+			name: "success: trainee is added as a shadow and never as a reviewer",
+			repo: func(mc *minimock.Controller) repository {
+				return poolOf(mc, domain.AssignmentPool{
+					AuthorID: "u1",
+					TeamName: "backend",
+					Candidates: []domain.Candidate{
+						{UserID: "u1", TeamName: "backend", IsActive: true},
+						{UserID: "t1", TeamName: "backend", IsActive: true, IsTrainee: true, OpenShadows: 2},
+						{UserID: "t2", TeamName: "backend", IsActive: true, IsTrainee: true},
+						{UserID: "u2", TeamName: "backend", IsActive: true},
+					},
+				}, domain.TeamPolicy{}, domain.ErrPolicyNotFound)
+			},
+			shadow: true,
+			want: domain.Assignment{
+				TeamName:  "backend",
+				Strategy:  domain.StrategyLeastLoaded,
+				Reviewers: auto("u2"),
+				Rejected: []domain.RejectedCandidate{
+					{UserID: "u1", Reason: domain.RejectionAuthor},
+					{UserID: "t1", Reason: domain.RejectionTrainee},
+					{UserID: "t2", Reason: domain.RejectionTrainee},
+				},
+				RejectedRequests: []domain.RejectedCandidate{},
+				Shadow: &domain.Reviewer{
+					UserID: "t2",
+					Role:   domain.ReviewerRoleShadow,
+					Source: domain.ReviewerSourceAuto,
+				},
+			},
+		},
+		{
+			name: "success: eligible reviewers of the base pull request are preferred",
+			repo: func(mc *minimock.Controller) repository {
+				stackPool := domain.AssignmentPool{
+					AuthorID: "u1",
+					TeamName: "backend",
+					Candidates: []domain.Candidate{
+						{UserID: "u1", TeamName: "backend", IsActive: true},
+						{UserID: "u2", TeamName: "backend", IsActive: true},
+						{UserID: "u3", TeamName: "backend", IsActive: false},
+						{UserID: "u4", TeamName: "backend", IsActive: true, OpenReviews: 5},
+					},
+				}
+				repo := poolOf(mc, stackPool, withPolicy(4, domain.AssignmentPolicy{
+					Strategy:             domain.StrategyLeastLoaded,
+					Reviewers:            domain.ReviewersPolicy{Count: 2},
+					PreferStackReviewers: true,
+				}), nil)
+				repo.GetStackReviewersMock.Expect(minimock.AnyContext, []string{"acme/api#1"}).
+					Return([]string{"u3", "u4"}, nil)
+				repo.GetCandidatesMock.Expect(minimock.AnyContext, []string{"u3", "u4"}).
+					Return(stackPool.Candidates[2:], nil)
+				return repo
+			},
+			dependsOn: []string{"acme/api#1"},
+			want: domain.Assignment{
+				TeamName:      "backend",
+				PolicyVersion: 4,
+				Strategy:      domain.StrategyLeastLoaded,
+				Reviewers: []domain.Reviewer{
+					{UserID: "u4", Role: domain.ReviewerRoleReviewer, Source: domain.ReviewerSourceStack},
+					{UserID: "u2", Role: domain.ReviewerRoleReviewer, Source: domain.ReviewerSourceAuto},
+				},
+				Rejected: []domain.RejectedCandidate{
+					{UserID: "u1", Reason: domain.RejectionAuthor},
+					{UserID: "u3", Reason: domain.RejectionInactive},
+				},
+				RejectedRequests: []domain.RejectedCandidate{},
+			},
+		},
+		{
+			name: "success: knowledge spread prefers who reviewed the author least",
+			repo: func(mc *minimock.Controller) repository {
+				repo := poolOf(mc, domain.AssignmentPool{
+					AuthorID: "u1",
+					TeamName: "backend",
+					Candidates: []domain.Candidate{
+						{UserID: "u1", TeamName: "backend", IsActive: true},
+						{UserID: "u2", TeamName: "backend", IsActive: true},
+						{UserID: "u4", TeamName: "backend", IsActive: true, OpenReviews: 2},
+					},
+				}, withPolicy(2, domain.AssignmentPolicy{
+					Strategy:  domain.StrategyKnowledgeSpread,
+					Reviewers: domain.ReviewersPolicy{Count: 1},
+				}), nil)
+				repo.GetReviewCountsMock.Set(func(_ context.Context, filter domain.KnowledgeFilter,
+				) ([]domain.ReviewCount, error) {
+					assert.Equal(t, "u1", filter.AuthorID)
+					return []domain.ReviewCount{
+						{AuthorID: "u1", Repository: "acme/api", ReviewerID: "u2", Reviews: 2},
+						{AuthorID: "u1", Repository: "acme/web", ReviewerID: "u2", Reviews: 1},
+					}, nil
+				})
+				return repo
+			},
+			want: domain.Assignment{
+				TeamName:      "backend",
+				PolicyVersion: 2,
+				Strategy:      domain.StrategyKnowledgeSpread,
+				Reviewers:     auto("u4"),
+				Rejected: []domain.RejectedCandidate{
+					{UserID: "u1", Reason: domain.RejectionAuthor},
+					{UserID: "u2", Reason: domain.RejectionLowerScore},
+				},
+				RejectedRequests: []domain.RejectedCandidate{},
+			},
+		},
+		{
+			name: "error: policy minimum cannot be met",
+			repo: func(mc *minimock.Controller) repository {
+				return poolOf(mc, pool, withPolicy(1, domain.AssignmentPolicy{
+					Strategy:  domain.StrategyLeastLoaded,
+					Reviewers: domain.ReviewersPolicy{Count: 2, Min: 2},
+				}), nil)
+			},
+			want: domain.Assignment{
+				TeamName:      "backend",
+				PolicyVersion: 1,
+				Strategy:      domain.StrategyLeastLoaded,
+				MinReviewers:  2,
+				Reviewers:     auto("u2"),
+				Rejected: []domain.RejectedCandidate{
+					{UserID: "u1", Reason: domain.RejectionAuthor},
+					{UserID: "u3", Reason: domain.RejectionInactive},
+				},
+				RejectedRequests: []domain.RejectedCandidate{},
+			},
+			wantErr: domain.ErrNotEnoughReviewers,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			got, err := New(tt.repo(mc)).Select(context.Background(), domain.CreatePullRequest{
+				AuthorID:           "u1",
+				Labels:             tt.labels,
+				RequestedReviewers: tt.requested,
+				AddShadow:          tt.shadow,
+				DependsOn:          tt.dependsOn,
+			})
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSelector_Additional(t *testing.T) {
+	t.Parallel()
+
+	pool := domain.AssignmentPool{
+		AuthorID: "u1",
+		TeamName: "backend",
+		Candidates: []domain.Candidate{
+			{UserID: "u1", TeamName: "backend", IsActive: true},
+			{UserID: "u2", TeamName: "backend", IsActive: true},
+			{UserID: "u3", TeamName: "backend", IsActive: true, OpenReviews: 1},
+			{UserID: "u4", TeamName: "backend", IsActive: true, OpenReviews: 2},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		taken   []string
+		count   int
+		want    []domain.Reviewer
+		wantErr error
+	}{
+		{
+			name:  "success: taken users are skipped",
+			taken: []string{"u2"},
+			count: 1,
+			want:  auto("u3"),
+		},
+		{
+			name:    "error: not enough candidates left",
+			taken:   []string{"u2", "u3"},
+			count:   2,
+			want:    auto("u4"),
+			wantErr: domain.ErrNotEnoughReviewers,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			repo := poolOf(mc, pool, domain.TeamPolicy{}, domain.ErrPolicyNotFound)
+
+			got, err := New(repo).Additional(context.Background(), "u1", tt.taken, tt.count)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got.Reviewers)
+		})
+	}
+}
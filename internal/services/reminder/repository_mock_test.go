@@ -0,0 +1,1580 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package reminder
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/reminder.repository -o repository_mock_test.go -n RepositoryMock -p reminder
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcFinishReminderRun          func(ctx context.Context, runID int64, deliveries []domain.ReminderDelivery) (err error)
+	funcFinishReminderRunOrigin    string
+	inspectFuncFinishReminderRun   func(ctx context.Context, runID int64, deliveries []domain.ReminderDelivery)
+	afterFinishReminderRunCounter  uint64
+	beforeFinishReminderRunCounter uint64
+	FinishReminderRunMock          mRepositoryMockFinishReminderRun
+
+	funcGetTeamPolicy          func(ctx context.Context, teamName string, version int) (t1 domain.TeamPolicy, err error)
+	funcGetTeamPolicyOrigin    string
+	inspectFuncGetTeamPolicy   func(ctx context.Context, teamName string, version int)
+	afterGetTeamPolicyCounter  uint64
+	beforeGetTeamPolicyCounter uint64
+	GetTeamPolicyMock          mRepositoryMockGetTeamPolicy
+
+	funcListPendingReviews          func(ctx context.Context, assignedBefore time.Time) (pa1 []domain.PendingReview, err error)
+	funcListPendingReviewsOrigin    string
+	inspectFuncListPendingReviews   func(ctx context.Context, assignedBefore time.Time)
+	afterListPendingReviewsCounter  uint64
+	beforeListPendingReviewsCounter uint64
+	ListPendingReviewsMock          mRepositoryMockListPendingReviews
+
+	funcStartReminderRun          func(ctx context.Context, teamName string, scheduledFor time.Time) (r1 domain.ReminderRun, b1 bool, err error)
+	funcStartReminderRunOrigin    string
+	inspectFuncStartReminderRun   func(ctx context.Context, teamName string, scheduledFor time.Time)
+	afterStartReminderRunCounter  uint64
+	beforeStartReminderRunCounter uint64
+	StartReminderRunMock          mRepositoryMockStartReminderRun
+}
+
+// NewRepositoryMock returns a mock for repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.FinishReminderRunMock = mRepositoryMockFinishReminderRun{mock: m}
+	m.FinishReminderRunMock.callArgs = []*RepositoryMockFinishReminderRunParams{}
+
+	m.GetTeamPolicyMock = mRepositoryMockGetTeamPolicy{mock: m}
+	m.GetTeamPolicyMock.callArgs = []*RepositoryMockGetTeamPolicyParams{}
+
+	m.ListPendingReviewsMock = mRepositoryMockListPendingReviews{mock: m}
+	m.ListPendingReviewsMock.callArgs = []*RepositoryMockListPendingReviewsParams{}
+
+	m.StartReminderRunMock = mRepositoryMockStartReminderRun{mock: m}
+	m.StartReminderRunMock.callArgs = []*RepositoryMockStartReminderRunParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockFinishReminderRun struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockFinishReminderRunExpectation
+	expectations       []*RepositoryMockFinishReminderRunExpectation
+
+	callArgs []*RepositoryMockFinishReminderRunParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockFinishReminderRunExpectation specifies expectation struct of the repository.FinishReminderRun
+type RepositoryMockFinishReminderRunExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockFinishReminderRunParams
+	paramPtrs          *RepositoryMockFinishReminderRunParamPtrs
+	expectationOrigins RepositoryMockFinishReminderRunExpectationOrigins
+	results            *RepositoryMockFinishReminderRunResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockFinishReminderRunParams contains parameters of the repository.FinishReminderRun
+type RepositoryMockFinishReminderRunParams struct {
+	ctx        context.Context
+	runID      int64
+	deliveries []domain.ReminderDelivery
+}
+
+// RepositoryMockFinishReminderRunParamPtrs contains pointers to parameters of the repository.FinishReminderRun
+type RepositoryMockFinishReminderRunParamPtrs struct {
+	ctx        *context.Context
+	runID      *int64
+	deliveries *[]domain.ReminderDelivery
+}
+
+// RepositoryMockFinishReminderRunResults contains results of the repository.FinishReminderRun
+type RepositoryMockFinishReminderRunResults struct {
+	err error
+}
+
+// RepositoryMockFinishReminderRunOrigins contains origins of expectations of the repository.FinishReminderRun
+type RepositoryMockFinishReminderRunExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originRunID      string
+	originDeliveries string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFinishReminderRun *mRepositoryMockFinishReminderRun) Optional() *mRepositoryMockFinishReminderRun {
+	mmFinishReminderRun.optional = true
+	return mmFinishReminderRun
+}
+
+// Expect sets up expected params for repository.FinishReminderRun
+func (mmFinishReminderRun *mRepositoryMockFinishReminderRun) Expect(ctx context.Context, runID int64, deliveries []domain.ReminderDelivery) *mRepositoryMockFinishReminderRun {
+	if mmFinishReminderRun.mock.funcFinishReminderRun != nil {
+		mmFinishReminderRun.mock.t.Fatalf("RepositoryMock.FinishReminderRun mock is already set by Set")
+	}
+
+	if mmFinishReminderRun.defaultExpectation == nil {
+		mmFinishReminderRun.defaultExpectation = &RepositoryMockFinishReminderRunExpectation{}
+	}
+
+	if mmFinishReminderRun.defaultExpectation.paramPtrs != nil {
+		mmFinishReminderRun.mock.t.Fatalf("RepositoryMock.FinishReminderRun mock is already set by ExpectParams functions")
+	}
+
+	mmFinishReminderRun.defaultExpectation.params = &RepositoryMockFinishReminderRunParams{ctx, runID, deliveries}
+	mmFinishReminderRun.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFinishReminderRun.expectations {
+		if minimock.Equal(e.params, mmFinishReminderRun.defaultExpectation.params) {
+			mmFinishReminderRun.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFinishReminderRun.defaultExpectation.params)
+		}
+	}
+
+	return mmFinishReminderRun
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.FinishReminderRun
+func (mmFinishReminderRun *mRepositoryMockFinishReminderRun) ExpectCtxParam1(ctx context.Context) *mRepositoryMockFinishReminderRun {
+	if mmFinishReminderRun.mock.funcFinishReminderRun != nil {
+		mmFinishReminderRun.mock.t.Fatalf("RepositoryMock.FinishReminderRun mock is already set by Set")
+	}
+
+	if mmFinishReminderRun.defaultExpectation == nil {
+		mmFinishReminderRun.defaultExpectation = &RepositoryMockFinishReminderRunExpectation{}
+	}
+
+	if mmFinishReminderRun.defaultExpectation.params != nil {
+		mmFinishReminderRun.mock.t.Fatalf("RepositoryMock.FinishReminderRun mock is already set by Expect")
+	}
+
+	if mmFinishReminderRun.defaultExpectation.paramPtrs == nil {
+		mmFinishReminderRun.defaultExpectation.paramPtrs = &RepositoryMockFinishReminderRunParamPtrs{}
+	}
+	mmFinishReminderRun.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFinishReminderRun.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFinishReminderRun
+}
+
+// ExpectRunIDParam2 sets up expected param runID for repository.FinishReminderRun
+func (mmFinishReminderRun *mRepositoryMockFinishReminderRun) ExpectRunIDParam2(runID int64) *mRepositoryMockFinishReminderRun {
+	if mmFinishReminderRun.mock.funcFinishReminderRun != nil {
+		mmFinishReminderRun.mock.t.Fatalf("RepositoryMock.FinishReminderRun mock is already set by Set")
+	}
+
+	if mmFinishReminderRun.defaultExpectation == nil {
+		mmFinishReminderRun.defaultExpectation = &RepositoryMockFinishReminderRunExpectation{}
+	}
+
+	if mmFinishReminderRun.defaultExpectation.params != nil {
+		mmFinishReminderRun.mock.t.Fatalf("RepositoryMock.FinishReminderRun mock is already set by Expect")
+	}
+
+	if mmFinishReminderRun.defaultExpectation.paramPtrs == nil {
+		mmFinishReminderRun.defaultExpectation.paramPtrs = &RepositoryMockFinishReminderRunParamPtrs{}
+	}
+	mmFinishReminderRun.defaultExpectation.paramPtrs.runID = &runID
+	mmFinishReminderRun.defaultExpectation.expectationOrigins.originRunID = minimock.CallerInfo(1)
+
+	return mmFinishReminderRun
+}
+
+// ExpectDeliveriesParam3 sets up expected param deliveries for repository.FinishReminderRun
+func (mmFinishReminderRun *mRepositoryMockFinishReminderRun) ExpectDeliveriesParam3(deliveries []domain.ReminderDelivery) *mRepositoryMockFinishReminderRun {
+	if mmFinishReminderRun.mock.funcFinishReminderRun != nil {
+		mmFinishReminderRun.mock.t.Fatalf("RepositoryMock.FinishReminderRun mock is already set by Set")
+	}
+
+	if mmFinishReminderRun.defaultExpectation == nil {
+		mmFinishReminderRun.defaultExpectation = &RepositoryMockFinishReminderRunExpectation{}
+	}
+
+	if mmFinishReminderRun.defaultExpectation.params != nil {
+		mmFinishReminderRun.mock.t.Fatalf("RepositoryMock.FinishReminderRun mock is already set by Expect")
+	}
+
+	if mmFinishReminderRun.defaultExpectation.paramPtrs == nil {
+		mmFinishReminderRun.defaultExpectation.paramPtrs = &RepositoryMockFinishReminderRunParamPtrs{}
+	}
+	mmFinishReminderRun.defaultExpectation.paramPtrs.deliveries = &deliveries
+	mmFinishReminderRun.defaultExpectation.expectationOrigins.originDeliveries = minimock.CallerInfo(1)
+
+	return mmFinishReminderRun
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.FinishReminderRun
+func (mmFinishReminderRun *mRepositoryMockFinishReminderRun) Inspect(f func(ctx context.Context, runID int64, deliveries []domain.ReminderDelivery)) *mRepositoryMockFinishReminderRun {
+	if mmFinishReminderRun.mock.inspectFuncFinishReminderRun != nil {
+		mmFinishReminderRun.mock.t.Fatalf("Inspect function is already set for RepositoryMock.FinishReminderRun")
+	}
+
+	mmFinishReminderRun.mock.inspectFuncFinishReminderRun = f
+
+	return mmFinishReminderRun
+}
+
+// Return sets up results that will be returned by repository.FinishReminderRun
+func (mmFinishReminderRun *mRepositoryMockFinishReminderRun) Return(err error) *RepositoryMock {
+	if mmFinishReminderRun.mock.funcFinishReminderRun != nil {
+		mmFinishReminderRun.mock.t.Fatalf("RepositoryMock.FinishReminderRun mock is already set by Set")
+	}
+
+	if mmFinishReminderRun.defaultExpectation == nil {
+		mmFinishReminderRun.defaultExpectation = &RepositoryMockFinishReminderRunExpectation{mock: mmFinishReminderRun.mock}
+	}
+	mmFinishReminderRun.defaultExpectation.results = &RepositoryMockFinishReminderRunResults{err}
+	mmFinishReminderRun.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFinishReminderRun.mock
+}
+
+// Set uses given function f to mock the repository.FinishReminderRun method
+func (mmFinishReminderRun *mRepositoryMockFinishReminderRun) Set(f func(ctx context.Context, runID int64, deliveries []domain.ReminderDelivery) (err error)) *RepositoryMock {
+	if mmFinishReminderRun.defaultExpectation != nil {
+		mmFinishReminderRun.mock.t.Fatalf("Default expectation is already set for the repository.FinishReminderRun method")
+	}
+
+	if len(mmFinishReminderRun.expectations) > 0 {
+		mmFinishReminderRun.mock.t.Fatalf("Some expectations are already set for the repository.FinishReminderRun method")
+	}
+
+	mmFinishReminderRun.mock.funcFinishReminderRun = f
+	mmFinishReminderRun.mock.funcFinishReminderRunOrigin = minimock.CallerInfo(1)
+	return mmFinishReminderRun.mock
+}
+
+// When sets expectation for the repository.FinishReminderRun which will trigger the result defined by the following
+// Then helper
+func (mmFinishReminderRun *mRepositoryMockFinishReminderRun) When(ctx context.Context, runID int64, deliveries []domain.ReminderDelivery) *RepositoryMockFinishReminderRunExpectation {
+	if mmFinishReminderRun.mock.funcFinishReminderRun != nil {
+		mmFinishReminderRun.mock.t.Fatalf("RepositoryMock.FinishReminderRun mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockFinishReminderRunExpectation{
+		mock:               mmFinishReminderRun.mock,
+		params:             &RepositoryMockFinishReminderRunParams{ctx, runID, deliveries},
+		expectationOrigins: RepositoryMockFinishReminderRunExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFinishReminderRun.expectations = append(mmFinishReminderRun.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.FinishReminderRun return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockFinishReminderRunExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockFinishReminderRunResults{err}
+	return e.mock
+}
+
+// Times sets number of times repository.FinishReminderRun should be invoked
+func (mmFinishReminderRun *mRepositoryMockFinishReminderRun) Times(n uint64) *mRepositoryMockFinishReminderRun {
+	if n == 0 {
+		mmFinishReminderRun.mock.t.Fatalf("Times of RepositoryMock.FinishReminderRun mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFinishReminderRun.expectedInvocations, n)
+	mmFinishReminderRun.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFinishReminderRun
+}
+
+func (mmFinishReminderRun *mRepositoryMockFinishReminderRun) invocationsDone() bool {
+	if len(mmFinishReminderRun.expectations) == 0 && mmFinishReminderRun.defaultExpectation == nil && mmFinishReminderRun.mock.funcFinishReminderRun == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFinishReminderRun.mock.afterFinishReminderRunCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFinishReminderRun.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FinishReminderRun implements repository
+func (mmFinishReminderRun *RepositoryMock) FinishReminderRun(ctx context.Context, runID int64, deliveries []domain.ReminderDelivery) (err error) {
+	mm_atomic.AddUint64(&mmFinishReminderRun.beforeFinishReminderRunCounter, 1)
+	defer mm_atomic.AddUint64(&mmFinishReminderRun.afterFinishReminderRunCounter, 1)
+
+	mmFinishReminderRun.t.Helper()
+
+	if mmFinishReminderRun.inspectFuncFinishReminderRun != nil {
+		mmFinishReminderRun.inspectFuncFinishReminderRun(ctx, runID, deliveries)
+	}
+
+	mm_params := RepositoryMockFinishReminderRunParams{ctx, runID, deliveries}
+
+	// Record call args
+	mmFinishReminderRun.FinishReminderRunMock.mutex.Lock()
+	mmFinishReminderRun.FinishReminderRunMock.callArgs = append(mmFinishReminderRun.FinishReminderRunMock.callArgs, &mm_params)
+	mmFinishReminderRun.FinishReminderRunMock.mutex.Unlock()
+
+	for _, e := range mmFinishReminderRun.FinishReminderRunMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmFinishReminderRun.FinishReminderRunMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFinishReminderRun.FinishReminderRunMock.defaultExpectation.Counter, 1)
+		mm_want := mmFinishReminderRun.FinishReminderRunMock.defaultExpectation.params
+		mm_want_ptrs := mmFinishReminderRun.FinishReminderRunMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockFinishReminderRunParams{ctx, runID, deliveries}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFinishReminderRun.t.Errorf("RepositoryMock.FinishReminderRun got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishReminderRun.FinishReminderRunMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.runID != nil && !minimock.Equal(*mm_want_ptrs.runID, mm_got.runID) {
+				mmFinishReminderRun.t.Errorf("RepositoryMock.FinishReminderRun got unexpected parameter runID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishReminderRun.FinishReminderRunMock.defaultExpectation.expectationOrigins.originRunID, *mm_want_ptrs.runID, mm_got.runID, minimock.Diff(*mm_want_ptrs.runID, mm_got.runID))
+			}
+
+			if mm_want_ptrs.deliveries != nil && !minimock.Equal(*mm_want_ptrs.deliveries, mm_got.deliveries) {
+				mmFinishReminderRun.t.Errorf("RepositoryMock.FinishReminderRun got unexpected parameter deliveries, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFinishReminderRun.FinishReminderRunMock.defaultExpectation.expectationOrigins.originDeliveries, *mm_want_ptrs.deliveries, mm_got.deliveries, minimock.Diff(*mm_want_ptrs.deliveries, mm_got.deliveries))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFinishReminderRun.t.Errorf("RepositoryMock.FinishReminderRun got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFinishReminderRun.FinishReminderRunMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFinishReminderRun.FinishReminderRunMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFinishReminderRun.t.Fatal("No results are set for the RepositoryMock.FinishReminderRun")
+		}
+		return (*mm_results).err
+	}
+	if mmFinishReminderRun.funcFinishReminderRun != nil {
+		return mmFinishReminderRun.funcFinishReminderRun(ctx, runID, deliveries)
+	}
+	mmFinishReminderRun.t.Fatalf("Unexpected call to RepositoryMock.FinishReminderRun. %v %v %v", ctx, runID, deliveries)
+	return
+}
+
+// FinishReminderRunAfterCounter returns a count of finished RepositoryMock.FinishReminderRun invocations
+func (mmFinishReminderRun *RepositoryMock) FinishReminderRunAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFinishReminderRun.afterFinishReminderRunCounter)
+}
+
+// FinishReminderRunBeforeCounter returns a count of RepositoryMock.FinishReminderRun invocations
+func (mmFinishReminderRun *RepositoryMock) FinishReminderRunBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFinishReminderRun.beforeFinishReminderRunCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.FinishReminderRun.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFinishReminderRun *mRepositoryMockFinishReminderRun) Calls() []*RepositoryMockFinishReminderRunParams {
+	mmFinishReminderRun.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockFinishReminderRunParams, len(mmFinishReminderRun.callArgs))
+	copy(argCopy, mmFinishReminderRun.callArgs)
+
+	mmFinishReminderRun.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFinishReminderRunDone returns true if the count of the FinishReminderRun invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockFinishReminderRunDone() bool {
+	if m.FinishReminderRunMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FinishReminderRunMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FinishReminderRunMock.invocationsDone()
+}
+
+// MinimockFinishReminderRunInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockFinishReminderRunInspect() {
+	for _, e := range m.FinishReminderRunMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.FinishReminderRun at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFinishReminderRunCounter := mm_atomic.LoadUint64(&m.afterFinishReminderRunCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FinishReminderRunMock.defaultExpectation != nil && afterFinishReminderRunCounter < 1 {
+		if m.FinishReminderRunMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.FinishReminderRun at\n%s", m.FinishReminderRunMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.FinishReminderRun at\n%s with params: %#v", m.FinishReminderRunMock.defaultExpectation.expectationOrigins.origin, *m.FinishReminderRunMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFinishReminderRun != nil && afterFinishReminderRunCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.FinishReminderRun at\n%s", m.funcFinishReminderRunOrigin)
+	}
+
+	if !m.FinishReminderRunMock.invocationsDone() && afterFinishReminderRunCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.FinishReminderRun at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FinishReminderRunMock.expectedInvocations), m.FinishReminderRunMock.expectedInvocationsOrigin, afterFinishReminderRunCounter)
+	}
+}
+
+type mRepositoryMockGetTeamPolicy struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetTeamPolicyExpectation
+	expectations       []*RepositoryMockGetTeamPolicyExpectation
+
+	callArgs []*RepositoryMockGetTeamPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetTeamPolicyExpectation specifies expectation struct of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetTeamPolicyParams
+	paramPtrs          *RepositoryMockGetTeamPolicyParamPtrs
+	expectationOrigins RepositoryMockGetTeamPolicyExpectationOrigins
+	results            *RepositoryMockGetTeamPolicyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetTeamPolicyParams contains parameters of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyParams struct {
+	ctx      context.Context
+	teamName string
+	version  int
+}
+
+// RepositoryMockGetTeamPolicyParamPtrs contains pointers to parameters of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyParamPtrs struct {
+	ctx      *context.Context
+	teamName *string
+	version  *int
+}
+
+// RepositoryMockGetTeamPolicyResults contains results of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyResults struct {
+	t1  domain.TeamPolicy
+	err error
+}
+
+// RepositoryMockGetTeamPolicyOrigins contains origins of expectations of the repository.GetTeamPolicy
+type RepositoryMockGetTeamPolicyExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originTeamName string
+	originVersion  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Optional() *mRepositoryMockGetTeamPolicy {
+	mmGetTeamPolicy.optional = true
+	return mmGetTeamPolicy
+}
+
+// Expect sets up expected params for repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Expect(ctx context.Context, teamName string, version int) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{}
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.paramPtrs != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmGetTeamPolicy.defaultExpectation.params = &RepositoryMockGetTeamPolicyParams{ctx, teamName, version}
+	mmGetTeamPolicy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTeamPolicy.expectations {
+		if minimock.Equal(e.params, mmGetTeamPolicy.defaultExpectation.params) {
+			mmGetTeamPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTeamPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTeamPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{}
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.params != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Expect")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetTeamPolicy.defaultExpectation.paramPtrs = &RepositoryMockGetTeamPolicyParamPtrs{}
+	}
+	mmGetTeamPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTeamPolicy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTeamPolicy
+}
+
+// ExpectTeamNameParam2 sets up expected param teamName for repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) ExpectTeamNameParam2(teamName string) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{}
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.params != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Expect")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetTeamPolicy.defaultExpectation.paramPtrs = &RepositoryMockGetTeamPolicyParamPtrs{}
+	}
+	mmGetTeamPolicy.defaultExpectation.paramPtrs.teamName = &teamName
+	mmGetTeamPolicy.defaultExpectation.expectationOrigins.originTeamName = minimock.CallerInfo(1)
+
+	return mmGetTeamPolicy
+}
+
+// ExpectVersionParam3 sets up expected param version for repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) ExpectVersionParam3(version int) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{}
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.params != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Expect")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetTeamPolicy.defaultExpectation.paramPtrs = &RepositoryMockGetTeamPolicyParamPtrs{}
+	}
+	mmGetTeamPolicy.defaultExpectation.paramPtrs.version = &version
+	mmGetTeamPolicy.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmGetTeamPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Inspect(f func(ctx context.Context, teamName string, version int)) *mRepositoryMockGetTeamPolicy {
+	if mmGetTeamPolicy.mock.inspectFuncGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetTeamPolicy")
+	}
+
+	mmGetTeamPolicy.mock.inspectFuncGetTeamPolicy = f
+
+	return mmGetTeamPolicy
+}
+
+// Return sets up results that will be returned by repository.GetTeamPolicy
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Return(t1 domain.TeamPolicy, err error) *RepositoryMock {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	if mmGetTeamPolicy.defaultExpectation == nil {
+		mmGetTeamPolicy.defaultExpectation = &RepositoryMockGetTeamPolicyExpectation{mock: mmGetTeamPolicy.mock}
+	}
+	mmGetTeamPolicy.defaultExpectation.results = &RepositoryMockGetTeamPolicyResults{t1, err}
+	mmGetTeamPolicy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTeamPolicy.mock
+}
+
+// Set uses given function f to mock the repository.GetTeamPolicy method
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Set(f func(ctx context.Context, teamName string, version int) (t1 domain.TeamPolicy, err error)) *RepositoryMock {
+	if mmGetTeamPolicy.defaultExpectation != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("Default expectation is already set for the repository.GetTeamPolicy method")
+	}
+
+	if len(mmGetTeamPolicy.expectations) > 0 {
+		mmGetTeamPolicy.mock.t.Fatalf("Some expectations are already set for the repository.GetTeamPolicy method")
+	}
+
+	mmGetTeamPolicy.mock.funcGetTeamPolicy = f
+	mmGetTeamPolicy.mock.funcGetTeamPolicyOrigin = minimock.CallerInfo(1)
+	return mmGetTeamPolicy.mock
+}
+
+// When sets expectation for the repository.GetTeamPolicy which will trigger the result defined by the following
+// Then helper
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) When(ctx context.Context, teamName string, version int) *RepositoryMockGetTeamPolicyExpectation {
+	if mmGetTeamPolicy.mock.funcGetTeamPolicy != nil {
+		mmGetTeamPolicy.mock.t.Fatalf("RepositoryMock.GetTeamPolicy mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetTeamPolicyExpectation{
+		mock:               mmGetTeamPolicy.mock,
+		params:             &RepositoryMockGetTeamPolicyParams{ctx, teamName, version},
+		expectationOrigins: RepositoryMockGetTeamPolicyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTeamPolicy.expectations = append(mmGetTeamPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.GetTeamPolicy return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetTeamPolicyExpectation) Then(t1 domain.TeamPolicy, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetTeamPolicyResults{t1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.GetTeamPolicy should be invoked
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Times(n uint64) *mRepositoryMockGetTeamPolicy {
+	if n == 0 {
+		mmGetTeamPolicy.mock.t.Fatalf("Times of RepositoryMock.GetTeamPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTeamPolicy.expectedInvocations, n)
+	mmGetTeamPolicy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTeamPolicy
+}
+
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) invocationsDone() bool {
+	if len(mmGetTeamPolicy.expectations) == 0 && mmGetTeamPolicy.defaultExpectation == nil && mmGetTeamPolicy.mock.funcGetTeamPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTeamPolicy.mock.afterGetTeamPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTeamPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTeamPolicy implements repository
+func (mmGetTeamPolicy *RepositoryMock) GetTeamPolicy(ctx context.Context, teamName string, version int) (t1 domain.TeamPolicy, err error) {
+	mm_atomic.AddUint64(&mmGetTeamPolicy.beforeGetTeamPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTeamPolicy.afterGetTeamPolicyCounter, 1)
+
+	mmGetTeamPolicy.t.Helper()
+
+	if mmGetTeamPolicy.inspectFuncGetTeamPolicy != nil {
+		mmGetTeamPolicy.inspectFuncGetTeamPolicy(ctx, teamName, version)
+	}
+
+	mm_params := RepositoryMockGetTeamPolicyParams{ctx, teamName, version}
+
+	// Record call args
+	mmGetTeamPolicy.GetTeamPolicyMock.mutex.Lock()
+	mmGetTeamPolicy.GetTeamPolicyMock.callArgs = append(mmGetTeamPolicy.GetTeamPolicyMock.callArgs, &mm_params)
+	mmGetTeamPolicy.GetTeamPolicyMock.mutex.Unlock()
+
+	for _, e := range mmGetTeamPolicy.GetTeamPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.err
+		}
+	}
+
+	if mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetTeamPolicyParams{ctx, teamName, version}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTeamPolicy.t.Errorf("RepositoryMock.GetTeamPolicy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.teamName != nil && !minimock.Equal(*mm_want_ptrs.teamName, mm_got.teamName) {
+				mmGetTeamPolicy.t.Errorf("RepositoryMock.GetTeamPolicy got unexpected parameter teamName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.expectationOrigins.originTeamName, *mm_want_ptrs.teamName, mm_got.teamName, minimock.Diff(*mm_want_ptrs.teamName, mm_got.teamName))
+			}
+
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmGetTeamPolicy.t.Errorf("RepositoryMock.GetTeamPolicy got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTeamPolicy.t.Errorf("RepositoryMock.GetTeamPolicy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTeamPolicy.GetTeamPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTeamPolicy.t.Fatal("No results are set for the RepositoryMock.GetTeamPolicy")
+		}
+		return (*mm_results).t1, (*mm_results).err
+	}
+	if mmGetTeamPolicy.funcGetTeamPolicy != nil {
+		return mmGetTeamPolicy.funcGetTeamPolicy(ctx, teamName, version)
+	}
+	mmGetTeamPolicy.t.Fatalf("Unexpected call to RepositoryMock.GetTeamPolicy. %v %v %v", ctx, teamName, version)
+	return
+}
+
+// GetTeamPolicyAfterCounter returns a count of finished RepositoryMock.GetTeamPolicy invocations
+func (mmGetTeamPolicy *RepositoryMock) GetTeamPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTeamPolicy.afterGetTeamPolicyCounter)
+}
+
+// GetTeamPolicyBeforeCounter returns a count of RepositoryMock.GetTeamPolicy invocations
+func (mmGetTeamPolicy *RepositoryMock) GetTeamPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTeamPolicy.beforeGetTeamPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetTeamPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTeamPolicy *mRepositoryMockGetTeamPolicy) Calls() []*RepositoryMockGetTeamPolicyParams {
+	mmGetTeamPolicy.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetTeamPolicyParams, len(mmGetTeamPolicy.callArgs))
+	copy(argCopy, mmGetTeamPolicy.callArgs)
+
+	mmGetTeamPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTeamPolicyDone returns true if the count of the GetTeamPolicy invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetTeamPolicyDone() bool {
+	if m.GetTeamPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTeamPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTeamPolicyMock.invocationsDone()
+}
+
+// MinimockGetTeamPolicyInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetTeamPolicyInspect() {
+	for _, e := range m.GetTeamPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetTeamPolicy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTeamPolicyCounter := mm_atomic.LoadUint64(&m.afterGetTeamPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTeamPolicyMock.defaultExpectation != nil && afterGetTeamPolicyCounter < 1 {
+		if m.GetTeamPolicyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetTeamPolicy at\n%s", m.GetTeamPolicyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetTeamPolicy at\n%s with params: %#v", m.GetTeamPolicyMock.defaultExpectation.expectationOrigins.origin, *m.GetTeamPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTeamPolicy != nil && afterGetTeamPolicyCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetTeamPolicy at\n%s", m.funcGetTeamPolicyOrigin)
+	}
+
+	if !m.GetTeamPolicyMock.invocationsDone() && afterGetTeamPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetTeamPolicy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTeamPolicyMock.expectedInvocations), m.GetTeamPolicyMock.expectedInvocationsOrigin, afterGetTeamPolicyCounter)
+	}
+}
+
+type mRepositoryMockListPendingReviews struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockListPendingReviewsExpectation
+	expectations       []*RepositoryMockListPendingReviewsExpectation
+
+	callArgs []*RepositoryMockListPendingReviewsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockListPendingReviewsExpectation specifies expectation struct of the repository.ListPendingReviews
+type RepositoryMockListPendingReviewsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockListPendingReviewsParams
+	paramPtrs          *RepositoryMockListPendingReviewsParamPtrs
+	expectationOrigins RepositoryMockListPendingReviewsExpectationOrigins
+	results            *RepositoryMockListPendingReviewsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockListPendingReviewsParams contains parameters of the repository.ListPendingReviews
+type RepositoryMockListPendingReviewsParams struct {
+	ctx            context.Context
+	assignedBefore time.Time
+}
+
+// RepositoryMockListPendingReviewsParamPtrs contains pointers to parameters of the repository.ListPendingReviews
+type RepositoryMockListPendingReviewsParamPtrs struct {
+	ctx            *context.Context
+	assignedBefore *time.Time
+}
+
+// RepositoryMockListPendingReviewsResults contains results of the repository.ListPendingReviews
+type RepositoryMockListPendingReviewsResults struct {
+	pa1 []domain.PendingReview
+	err error
+}
+
+// RepositoryMockListPendingReviewsOrigins contains origins of expectations of the repository.ListPendingReviews
+type RepositoryMockListPendingReviewsExpectationOrigins struct {
+	origin               string
+	originCtx            string
+	originAssignedBefore string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) Optional() *mRepositoryMockListPendingReviews {
+	mmListPendingReviews.optional = true
+	return mmListPendingReviews
+}
+
+// Expect sets up expected params for repository.ListPendingReviews
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) Expect(ctx context.Context, assignedBefore time.Time) *mRepositoryMockListPendingReviews {
+	if mmListPendingReviews.mock.funcListPendingReviews != nil {
+		mmListPendingReviews.mock.t.Fatalf("RepositoryMock.ListPendingReviews mock is already set by Set")
+	}
+
+	if mmListPendingReviews.defaultExpectation == nil {
+		mmListPendingReviews.defaultExpectation = &RepositoryMockListPendingReviewsExpectation{}
+	}
+
+	if mmListPendingReviews.defaultExpectation.paramPtrs != nil {
+		mmListPendingReviews.mock.t.Fatalf("RepositoryMock.ListPendingReviews mock is already set by ExpectParams functions")
+	}
+
+	mmListPendingReviews.defaultExpectation.params = &RepositoryMockListPendingReviewsParams{ctx, assignedBefore}
+	mmListPendingReviews.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPendingReviews.expectations {
+		if minimock.Equal(e.params, mmListPendingReviews.defaultExpectation.params) {
+			mmListPendingReviews.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPendingReviews.defaultExpectation.params)
+		}
+	}
+
+	return mmListPendingReviews
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.ListPendingReviews
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) ExpectCtxParam1(ctx context.Context) *mRepositoryMockListPendingReviews {
+	if mmListPendingReviews.mock.funcListPendingReviews != nil {
+		mmListPendingReviews.mock.t.Fatalf("RepositoryMock.ListPendingReviews mock is already set by Set")
+	}
+
+	if mmListPendingReviews.defaultExpectation == nil {
+		mmListPendingReviews.defaultExpectation = &RepositoryMockListPendingReviewsExpectation{}
+	}
+
+	if mmListPendingReviews.defaultExpectation.params != nil {
+		mmListPendingReviews.mock.t.Fatalf("RepositoryMock.ListPendingReviews mock is already set by Expect")
+	}
+
+	if mmListPendingReviews.defaultExpectation.paramPtrs == nil {
+		mmListPendingReviews.defaultExpectation.paramPtrs = &RepositoryMockListPendingReviewsParamPtrs{}
+	}
+	mmListPendingReviews.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPendingReviews.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPendingReviews
+}
+
+// ExpectAssignedBeforeParam2 sets up expected param assignedBefore for repository.ListPendingReviews
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) ExpectAssignedBeforeParam2(assignedBefore time.Time) *mRepositoryMockListPendingReviews {
+	if mmListPendingReviews.mock.funcListPendingReviews != nil {
+		mmListPendingReviews.mock.t.Fatalf("RepositoryMock.ListPendingReviews mock is already set by Set")
+	}
+
+	if mmListPendingReviews.defaultExpectation == nil {
+		mmListPendingReviews.defaultExpectation = &RepositoryMockListPendingReviewsExpectation{}
+	}
+
+	if mmListPendingReviews.defaultExpectation.params != nil {
+		mmListPendingReviews.mock.t.Fatalf("RepositoryMock.ListPendingReviews mock is already set by Expect")
+	}
+
+	if mmListPendingReviews.defaultExpectation.paramPtrs == nil {
+		mmListPendingReviews.defaultExpectation.paramPtrs = &RepositoryMockListPendingReviewsParamPtrs{}
+	}
+	mmListPendingReviews.defaultExpectation.paramPtrs.assignedBefore = &assignedBefore
+	mmListPendingReviews.defaultExpectation.expectationOrigins.originAssignedBefore = minimock.CallerInfo(1)
+
+	return mmListPendingReviews
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.ListPendingReviews
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) Inspect(f func(ctx context.Context, assignedBefore time.Time)) *mRepositoryMockListPendingReviews {
+	if mmListPendingReviews.mock.inspectFuncListPendingReviews != nil {
+		mmListPendingReviews.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ListPendingReviews")
+	}
+
+	mmListPendingReviews.mock.inspectFuncListPendingReviews = f
+
+	return mmListPendingReviews
+}
+
+// Return sets up results that will be returned by repository.ListPendingReviews
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) Return(pa1 []domain.PendingReview, err error) *RepositoryMock {
+	if mmListPendingReviews.mock.funcListPendingReviews != nil {
+		mmListPendingReviews.mock.t.Fatalf("RepositoryMock.ListPendingReviews mock is already set by Set")
+	}
+
+	if mmListPendingReviews.defaultExpectation == nil {
+		mmListPendingReviews.defaultExpectation = &RepositoryMockListPendingReviewsExpectation{mock: mmListPendingReviews.mock}
+	}
+	mmListPendingReviews.defaultExpectation.results = &RepositoryMockListPendingReviewsResults{pa1, err}
+	mmListPendingReviews.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPendingReviews.mock
+}
+
+// Set uses given function f to mock the repository.ListPendingReviews method
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) Set(f func(ctx context.Context, assignedBefore time.Time) (pa1 []domain.PendingReview, err error)) *RepositoryMock {
+	if mmListPendingReviews.defaultExpectation != nil {
+		mmListPendingReviews.mock.t.Fatalf("Default expectation is already set for the repository.ListPendingReviews method")
+	}
+
+	if len(mmListPendingReviews.expectations) > 0 {
+		mmListPendingReviews.mock.t.Fatalf("Some expectations are already set for the repository.ListPendingReviews method")
+	}
+
+	mmListPendingReviews.mock.funcListPendingReviews = f
+	mmListPendingReviews.mock.funcListPendingReviewsOrigin = minimock.CallerInfo(1)
+	return mmListPendingReviews.mock
+}
+
+// When sets expectation for the repository.ListPendingReviews which will trigger the result defined by the following
+// Then helper
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) When(ctx context.Context, assignedBefore time.Time) *RepositoryMockListPendingReviewsExpectation {
+	if mmListPendingReviews.mock.funcListPendingReviews != nil {
+		mmListPendingReviews.mock.t.Fatalf("RepositoryMock.ListPendingReviews mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockListPendingReviewsExpectation{
+		mock:               mmListPendingReviews.mock,
+		params:             &RepositoryMockListPendingReviewsParams{ctx, assignedBefore},
+		expectationOrigins: RepositoryMockListPendingReviewsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPendingReviews.expectations = append(mmListPendingReviews.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.ListPendingReviews return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockListPendingReviewsExpectation) Then(pa1 []domain.PendingReview, err error) *RepositoryMock {
+	e.results = &RepositoryMockListPendingReviewsResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.ListPendingReviews should be invoked
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) Times(n uint64) *mRepositoryMockListPendingReviews {
+	if n == 0 {
+		mmListPendingReviews.mock.t.Fatalf("Times of RepositoryMock.ListPendingReviews mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPendingReviews.expectedInvocations, n)
+	mmListPendingReviews.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPendingReviews
+}
+
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) invocationsDone() bool {
+	if len(mmListPendingReviews.expectations) == 0 && mmListPendingReviews.defaultExpectation == nil && mmListPendingReviews.mock.funcListPendingReviews == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPendingReviews.mock.afterListPendingReviewsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPendingReviews.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPendingReviews implements repository
+func (mmListPendingReviews *RepositoryMock) ListPendingReviews(ctx context.Context, assignedBefore time.Time) (pa1 []domain.PendingReview, err error) {
+	mm_atomic.AddUint64(&mmListPendingReviews.beforeListPendingReviewsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPendingReviews.afterListPendingReviewsCounter, 1)
+
+	mmListPendingReviews.t.Helper()
+
+	if mmListPendingReviews.inspectFuncListPendingReviews != nil {
+		mmListPendingReviews.inspectFuncListPendingReviews(ctx, assignedBefore)
+	}
+
+	mm_params := RepositoryMockListPendingReviewsParams{ctx, assignedBefore}
+
+	// Record call args
+	mmListPendingReviews.ListPendingReviewsMock.mutex.Lock()
+	mmListPendingReviews.ListPendingReviewsMock.callArgs = append(mmListPendingReviews.ListPendingReviewsMock.callArgs, &mm_params)
+	mmListPendingReviews.ListPendingReviewsMock.mutex.Unlock()
+
+	for _, e := range mmListPendingReviews.ListPendingReviewsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmListPendingReviews.ListPendingReviewsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPendingReviews.ListPendingReviewsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPendingReviews.ListPendingReviewsMock.defaultExpectation.params
+		mm_want_ptrs := mmListPendingReviews.ListPendingReviewsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockListPendingReviewsParams{ctx, assignedBefore}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPendingReviews.t.Errorf("RepositoryMock.ListPendingReviews got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPendingReviews.ListPendingReviewsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.assignedBefore != nil && !minimock.Equal(*mm_want_ptrs.assignedBefore, mm_got.assignedBefore) {
+				mmListPendingReviews.t.Errorf("RepositoryMock.ListPendingReviews got unexpected parameter assignedBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPendingReviews.ListPendingReviewsMock.defaultExpectation.expectationOrigins.originAssignedBefore, *mm_want_ptrs.assignedBefore, mm_got.assignedBefore, minimock.Diff(*mm_want_ptrs.assignedBefore, mm_got.assignedBefore))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPendingReviews.t.Errorf("RepositoryMock.ListPendingReviews got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPendingReviews.ListPendingReviewsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPendingReviews.ListPendingReviewsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPendingReviews.t.Fatal("No results are set for the RepositoryMock.ListPendingReviews")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmListPendingReviews.funcListPendingReviews != nil {
+		return mmListPendingReviews.funcListPendingReviews(ctx, assignedBefore)
+	}
+	mmListPendingReviews.t.Fatalf("Unexpected call to RepositoryMock.ListPendingReviews. %v %v", ctx, assignedBefore)
+	return
+}
+
+// ListPendingReviewsAfterCounter returns a count of finished RepositoryMock.ListPendingReviews invocations
+func (mmListPendingReviews *RepositoryMock) ListPendingReviewsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPendingReviews.afterListPendingReviewsCounter)
+}
+
+// ListPendingReviewsBeforeCounter returns a count of RepositoryMock.ListPendingReviews invocations
+func (mmListPendingReviews *RepositoryMock) ListPendingReviewsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPendingReviews.beforeListPendingReviewsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ListPendingReviews.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPendingReviews *mRepositoryMockListPendingReviews) Calls() []*RepositoryMockListPendingReviewsParams {
+	mmListPendingReviews.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockListPendingReviewsParams, len(mmListPendingReviews.callArgs))
+	copy(argCopy, mmListPendingReviews.callArgs)
+
+	mmListPendingReviews.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPendingReviewsDone returns true if the count of the ListPendingReviews invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockListPendingReviewsDone() bool {
+	if m.ListPendingReviewsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPendingReviewsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPendingReviewsMock.invocationsDone()
+}
+
+// MinimockListPendingReviewsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockListPendingReviewsInspect() {
+	for _, e := range m.ListPendingReviewsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ListPendingReviews at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPendingReviewsCounter := mm_atomic.LoadUint64(&m.afterListPendingReviewsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPendingReviewsMock.defaultExpectation != nil && afterListPendingReviewsCounter < 1 {
+		if m.ListPendingReviewsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.ListPendingReviews at\n%s", m.ListPendingReviewsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ListPendingReviews at\n%s with params: %#v", m.ListPendingReviewsMock.defaultExpectation.expectationOrigins.origin, *m.ListPendingReviewsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPendingReviews != nil && afterListPendingReviewsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.ListPendingReviews at\n%s", m.funcListPendingReviewsOrigin)
+	}
+
+	if !m.ListPendingReviewsMock.invocationsDone() && afterListPendingReviewsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ListPendingReviews at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPendingReviewsMock.expectedInvocations), m.ListPendingReviewsMock.expectedInvocationsOrigin, afterListPendingReviewsCounter)
+	}
+}
+
+type mRepositoryMockStartReminderRun struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockStartReminderRunExpectation
+	expectations       []*RepositoryMockStartReminderRunExpectation
+
+	callArgs []*RepositoryMockStartReminderRunParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockStartReminderRunExpectation specifies expectation struct of the repository.StartReminderRun
+type RepositoryMockStartReminderRunExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockStartReminderRunParams
+	paramPtrs          *RepositoryMockStartReminderRunParamPtrs
+	expectationOrigins RepositoryMockStartReminderRunExpectationOrigins
+	results            *RepositoryMockStartReminderRunResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockStartReminderRunParams contains parameters of the repository.StartReminderRun
+type RepositoryMockStartReminderRunParams struct {
+	ctx          context.Context
+	teamName     string
+	scheduledFor time.Time
+}
+
+// RepositoryMockStartReminderRunParamPtrs contains pointers to parameters of the repository.StartReminderRun
+type RepositoryMockStartReminderRunParamPtrs struct {
+	ctx          *context.Context
+	teamName     *string
+	scheduledFor *time.Time
+}
+
+// RepositoryMockStartReminderRunResults contains results of the repository.StartReminderRun
+type RepositoryMockStartReminderRunResults struct {
+	r1  domain.ReminderRun
+	b1  bool
+	err error
+}
+
+// RepositoryMockStartReminderRunOrigins contains origins of expectations of the repository.StartReminderRun
+type RepositoryMockStartReminderRunExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originTeamName     string
+	originScheduledFor string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmStartReminderRun *mRepositoryMockStartReminderRun) Optional() *mRepositoryMockStartReminderRun {
+	mmStartReminderRun.optional = true
+	return mmStartReminderRun
+}
+
+// Expect sets up expected params for repository.StartReminderRun
+func (mmStartReminderRun *mRepositoryMockStartReminderRun) Expect(ctx context.Context, teamName string, scheduledFor time.Time) *mRepositoryMockStartReminderRun {
+	if mmStartReminderRun.mock.funcStartReminderRun != nil {
+		mmStartReminderRun.mock.t.Fatalf("RepositoryMock.StartReminderRun mock is already set by Set")
+	}
+
+	if mmStartReminderRun.defaultExpectation == nil {
+		mmStartReminderRun.defaultExpectation = &RepositoryMockStartReminderRunExpectation{}
+	}
+
+	if mmStartReminderRun.defaultExpectation.paramPtrs != nil {
+		mmStartReminderRun.mock.t.Fatalf("RepositoryMock.StartReminderRun mock is already set by ExpectParams functions")
+	}
+
+	mmStartReminderRun.defaultExpectation.params = &RepositoryMockStartReminderRunParams{ctx, teamName, scheduledFor}
+	mmStartReminderRun.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmStartReminderRun.expectations {
+		if minimock.Equal(e.params, mmStartReminderRun.defaultExpectation.params) {
+			mmStartReminderRun.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStartReminderRun.defaultExpectation.params)
+		}
+	}
+
+	return mmStartReminderRun
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.StartReminderRun
+func (mmStartReminderRun *mRepositoryMockStartReminderRun) ExpectCtxParam1(ctx context.Context) *mRepositoryMockStartReminderRun {
+	if mmStartReminderRun.mock.funcStartReminderRun != nil {
+		mmStartReminderRun.mock.t.Fatalf("RepositoryMock.StartReminderRun mock is already set by Set")
+	}
+
+	if mmStartReminderRun.defaultExpectation == nil {
+		mmStartReminderRun.defaultExpectation = &RepositoryMockStartReminderRunExpectation{}
+	}
+
+	if mmStartReminderRun.defaultExpectation.params != nil {
+		mmStartReminderRun.mock.t.Fatalf("RepositoryMock.StartReminderRun mock is already set by Expect")
+	}
+
+	if mmStartReminderRun.defaultExpectation.paramPtrs == nil {
+		mmStartReminderRun.defaultExpectation.paramPtrs = &RepositoryMockStartReminderRunParamPtrs{}
+	}
+	mmStartReminderRun.defaultExpectation.paramPtrs.ctx = &ctx
+	mmStartReminderRun.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmStartReminderRun
+}
+
+// ExpectTeamNameParam2 sets up expected param teamName for repository.StartReminderRun
+func (mmStartReminderRun *mRepositoryMockStartReminderRun) ExpectTeamNameParam2(teamName string) *mRepositoryMockStartReminderRun {
+	if mmStartReminderRun.mock.funcStartReminderRun != nil {
+		mmStartReminderRun.mock.t.Fatalf("RepositoryMock.StartReminderRun mock is already set by Set")
+	}
+
+	if mmStartReminderRun.defaultExpectation == nil {
+		mmStartReminderRun.defaultExpectation = &RepositoryMockStartReminderRunExpectation{}
+	}
+
+	if mmStartReminderRun.defaultExpectation.params != nil {
+		mmStartReminderRun.mock.t.Fatalf("RepositoryMock.StartReminderRun mock is already set by Expect")
+	}
+
+	if mmStartReminderRun.defaultExpectation.paramPtrs == nil {
+		mmStartReminderRun.defaultExpectation.paramPtrs = &RepositoryMockStartReminderRunParamPtrs{}
+	}
+	mmStartReminderRun.defaultExpectation.paramPtrs.teamName = &teamName
+	mmStartReminderRun.defaultExpectation.expectationOrigins.originTeamName = minimock.CallerInfo(1)
+
+	return mmStartReminderRun
+}
+
+// ExpectScheduledForParam3 sets up expected param scheduledFor for repository.StartReminderRun
+func (mmStartReminderRun *mRepositoryMockStartReminderRun) ExpectScheduledForParam3(scheduledFor time.Time) *mRepositoryMockStartReminderRun {
+	if mmStartReminderRun.mock.funcStartReminderRun != nil {
+		mmStartReminderRun.mock.t.Fatalf("RepositoryMock.StartReminderRun mock is already set by Set")
+	}
+
+	if mmStartReminderRun.defaultExpectation == nil {
+		mmStartReminderRun.defaultExpectation = &RepositoryMockStartReminderRunExpectation{}
+	}
+
+	if mmStartReminderRun.defaultExpectation.params != nil {
+		mmStartReminderRun.mock.t.Fatalf("RepositoryMock.StartReminderRun mock is already set by Expect")
+	}
+
+	if mmStartReminderRun.defaultExpectation.paramPtrs == nil {
+		mmStartReminderRun.defaultExpectation.paramPtrs = &RepositoryMockStartReminderRunParamPtrs{}
+	}
+	mmStartReminderRun.defaultExpectation.paramPtrs.scheduledFor = &scheduledFor
+	mmStartReminderRun.defaultExpectation.expectationOrigins.originScheduledFor = minimock.CallerInfo(1)
+
+	return mmStartReminderRun
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.StartReminderRun
+func (mmStartReminderRun *mRepositoryMockStartReminderRun) Inspect(f func(ctx context.Context, teamName string, scheduledFor time.Time)) *mRepositoryMockStartReminderRun {
+	if mmStartReminderRun.mock.inspectFuncStartReminderRun != nil {
+		mmStartReminderRun.mock.t.Fatalf("Inspect function is already set for RepositoryMock.StartReminderRun")
+	}
+
+	mmStartReminderRun.mock.inspectFuncStartReminderRun = f
+
+	return mmStartReminderRun
+}
+
+// Return sets up results that will be returned by repository.StartReminderRun
+func (mmStartReminderRun *mRepositoryMockStartReminderRun) Return(r1 domain.ReminderRun, b1 bool, err error) *RepositoryMock {
+	if mmStartReminderRun.mock.funcStartReminderRun != nil {
+		mmStartReminderRun.mock.t.Fatalf("RepositoryMock.StartReminderRun mock is already set by Set")
+	}
+
+	if mmStartReminderRun.defaultExpectation == nil {
+		mmStartReminderRun.defaultExpectation = &RepositoryMockStartReminderRunExpectation{mock: mmStartReminderRun.mock}
+	}
+	mmStartReminderRun.defaultExpectation.results = &RepositoryMockStartReminderRunResults{r1, b1, err}
+	mmStartReminderRun.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmStartReminderRun.mock
+}
+
+// Set uses given function f to mock the repository.StartReminderRun method
+func (mmStartReminderRun *mRepositoryMockStartReminderRun) Set(f func(ctx context.Context, teamName string, scheduledFor time.Time) (r1 domain.ReminderRun, b1 bool, err error)) *RepositoryMock {
+	if mmStartReminderRun.defaultExpectation != nil {
+		mmStartReminderRun.mock.t.Fatalf("Default expectation is already set for the repository.StartReminderRun method")
+	}
+
+	if len(mmStartReminderRun.expectations) > 0 {
+		mmStartReminderRun.mock.t.Fatalf("Some expectations are already set for the repository.StartReminderRun method")
+	}
+
+	mmStartReminderRun.mock.funcStartReminderRun = f
+	mmStartReminderRun.mock.funcStartReminderRunOrigin = minimock.CallerInfo(1)
+	return mmStartReminderRun.mock
+}
+
+// When sets expectation for the repository.StartReminderRun which will trigger the result defined by the following
+// Then helper
+func (mmStartReminderRun *mRepositoryMockStartReminderRun) When(ctx context.Context, teamName string, scheduledFor time.Time) *RepositoryMockStartReminderRunExpectation {
+	if mmStartReminderRun.mock.funcStartReminderRun != nil {
+		mmStartReminderRun.mock.t.Fatalf("RepositoryMock.StartReminderRun mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockStartReminderRunExpectation{
+		mock:               mmStartReminderRun.mock,
+		params:             &RepositoryMockStartReminderRunParams{ctx, teamName, scheduledFor},
+		expectationOrigins: RepositoryMockStartReminderRunExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmStartReminderRun.expectations = append(mmStartReminderRun.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.StartReminderRun return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockStartReminderRunExpectation) Then(r1 domain.ReminderRun, b1 bool, err error) *RepositoryMock {
+	e.results = &RepositoryMockStartReminderRunResults{r1, b1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.StartReminderRun should be invoked
+func (mmStartReminderRun *mRepositoryMockStartReminderRun) Times(n uint64) *mRepositoryMockStartReminderRun {
+	if n == 0 {
+		mmStartReminderRun.mock.t.Fatalf("Times of RepositoryMock.StartReminderRun mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmStartReminderRun.expectedInvocations, n)
+	mmStartReminderRun.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmStartReminderRun
+}
+
+func (mmStartReminderRun *mRepositoryMockStartReminderRun) invocationsDone() bool {
+	if len(mmStartReminderRun.expectations) == 0 && mmStartReminderRun.defaultExpectation == nil && mmStartReminderRun.mock.funcStartReminderRun == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmStartReminderRun.mock.afterStartReminderRunCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStartReminderRun.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// StartReminderRun implements repository
+func (mmStartReminderRun *RepositoryMock) StartReminderRun(ctx context.Context, teamName string, scheduledFor time.Time) (r1 domain.ReminderRun, b1 bool, err error) {
+	mm_atomic.AddUint64(&mmStartReminderRun.beforeStartReminderRunCounter, 1)
+	defer mm_atomic.AddUint64(&mmStartReminderRun.afterStartReminderRunCounter, 1)
+
+	mmStartReminderRun.t.Helper()
+
+	if mmStartReminderRun.inspectFuncStartReminderRun != nil {
+		mmStartReminderRun.inspectFuncStartReminderRun(ctx, teamName, scheduledFor)
+	}
+
+	mm_params := RepositoryMockStartReminderRunParams{ctx, teamName, scheduledFor}
+
+	// Record call args
+	mmStartReminderRun.StartReminderRunMock.mutex.Lock()
+	mmStartReminderRun.StartReminderRunMock.callArgs = append(mmStartReminderRun.StartReminderRunMock.callArgs, &mm_params)
+	mmStartReminderRun.StartReminderRunMock.mutex.Unlock()
+
+	for _, e := range mmStartReminderRun.StartReminderRunMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.b1, e.results.err
+		}
+	}
+
+	if mmStartReminderRun.StartReminderRunMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStartReminderRun.StartReminderRunMock.defaultExpectation.Counter, 1)
+		mm_want := mmStartReminderRun.StartReminderRunMock.defaultExpectation.params
+		mm_want_ptrs := mmStartReminderRun.StartReminderRunMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockStartReminderRunParams{ctx, teamName, scheduledFor}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmStartReminderRun.t.Errorf("RepositoryMock.StartReminderRun got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStartReminderRun.StartReminderRunMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.teamName != nil && !minimock.Equal(*mm_want_ptrs.teamName, mm_got.teamName) {
+				mmStartReminderRun.t.Errorf("RepositoryMock.StartReminderRun got unexpected parameter teamName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStartReminderRun.StartReminderRunMock.defaultExpectation.expectationOrigins.originTeamName, *mm_want_ptrs.teamName, mm_got.teamName, minimock.Diff(*mm_want_ptrs.teamName, mm_got.teamName))
+			}
+
+			if mm_want_ptrs.scheduledFor != nil && !minimock.Equal(*mm_want_ptrs.scheduledFor, mm_got.scheduledFor) {
+				mmStartReminderRun.t.Errorf("RepositoryMock.StartReminderRun got unexpected parameter scheduledFor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStartReminderRun.StartReminderRunMock.defaultExpectation.expectationOrigins.originScheduledFor, *mm_want_ptrs.scheduledFor, mm_got.scheduledFor, minimock.Diff(*mm_want_ptrs.scheduledFor, mm_got.scheduledFor))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStartReminderRun.t.Errorf("RepositoryMock.StartReminderRun got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmStartReminderRun.StartReminderRunMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmStartReminderRun.StartReminderRunMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStartReminderRun.t.Fatal("No results are set for the RepositoryMock.StartReminderRun")
+		}
+		return (*mm_results).r1, (*mm_results).b1, (*mm_results).err
+	}
+	if mmStartReminderRun.funcStartReminderRun != nil {
+		return mmStartReminderRun.funcStartReminderRun(ctx, teamName, scheduledFor)
+	}
+	mmStartReminderRun.t.Fatalf("Unexpected call to RepositoryMock.StartReminderRun. %v %v %v", ctx, teamName, scheduledFor)
+	return
+}
+
+// StartReminderRunAfterCounter returns a count of finished RepositoryMock.StartReminderRun invocations
+func (mmStartReminderRun *RepositoryMock) StartReminderRunAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStartReminderRun.afterStartReminderRunCounter)
+}
+
+// StartReminderRunBeforeCounter returns a count of RepositoryMock.StartReminderRun invocations
+func (mmStartReminderRun *RepositoryMock) StartReminderRunBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStartReminderRun.beforeStartReminderRunCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.StartReminderRun.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStartReminderRun *mRepositoryMockStartReminderRun) Calls() []*RepositoryMockStartReminderRunParams {
+	mmStartReminderRun.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockStartReminderRunParams, len(mmStartReminderRun.callArgs))
+	copy(argCopy, mmStartReminderRun.callArgs)
+
+	mmStartReminderRun.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStartReminderRunDone returns true if the count of the StartReminderRun invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockStartReminderRunDone() bool {
+	if m.StartReminderRunMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.StartReminderRunMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.StartReminderRunMock.invocationsDone()
+}
+
+// MinimockStartReminderRunInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockStartReminderRunInspect() {
+	for _, e := range m.StartReminderRunMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.StartReminderRun at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterStartReminderRunCounter := mm_atomic.LoadUint64(&m.afterStartReminderRunCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StartReminderRunMock.defaultExpectation != nil && afterStartReminderRunCounter < 1 {
+		if m.StartReminderRunMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.StartReminderRun at\n%s", m.StartReminderRunMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.StartReminderRun at\n%s with params: %#v", m.StartReminderRunMock.defaultExpectation.expectationOrigins.origin, *m.StartReminderRunMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStartReminderRun != nil && afterStartReminderRunCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.StartReminderRun at\n%s", m.funcStartReminderRunOrigin)
+	}
+
+	if !m.StartReminderRunMock.invocationsDone() && afterStartReminderRunCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.StartReminderRun at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.StartReminderRunMock.expectedInvocations), m.StartReminderRunMock.expectedInvocationsOrigin, afterStartReminderRunCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockFinishReminderRunInspect()
+
+			m.MinimockGetTeamPolicyInspect()
+
+			m.MinimockListPendingReviewsInspect()
+
+			m.MinimockStartReminderRunInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockFinishReminderRunDone() &&
+		m.MinimockGetTeamPolicyDone() &&
+		m.MinimockListPendingReviewsDone() &&
+		m.MinimockStartReminderRunDone()
+}
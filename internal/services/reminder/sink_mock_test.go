@@ -0,0 +1,417 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package reminder
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/reminder.sink -o sink_mock_test.go -n SinkMock -p reminder
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// SinkMock implements sink
+type SinkMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcSend          func(ctx context.Context, notification domain.Notification) (err error)
+	funcSendOrigin    string
+	inspectFuncSend   func(ctx context.Context, notification domain.Notification)
+	afterSendCounter  uint64
+	beforeSendCounter uint64
+	SendMock          mSinkMockSend
+}
+
+// NewSinkMock returns a mock for sink
+func NewSinkMock(t minimock.Tester) *SinkMock {
+	m := &SinkMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SendMock = mSinkMockSend{mock: m}
+	m.SendMock.callArgs = []*SinkMockSendParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mSinkMockSend struct {
+	optional           bool
+	mock               *SinkMock
+	defaultExpectation *SinkMockSendExpectation
+	expectations       []*SinkMockSendExpectation
+
+	callArgs []*SinkMockSendParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SinkMockSendExpectation specifies expectation struct of the sink.Send
+type SinkMockSendExpectation struct {
+	mock               *SinkMock
+	params             *SinkMockSendParams
+	paramPtrs          *SinkMockSendParamPtrs
+	expectationOrigins SinkMockSendExpectationOrigins
+	results            *SinkMockSendResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SinkMockSendParams contains parameters of the sink.Send
+type SinkMockSendParams struct {
+	ctx          context.Context
+	notification domain.Notification
+}
+
+// SinkMockSendParamPtrs contains pointers to parameters of the sink.Send
+type SinkMockSendParamPtrs struct {
+	ctx          *context.Context
+	notification *domain.Notification
+}
+
+// SinkMockSendResults contains results of the sink.Send
+type SinkMockSendResults struct {
+	err error
+}
+
+// SinkMockSendOrigins contains origins of expectations of the sink.Send
+type SinkMockSendExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originNotification string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSend *mSinkMockSend) Optional() *mSinkMockSend {
+	mmSend.optional = true
+	return mmSend
+}
+
+// Expect sets up expected params for sink.Send
+func (mmSend *mSinkMockSend) Expect(ctx context.Context, notification domain.Notification) *mSinkMockSend {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("SinkMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &SinkMockSendExpectation{}
+	}
+
+	if mmSend.defaultExpectation.paramPtrs != nil {
+		mmSend.mock.t.Fatalf("SinkMock.Send mock is already set by ExpectParams functions")
+	}
+
+	mmSend.defaultExpectation.params = &SinkMockSendParams{ctx, notification}
+	mmSend.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSend.expectations {
+		if minimock.Equal(e.params, mmSend.defaultExpectation.params) {
+			mmSend.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSend.defaultExpectation.params)
+		}
+	}
+
+	return mmSend
+}
+
+// ExpectCtxParam1 sets up expected param ctx for sink.Send
+func (mmSend *mSinkMockSend) ExpectCtxParam1(ctx context.Context) *mSinkMockSend {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("SinkMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &SinkMockSendExpectation{}
+	}
+
+	if mmSend.defaultExpectation.params != nil {
+		mmSend.mock.t.Fatalf("SinkMock.Send mock is already set by Expect")
+	}
+
+	if mmSend.defaultExpectation.paramPtrs == nil {
+		mmSend.defaultExpectation.paramPtrs = &SinkMockSendParamPtrs{}
+	}
+	mmSend.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSend.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSend
+}
+
+// ExpectNotificationParam2 sets up expected param notification for sink.Send
+func (mmSend *mSinkMockSend) ExpectNotificationParam2(notification domain.Notification) *mSinkMockSend {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("SinkMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &SinkMockSendExpectation{}
+	}
+
+	if mmSend.defaultExpectation.params != nil {
+		mmSend.mock.t.Fatalf("SinkMock.Send mock is already set by Expect")
+	}
+
+	if mmSend.defaultExpectation.paramPtrs == nil {
+		mmSend.defaultExpectation.paramPtrs = &SinkMockSendParamPtrs{}
+	}
+	mmSend.defaultExpectation.paramPtrs.notification = &notification
+	mmSend.defaultExpectation.expectationOrigins.originNotification = minimock.CallerInfo(1)
+
+	return mmSend
+}
+
+// Inspect accepts an inspector function that has same arguments as the sink.Send
+func (mmSend *mSinkMockSend) Inspect(f func(ctx context.Context, notification domain.Notification)) *mSinkMockSend {
+	if mmSend.mock.inspectFuncSend != nil {
+		mmSend.mock.t.Fatalf("Inspect function is already set for SinkMock.Send")
+	}
+
+	mmSend.mock.inspectFuncSend = f
+
+	return mmSend
+}
+
+// Return sets up results that will be returned by sink.Send
+func (mmSend *mSinkMockSend) Return(err error) *SinkMock {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("SinkMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &SinkMockSendExpectation{mock: mmSend.mock}
+	}
+	mmSend.defaultExpectation.results = &SinkMockSendResults{err}
+	mmSend.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSend.mock
+}
+
+// Set uses given function f to mock the sink.Send method
+func (mmSend *mSinkMockSend) Set(f func(ctx context.Context, notification domain.Notification) (err error)) *SinkMock {
+	if mmSend.defaultExpectation != nil {
+		mmSend.mock.t.Fatalf("Default expectation is already set for the sink.Send method")
+	}
+
+	if len(mmSend.expectations) > 0 {
+		mmSend.mock.t.Fatalf("Some expectations are already set for the sink.Send method")
+	}
+
+	mmSend.mock.funcSend = f
+	mmSend.mock.funcSendOrigin = minimock.CallerInfo(1)
+	return mmSend.mock
+}
+
+// When sets expectation for the sink.Send which will trigger the result defined by the following
+// Then helper
+func (mmSend *mSinkMockSend) When(ctx context.Context, notification domain.Notification) *SinkMockSendExpectation {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("SinkMock.Send mock is already set by Set")
+	}
+
+	expectation := &SinkMockSendExpectation{
+		mock:               mmSend.mock,
+		params:             &SinkMockSendParams{ctx, notification},
+		expectationOrigins: SinkMockSendExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSend.expectations = append(mmSend.expectations, expectation)
+	return expectation
+}
+
+// Then sets up sink.Send return parameters for the expectation previously defined by the When method
+func (e *SinkMockSendExpectation) Then(err error) *SinkMock {
+	e.results = &SinkMockSendResults{err}
+	return e.mock
+}
+
+// Times sets number of times sink.Send should be invoked
+func (mmSend *mSinkMockSend) Times(n uint64) *mSinkMockSend {
+	if n == 0 {
+		mmSend.mock.t.Fatalf("Times of SinkMock.Send mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSend.expectedInvocations, n)
+	mmSend.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSend
+}
+
+func (mmSend *mSinkMockSend) invocationsDone() bool {
+	if len(mmSend.expectations) == 0 && mmSend.defaultExpectation == nil && mmSend.mock.funcSend == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSend.mock.afterSendCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSend.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Send implements sink
+func (mmSend *SinkMock) Send(ctx context.Context, notification domain.Notification) (err error) {
+	mm_atomic.AddUint64(&mmSend.beforeSendCounter, 1)
+	defer mm_atomic.AddUint64(&mmSend.afterSendCounter, 1)
+
+	mmSend.t.Helper()
+
+	if mmSend.inspectFuncSend != nil {
+		mmSend.inspectFuncSend(ctx, notification)
+	}
+
+	mm_params := SinkMockSendParams{ctx, notification}
+
+	// Record call args
+	mmSend.SendMock.mutex.Lock()
+	mmSend.SendMock.callArgs = append(mmSend.SendMock.callArgs, &mm_params)
+	mmSend.SendMock.mutex.Unlock()
+
+	for _, e := range mmSend.SendMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSend.SendMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSend.SendMock.defaultExpectation.Counter, 1)
+		mm_want := mmSend.SendMock.defaultExpectation.params
+		mm_want_ptrs := mmSend.SendMock.defaultExpectation.paramPtrs
+
+		mm_got := SinkMockSendParams{ctx, notification}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSend.t.Errorf("SinkMock.Send got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSend.SendMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.notification != nil && !minimock.Equal(*mm_want_ptrs.notification, mm_got.notification) {
+				mmSend.t.Errorf("SinkMock.Send got unexpected parameter notification, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSend.SendMock.defaultExpectation.expectationOrigins.originNotification, *mm_want_ptrs.notification, mm_got.notification, minimock.Diff(*mm_want_ptrs.notification, mm_got.notification))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSend.t.Errorf("SinkMock.Send got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSend.SendMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSend.SendMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSend.t.Fatal("No results are set for the SinkMock.Send")
+		}
+		return (*mm_results).err
+	}
+	if mmSend.funcSend != nil {
+		return mmSend.funcSend(ctx, notification)
+	}
+	mmSend.t.Fatalf("Unexpected call to SinkMock.Send. %v %v", ctx, notification)
+	return
+}
+
+// SendAfterCounter returns a count of finished SinkMock.Send invocations
+func (mmSend *SinkMock) SendAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSend.afterSendCounter)
+}
+
+// SendBeforeCounter returns a count of SinkMock.Send invocations
+func (mmSend *SinkMock) SendBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSend.beforeSendCounter)
+}
+
+// Calls returns a list of arguments used in each call to SinkMock.Send.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSend *mSinkMockSend) Calls() []*SinkMockSendParams {
+	mmSend.mutex.RLock()
+
+	argCopy := make([]*SinkMockSendParams, len(mmSend.callArgs))
+	copy(argCopy, mmSend.callArgs)
+
+	mmSend.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendDone returns true if the count of the Send invocations corresponds
+// the number of defined expectations
+func (m *SinkMock) MinimockSendDone() bool {
+	if m.SendMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendMock.invocationsDone()
+}
+
+// MinimockSendInspect logs each unmet expectation
+func (m *SinkMock) MinimockSendInspect() {
+	for _, e := range m.SendMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SinkMock.Send at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendCounter := mm_atomic.LoadUint64(&m.afterSendCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendMock.defaultExpectation != nil && afterSendCounter < 1 {
+		if m.SendMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SinkMock.Send at\n%s", m.SendMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SinkMock.Send at\n%s with params: %#v", m.SendMock.defaultExpectation.expectationOrigins.origin, *m.SendMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSend != nil && afterSendCounter < 1 {
+		m.t.Errorf("Expected call to SinkMock.Send at\n%s", m.funcSendOrigin)
+	}
+
+	if !m.SendMock.invocationsDone() && afterSendCounter > 0 {
+		m.t.Errorf("Expected %d calls to SinkMock.Send at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendMock.expectedInvocations), m.SendMock.expectedInvocationsOrigin, afterSendCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SinkMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockSendInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *SinkMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *SinkMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockSendDone()
+}
@@ -0,0 +1,217 @@
+// Package reminder runs the background worker that sends reviewers a digest of the PRs waiting
+// on them, following the reminder schedule of their team.
+//
+// Every replica of the service runs the worker. A run is stored once per team and scheduled
+// moment before anything is sent, so each reviewer gets at most one reminder per run.
+package reminder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// lateness is how long after its scheduled moment a run is still started. Runs missed
+// for longer, for example while the service was down, are skipped instead of sent late.
+const lateness = time.Hour
+
+type (
+	repository interface {
+		ListPendingReviews(ctx context.Context, assignedBefore time.Time) ([]domain.PendingReview, error)
+		GetTeamPolicy(ctx context.Context, teamName string, version int) (domain.TeamPolicy, error)
+		StartReminderRun(ctx context.Context, teamName string, scheduledFor time.Time) (domain.ReminderRun, bool, error)
+		FinishReminderRun(ctx context.Context, runID int64, deliveries []domain.ReminderDelivery) error
+	}
+	sink interface {
+		Send(ctx context.Context, notification domain.Notification) error
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Worker struct {
+		repo     repository
+		sink     sink
+		logger   logger
+		interval time.Duration
+		now      func() time.Time
+	}
+)
+
+func New(repo repository, sink sink, logger logger, interval time.Duration) *Worker {
+	return &Worker{
+		repo:     repo,
+		sink:     sink,
+		logger:   logger,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run checks the reminder schedules every interval until ctx is done.
+func (w *Worker) Run(ctx context.Context) {
+	logger := w.logger.With(zap.String("service", "reminder.worker"))
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			runs, err := w.Remind(ctx)
+			if err != nil {
+				logger.Error("Remind", zap.Error(err))
+				continue
+			}
+			for _, run := range runs {
+				logger.Info("reminders sent", zap.String("team_name", run.TeamName),
+					zap.Time("scheduled_for", run.ScheduledFor), zap.Int("deliveries", len(run.Deliveries)))
+			}
+		}
+	}
+}
+
+// Remind starts the due reminder run of every team having pending reviews and returns the runs
+// started by this call. A failing team is logged and skipped, so it does not block the others.
+func (w *Worker) Remind(ctx context.Context) ([]domain.ReminderRun, error) {
+	logger := w.logger.With(zap.String("service", "reminder.worker"))
+	now := w.now()
+
+	reviews, err := w.repo.ListPendingReviews(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListPendingReviews: %w", err)
+	}
+
+	var (
+		teams  []string
+		byTeam = make(map[string][]domain.PendingReview)
+		runs   []domain.ReminderRun
+	)
+	for _, review := range reviews {
+		if _, ok := byTeam[review.TeamName]; !ok {
+			teams = append(teams, review.TeamName)
+		}
+		byTeam[review.TeamName] = append(byTeam[review.TeamName], review)
+	}
+
+	for _, teamName := range teams {
+		logger := logger.With(zap.String("team_name", teamName))
+
+		policy, err := w.policy(ctx, teamName)
+		if err != nil {
+			logger.Error("policy", zap.Error(err))
+			continue
+		}
+		if policy.Reminders == nil {
+			continue
+		}
+
+		scheduledFor, ok := policy.Reminders.Last(now, policy.Calendar().Location())
+		if !ok || now.Sub(scheduledFor) > lateness {
+			continue
+		}
+
+		digests := digests(byTeam[teamName], scheduledFor)
+		if len(digests) == 0 {
+			continue
+		}
+
+		run, claimed, err := w.repo.StartReminderRun(ctx, teamName, scheduledFor)
+		if err != nil {
+			logger.Error("repo.StartReminderRun", zap.Error(err))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		run.Deliveries = w.send(ctx, digests)
+
+		if err := w.repo.FinishReminderRun(ctx, run.ID, run.Deliveries); err != nil {
+			logger.Error("repo.FinishReminderRun", zap.Error(err), zap.Int64("run_id", run.ID))
+			continue
+		}
+
+		runs = append(runs, run)
+	}
+
+	return runs, nil
+}
+
+func (w *Worker) policy(ctx context.Context, teamName string) (domain.AssignmentPolicy, error) {
+	teamPolicy, err := w.repo.GetTeamPolicy(ctx, teamName, 0)
+	if err != nil {
+		if errors.Is(err, domain.ErrPolicyNotFound) {
+			return domain.DefaultAssignmentPolicy(), nil
+		}
+		return domain.AssignmentPolicy{}, fmt.Errorf("repo.GetTeamPolicy: %w", err)
+	}
+	return teamPolicy.Policy, nil
+}
+
+func (w *Worker) send(ctx context.Context, digests []domain.Notification) []domain.ReminderDelivery {
+	deliveries := make([]domain.ReminderDelivery, 0, len(digests))
+
+	for _, digest := range digests {
+		delivery := domain.ReminderDelivery{
+			UserID:         digest.UserID,
+			PullRequestIDs: digest.PullRequestIDs,
+			Status:         domain.ReminderDeliverySent,
+		}
+		if err := w.sink.Send(ctx, digest); err != nil {
+			delivery.Status = domain.ReminderDeliveryFailed
+			delivery.Error = err.Error()
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries
+}
+
+// digests builds one reminder per reviewer listing the reviews assigned before the scheduled moment.
+func digests(reviews []domain.PendingReview, scheduledFor time.Time) []domain.Notification {
+	var (
+		users   []string
+		byUser  = make(map[string][]domain.PendingReview)
+		digests []domain.Notification
+	)
+	for _, review := range reviews {
+		if review.AssignedAt.After(scheduledFor) {
+			continue
+		}
+		if _, ok := byUser[review.UserID]; !ok {
+			users = append(users, review.UserID)
+		}
+		byUser[review.UserID] = append(byUser[review.UserID], review)
+	}
+
+	for _, userID := range users {
+		pending := byUser[userID]
+
+		ids := make([]string, len(pending))
+		names := make([]string, len(pending))
+		for i, review := range pending {
+			ids[i] = review.PullRequestID
+			names[i] = fmt.Sprintf("%q", review.PullRequestName)
+		}
+
+		digests = append(digests, domain.Notification{
+			UserID:         userID,
+			Kind:           domain.NotificationReminder,
+			Text:           fmt.Sprintf("%d pull request(s) waiting for your review: %s", len(pending), strings.Join(names, ", ")),
+			PullRequestIDs: ids,
+		})
+	}
+
+	return digests
+}
@@ -0,0 +1,169 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func TestWorker_Remind(t *testing.T) {
+	t.Parallel()
+
+	// Wednesday, the schedule fires at 10:00 UTC.
+	slot := time.Date(2025, 3, 5, 10, 0, 0, 0, time.UTC)
+	assigned := slot.Add(-24 * time.Hour)
+	reviews := []domain.PendingReview{
+		{PullRequestID: "pr-1", PullRequestName: "Fix", TeamName: "backend", UserID: "u2", AssignedAt: assigned},
+		{PullRequestID: "pr-2", PullRequestName: "Feat", TeamName: "backend", UserID: "u3", AssignedAt: assigned},
+		{PullRequestID: "pr-3", PullRequestName: "Docs", TeamName: "backend", UserID: "u2", AssignedAt: assigned},
+		{PullRequestID: "pr-4", PullRequestName: "New", TeamName: "backend", UserID: "u3", AssignedAt: slot.Add(time.Minute)},
+	}
+	withReminders := func() domain.TeamPolicy {
+		policy := domain.DefaultAssignmentPolicy()
+		policy.Reminders = &domain.ReminderSchedule{Time: "10:00"}
+		return domain.TeamPolicy{Policy: policy}
+	}
+	run := domain.ReminderRun{ID: 7, TeamName: "backend", ScheduledFor: slot}
+	digestU2 := domain.Notification{
+		UserID:         "u2",
+		Kind:           domain.NotificationReminder,
+		Text:           `2 pull request(s) waiting for your review: "Fix", "Docs"`,
+		PullRequestIDs: []string{"pr-1", "pr-3"},
+	}
+	digestU3 := domain.Notification{
+		UserID:         "u3",
+		Kind:           domain.NotificationReminder,
+		Text:           `1 pull request(s) waiting for your review: "Feat"`,
+		PullRequestIDs: []string{"pr-2"},
+	}
+	sent := []domain.ReminderDelivery{
+		{UserID: "u2", PullRequestIDs: []string{"pr-1", "pr-3"}, Status: domain.ReminderDeliverySent},
+		{UserID: "u3", PullRequestIDs: []string{"pr-2"}, Status: domain.ReminderDeliverySent},
+	}
+	failed := []domain.ReminderDelivery{
+		{UserID: "u2", PullRequestIDs: []string{"pr-1", "pr-3"}, Status: domain.ReminderDeliverySent},
+		{UserID: "u3", PullRequestIDs: []string{"pr-2"}, Status: domain.ReminderDeliveryFailed, Error: "sink is down"},
+	}
+
+	type fields struct {
+		repo func(mc *minimock.Controller) repository
+		sink func(mc *minimock.Controller) sink
+	}
+	noSink := func(mc *minimock.Controller) sink { return NewSinkMock(mc) }
+
+	tests := []struct {
+		name   string
+		now    time.Time
+		fields fields
+		want   []domain.ReminderRun
+	}{
+		{
+			name: "skip: team without schedule",
+			now:  slot.Add(time.Minute),
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.ListPendingReviewsMock.Expect(minimock.AnyContext, slot.Add(time.Minute)).Return(reviews, nil)
+					repo.GetTeamPolicyMock.Expect(minimock.AnyContext, "backend", 0).
+						Return(domain.TeamPolicy{}, domain.ErrPolicyNotFound)
+					return repo
+				},
+				sink: noSink,
+			},
+		},
+		{
+			name: "skip: run is too late",
+			now:  slot.Add(2 * time.Hour),
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.ListPendingReviewsMock.Return(reviews, nil)
+					repo.GetTeamPolicyMock.Return(withReminders(), nil)
+					return repo
+				},
+				sink: noSink,
+			},
+		},
+		{
+			name: "skip: run claimed by another replica",
+			now:  slot.Add(time.Minute),
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.ListPendingReviewsMock.Return(reviews, nil)
+					repo.GetTeamPolicyMock.Return(withReminders(), nil)
+					repo.StartReminderRunMock.Expect(minimock.AnyContext, "backend", slot).
+						Return(domain.ReminderRun{}, false, nil)
+					return repo
+				},
+				sink: noSink,
+			},
+		},
+		{
+			name: "success: one digest per reviewer",
+			now:  slot.Add(5 * time.Minute),
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.ListPendingReviewsMock.Return(reviews, nil)
+					repo.GetTeamPolicyMock.Return(withReminders(), nil)
+					repo.StartReminderRunMock.Expect(minimock.AnyContext, "backend", slot).Return(run, true, nil)
+					repo.FinishReminderRunMock.Expect(minimock.AnyContext, run.ID, sent).Return(nil)
+					return repo
+				},
+				sink: func(mc *minimock.Controller) sink {
+					sink := NewSinkMock(mc)
+					sink.SendMock.When(minimock.AnyContext, digestU2).Then(nil)
+					sink.SendMock.When(minimock.AnyContext, digestU3).Then(nil)
+					return sink
+				},
+			},
+			want: []domain.ReminderRun{{ID: 7, TeamName: "backend", ScheduledFor: slot, Deliveries: sent}},
+		},
+		{
+			name: "success: failed delivery is recorded",
+			now:  slot.Add(5 * time.Minute),
+			fields: fields{
+				repo: func(mc *minimock.Controller) repository {
+					repo := NewRepositoryMock(mc)
+					repo.ListPendingReviewsMock.Return(reviews, nil)
+					repo.GetTeamPolicyMock.Return(withReminders(), nil)
+					repo.StartReminderRunMock.Return(run, true, nil)
+					repo.FinishReminderRunMock.Expect(minimock.AnyContext, run.ID, failed).Return(nil)
+					return repo
+				},
+				sink: func(mc *minimock.Controller) sink {
+					sink := NewSinkMock(mc)
+					sink.SendMock.When(minimock.AnyContext, digestU2).Then(nil)
+					sink.SendMock.When(minimock.AnyContext, digestU3).Then(errors.New("sink is down"))
+					return sink
+				},
+			},
+			want: []domain.ReminderRun{{ID: 7, TeamName: "backend", ScheduledFor: slot, Deliveries: failed}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			worker := New(tt.fields.repo(mc), tt.fields.sink(mc), zap.NewNop(), time.Minute)
+			worker.now = func() time.Time { return tt.now }
+
+			runs, err := worker.Remind(context.Background())
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, runs)
+		})
+	}
+}
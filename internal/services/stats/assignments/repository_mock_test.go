@@ -0,0 +1,449 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package assignments
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/stats/assignments.repository -o repository_mock_test.go -n RepositoryMock -p assignments
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetAssignmentStats          func(ctx context.Context, filter domain.StatsFilter, groupBy domain.StatsGroupBy) (a1 domain.AssignmentStats, err error)
+	funcGetAssignmentStatsOrigin    string
+	inspectFuncGetAssignmentStats   func(ctx context.Context, filter domain.StatsFilter, groupBy domain.StatsGroupBy)
+	afterGetAssignmentStatsCounter  uint64
+	beforeGetAssignmentStatsCounter uint64
+	GetAssignmentStatsMock          mRepositoryMockGetAssignmentStats
+}
+
+// NewRepositoryMock returns a mock for repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetAssignmentStatsMock = mRepositoryMockGetAssignmentStats{mock: m}
+	m.GetAssignmentStatsMock.callArgs = []*RepositoryMockGetAssignmentStatsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockGetAssignmentStats struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetAssignmentStatsExpectation
+	expectations       []*RepositoryMockGetAssignmentStatsExpectation
+
+	callArgs []*RepositoryMockGetAssignmentStatsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetAssignmentStatsExpectation specifies expectation struct of the repository.GetAssignmentStats
+type RepositoryMockGetAssignmentStatsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetAssignmentStatsParams
+	paramPtrs          *RepositoryMockGetAssignmentStatsParamPtrs
+	expectationOrigins RepositoryMockGetAssignmentStatsExpectationOrigins
+	results            *RepositoryMockGetAssignmentStatsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetAssignmentStatsParams contains parameters of the repository.GetAssignmentStats
+type RepositoryMockGetAssignmentStatsParams struct {
+	ctx     context.Context
+	filter  domain.StatsFilter
+	groupBy domain.StatsGroupBy
+}
+
+// RepositoryMockGetAssignmentStatsParamPtrs contains pointers to parameters of the repository.GetAssignmentStats
+type RepositoryMockGetAssignmentStatsParamPtrs struct {
+	ctx     *context.Context
+	filter  *domain.StatsFilter
+	groupBy *domain.StatsGroupBy
+}
+
+// RepositoryMockGetAssignmentStatsResults contains results of the repository.GetAssignmentStats
+type RepositoryMockGetAssignmentStatsResults struct {
+	a1  domain.AssignmentStats
+	err error
+}
+
+// RepositoryMockGetAssignmentStatsOrigins contains origins of expectations of the repository.GetAssignmentStats
+type RepositoryMockGetAssignmentStatsExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originFilter  string
+	originGroupBy string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAssignmentStats *mRepositoryMockGetAssignmentStats) Optional() *mRepositoryMockGetAssignmentStats {
+	mmGetAssignmentStats.optional = true
+	return mmGetAssignmentStats
+}
+
+// Expect sets up expected params for repository.GetAssignmentStats
+func (mmGetAssignmentStats *mRepositoryMockGetAssignmentStats) Expect(ctx context.Context, filter domain.StatsFilter, groupBy domain.StatsGroupBy) *mRepositoryMockGetAssignmentStats {
+	if mmGetAssignmentStats.mock.funcGetAssignmentStats != nil {
+		mmGetAssignmentStats.mock.t.Fatalf("RepositoryMock.GetAssignmentStats mock is already set by Set")
+	}
+
+	if mmGetAssignmentStats.defaultExpectation == nil {
+		mmGetAssignmentStats.defaultExpectation = &RepositoryMockGetAssignmentStatsExpectation{}
+	}
+
+	if mmGetAssignmentStats.defaultExpectation.paramPtrs != nil {
+		mmGetAssignmentStats.mock.t.Fatalf("RepositoryMock.GetAssignmentStats mock is already set by ExpectParams functions")
+	}
+
+	mmGetAssignmentStats.defaultExpectation.params = &RepositoryMockGetAssignmentStatsParams{ctx, filter, groupBy}
+	mmGetAssignmentStats.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAssignmentStats.expectations {
+		if minimock.Equal(e.params, mmGetAssignmentStats.defaultExpectation.params) {
+			mmGetAssignmentStats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAssignmentStats.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAssignmentStats
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.GetAssignmentStats
+func (mmGetAssignmentStats *mRepositoryMockGetAssignmentStats) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetAssignmentStats {
+	if mmGetAssignmentStats.mock.funcGetAssignmentStats != nil {
+		mmGetAssignmentStats.mock.t.Fatalf("RepositoryMock.GetAssignmentStats mock is already set by Set")
+	}
+
+	if mmGetAssignmentStats.defaultExpectation == nil {
+		mmGetAssignmentStats.defaultExpectation = &RepositoryMockGetAssignmentStatsExpectation{}
+	}
+
+	if mmGetAssignmentStats.defaultExpectation.params != nil {
+		mmGetAssignmentStats.mock.t.Fatalf("RepositoryMock.GetAssignmentStats mock is already set by Expect")
+	}
+
+	if mmGetAssignmentStats.defaultExpectation.paramPtrs == nil {
+		mmGetAssignmentStats.defaultExpectation.paramPtrs = &RepositoryMockGetAssignmentStatsParamPtrs{}
+	}
+	mmGetAssignmentStats.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAssignmentStats.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAssignmentStats
+}
+
+// ExpectFilterParam2 sets up expected param filter for repository.GetAssignmentStats
+func (mmGetAssignmentStats *mRepositoryMockGetAssignmentStats) ExpectFilterParam2(filter domain.StatsFilter) *mRepositoryMockGetAssignmentStats {
+	if mmGetAssignmentStats.mock.funcGetAssignmentStats != nil {
+		mmGetAssignmentStats.mock.t.Fatalf("RepositoryMock.GetAssignmentStats mock is already set by Set")
+	}
+
+	if mmGetAssignmentStats.defaultExpectation == nil {
+		mmGetAssignmentStats.defaultExpectation = &RepositoryMockGetAssignmentStatsExpectation{}
+	}
+
+	if mmGetAssignmentStats.defaultExpectation.params != nil {
+		mmGetAssignmentStats.mock.t.Fatalf("RepositoryMock.GetAssignmentStats mock is already set by Expect")
+	}
+
+	if mmGetAssignmentStats.defaultExpectation.paramPtrs == nil {
+		mmGetAssignmentStats.defaultExpectation.paramPtrs = &RepositoryMockGetAssignmentStatsParamPtrs{}
+	}
+	mmGetAssignmentStats.defaultExpectation.paramPtrs.filter = &filter
+	mmGetAssignmentStats.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetAssignmentStats
+}
+
+// ExpectGroupByParam3 sets up expected param groupBy for repository.GetAssignmentStats
+func (mmGetAssignmentStats *mRepositoryMockGetAssignmentStats) ExpectGroupByParam3(groupBy domain.StatsGroupBy) *mRepositoryMockGetAssignmentStats {
+	if mmGetAssignmentStats.mock.funcGetAssignmentStats != nil {
+		mmGetAssignmentStats.mock.t.Fatalf("RepositoryMock.GetAssignmentStats mock is already set by Set")
+	}
+
+	if mmGetAssignmentStats.defaultExpectation == nil {
+		mmGetAssignmentStats.defaultExpectation = &RepositoryMockGetAssignmentStatsExpectation{}
+	}
+
+	if mmGetAssignmentStats.defaultExpectation.params != nil {
+		mmGetAssignmentStats.mock.t.Fatalf("RepositoryMock.GetAssignmentStats mock is already set by Expect")
+	}
+
+	if mmGetAssignmentStats.defaultExpectation.paramPtrs == nil {
+		mmGetAssignmentStats.defaultExpectation.paramPtrs = &RepositoryMockGetAssignmentStatsParamPtrs{}
+	}
+	mmGetAssignmentStats.defaultExpectation.paramPtrs.groupBy = &groupBy
+	mmGetAssignmentStats.defaultExpectation.expectationOrigins.originGroupBy = minimock.CallerInfo(1)
+
+	return mmGetAssignmentStats
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.GetAssignmentStats
+func (mmGetAssignmentStats *mRepositoryMockGetAssignmentStats) Inspect(f func(ctx context.Context, filter domain.StatsFilter, groupBy domain.StatsGroupBy)) *mRepositoryMockGetAssignmentStats {
+	if mmGetAssignmentStats.mock.inspectFuncGetAssignmentStats != nil {
+		mmGetAssignmentStats.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetAssignmentStats")
+	}
+
+	mmGetAssignmentStats.mock.inspectFuncGetAssignmentStats = f
+
+	return mmGetAssignmentStats
+}
+
+// Return sets up results that will be returned by repository.GetAssignmentStats
+func (mmGetAssignmentStats *mRepositoryMockGetAssignmentStats) Return(a1 domain.AssignmentStats, err error) *RepositoryMock {
+	if mmGetAssignmentStats.mock.funcGetAssignmentStats != nil {
+		mmGetAssignmentStats.mock.t.Fatalf("RepositoryMock.GetAssignmentStats mock is already set by Set")
+	}
+
+	if mmGetAssignmentStats.defaultExpectation == nil {
+		mmGetAssignmentStats.defaultExpectation = &RepositoryMockGetAssignmentStatsExpectation{mock: mmGetAssignmentStats.mock}
+	}
+	mmGetAssignmentStats.defaultExpectation.results = &RepositoryMockGetAssignmentStatsResults{a1, err}
+	mmGetAssignmentStats.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAssignmentStats.mock
+}
+
+// Set uses given function f to mock the repository.GetAssignmentStats method
+func (mmGetAssignmentStats *mRepositoryMockGetAssignmentStats) Set(f func(ctx context.Context, filter domain.StatsFilter, groupBy domain.StatsGroupBy) (a1 domain.AssignmentStats, err error)) *RepositoryMock {
+	if mmGetAssignmentStats.defaultExpectation != nil {
+		mmGetAssignmentStats.mock.t.Fatalf("Default expectation is already set for the repository.GetAssignmentStats method")
+	}
+
+	if len(mmGetAssignmentStats.expectations) > 0 {
+		mmGetAssignmentStats.mock.t.Fatalf("Some expectations are already set for the repository.GetAssignmentStats method")
+	}
+
+	mmGetAssignmentStats.mock.funcGetAssignmentStats = f
+	mmGetAssignmentStats.mock.funcGetAssignmentStatsOrigin = minimock.CallerInfo(1)
+	return mmGetAssignmentStats.mock
+}
+
+// When sets expectation for the repository.GetAssignmentStats which will trigger the result defined by the following
+// Then helper
+func (mmGetAssignmentStats *mRepositoryMockGetAssignmentStats) When(ctx context.Context, filter domain.StatsFilter, groupBy domain.StatsGroupBy) *RepositoryMockGetAssignmentStatsExpectation {
+	if mmGetAssignmentStats.mock.funcGetAssignmentStats != nil {
+		mmGetAssignmentStats.mock.t.Fatalf("RepositoryMock.GetAssignmentStats mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetAssignmentStatsExpectation{
+		mock:               mmGetAssignmentStats.mock,
+		params:             &RepositoryMockGetAssignmentStatsParams{ctx, filter, groupBy},
+		expectationOrigins: RepositoryMockGetAssignmentStatsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAssignmentStats.expectations = append(mmGetAssignmentStats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.GetAssignmentStats return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetAssignmentStatsExpectation) Then(a1 domain.AssignmentStats, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetAssignmentStatsResults{a1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.GetAssignmentStats should be invoked
+func (mmGetAssignmentStats *mRepositoryMockGetAssignmentStats) Times(n uint64) *mRepositoryMockGetAssignmentStats {
+	if n == 0 {
+		mmGetAssignmentStats.mock.t.Fatalf("Times of RepositoryMock.GetAssignmentStats mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAssignmentStats.expectedInvocations, n)
+	mmGetAssignmentStats.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAssignmentStats
+}
+
+func (mmGetAssignmentStats *mRepositoryMockGetAssignmentStats) invocationsDone() bool {
+	if len(mmGetAssignmentStats.expectations) == 0 && mmGetAssignmentStats.defaultExpectation == nil && mmGetAssignmentStats.mock.funcGetAssignmentStats == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAssignmentStats.mock.afterGetAssignmentStatsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAssignmentStats.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAssignmentStats implements repository
+func (mmGetAssignmentStats *RepositoryMock) GetAssignmentStats(ctx context.Context, filter domain.StatsFilter, groupBy domain.StatsGroupBy) (a1 domain.AssignmentStats, err error) {
+	mm_atomic.AddUint64(&mmGetAssignmentStats.beforeGetAssignmentStatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAssignmentStats.afterGetAssignmentStatsCounter, 1)
+
+	mmGetAssignmentStats.t.Helper()
+
+	if mmGetAssignmentStats.inspectFuncGetAssignmentStats != nil {
+		mmGetAssignmentStats.inspectFuncGetAssignmentStats(ctx, filter, groupBy)
+	}
+
+	mm_params := RepositoryMockGetAssignmentStatsParams{ctx, filter, groupBy}
+
+	// Record call args
+	mmGetAssignmentStats.GetAssignmentStatsMock.mutex.Lock()
+	mmGetAssignmentStats.GetAssignmentStatsMock.callArgs = append(mmGetAssignmentStats.GetAssignmentStatsMock.callArgs, &mm_params)
+	mmGetAssignmentStats.GetAssignmentStatsMock.mutex.Unlock()
+
+	for _, e := range mmGetAssignmentStats.GetAssignmentStatsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmGetAssignmentStats.GetAssignmentStatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAssignmentStats.GetAssignmentStatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAssignmentStats.GetAssignmentStatsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAssignmentStats.GetAssignmentStatsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetAssignmentStatsParams{ctx, filter, groupBy}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAssignmentStats.t.Errorf("RepositoryMock.GetAssignmentStats got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAssignmentStats.GetAssignmentStatsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetAssignmentStats.t.Errorf("RepositoryMock.GetAssignmentStats got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAssignmentStats.GetAssignmentStatsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.groupBy != nil && !minimock.Equal(*mm_want_ptrs.groupBy, mm_got.groupBy) {
+				mmGetAssignmentStats.t.Errorf("RepositoryMock.GetAssignmentStats got unexpected parameter groupBy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAssignmentStats.GetAssignmentStatsMock.defaultExpectation.expectationOrigins.originGroupBy, *mm_want_ptrs.groupBy, mm_got.groupBy, minimock.Diff(*mm_want_ptrs.groupBy, mm_got.groupBy))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAssignmentStats.t.Errorf("RepositoryMock.GetAssignmentStats got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAssignmentStats.GetAssignmentStatsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAssignmentStats.GetAssignmentStatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAssignmentStats.t.Fatal("No results are set for the RepositoryMock.GetAssignmentStats")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmGetAssignmentStats.funcGetAssignmentStats != nil {
+		return mmGetAssignmentStats.funcGetAssignmentStats(ctx, filter, groupBy)
+	}
+	mmGetAssignmentStats.t.Fatalf("Unexpected call to RepositoryMock.GetAssignmentStats. %v %v %v", ctx, filter, groupBy)
+	return
+}
+
+// GetAssignmentStatsAfterCounter returns a count of finished RepositoryMock.GetAssignmentStats invocations
+func (mmGetAssignmentStats *RepositoryMock) GetAssignmentStatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAssignmentStats.afterGetAssignmentStatsCounter)
+}
+
+// GetAssignmentStatsBeforeCounter returns a count of RepositoryMock.GetAssignmentStats invocations
+func (mmGetAssignmentStats *RepositoryMock) GetAssignmentStatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAssignmentStats.beforeGetAssignmentStatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetAssignmentStats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAssignmentStats *mRepositoryMockGetAssignmentStats) Calls() []*RepositoryMockGetAssignmentStatsParams {
+	mmGetAssignmentStats.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetAssignmentStatsParams, len(mmGetAssignmentStats.callArgs))
+	copy(argCopy, mmGetAssignmentStats.callArgs)
+
+	mmGetAssignmentStats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAssignmentStatsDone returns true if the count of the GetAssignmentStats invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetAssignmentStatsDone() bool {
+	if m.GetAssignmentStatsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAssignmentStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAssignmentStatsMock.invocationsDone()
+}
+
+// MinimockGetAssignmentStatsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetAssignmentStatsInspect() {
+	for _, e := range m.GetAssignmentStatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetAssignmentStats at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAssignmentStatsCounter := mm_atomic.LoadUint64(&m.afterGetAssignmentStatsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAssignmentStatsMock.defaultExpectation != nil && afterGetAssignmentStatsCounter < 1 {
+		if m.GetAssignmentStatsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetAssignmentStats at\n%s", m.GetAssignmentStatsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetAssignmentStats at\n%s with params: %#v", m.GetAssignmentStatsMock.defaultExpectation.expectationOrigins.origin, *m.GetAssignmentStatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAssignmentStats != nil && afterGetAssignmentStatsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetAssignmentStats at\n%s", m.funcGetAssignmentStatsOrigin)
+	}
+
+	if !m.GetAssignmentStatsMock.invocationsDone() && afterGetAssignmentStatsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetAssignmentStats at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAssignmentStatsMock.expectedInvocations), m.GetAssignmentStatsMock.expectedInvocationsOrigin, afterGetAssignmentStatsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetAssignmentStatsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetAssignmentStatsDone()
+}
@@ -0,0 +1,51 @@
+package assignments
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		GetAssignmentStats(ctx context.Context, filter domain.StatsFilter, groupBy domain.StatsGroupBy,
+		) (domain.AssignmentStats, error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// GetAssignmentStats returns assignments and load per user and reviewers per PR, optionally grouped.
+func (h *Handler) GetAssignmentStats(ctx context.Context, filter domain.StatsFilter, groupBy domain.StatsGroupBy,
+) (domain.AssignmentStats, error) {
+	logger := h.logger.With(
+		zap.String("service", "stats.assignments"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	stats, err := h.repo.GetAssignmentStats(ctx, filter, groupBy)
+	if err != nil {
+		logger.Error("repo.GetAssignmentStats", zap.Error(err), zap.String("team_name", filter.TeamName))
+		return domain.AssignmentStats{}, fmt.Errorf("repo.GetAssignmentStats: %w", err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,99 @@
+package assignments
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func TestHandler_GetAssignmentStats(t *testing.T) {
+	t.Parallel()
+
+	filter := domain.StatsFilter{
+		From: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+	stats := domain.AssignmentStats{
+		From: filter.From,
+		To:   filter.To,
+		Users: []domain.UserAssignmentStats{
+			{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true, Assignments: 4, OpenReviews: 1},
+		},
+		PullRequests: []domain.PullRequestAssignmentStats{
+			{PullRequestID: "pr-1", TeamName: "backend", Reviewers: 2, CurrentReviewers: 2},
+		},
+	}
+	grouped := stats
+	grouped.Teams = []domain.TeamAssignmentStats{
+		{TeamName: "backend", Members: 1, Assignments: 4, OpenReviews: 1, PullRequests: 1, ReviewersPerPR: 2,
+			AssignmentsPerMember: 4},
+	}
+
+	tests := []struct {
+		name    string
+		groupBy domain.StatsGroupBy
+		repo    func(mc *minimock.Controller) repository
+		want    domain.AssignmentStats
+		wantErr error
+	}{
+		{
+			name: "success: per user and per PR",
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.GetAssignmentStatsMock.Expect(minimock.AnyContext, filter, domain.StatsGroupByNone).
+					Return(stats, nil)
+				return repo
+			},
+			want: stats,
+		},
+		{
+			name:    "success: grouped by team",
+			groupBy: domain.StatsGroupByTeam,
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.GetAssignmentStatsMock.Expect(minimock.AnyContext, filter, domain.StatsGroupByTeam).
+					Return(grouped, nil)
+				return repo
+			},
+			want: grouped,
+		},
+		{
+			name: "error: database failure",
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.GetAssignmentStatsMock.Return(domain.AssignmentStats{}, errors.New("database connection failed"))
+				return repo
+			},
+			wantErr: errors.New("database connection failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			handler := New(tt.repo(mc), zap.NewNop())
+
+			got, err := handler.GetAssignmentStats(context.Background(), filter, tt.groupBy)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr.Error())
+				assert.Equal(t, domain.AssignmentStats{}, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
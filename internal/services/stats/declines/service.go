@@ -0,0 +1,49 @@
+package declines
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		GetDeclineStats(ctx context.Context, filter domain.StatsFilter) (domain.DeclineStats, error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// GetDeclineStats returns decline counts and rates per user and per reason.
+func (h *Handler) GetDeclineStats(ctx context.Context, filter domain.StatsFilter) (domain.DeclineStats, error) {
+	logger := h.logger.With(
+		zap.String("service", "stats.declines"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	stats, err := h.repo.GetDeclineStats(ctx, filter)
+	if err != nil {
+		logger.Error("repo.GetDeclineStats", zap.Error(err), zap.String("team_name", filter.TeamName))
+		return domain.DeclineStats{}, fmt.Errorf("repo.GetDeclineStats: %w", err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,136 @@
+package distribution
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		GetReviewLoads(ctx context.Context, filter domain.StatsFilter) ([]domain.ReviewLoad, error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// GetLoadDistribution returns the review work per member together with the inequality of the
+// assignments. Members more than threshold standard deviations above the mean are flagged.
+func (h *Handler) GetLoadDistribution(ctx context.Context, filter domain.StatsFilter, threshold float64,
+) (domain.LoadDistribution, error) {
+	logger := h.logger.With(
+		zap.String("service", "stats.distribution"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	loads, err := h.repo.GetReviewLoads(ctx, filter)
+	if err != nil {
+		logger.Error("repo.GetReviewLoads", zap.Error(err), zap.String("team_name", filter.TeamName))
+		return domain.LoadDistribution{}, fmt.Errorf("repo.GetReviewLoads: %w", err)
+	}
+
+	distribution := Distribute(loads, threshold)
+	distribution.TeamName = filter.TeamName
+	distribution.From = filter.From
+	distribution.To = filter.To
+
+	return distribution, nil
+}
+
+// Distribute computes the inequality measures over the assignments of the members. The standard
+// deviation is the population one, as the members are the whole team and not a sample.
+func Distribute(loads []domain.ReviewLoad, threshold float64) domain.LoadDistribution {
+	distribution := domain.LoadDistribution{
+		Members:           make([]domain.MemberLoad, len(loads)),
+		OverloadThreshold: threshold,
+	}
+	if len(loads) == 0 {
+		return distribution
+	}
+
+	assignments := make([]float64, len(loads))
+	for i, load := range loads {
+		assignments[i] = float64(load.Assignments)
+		distribution.Assignments += load.Assignments
+	}
+
+	mean := float64(distribution.Assignments) / float64(len(loads))
+	var variance float64
+	for _, value := range assignments {
+		variance += (value - mean) * (value - mean)
+	}
+	stdDev := math.Sqrt(variance / float64(len(loads)))
+
+	distribution.Mean = round(mean)
+	distribution.StdDev = round(stdDev)
+	distribution.Gini = round(gini(assignments))
+	if lowest, highest := slices.Min(assignments), slices.Max(assignments); lowest > 0 {
+		ratio := round(highest / lowest)
+		distribution.MaxMinRatio = &ratio
+	}
+
+	for i, load := range loads {
+		member := domain.MemberLoad{
+			UserID:           load.UserID,
+			Username:         load.Username,
+			TeamName:         load.TeamName,
+			IsActive:         load.IsActive,
+			Assignments:      load.Assignments,
+			ReviewsCompleted: load.ReviewsCompleted,
+		}
+		if load.MeanTimeToVerdict != nil {
+			hours := round(load.MeanTimeToVerdict.Hours())
+			member.MeanTimeToVerdictHours = &hours
+		}
+		if stdDev > 0 {
+			zScore := (assignments[i] - mean) / stdDev
+			member.ZScore = round(zScore)
+			member.Overloaded = zScore > threshold
+		}
+		distribution.Members[i] = member
+	}
+
+	return distribution
+}
+
+// gini returns the Gini coefficient of the values, 0 when they are all zero.
+func gini(values []float64) float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	var sum, weighted float64
+	for i, value := range sorted {
+		sum += value
+		weighted += float64(i+1) * value
+	}
+	if sum == 0 {
+		return 0
+	}
+
+	n := float64(len(sorted))
+	return 2*weighted/(n*sum) - (n+1)/n
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
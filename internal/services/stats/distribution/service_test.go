@@ -0,0 +1,87 @@
+package distribution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+func TestDistribute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		loads     []domain.ReviewLoad
+		threshold float64
+		want      domain.LoadDistribution
+	}{
+		{
+			name:      "success: no members",
+			threshold: 2,
+			want: domain.LoadDistribution{
+				Members:           []domain.MemberLoad{},
+				OverloadThreshold: 2,
+			},
+		},
+		{
+			name: "success: even load",
+			loads: []domain.ReviewLoad{
+				{UserID: "u1", Assignments: 3, ReviewsCompleted: 3, MeanTimeToVerdict: ptr(90 * time.Minute)},
+				{UserID: "u2", Assignments: 3, ReviewsCompleted: 1, MeanTimeToVerdict: ptr(4 * time.Hour)},
+			},
+			threshold: 1,
+			want: domain.LoadDistribution{
+				Members: []domain.MemberLoad{
+					{UserID: "u1", Assignments: 3, ReviewsCompleted: 3, MeanTimeToVerdictHours: ptr(1.5)},
+					{UserID: "u2", Assignments: 3, ReviewsCompleted: 1, MeanTimeToVerdictHours: ptr(4.0)},
+				},
+				Assignments:       6,
+				Mean:              3,
+				MaxMinRatio:       ptr(1.0),
+				OverloadThreshold: 1,
+			},
+		},
+		{
+			name: "success: one member takes most reviews",
+			loads: []domain.ReviewLoad{
+				{UserID: "u1", Assignments: 0},
+				{UserID: "u2", Assignments: 1},
+				{UserID: "u3", Assignments: 1},
+				{UserID: "u4", Assignments: 10, ReviewsCompleted: 2, MeanTimeToVerdict: ptr(time.Hour)},
+			},
+			threshold: 1.5,
+			want: domain.LoadDistribution{
+				Members: []domain.MemberLoad{
+					{UserID: "u1", ZScore: -0.74},
+					{UserID: "u2", Assignments: 1, ZScore: -0.49},
+					{UserID: "u3", Assignments: 1, ZScore: -0.49},
+					{UserID: "u4", Assignments: 10, ReviewsCompleted: 2, MeanTimeToVerdictHours: ptr(1.0),
+						ZScore: 1.72, Overloaded: true},
+				},
+				Assignments:       12,
+				Mean:              3,
+				StdDev:            4.06,
+				Gini:              0.63,
+				OverloadThreshold: 1.5,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Distribute(tt.loads, tt.threshold)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		GetReviewGraph(ctx context.Context, filter domain.StatsFilter) (domain.ReviewGraph, error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// GetReviewGraph returns the author to reviewer graph of the team, or of everyone without one.
+func (h *Handler) GetReviewGraph(ctx context.Context, filter domain.StatsFilter) (domain.ReviewGraph, error) {
+	logger := h.logger.With(
+		zap.String("service", "stats.graph"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	graph, err := h.repo.GetReviewGraph(ctx, filter)
+	if err != nil {
+		logger.Error("repo.GetReviewGraph", zap.Error(err), zap.String("team_name", filter.TeamName))
+		return domain.ReviewGraph{}, fmt.Errorf("repo.GetReviewGraph: %w", err)
+	}
+
+	return graph, nil
+}
@@ -0,0 +1,141 @@
+package knowledge
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		GetReviewCounts(ctx context.Context, filter domain.KnowledgeFilter) ([]domain.ReviewCount, error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// GetKnowledgeReport returns the knowledge spread of the authors and repositories over the last
+// weeks, filter.Since is derived from them.
+func (h *Handler) GetKnowledgeReport(ctx context.Context, filter domain.KnowledgeFilter, weeks int,
+) (domain.KnowledgeReport, error) {
+	logger := h.logger.With(
+		zap.String("service", "stats.knowledge"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	filter.Since = time.Now().AddDate(0, 0, -7*weeks)
+
+	counts, err := h.repo.GetReviewCounts(ctx, filter)
+	if err != nil {
+		logger.Error("repo.GetReviewCounts", zap.Error(err), zap.String("team_name", filter.TeamName),
+			zap.String("repository", filter.Repository))
+		return domain.KnowledgeReport{}, fmt.Errorf("repo.GetReviewCounts: %w", err)
+	}
+
+	report := domain.KnowledgeReport{
+		TeamName:                 filter.TeamName,
+		Repository:               filter.Repository,
+		Weeks:                    weeks,
+		Since:                    filter.Since,
+		Authors:                  SpreadByAuthor(counts),
+		Repositories:             SpreadByRepository(counts),
+		ConcentratedAuthors:      []string{},
+		ConcentratedRepositories: []string{},
+	}
+	for _, spread := range report.Authors {
+		if spread.BusFactor == 1 {
+			report.ConcentratedAuthors = append(report.ConcentratedAuthors, spread.AuthorID)
+		}
+	}
+	for _, spread := range report.Repositories {
+		if spread.BusFactor == 1 {
+			report.ConcentratedRepositories = append(report.ConcentratedRepositories, spread.Repository)
+		}
+	}
+
+	return report, nil
+}
+
+// SpreadByAuthor returns the knowledge spread of every author, most concentrated first.
+func SpreadByAuthor(counts []domain.ReviewCount) []domain.KnowledgeSpread {
+	return spread(counts, func(count domain.ReviewCount) string { return count.AuthorID },
+		func(spread *domain.KnowledgeSpread, key string) { spread.AuthorID = key })
+}
+
+// SpreadByRepository returns the knowledge spread of every repository, most concentrated first.
+// PRs without a repository are left out.
+func SpreadByRepository(counts []domain.ReviewCount) []domain.KnowledgeSpread {
+	return spread(counts, func(count domain.ReviewCount) string { return count.Repository },
+		func(spread *domain.KnowledgeSpread, key string) { spread.Repository = key })
+}
+
+func spread(counts []domain.ReviewCount, key func(count domain.ReviewCount) string,
+	setKey func(spread *domain.KnowledgeSpread, key string),
+) []domain.KnowledgeSpread {
+	reviews := make(map[string]map[string]int)
+	for _, count := range counts {
+		k := key(count)
+		if k == "" {
+			continue
+		}
+		if reviews[k] == nil {
+			reviews[k] = make(map[string]int)
+		}
+		reviews[k][count.ReviewerID] += count.Reviews
+	}
+
+	spreads := make([]domain.KnowledgeSpread, 0, len(reviews))
+	for k, byReviewer := range reviews {
+		var (
+			spread   domain.KnowledgeSpread
+			perUser  = make([]int, 0, len(byReviewer))
+			topCount int
+		)
+		setKey(&spread, k)
+		for reviewerID, count := range byReviewer {
+			spread.Reviews += count
+			perUser = append(perUser, count)
+			if count > topCount || (count == topCount && reviewerID < spread.TopReviewerID) {
+				spread.TopReviewerID, topCount = reviewerID, count
+			}
+		}
+		spread.Reviewers = len(byReviewer)
+		spread.TopReviewerShare = math.Round(float64(topCount)/float64(spread.Reviews)*100) / 100
+		spread.BusFactor = domain.BusFactor(perUser)
+		spreads = append(spreads, spread)
+	}
+
+	sort.Slice(spreads, func(i, j int) bool {
+		a, b := spreads[i], spreads[j]
+		if a.BusFactor != b.BusFactor {
+			return a.BusFactor < b.BusFactor
+		}
+		if a.TopReviewerShare != b.TopReviewerShare {
+			return a.TopReviewerShare > b.TopReviewerShare
+		}
+		return a.AuthorID+a.Repository < b.AuthorID+b.Repository
+	})
+
+	return spreads
+}
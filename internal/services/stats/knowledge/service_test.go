@@ -0,0 +1,64 @@
+package knowledge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func TestSpread(t *testing.T) {
+	t.Parallel()
+
+	counts := []domain.ReviewCount{
+		{AuthorID: "u1", Repository: "acme/api", ReviewerID: "u2", Reviews: 4},
+		{AuthorID: "u1", Repository: "acme/web", ReviewerID: "u2", Reviews: 1},
+		{AuthorID: "u1", Repository: "acme/web", ReviewerID: "u3", Reviews: 1},
+		{AuthorID: "u2", Repository: "acme/web", ReviewerID: "u1", Reviews: 2},
+		{AuthorID: "u2", Repository: "acme/web", ReviewerID: "u3", Reviews: 2},
+		{AuthorID: "u3", ReviewerID: "u1", Reviews: 3},
+	}
+
+	tests := []struct {
+		name   string
+		counts []domain.ReviewCount
+		spread func(counts []domain.ReviewCount) []domain.KnowledgeSpread
+		want   []domain.KnowledgeSpread
+	}{
+		{
+			name:   "success: by author",
+			counts: counts,
+			spread: SpreadByAuthor,
+			want: []domain.KnowledgeSpread{
+				{AuthorID: "u3", Reviews: 3, Reviewers: 1, TopReviewerID: "u1", TopReviewerShare: 1, BusFactor: 1},
+				{AuthorID: "u1", Reviews: 6, Reviewers: 2, TopReviewerID: "u2", TopReviewerShare: 0.83, BusFactor: 1},
+				{AuthorID: "u2", Reviews: 4, Reviewers: 2, TopReviewerID: "u1", TopReviewerShare: 0.5, BusFactor: 2},
+			},
+		},
+		{
+			name:   "success: by repository without the PRs lacking one",
+			counts: counts,
+			spread: SpreadByRepository,
+			want: []domain.KnowledgeSpread{
+				{Repository: "acme/api", Reviews: 4, Reviewers: 1, TopReviewerID: "u2", TopReviewerShare: 1, BusFactor: 1},
+				{Repository: "acme/web", Reviews: 6, Reviewers: 3, TopReviewerID: "u3", TopReviewerShare: 0.5,
+					BusFactor: 2},
+			},
+		},
+		{
+			name:   "success: no reviews",
+			spread: SpreadByAuthor,
+			want:   []domain.KnowledgeSpread{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.spread(tt.counts))
+		})
+	}
+}
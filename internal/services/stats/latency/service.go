@@ -0,0 +1,50 @@
+package latency
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		GetLatencyReport(ctx context.Context, filter domain.ReportFilter) (domain.LatencyReport, error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// GetLatencyReport returns the review latency percentiles per team, repository and week.
+func (h *Handler) GetLatencyReport(ctx context.Context, filter domain.ReportFilter) (domain.LatencyReport, error) {
+	logger := h.logger.With(
+		zap.String("service", "stats.latency"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	report, err := h.repo.GetLatencyReport(ctx, filter)
+	if err != nil {
+		logger.Error("repo.GetLatencyReport", zap.Error(err), zap.String("team_name", filter.TeamName),
+			zap.String("repository", filter.Repository))
+		return domain.LatencyReport{}, fmt.Errorf("repo.GetLatencyReport: %w", err)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,145 @@
+// Package document parses and validates team assignment policy documents.
+//
+// Documents are accepted in JSON or YAML. Omitted fields take the default
+// assignment behaviour, unknown fields are rejected.
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	validatorV10 "github.com/go-playground/validator/v10"
+	"gopkg.in/yaml.v3"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+var validate = newValidator()
+
+// Parse decodes the document and validates it. Decoding problems are returned as
+// *domain.PolicyValidationError, rule violations as validator.ValidationErrors.
+func Parse(format domain.PolicyFormat, raw []byte) (domain.AssignmentPolicy, error) {
+	policy := domain.DefaultAssignmentPolicy()
+
+	var err error
+	switch format {
+	case domain.PolicyFormatJSON:
+		err = decodeJSON(raw, &policy)
+	case domain.PolicyFormatYAML:
+		err = decodeYAML(raw, &policy)
+	default:
+		err = &domain.PolicyValidationError{Errors: []domain.FieldError{
+			{Field: "format", Message: "unsupported format, expected json or yaml"},
+		}}
+	}
+	if err != nil {
+		return domain.AssignmentPolicy{}, err
+	}
+
+	if err := validate.Struct(policy); err != nil {
+		return domain.AssignmentPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+func decodeJSON(raw []byte, policy *domain.AssignmentPolicy) error {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+
+	err := decoder.Decode(policy)
+	if err == nil {
+		return nil
+	}
+
+	var (
+		typeErr   *json.UnmarshalTypeError
+		syntaxErr *json.SyntaxError
+		fieldErr  domain.FieldError
+	)
+	switch {
+	case errors.As(err, &typeErr):
+		fieldErr = domain.FieldError{
+			Field:   typeErr.Field,
+			Message: "invalid type, expected " + typeErr.Type.String(),
+		}
+	case errors.As(err, &syntaxErr):
+		fieldErr = domain.FieldError{
+			Field:   "document",
+			Message: fmt.Sprintf("invalid json syntax at offset %d", syntaxErr.Offset),
+		}
+	case errors.Is(err, io.EOF):
+		fieldErr = domain.FieldError{Field: "document", Message: "document is empty"}
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		fieldErr = domain.FieldError{
+			Field:   strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`),
+			Message: "unknown field",
+		}
+	default:
+		fieldErr = domain.FieldError{Field: "document", Message: err.Error()}
+	}
+
+	return &domain.PolicyValidationError{Errors: []domain.FieldError{fieldErr}}
+}
+
+func decodeYAML(raw []byte, policy *domain.AssignmentPolicy) error {
+	decoder := yaml.NewDecoder(bytes.NewReader(raw))
+	decoder.KnownFields(true)
+
+	err := decoder.Decode(policy)
+	if err == nil {
+		return nil
+	}
+
+	var typeErr *yaml.TypeError
+	switch {
+	case errors.As(err, &typeErr):
+		validationErr := &domain.PolicyValidationError{}
+		for _, msg := range typeErr.Errors {
+			validationErr.Errors = append(validationErr.Errors, yamlFieldError(msg))
+		}
+		return validationErr
+	case errors.Is(err, io.EOF):
+		return &domain.PolicyValidationError{Errors: []domain.FieldError{
+			{Field: "document", Message: "document is empty"},
+		}}
+	default:
+		return &domain.PolicyValidationError{Errors: []domain.FieldError{
+			{Field: "document", Message: strings.TrimPrefix(err.Error(), "yaml: ")},
+		}}
+	}
+}
+
+// yamlFieldError turns "line 3: field foo not found in type ..." into a field error
+// pointing at the offending line.
+func yamlFieldError(msg string) domain.FieldError {
+	line, detail, ok := strings.Cut(msg, ": ")
+	if !ok {
+		return domain.FieldError{Field: "document", Message: msg}
+	}
+
+	if name, found := strings.CutPrefix(detail, "field "); found {
+		if name, _, found = strings.Cut(name, " not found"); found {
+			return domain.FieldError{Field: name, Message: "unknown field (" + line + ")"}
+		}
+	}
+
+	return domain.FieldError{Field: line, Message: detail}
+}
+
+func newValidator() *validatorV10.Validate {
+	v := validatorV10.New(validatorV10.WithRequiredStructEnabled())
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	return v
+}
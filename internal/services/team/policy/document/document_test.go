@@ -0,0 +1,166 @@
+package document
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	validatorV10 "github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		format     domain.PolicyFormat
+		raw        string
+		want       domain.AssignmentPolicy
+		wantFields []string
+	}{
+		{
+			name:   "success: yaml with every field",
+			format: domain.PolicyFormatYAML,
+			raw: `
+strategy: round_robin
+reviewers:
+  count: 3
+  min: 1
+max_open_reviews: 4
+required_skills: [go]
+fallback_teams: [platform]
+exclusions:
+  - user_id: u5
+  - user_id: u6
+    author_id: u1
+`,
+			want: domain.AssignmentPolicy{
+				Strategy:       domain.StrategyRoundRobin,
+				Reviewers:      domain.ReviewersPolicy{Count: 3, Min: 1},
+				MaxOpenReviews: 4,
+				RequiredSkills: []string{"go"},
+				FallbackTeams:  []string{"platform"},
+				Exclusions: []domain.PolicyExclusion{
+					{UserID: "u5"},
+					{UserID: "u6", AuthorID: "u1"},
+				},
+			},
+		},
+		{
+			name:   "success: json with defaults for omitted fields",
+			format: domain.PolicyFormatJSON,
+			raw:    `{"required_skills": ["sql"]}`,
+			want: domain.AssignmentPolicy{
+				Strategy:       domain.StrategyLeastLoaded,
+				Reviewers:      domain.ReviewersPolicy{Count: domain.DefaultReviewersCount},
+				RequiredSkills: []string{"sql"},
+			},
+		},
+		{
+			name:   "success: yaml with merge quorum",
+			format: domain.PolicyFormatYAML,
+			raw: `
+quorum:
+  approvals: 2
+`,
+			want: domain.AssignmentPolicy{
+				Strategy:  domain.StrategyLeastLoaded,
+				Reviewers: domain.ReviewersPolicy{Count: domain.DefaultReviewersCount},
+				Quorum:    &domain.QuorumPolicy{Approvals: 2},
+			},
+		},
+		{
+			name:       "error: quorum approvals out of range",
+			format:     domain.PolicyFormatJSON,
+			raw:        `{"quorum": {"approvals": 11}}`,
+			wantFields: []string{"quorum.approvals"},
+		},
+		{
+			name:       "error: rule violations are reported per field",
+			format:     domain.PolicyFormatJSON,
+			raw:        `{"strategy": "random", "reviewers": {"count": 1, "min": 2}, "exclusions": [{}]}`,
+			wantFields: []string{"strategy", "reviewers.min", "exclusions[0].user_id"},
+		},
+		{
+			name:   "error: mandatory reviewer must be either a user or a team",
+			format: domain.PolicyFormatYAML,
+			raw: `
+mandatory_reviewers:
+  - user_id: s1
+    team_name: security
+  - deputy_id: s2
+`,
+			wantFields: []string{
+				"mandatory_reviewers[0].user_id",
+				"mandatory_reviewers[1].user_id",
+				"mandatory_reviewers[1].deputy_id",
+			},
+		},
+		{
+			name:       "error: json type mismatch points at the field",
+			format:     domain.PolicyFormatJSON,
+			raw:        `{"reviewers": {"count": "two"}}`,
+			wantFields: []string{"reviewers.count"},
+		},
+		{
+			name:       "error: unknown json field",
+			format:     domain.PolicyFormatJSON,
+			raw:        `{"stratgy": "least_loaded"}`,
+			wantFields: []string{"stratgy"},
+		},
+		{
+			name:       "error: unknown yaml field",
+			format:     domain.PolicyFormatYAML,
+			raw:        "strategy: least_loaded\nreviewerz: 2\n",
+			wantFields: []string{"reviewerz"},
+		},
+		{
+			name:       "error: empty document",
+			format:     domain.PolicyFormatYAML,
+			raw:        "",
+			wantFields: []string{"document"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Parse(tt.format, []byte(tt.raw))
+
+			if tt.wantFields != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantFields, errorFields(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func errorFields(err error) []string {
+	var fields []string
+
+	var validationErrs validatorV10.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		for _, e := range validationErrs {
+			_, field, _ := strings.Cut(e.Namespace(), ".")
+			fields = append(fields, field)
+		}
+	}
+
+	var policyErr *domain.PolicyValidationError
+	if errors.As(err, &policyErr) {
+		for _, e := range policyErr.Errors {
+			fields = append(fields, e.Field)
+		}
+	}
+
+	return fields
+}
@@ -0,0 +1,49 @@
+package get
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		GetTeamPolicy(ctx context.Context, teamName string, version int) (domain.TeamPolicy, error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// GetTeamPolicy returns the requested policy version, version 0 means the active one.
+func (h *Handler) GetTeamPolicy(ctx context.Context, teamName string, version int) (domain.TeamPolicy, error) {
+	logger := h.logger.With(
+		zap.String("service", "team.policy.get"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	policy, err := h.repo.GetTeamPolicy(ctx, teamName, version)
+	if err != nil {
+		logger.Error("repo.GetTeamPolicy", zap.Error(err), zap.String("team_name", teamName),
+			zap.Int("version", version))
+		return domain.TeamPolicy{}, err
+	}
+
+	return policy, nil
+}
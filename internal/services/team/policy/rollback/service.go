@@ -0,0 +1,53 @@
+package rollback
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		ActivateTeamPolicy(ctx context.Context, teamName string, version int) (domain.TeamPolicy, error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// RollbackTeamPolicy makes an earlier stored version the active policy of the team.
+// Newer versions are kept, so the team can move forward again later.
+func (h *Handler) RollbackTeamPolicy(ctx context.Context, teamName string, version int) (domain.TeamPolicy, error) {
+	logger := h.logger.With(
+		zap.String("service", "team.policy.rollback"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	policy, err := h.repo.ActivateTeamPolicy(ctx, teamName, version)
+	if err != nil {
+		logger.Error("repo.ActivateTeamPolicy", zap.Error(err), zap.String("team_name", teamName),
+			zap.Int("version", version))
+		return domain.TeamPolicy{}, fmt.Errorf("repo.ActivateTeamPolicy: %w", err)
+	}
+
+	logger.Info("policy rolled back", zap.String("team_name", teamName), zap.Int("version", version))
+
+	return policy, nil
+}
@@ -0,0 +1,774 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package upload
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/team/policy/upload.repository -o repository_mock_test.go -n RepositoryMock -p upload
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RepositoryMock implements repository
+type RepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddTeamPolicy          func(ctx context.Context, policy domain.TeamPolicyDTO) (t1 domain.TeamPolicy, err error)
+	funcAddTeamPolicyOrigin    string
+	inspectFuncAddTeamPolicy   func(ctx context.Context, policy domain.TeamPolicyDTO)
+	afterAddTeamPolicyCounter  uint64
+	beforeAddTeamPolicyCounter uint64
+	AddTeamPolicyMock          mRepositoryMockAddTeamPolicy
+
+	funcFindMissingTeams          func(ctx context.Context, teamNames []string) (sa1 []string, err error)
+	funcFindMissingTeamsOrigin    string
+	inspectFuncFindMissingTeams   func(ctx context.Context, teamNames []string)
+	afterFindMissingTeamsCounter  uint64
+	beforeFindMissingTeamsCounter uint64
+	FindMissingTeamsMock          mRepositoryMockFindMissingTeams
+}
+
+// NewRepositoryMock returns a mock for repository
+func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
+	m := &RepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddTeamPolicyMock = mRepositoryMockAddTeamPolicy{mock: m}
+	m.AddTeamPolicyMock.callArgs = []*RepositoryMockAddTeamPolicyParams{}
+
+	m.FindMissingTeamsMock = mRepositoryMockFindMissingTeams{mock: m}
+	m.FindMissingTeamsMock.callArgs = []*RepositoryMockFindMissingTeamsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRepositoryMockAddTeamPolicy struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockAddTeamPolicyExpectation
+	expectations       []*RepositoryMockAddTeamPolicyExpectation
+
+	callArgs []*RepositoryMockAddTeamPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockAddTeamPolicyExpectation specifies expectation struct of the repository.AddTeamPolicy
+type RepositoryMockAddTeamPolicyExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockAddTeamPolicyParams
+	paramPtrs          *RepositoryMockAddTeamPolicyParamPtrs
+	expectationOrigins RepositoryMockAddTeamPolicyExpectationOrigins
+	results            *RepositoryMockAddTeamPolicyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockAddTeamPolicyParams contains parameters of the repository.AddTeamPolicy
+type RepositoryMockAddTeamPolicyParams struct {
+	ctx    context.Context
+	policy domain.TeamPolicyDTO
+}
+
+// RepositoryMockAddTeamPolicyParamPtrs contains pointers to parameters of the repository.AddTeamPolicy
+type RepositoryMockAddTeamPolicyParamPtrs struct {
+	ctx    *context.Context
+	policy *domain.TeamPolicyDTO
+}
+
+// RepositoryMockAddTeamPolicyResults contains results of the repository.AddTeamPolicy
+type RepositoryMockAddTeamPolicyResults struct {
+	t1  domain.TeamPolicy
+	err error
+}
+
+// RepositoryMockAddTeamPolicyOrigins contains origins of expectations of the repository.AddTeamPolicy
+type RepositoryMockAddTeamPolicyExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originPolicy string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddTeamPolicy *mRepositoryMockAddTeamPolicy) Optional() *mRepositoryMockAddTeamPolicy {
+	mmAddTeamPolicy.optional = true
+	return mmAddTeamPolicy
+}
+
+// Expect sets up expected params for repository.AddTeamPolicy
+func (mmAddTeamPolicy *mRepositoryMockAddTeamPolicy) Expect(ctx context.Context, policy domain.TeamPolicyDTO) *mRepositoryMockAddTeamPolicy {
+	if mmAddTeamPolicy.mock.funcAddTeamPolicy != nil {
+		mmAddTeamPolicy.mock.t.Fatalf("RepositoryMock.AddTeamPolicy mock is already set by Set")
+	}
+
+	if mmAddTeamPolicy.defaultExpectation == nil {
+		mmAddTeamPolicy.defaultExpectation = &RepositoryMockAddTeamPolicyExpectation{}
+	}
+
+	if mmAddTeamPolicy.defaultExpectation.paramPtrs != nil {
+		mmAddTeamPolicy.mock.t.Fatalf("RepositoryMock.AddTeamPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmAddTeamPolicy.defaultExpectation.params = &RepositoryMockAddTeamPolicyParams{ctx, policy}
+	mmAddTeamPolicy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddTeamPolicy.expectations {
+		if minimock.Equal(e.params, mmAddTeamPolicy.defaultExpectation.params) {
+			mmAddTeamPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddTeamPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmAddTeamPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.AddTeamPolicy
+func (mmAddTeamPolicy *mRepositoryMockAddTeamPolicy) ExpectCtxParam1(ctx context.Context) *mRepositoryMockAddTeamPolicy {
+	if mmAddTeamPolicy.mock.funcAddTeamPolicy != nil {
+		mmAddTeamPolicy.mock.t.Fatalf("RepositoryMock.AddTeamPolicy mock is already set by Set")
+	}
+
+	if mmAddTeamPolicy.defaultExpectation == nil {
+		mmAddTeamPolicy.defaultExpectation = &RepositoryMockAddTeamPolicyExpectation{}
+	}
+
+	if mmAddTeamPolicy.defaultExpectation.params != nil {
+		mmAddTeamPolicy.mock.t.Fatalf("RepositoryMock.AddTeamPolicy mock is already set by Expect")
+	}
+
+	if mmAddTeamPolicy.defaultExpectation.paramPtrs == nil {
+		mmAddTeamPolicy.defaultExpectation.paramPtrs = &RepositoryMockAddTeamPolicyParamPtrs{}
+	}
+	mmAddTeamPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddTeamPolicy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddTeamPolicy
+}
+
+// ExpectPolicyParam2 sets up expected param policy for repository.AddTeamPolicy
+func (mmAddTeamPolicy *mRepositoryMockAddTeamPolicy) ExpectPolicyParam2(policy domain.TeamPolicyDTO) *mRepositoryMockAddTeamPolicy {
+	if mmAddTeamPolicy.mock.funcAddTeamPolicy != nil {
+		mmAddTeamPolicy.mock.t.Fatalf("RepositoryMock.AddTeamPolicy mock is already set by Set")
+	}
+
+	if mmAddTeamPolicy.defaultExpectation == nil {
+		mmAddTeamPolicy.defaultExpectation = &RepositoryMockAddTeamPolicyExpectation{}
+	}
+
+	if mmAddTeamPolicy.defaultExpectation.params != nil {
+		mmAddTeamPolicy.mock.t.Fatalf("RepositoryMock.AddTeamPolicy mock is already set by Expect")
+	}
+
+	if mmAddTeamPolicy.defaultExpectation.paramPtrs == nil {
+		mmAddTeamPolicy.defaultExpectation.paramPtrs = &RepositoryMockAddTeamPolicyParamPtrs{}
+	}
+	mmAddTeamPolicy.defaultExpectation.paramPtrs.policy = &policy
+	mmAddTeamPolicy.defaultExpectation.expectationOrigins.originPolicy = minimock.CallerInfo(1)
+
+	return mmAddTeamPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.AddTeamPolicy
+func (mmAddTeamPolicy *mRepositoryMockAddTeamPolicy) Inspect(f func(ctx context.Context, policy domain.TeamPolicyDTO)) *mRepositoryMockAddTeamPolicy {
+	if mmAddTeamPolicy.mock.inspectFuncAddTeamPolicy != nil {
+		mmAddTeamPolicy.mock.t.Fatalf("Inspect function is already set for RepositoryMock.AddTeamPolicy")
+	}
+
+	mmAddTeamPolicy.mock.inspectFuncAddTeamPolicy = f
+
+	return mmAddTeamPolicy
+}
+
+// Return sets up results that will be returned by repository.AddTeamPolicy
+func (mmAddTeamPolicy *mRepositoryMockAddTeamPolicy) Return(t1 domain.TeamPolicy, err error) *RepositoryMock {
+	if mmAddTeamPolicy.mock.funcAddTeamPolicy != nil {
+		mmAddTeamPolicy.mock.t.Fatalf("RepositoryMock.AddTeamPolicy mock is already set by Set")
+	}
+
+	if mmAddTeamPolicy.defaultExpectation == nil {
+		mmAddTeamPolicy.defaultExpectation = &RepositoryMockAddTeamPolicyExpectation{mock: mmAddTeamPolicy.mock}
+	}
+	mmAddTeamPolicy.defaultExpectation.results = &RepositoryMockAddTeamPolicyResults{t1, err}
+	mmAddTeamPolicy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddTeamPolicy.mock
+}
+
+// Set uses given function f to mock the repository.AddTeamPolicy method
+func (mmAddTeamPolicy *mRepositoryMockAddTeamPolicy) Set(f func(ctx context.Context, policy domain.TeamPolicyDTO) (t1 domain.TeamPolicy, err error)) *RepositoryMock {
+	if mmAddTeamPolicy.defaultExpectation != nil {
+		mmAddTeamPolicy.mock.t.Fatalf("Default expectation is already set for the repository.AddTeamPolicy method")
+	}
+
+	if len(mmAddTeamPolicy.expectations) > 0 {
+		mmAddTeamPolicy.mock.t.Fatalf("Some expectations are already set for the repository.AddTeamPolicy method")
+	}
+
+	mmAddTeamPolicy.mock.funcAddTeamPolicy = f
+	mmAddTeamPolicy.mock.funcAddTeamPolicyOrigin = minimock.CallerInfo(1)
+	return mmAddTeamPolicy.mock
+}
+
+// When sets expectation for the repository.AddTeamPolicy which will trigger the result defined by the following
+// Then helper
+func (mmAddTeamPolicy *mRepositoryMockAddTeamPolicy) When(ctx context.Context, policy domain.TeamPolicyDTO) *RepositoryMockAddTeamPolicyExpectation {
+	if mmAddTeamPolicy.mock.funcAddTeamPolicy != nil {
+		mmAddTeamPolicy.mock.t.Fatalf("RepositoryMock.AddTeamPolicy mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockAddTeamPolicyExpectation{
+		mock:               mmAddTeamPolicy.mock,
+		params:             &RepositoryMockAddTeamPolicyParams{ctx, policy},
+		expectationOrigins: RepositoryMockAddTeamPolicyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddTeamPolicy.expectations = append(mmAddTeamPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.AddTeamPolicy return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockAddTeamPolicyExpectation) Then(t1 domain.TeamPolicy, err error) *RepositoryMock {
+	e.results = &RepositoryMockAddTeamPolicyResults{t1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.AddTeamPolicy should be invoked
+func (mmAddTeamPolicy *mRepositoryMockAddTeamPolicy) Times(n uint64) *mRepositoryMockAddTeamPolicy {
+	if n == 0 {
+		mmAddTeamPolicy.mock.t.Fatalf("Times of RepositoryMock.AddTeamPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddTeamPolicy.expectedInvocations, n)
+	mmAddTeamPolicy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddTeamPolicy
+}
+
+func (mmAddTeamPolicy *mRepositoryMockAddTeamPolicy) invocationsDone() bool {
+	if len(mmAddTeamPolicy.expectations) == 0 && mmAddTeamPolicy.defaultExpectation == nil && mmAddTeamPolicy.mock.funcAddTeamPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddTeamPolicy.mock.afterAddTeamPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddTeamPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddTeamPolicy implements repository
+func (mmAddTeamPolicy *RepositoryMock) AddTeamPolicy(ctx context.Context, policy domain.TeamPolicyDTO) (t1 domain.TeamPolicy, err error) {
+	mm_atomic.AddUint64(&mmAddTeamPolicy.beforeAddTeamPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddTeamPolicy.afterAddTeamPolicyCounter, 1)
+
+	mmAddTeamPolicy.t.Helper()
+
+	if mmAddTeamPolicy.inspectFuncAddTeamPolicy != nil {
+		mmAddTeamPolicy.inspectFuncAddTeamPolicy(ctx, policy)
+	}
+
+	mm_params := RepositoryMockAddTeamPolicyParams{ctx, policy}
+
+	// Record call args
+	mmAddTeamPolicy.AddTeamPolicyMock.mutex.Lock()
+	mmAddTeamPolicy.AddTeamPolicyMock.callArgs = append(mmAddTeamPolicy.AddTeamPolicyMock.callArgs, &mm_params)
+	mmAddTeamPolicy.AddTeamPolicyMock.mutex.Unlock()
+
+	for _, e := range mmAddTeamPolicy.AddTeamPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.err
+		}
+	}
+
+	if mmAddTeamPolicy.AddTeamPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddTeamPolicy.AddTeamPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddTeamPolicy.AddTeamPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmAddTeamPolicy.AddTeamPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockAddTeamPolicyParams{ctx, policy}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddTeamPolicy.t.Errorf("RepositoryMock.AddTeamPolicy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddTeamPolicy.AddTeamPolicyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.policy != nil && !minimock.Equal(*mm_want_ptrs.policy, mm_got.policy) {
+				mmAddTeamPolicy.t.Errorf("RepositoryMock.AddTeamPolicy got unexpected parameter policy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddTeamPolicy.AddTeamPolicyMock.defaultExpectation.expectationOrigins.originPolicy, *mm_want_ptrs.policy, mm_got.policy, minimock.Diff(*mm_want_ptrs.policy, mm_got.policy))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddTeamPolicy.t.Errorf("RepositoryMock.AddTeamPolicy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddTeamPolicy.AddTeamPolicyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddTeamPolicy.AddTeamPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddTeamPolicy.t.Fatal("No results are set for the RepositoryMock.AddTeamPolicy")
+		}
+		return (*mm_results).t1, (*mm_results).err
+	}
+	if mmAddTeamPolicy.funcAddTeamPolicy != nil {
+		return mmAddTeamPolicy.funcAddTeamPolicy(ctx, policy)
+	}
+	mmAddTeamPolicy.t.Fatalf("Unexpected call to RepositoryMock.AddTeamPolicy. %v %v", ctx, policy)
+	return
+}
+
+// AddTeamPolicyAfterCounter returns a count of finished RepositoryMock.AddTeamPolicy invocations
+func (mmAddTeamPolicy *RepositoryMock) AddTeamPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddTeamPolicy.afterAddTeamPolicyCounter)
+}
+
+// AddTeamPolicyBeforeCounter returns a count of RepositoryMock.AddTeamPolicy invocations
+func (mmAddTeamPolicy *RepositoryMock) AddTeamPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddTeamPolicy.beforeAddTeamPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.AddTeamPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddTeamPolicy *mRepositoryMockAddTeamPolicy) Calls() []*RepositoryMockAddTeamPolicyParams {
+	mmAddTeamPolicy.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockAddTeamPolicyParams, len(mmAddTeamPolicy.callArgs))
+	copy(argCopy, mmAddTeamPolicy.callArgs)
+
+	mmAddTeamPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddTeamPolicyDone returns true if the count of the AddTeamPolicy invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockAddTeamPolicyDone() bool {
+	if m.AddTeamPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddTeamPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddTeamPolicyMock.invocationsDone()
+}
+
+// MinimockAddTeamPolicyInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockAddTeamPolicyInspect() {
+	for _, e := range m.AddTeamPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.AddTeamPolicy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddTeamPolicyCounter := mm_atomic.LoadUint64(&m.afterAddTeamPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddTeamPolicyMock.defaultExpectation != nil && afterAddTeamPolicyCounter < 1 {
+		if m.AddTeamPolicyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.AddTeamPolicy at\n%s", m.AddTeamPolicyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.AddTeamPolicy at\n%s with params: %#v", m.AddTeamPolicyMock.defaultExpectation.expectationOrigins.origin, *m.AddTeamPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddTeamPolicy != nil && afterAddTeamPolicyCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.AddTeamPolicy at\n%s", m.funcAddTeamPolicyOrigin)
+	}
+
+	if !m.AddTeamPolicyMock.invocationsDone() && afterAddTeamPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.AddTeamPolicy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddTeamPolicyMock.expectedInvocations), m.AddTeamPolicyMock.expectedInvocationsOrigin, afterAddTeamPolicyCounter)
+	}
+}
+
+type mRepositoryMockFindMissingTeams struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockFindMissingTeamsExpectation
+	expectations       []*RepositoryMockFindMissingTeamsExpectation
+
+	callArgs []*RepositoryMockFindMissingTeamsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockFindMissingTeamsExpectation specifies expectation struct of the repository.FindMissingTeams
+type RepositoryMockFindMissingTeamsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockFindMissingTeamsParams
+	paramPtrs          *RepositoryMockFindMissingTeamsParamPtrs
+	expectationOrigins RepositoryMockFindMissingTeamsExpectationOrigins
+	results            *RepositoryMockFindMissingTeamsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockFindMissingTeamsParams contains parameters of the repository.FindMissingTeams
+type RepositoryMockFindMissingTeamsParams struct {
+	ctx       context.Context
+	teamNames []string
+}
+
+// RepositoryMockFindMissingTeamsParamPtrs contains pointers to parameters of the repository.FindMissingTeams
+type RepositoryMockFindMissingTeamsParamPtrs struct {
+	ctx       *context.Context
+	teamNames *[]string
+}
+
+// RepositoryMockFindMissingTeamsResults contains results of the repository.FindMissingTeams
+type RepositoryMockFindMissingTeamsResults struct {
+	sa1 []string
+	err error
+}
+
+// RepositoryMockFindMissingTeamsOrigins contains origins of expectations of the repository.FindMissingTeams
+type RepositoryMockFindMissingTeamsExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originTeamNames string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindMissingTeams *mRepositoryMockFindMissingTeams) Optional() *mRepositoryMockFindMissingTeams {
+	mmFindMissingTeams.optional = true
+	return mmFindMissingTeams
+}
+
+// Expect sets up expected params for repository.FindMissingTeams
+func (mmFindMissingTeams *mRepositoryMockFindMissingTeams) Expect(ctx context.Context, teamNames []string) *mRepositoryMockFindMissingTeams {
+	if mmFindMissingTeams.mock.funcFindMissingTeams != nil {
+		mmFindMissingTeams.mock.t.Fatalf("RepositoryMock.FindMissingTeams mock is already set by Set")
+	}
+
+	if mmFindMissingTeams.defaultExpectation == nil {
+		mmFindMissingTeams.defaultExpectation = &RepositoryMockFindMissingTeamsExpectation{}
+	}
+
+	if mmFindMissingTeams.defaultExpectation.paramPtrs != nil {
+		mmFindMissingTeams.mock.t.Fatalf("RepositoryMock.FindMissingTeams mock is already set by ExpectParams functions")
+	}
+
+	mmFindMissingTeams.defaultExpectation.params = &RepositoryMockFindMissingTeamsParams{ctx, teamNames}
+	mmFindMissingTeams.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindMissingTeams.expectations {
+		if minimock.Equal(e.params, mmFindMissingTeams.defaultExpectation.params) {
+			mmFindMissingTeams.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindMissingTeams.defaultExpectation.params)
+		}
+	}
+
+	return mmFindMissingTeams
+}
+
+// ExpectCtxParam1 sets up expected param ctx for repository.FindMissingTeams
+func (mmFindMissingTeams *mRepositoryMockFindMissingTeams) ExpectCtxParam1(ctx context.Context) *mRepositoryMockFindMissingTeams {
+	if mmFindMissingTeams.mock.funcFindMissingTeams != nil {
+		mmFindMissingTeams.mock.t.Fatalf("RepositoryMock.FindMissingTeams mock is already set by Set")
+	}
+
+	if mmFindMissingTeams.defaultExpectation == nil {
+		mmFindMissingTeams.defaultExpectation = &RepositoryMockFindMissingTeamsExpectation{}
+	}
+
+	if mmFindMissingTeams.defaultExpectation.params != nil {
+		mmFindMissingTeams.mock.t.Fatalf("RepositoryMock.FindMissingTeams mock is already set by Expect")
+	}
+
+	if mmFindMissingTeams.defaultExpectation.paramPtrs == nil {
+		mmFindMissingTeams.defaultExpectation.paramPtrs = &RepositoryMockFindMissingTeamsParamPtrs{}
+	}
+	mmFindMissingTeams.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindMissingTeams.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindMissingTeams
+}
+
+// ExpectTeamNamesParam2 sets up expected param teamNames for repository.FindMissingTeams
+func (mmFindMissingTeams *mRepositoryMockFindMissingTeams) ExpectTeamNamesParam2(teamNames []string) *mRepositoryMockFindMissingTeams {
+	if mmFindMissingTeams.mock.funcFindMissingTeams != nil {
+		mmFindMissingTeams.mock.t.Fatalf("RepositoryMock.FindMissingTeams mock is already set by Set")
+	}
+
+	if mmFindMissingTeams.defaultExpectation == nil {
+		mmFindMissingTeams.defaultExpectation = &RepositoryMockFindMissingTeamsExpectation{}
+	}
+
+	if mmFindMissingTeams.defaultExpectation.params != nil {
+		mmFindMissingTeams.mock.t.Fatalf("RepositoryMock.FindMissingTeams mock is already set by Expect")
+	}
+
+	if mmFindMissingTeams.defaultExpectation.paramPtrs == nil {
+		mmFindMissingTeams.defaultExpectation.paramPtrs = &RepositoryMockFindMissingTeamsParamPtrs{}
+	}
+	mmFindMissingTeams.defaultExpectation.paramPtrs.teamNames = &teamNames
+	mmFindMissingTeams.defaultExpectation.expectationOrigins.originTeamNames = minimock.CallerInfo(1)
+
+	return mmFindMissingTeams
+}
+
+// Inspect accepts an inspector function that has same arguments as the repository.FindMissingTeams
+func (mmFindMissingTeams *mRepositoryMockFindMissingTeams) Inspect(f func(ctx context.Context, teamNames []string)) *mRepositoryMockFindMissingTeams {
+	if mmFindMissingTeams.mock.inspectFuncFindMissingTeams != nil {
+		mmFindMissingTeams.mock.t.Fatalf("Inspect function is already set for RepositoryMock.FindMissingTeams")
+	}
+
+	mmFindMissingTeams.mock.inspectFuncFindMissingTeams = f
+
+	return mmFindMissingTeams
+}
+
+// Return sets up results that will be returned by repository.FindMissingTeams
+func (mmFindMissingTeams *mRepositoryMockFindMissingTeams) Return(sa1 []string, err error) *RepositoryMock {
+	if mmFindMissingTeams.mock.funcFindMissingTeams != nil {
+		mmFindMissingTeams.mock.t.Fatalf("RepositoryMock.FindMissingTeams mock is already set by Set")
+	}
+
+	if mmFindMissingTeams.defaultExpectation == nil {
+		mmFindMissingTeams.defaultExpectation = &RepositoryMockFindMissingTeamsExpectation{mock: mmFindMissingTeams.mock}
+	}
+	mmFindMissingTeams.defaultExpectation.results = &RepositoryMockFindMissingTeamsResults{sa1, err}
+	mmFindMissingTeams.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindMissingTeams.mock
+}
+
+// Set uses given function f to mock the repository.FindMissingTeams method
+func (mmFindMissingTeams *mRepositoryMockFindMissingTeams) Set(f func(ctx context.Context, teamNames []string) (sa1 []string, err error)) *RepositoryMock {
+	if mmFindMissingTeams.defaultExpectation != nil {
+		mmFindMissingTeams.mock.t.Fatalf("Default expectation is already set for the repository.FindMissingTeams method")
+	}
+
+	if len(mmFindMissingTeams.expectations) > 0 {
+		mmFindMissingTeams.mock.t.Fatalf("Some expectations are already set for the repository.FindMissingTeams method")
+	}
+
+	mmFindMissingTeams.mock.funcFindMissingTeams = f
+	mmFindMissingTeams.mock.funcFindMissingTeamsOrigin = minimock.CallerInfo(1)
+	return mmFindMissingTeams.mock
+}
+
+// When sets expectation for the repository.FindMissingTeams which will trigger the result defined by the following
+// Then helper
+func (mmFindMissingTeams *mRepositoryMockFindMissingTeams) When(ctx context.Context, teamNames []string) *RepositoryMockFindMissingTeamsExpectation {
+	if mmFindMissingTeams.mock.funcFindMissingTeams != nil {
+		mmFindMissingTeams.mock.t.Fatalf("RepositoryMock.FindMissingTeams mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockFindMissingTeamsExpectation{
+		mock:               mmFindMissingTeams.mock,
+		params:             &RepositoryMockFindMissingTeamsParams{ctx, teamNames},
+		expectationOrigins: RepositoryMockFindMissingTeamsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindMissingTeams.expectations = append(mmFindMissingTeams.expectations, expectation)
+	return expectation
+}
+
+// Then sets up repository.FindMissingTeams return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockFindMissingTeamsExpectation) Then(sa1 []string, err error) *RepositoryMock {
+	e.results = &RepositoryMockFindMissingTeamsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times repository.FindMissingTeams should be invoked
+func (mmFindMissingTeams *mRepositoryMockFindMissingTeams) Times(n uint64) *mRepositoryMockFindMissingTeams {
+	if n == 0 {
+		mmFindMissingTeams.mock.t.Fatalf("Times of RepositoryMock.FindMissingTeams mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindMissingTeams.expectedInvocations, n)
+	mmFindMissingTeams.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindMissingTeams
+}
+
+func (mmFindMissingTeams *mRepositoryMockFindMissingTeams) invocationsDone() bool {
+	if len(mmFindMissingTeams.expectations) == 0 && mmFindMissingTeams.defaultExpectation == nil && mmFindMissingTeams.mock.funcFindMissingTeams == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindMissingTeams.mock.afterFindMissingTeamsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindMissingTeams.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindMissingTeams implements repository
+func (mmFindMissingTeams *RepositoryMock) FindMissingTeams(ctx context.Context, teamNames []string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmFindMissingTeams.beforeFindMissingTeamsCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindMissingTeams.afterFindMissingTeamsCounter, 1)
+
+	mmFindMissingTeams.t.Helper()
+
+	if mmFindMissingTeams.inspectFuncFindMissingTeams != nil {
+		mmFindMissingTeams.inspectFuncFindMissingTeams(ctx, teamNames)
+	}
+
+	mm_params := RepositoryMockFindMissingTeamsParams{ctx, teamNames}
+
+	// Record call args
+	mmFindMissingTeams.FindMissingTeamsMock.mutex.Lock()
+	mmFindMissingTeams.FindMissingTeamsMock.callArgs = append(mmFindMissingTeams.FindMissingTeamsMock.callArgs, &mm_params)
+	mmFindMissingTeams.FindMissingTeamsMock.mutex.Unlock()
+
+	for _, e := range mmFindMissingTeams.FindMissingTeamsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmFindMissingTeams.FindMissingTeamsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindMissingTeams.FindMissingTeamsMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindMissingTeams.FindMissingTeamsMock.defaultExpectation.params
+		mm_want_ptrs := mmFindMissingTeams.FindMissingTeamsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockFindMissingTeamsParams{ctx, teamNames}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindMissingTeams.t.Errorf("RepositoryMock.FindMissingTeams got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindMissingTeams.FindMissingTeamsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.teamNames != nil && !minimock.Equal(*mm_want_ptrs.teamNames, mm_got.teamNames) {
+				mmFindMissingTeams.t.Errorf("RepositoryMock.FindMissingTeams got unexpected parameter teamNames, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindMissingTeams.FindMissingTeamsMock.defaultExpectation.expectationOrigins.originTeamNames, *mm_want_ptrs.teamNames, mm_got.teamNames, minimock.Diff(*mm_want_ptrs.teamNames, mm_got.teamNames))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindMissingTeams.t.Errorf("RepositoryMock.FindMissingTeams got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindMissingTeams.FindMissingTeamsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindMissingTeams.FindMissingTeamsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindMissingTeams.t.Fatal("No results are set for the RepositoryMock.FindMissingTeams")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmFindMissingTeams.funcFindMissingTeams != nil {
+		return mmFindMissingTeams.funcFindMissingTeams(ctx, teamNames)
+	}
+	mmFindMissingTeams.t.Fatalf("Unexpected call to RepositoryMock.FindMissingTeams. %v %v", ctx, teamNames)
+	return
+}
+
+// FindMissingTeamsAfterCounter returns a count of finished RepositoryMock.FindMissingTeams invocations
+func (mmFindMissingTeams *RepositoryMock) FindMissingTeamsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindMissingTeams.afterFindMissingTeamsCounter)
+}
+
+// FindMissingTeamsBeforeCounter returns a count of RepositoryMock.FindMissingTeams invocations
+func (mmFindMissingTeams *RepositoryMock) FindMissingTeamsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindMissingTeams.beforeFindMissingTeamsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.FindMissingTeams.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindMissingTeams *mRepositoryMockFindMissingTeams) Calls() []*RepositoryMockFindMissingTeamsParams {
+	mmFindMissingTeams.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockFindMissingTeamsParams, len(mmFindMissingTeams.callArgs))
+	copy(argCopy, mmFindMissingTeams.callArgs)
+
+	mmFindMissingTeams.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindMissingTeamsDone returns true if the count of the FindMissingTeams invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockFindMissingTeamsDone() bool {
+	if m.FindMissingTeamsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindMissingTeamsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindMissingTeamsMock.invocationsDone()
+}
+
+// MinimockFindMissingTeamsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockFindMissingTeamsInspect() {
+	for _, e := range m.FindMissingTeamsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.FindMissingTeams at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindMissingTeamsCounter := mm_atomic.LoadUint64(&m.afterFindMissingTeamsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindMissingTeamsMock.defaultExpectation != nil && afterFindMissingTeamsCounter < 1 {
+		if m.FindMissingTeamsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.FindMissingTeams at\n%s", m.FindMissingTeamsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.FindMissingTeams at\n%s with params: %#v", m.FindMissingTeamsMock.defaultExpectation.expectationOrigins.origin, *m.FindMissingTeamsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindMissingTeams != nil && afterFindMissingTeamsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.FindMissingTeams at\n%s", m.funcFindMissingTeamsOrigin)
+	}
+
+	if !m.FindMissingTeamsMock.invocationsDone() && afterFindMissingTeamsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.FindMissingTeams at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindMissingTeamsMock.expectedInvocations), m.FindMissingTeamsMock.expectedInvocationsOrigin, afterFindMissingTeamsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddTeamPolicyInspect()
+
+			m.MinimockFindMissingTeamsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddTeamPolicyDone() &&
+		m.MinimockFindMissingTeamsDone()
+}
@@ -0,0 +1,120 @@
+package upload
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/AndrejDubinin/review-assigner/internal/services/team/policy/document"
+)
+
+type (
+	repository interface {
+		AddTeamPolicy(ctx context.Context, policy domain.TeamPolicyDTO) (domain.TeamPolicy, error)
+		FindMissingTeams(ctx context.Context, teamNames []string) ([]string, error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// UploadTeamPolicy validates the document and stores it as the new active policy version of the team.
+func (h *Handler) UploadTeamPolicy(ctx context.Context, teamName string, format domain.PolicyFormat,
+	raw []byte,
+) (domain.TeamPolicy, error) {
+	logger := h.logger.With(
+		zap.String("service", "team.policy.upload"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	policy, err := document.Parse(format, raw)
+	if err != nil {
+		return domain.TeamPolicy{}, fmt.Errorf("%w: %w", domain.ErrInvalidPolicy, err)
+	}
+
+	if err = h.checkTeams(ctx, teamName, policy); err != nil {
+		return domain.TeamPolicy{}, err
+	}
+
+	stored, err := h.repo.AddTeamPolicy(ctx, domain.TeamPolicyDTO{
+		TeamName: teamName,
+		Format:   format,
+		Document: string(raw),
+		Policy:   policy,
+	})
+	if err != nil {
+		logger.Error("repo.AddTeamPolicy", zap.Error(err), zap.String("team_name", teamName))
+		return domain.TeamPolicy{}, fmt.Errorf("repo.AddTeamPolicy: %w", err)
+	}
+
+	logger.Info("policy uploaded", zap.String("team_name", teamName), zap.Int("version", stored.Version))
+
+	return stored, nil
+}
+
+// checkTeams verifies that teams referenced by the policy exist and that the team
+// does not fall back to itself.
+func (h *Handler) checkTeams(ctx context.Context, teamName string, policy domain.AssignmentPolicy) error {
+	referenced := slices.Clone(policy.FallbackTeams)
+	for _, rule := range policy.MandatoryReviewers {
+		if rule.TeamName != "" {
+			referenced = append(referenced, rule.TeamName)
+		}
+	}
+	if len(referenced) == 0 {
+		return nil
+	}
+
+	missing, err := h.repo.FindMissingTeams(ctx, referenced)
+	if err != nil {
+		return fmt.Errorf("repo.FindMissingTeams: %w", err)
+	}
+
+	validationErr := &domain.PolicyValidationError{}
+	for i, fallback := range policy.FallbackTeams {
+		field := fmt.Sprintf("fallback_teams[%d]", i)
+
+		switch {
+		case fallback == teamName:
+			validationErr.Errors = append(validationErr.Errors, domain.FieldError{
+				Field:   field,
+				Message: "team cannot fall back to itself",
+			})
+		case slices.Contains(missing, fallback):
+			validationErr.Errors = append(validationErr.Errors, domain.FieldError{
+				Field:   field,
+				Message: "team not found",
+			})
+		}
+	}
+	for i, rule := range policy.MandatoryReviewers {
+		if rule.TeamName != "" && slices.Contains(missing, rule.TeamName) {
+			validationErr.Errors = append(validationErr.Errors, domain.FieldError{
+				Field:   fmt.Sprintf("mandatory_reviewers[%d].team_name", i),
+				Message: "team not found",
+			})
+		}
+	}
+
+	if len(validationErr.Errors) > 0 {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidPolicy, validationErr)
+	}
+	return nil
+}
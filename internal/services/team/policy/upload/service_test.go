@@ -0,0 +1,149 @@
+package upload
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func TestHandler_UploadTeamPolicy(t *testing.T) {
+	t.Parallel()
+
+	const document = `
+reviewers:
+  count: 2
+fallback_teams: [platform, frontend]
+mandatory_reviewers:
+  - team_name: security
+`
+	policy := domain.AssignmentPolicy{
+		Strategy:           domain.StrategyLeastLoaded,
+		Reviewers:          domain.ReviewersPolicy{Count: 2},
+		FallbackTeams:      []string{"platform", "frontend"},
+		MandatoryReviewers: []domain.MandatoryReviewer{{TeamName: "security"}},
+	}
+	stored := domain.TeamPolicy{TeamName: "backend", Version: 3, Active: true, Policy: policy}
+	referenced := []string{"platform", "frontend", "security"}
+
+	tests := []struct {
+		name       string
+		teamName   string
+		raw        string
+		repo       func(mc *minimock.Controller) repository
+		want       domain.TeamPolicy
+		wantErr    error
+		wantFields []domain.FieldError
+	}{
+		{
+			name:     "success: policy is stored as a new version",
+			teamName: "backend",
+			raw:      document,
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.FindMissingTeamsMock.Expect(minimock.AnyContext, referenced).Return(nil, nil)
+				repo.AddTeamPolicyMock.Expect(minimock.AnyContext, domain.TeamPolicyDTO{
+					TeamName: "backend",
+					Format:   domain.PolicyFormatYAML,
+					Document: document,
+					Policy:   policy,
+				}).Return(stored, nil)
+				return repo
+			},
+			want: stored,
+		},
+		{
+			name:     "success: policy without team references is not checked",
+			teamName: "backend",
+			raw:      "strategy: round_robin\n",
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.AddTeamPolicyMock.Return(stored, nil)
+				return repo
+			},
+			want: stored,
+		},
+		{
+			name:     "error: document does not parse",
+			teamName: "backend",
+			raw:      "reviewers: [",
+			repo: func(mc *minimock.Controller) repository {
+				return NewRepositoryMock(mc)
+			},
+			wantErr: domain.ErrInvalidPolicy,
+		},
+		{
+			name:     "error: unknown and own teams are reported per field",
+			teamName: "platform",
+			raw:      document,
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.FindMissingTeamsMock.Expect(minimock.AnyContext, referenced).
+					Return([]string{"frontend", "security"}, nil)
+				return repo
+			},
+			wantErr: domain.ErrInvalidPolicy,
+			wantFields: []domain.FieldError{
+				{Field: "fallback_teams[0]", Message: "team cannot fall back to itself"},
+				{Field: "fallback_teams[1]", Message: "team not found"},
+				{Field: "mandatory_reviewers[0].team_name", Message: "team not found"},
+			},
+		},
+		{
+			name:     "error: team not found",
+			teamName: "backend",
+			raw:      document,
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.FindMissingTeamsMock.Return(nil, nil)
+				repo.AddTeamPolicyMock.Return(domain.TeamPolicy{}, domain.ErrTeamNotFound)
+				return repo
+			},
+			wantErr: domain.ErrTeamNotFound,
+		},
+		{
+			name:     "error: database failure",
+			teamName: "backend",
+			raw:      document,
+			repo: func(mc *minimock.Controller) repository {
+				repo := NewRepositoryMock(mc)
+				repo.FindMissingTeamsMock.Return(nil, errors.New("database connection failed"))
+				return repo
+			},
+			wantErr: errors.New("database connection failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			handler := New(tt.repo(mc), zap.NewNop())
+
+			got, err := handler.UploadTeamPolicy(context.Background(), tt.teamName, domain.PolicyFormatYAML,
+				[]byte(tt.raw))
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr.Error())
+				if tt.wantFields != nil {
+					var validationErr *domain.PolicyValidationError
+					require.ErrorAs(t, err, &validationErr)
+					assert.Equal(t, tt.wantFields, validationErr.Errors)
+				}
+				assert.Equal(t, domain.TeamPolicy{}, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
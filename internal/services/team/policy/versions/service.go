@@ -0,0 +1,48 @@
+package versions
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		ListTeamPolicies(ctx context.Context, teamName string) ([]domain.TeamPolicy, error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// ListTeamPolicies returns every stored policy version of the team, newest first.
+func (h *Handler) ListTeamPolicies(ctx context.Context, teamName string) ([]domain.TeamPolicy, error) {
+	logger := h.logger.With(
+		zap.String("service", "team.policy.versions"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	policies, err := h.repo.ListTeamPolicies(ctx, teamName)
+	if err != nil {
+		logger.Error("repo.ListTeamPolicies", zap.Error(err), zap.String("team_name", teamName))
+		return nil, err
+	}
+
+	return policies, nil
+}
@@ -0,0 +1,48 @@
+package reminders
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		ListReminderRuns(ctx context.Context, teamName string, limit int) ([]domain.ReminderRun, error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// ListReminderRuns returns the latest reminder runs of the team with every delivery they made.
+func (h *Handler) ListReminderRuns(ctx context.Context, teamName string, limit int) ([]domain.ReminderRun, error) {
+	logger := h.logger.With(
+		zap.String("service", "team.reminderRuns"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	runs, err := h.repo.ListReminderRuns(ctx, teamName, limit)
+	if err != nil {
+		logger.Error("repo.ListReminderRuns", zap.Error(err), zap.String("team_name", teamName))
+		return nil, err
+	}
+
+	return runs, nil
+}
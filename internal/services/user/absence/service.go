@@ -0,0 +1,51 @@
+package absence
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		AddUserAbsence(ctx context.Context, absence domain.UserAbsence) error
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// AddUserAbsence records a period the user is away. While the absence lasts the user is rejected
+// as ABSENT by the reviewer selection, including mandatory and requested reviews.
+func (h *Handler) AddUserAbsence(ctx context.Context, absence domain.UserAbsence) (domain.UserAbsence, error) {
+	logger := h.logger.With(
+		zap.String("service", "users.addAbsence"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	if err := h.repo.AddUserAbsence(ctx, absence); err != nil {
+		logger.Error("repo.AddUserAbsence", zap.Error(err), zap.String("user_id", absence.UserID))
+		return domain.UserAbsence{}, fmt.Errorf("repo.AddUserAbsence: %w", err)
+	}
+
+	logger.Info("absence added", zap.String("user_id", absence.UserID),
+		zap.Time("from", absence.From), zap.Time("until", absence.Until))
+	return absence, nil
+}
@@ -0,0 +1,52 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		SetUserIdentity(ctx context.Context, identity domain.UserIdentity) error
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// SetUserIdentity maps a code host account to the user, so webhooks of the code host resolve
+// the account to the user. An account already mapped is moved to the user.
+func (h *Handler) SetUserIdentity(ctx context.Context, identity domain.UserIdentity) (domain.UserIdentity, error) {
+	logger := h.logger.With(
+		zap.String("service", "users.setIdentity"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	if err := h.repo.SetUserIdentity(ctx, identity); err != nil {
+		logger.Error("repo.SetUserIdentity", zap.Error(err), zap.String("user_id", identity.UserID),
+			zap.String("provider", string(identity.Provider)))
+		return domain.UserIdentity{}, fmt.Errorf("repo.SetUserIdentity: %w", err)
+	}
+
+	logger.Info("identity set", zap.String("user_id", identity.UserID),
+		zap.String("provider", string(identity.Provider)), zap.String("external_id", identity.ExternalID))
+	return identity, nil
+}
@@ -0,0 +1,48 @@
+package reviews
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	repository interface {
+		GetUserReviews(ctx context.Context, userID string) (domain.UserReviews, error)
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	Handler struct {
+		repo   repository
+		logger logger
+	}
+)
+
+func New(repo repository, logger logger) *Handler {
+	return &Handler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// GetUserReviews lists the pull requests the user currently reviews, shadow reviews included.
+func (h *Handler) GetUserReviews(ctx context.Context, userID string) (domain.UserReviews, error) {
+	logger := h.logger.With(
+		zap.String("service", "users.getReview"),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+	)
+
+	reviews, err := h.repo.GetUserReviews(ctx, userID)
+	if err != nil {
+		logger.Error("repo.GetUserReviews", zap.Error(err), zap.String("user_id", userID))
+		return domain.UserReviews{}, err
+	}
+
+	return reviews, nil
+}
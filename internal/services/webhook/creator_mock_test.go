@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package webhook
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/webhook.creator -o creator_mock_test.go -n CreatorMock -p webhook
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// CreatorMock implements creator
+type CreatorMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreatePullRequest          func(ctx context.Context, pr domain.CreatePullRequest) (c2 domain.CreatePullRequestResult, err error)
+	funcCreatePullRequestOrigin    string
+	inspectFuncCreatePullRequest   func(ctx context.Context, pr domain.CreatePullRequest)
+	afterCreatePullRequestCounter  uint64
+	beforeCreatePullRequestCounter uint64
+	CreatePullRequestMock          mCreatorMockCreatePullRequest
+}
+
+// NewCreatorMock returns a mock for creator
+func NewCreatorMock(t minimock.Tester) *CreatorMock {
+	m := &CreatorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreatePullRequestMock = mCreatorMockCreatePullRequest{mock: m}
+	m.CreatePullRequestMock.callArgs = []*CreatorMockCreatePullRequestParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mCreatorMockCreatePullRequest struct {
+	optional           bool
+	mock               *CreatorMock
+	defaultExpectation *CreatorMockCreatePullRequestExpectation
+	expectations       []*CreatorMockCreatePullRequestExpectation
+
+	callArgs []*CreatorMockCreatePullRequestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CreatorMockCreatePullRequestExpectation specifies expectation struct of the creator.CreatePullRequest
+type CreatorMockCreatePullRequestExpectation struct {
+	mock               *CreatorMock
+	params             *CreatorMockCreatePullRequestParams
+	paramPtrs          *CreatorMockCreatePullRequestParamPtrs
+	expectationOrigins CreatorMockCreatePullRequestExpectationOrigins
+	results            *CreatorMockCreatePullRequestResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CreatorMockCreatePullRequestParams contains parameters of the creator.CreatePullRequest
+type CreatorMockCreatePullRequestParams struct {
+	ctx context.Context
+	pr  domain.CreatePullRequest
+}
+
+// CreatorMockCreatePullRequestParamPtrs contains pointers to parameters of the creator.CreatePullRequest
+type CreatorMockCreatePullRequestParamPtrs struct {
+	ctx *context.Context
+	pr  *domain.CreatePullRequest
+}
+
+// CreatorMockCreatePullRequestResults contains results of the creator.CreatePullRequest
+type CreatorMockCreatePullRequestResults struct {
+	c2  domain.CreatePullRequestResult
+	err error
+}
+
+// CreatorMockCreatePullRequestOrigins contains origins of expectations of the creator.CreatePullRequest
+type CreatorMockCreatePullRequestExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originPr  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreatePullRequest *mCreatorMockCreatePullRequest) Optional() *mCreatorMockCreatePullRequest {
+	mmCreatePullRequest.optional = true
+	return mmCreatePullRequest
+}
+
+// Expect sets up expected params for creator.CreatePullRequest
+func (mmCreatePullRequest *mCreatorMockCreatePullRequest) Expect(ctx context.Context, pr domain.CreatePullRequest) *mCreatorMockCreatePullRequest {
+	if mmCreatePullRequest.mock.funcCreatePullRequest != nil {
+		mmCreatePullRequest.mock.t.Fatalf("CreatorMock.CreatePullRequest mock is already set by Set")
+	}
+
+	if mmCreatePullRequest.defaultExpectation == nil {
+		mmCreatePullRequest.defaultExpectation = &CreatorMockCreatePullRequestExpectation{}
+	}
+
+	if mmCreatePullRequest.defaultExpectation.paramPtrs != nil {
+		mmCreatePullRequest.mock.t.Fatalf("CreatorMock.CreatePullRequest mock is already set by ExpectParams functions")
+	}
+
+	mmCreatePullRequest.defaultExpectation.params = &CreatorMockCreatePullRequestParams{ctx, pr}
+	mmCreatePullRequest.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreatePullRequest.expectations {
+		if minimock.Equal(e.params, mmCreatePullRequest.defaultExpectation.params) {
+			mmCreatePullRequest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePullRequest.defaultExpectation.params)
+		}
+	}
+
+	return mmCreatePullRequest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for creator.CreatePullRequest
+func (mmCreatePullRequest *mCreatorMockCreatePullRequest) ExpectCtxParam1(ctx context.Context) *mCreatorMockCreatePullRequest {
+	if mmCreatePullRequest.mock.funcCreatePullRequest != nil {
+		mmCreatePullRequest.mock.t.Fatalf("CreatorMock.CreatePullRequest mock is already set by Set")
+	}
+
+	if mmCreatePullRequest.defaultExpectation == nil {
+		mmCreatePullRequest.defaultExpectation = &CreatorMockCreatePullRequestExpectation{}
+	}
+
+	if mmCreatePullRequest.defaultExpectation.params != nil {
+		mmCreatePullRequest.mock.t.Fatalf("CreatorMock.CreatePullRequest mock is already set by Expect")
+	}
+
+	if mmCreatePullRequest.defaultExpectation.paramPtrs == nil {
+		mmCreatePullRequest.defaultExpectation.paramPtrs = &CreatorMockCreatePullRequestParamPtrs{}
+	}
+	mmCreatePullRequest.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreatePullRequest.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreatePullRequest
+}
+
+// ExpectPrParam2 sets up expected param pr for creator.CreatePullRequest
+func (mmCreatePullRequest *mCreatorMockCreatePullRequest) ExpectPrParam2(pr domain.CreatePullRequest) *mCreatorMockCreatePullRequest {
+	if mmCreatePullRequest.mock.funcCreatePullRequest != nil {
+		mmCreatePullRequest.mock.t.Fatalf("CreatorMock.CreatePullRequest mock is already set by Set")
+	}
+
+	if mmCreatePullRequest.defaultExpectation == nil {
+		mmCreatePullRequest.defaultExpectation = &CreatorMockCreatePullRequestExpectation{}
+	}
+
+	if mmCreatePullRequest.defaultExpectation.params != nil {
+		mmCreatePullRequest.mock.t.Fatalf("CreatorMock.CreatePullRequest mock is already set by Expect")
+	}
+
+	if mmCreatePullRequest.defaultExpectation.paramPtrs == nil {
+		mmCreatePullRequest.defaultExpectation.paramPtrs = &CreatorMockCreatePullRequestParamPtrs{}
+	}
+	mmCreatePullRequest.defaultExpectation.paramPtrs.pr = &pr
+	mmCreatePullRequest.defaultExpectation.expectationOrigins.originPr = minimock.CallerInfo(1)
+
+	return mmCreatePullRequest
+}
+
+// Inspect accepts an inspector function that has same arguments as the creator.CreatePullRequest
+func (mmCreatePullRequest *mCreatorMockCreatePullRequest) Inspect(f func(ctx context.Context, pr domain.CreatePullRequest)) *mCreatorMockCreatePullRequest {
+	if mmCreatePullRequest.mock.inspectFuncCreatePullRequest != nil {
+		mmCreatePullRequest.mock.t.Fatalf("Inspect function is already set for CreatorMock.CreatePullRequest")
+	}
+
+	mmCreatePullRequest.mock.inspectFuncCreatePullRequest = f
+
+	return mmCreatePullRequest
+}
+
+// Return sets up results that will be returned by creator.CreatePullRequest
+func (mmCreatePullRequest *mCreatorMockCreatePullRequest) Return(c2 domain.CreatePullRequestResult, err error) *CreatorMock {
+	if mmCreatePullRequest.mock.funcCreatePullRequest != nil {
+		mmCreatePullRequest.mock.t.Fatalf("CreatorMock.CreatePullRequest mock is already set by Set")
+	}
+
+	if mmCreatePullRequest.defaultExpectation == nil {
+		mmCreatePullRequest.defaultExpectation = &CreatorMockCreatePullRequestExpectation{mock: mmCreatePullRequest.mock}
+	}
+	mmCreatePullRequest.defaultExpectation.results = &CreatorMockCreatePullRequestResults{c2, err}
+	mmCreatePullRequest.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreatePullRequest.mock
+}
+
+// Set uses given function f to mock the creator.CreatePullRequest method
+func (mmCreatePullRequest *mCreatorMockCreatePullRequest) Set(f func(ctx context.Context, pr domain.CreatePullRequest) (c2 domain.CreatePullRequestResult, err error)) *CreatorMock {
+	if mmCreatePullRequest.defaultExpectation != nil {
+		mmCreatePullRequest.mock.t.Fatalf("Default expectation is already set for the creator.CreatePullRequest method")
+	}
+
+	if len(mmCreatePullRequest.expectations) > 0 {
+		mmCreatePullRequest.mock.t.Fatalf("Some expectations are already set for the creator.CreatePullRequest method")
+	}
+
+	mmCreatePullRequest.mock.funcCreatePullRequest = f
+	mmCreatePullRequest.mock.funcCreatePullRequestOrigin = minimock.CallerInfo(1)
+	return mmCreatePullRequest.mock
+}
+
+// When sets expectation for the creator.CreatePullRequest which will trigger the result defined by the following
+// Then helper
+func (mmCreatePullRequest *mCreatorMockCreatePullRequest) When(ctx context.Context, pr domain.CreatePullRequest) *CreatorMockCreatePullRequestExpectation {
+	if mmCreatePullRequest.mock.funcCreatePullRequest != nil {
+		mmCreatePullRequest.mock.t.Fatalf("CreatorMock.CreatePullRequest mock is already set by Set")
+	}
+
+	expectation := &CreatorMockCreatePullRequestExpectation{
+		mock:               mmCreatePullRequest.mock,
+		params:             &CreatorMockCreatePullRequestParams{ctx, pr},
+		expectationOrigins: CreatorMockCreatePullRequestExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreatePullRequest.expectations = append(mmCreatePullRequest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up creator.CreatePullRequest return parameters for the expectation previously defined by the When method
+func (e *CreatorMockCreatePullRequestExpectation) Then(c2 domain.CreatePullRequestResult, err error) *CreatorMock {
+	e.results = &CreatorMockCreatePullRequestResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times creator.CreatePullRequest should be invoked
+func (mmCreatePullRequest *mCreatorMockCreatePullRequest) Times(n uint64) *mCreatorMockCreatePullRequest {
+	if n == 0 {
+		mmCreatePullRequest.mock.t.Fatalf("Times of CreatorMock.CreatePullRequest mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreatePullRequest.expectedInvocations, n)
+	mmCreatePullRequest.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreatePullRequest
+}
+
+func (mmCreatePullRequest *mCreatorMockCreatePullRequest) invocationsDone() bool {
+	if len(mmCreatePullRequest.expectations) == 0 && mmCreatePullRequest.defaultExpectation == nil && mmCreatePullRequest.mock.funcCreatePullRequest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePullRequest.mock.afterCreatePullRequestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePullRequest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreatePullRequest implements creator
+func (mmCreatePullRequest *CreatorMock) CreatePullRequest(ctx context.Context, pr domain.CreatePullRequest) (c2 domain.CreatePullRequestResult, err error) {
+	mm_atomic.AddUint64(&mmCreatePullRequest.beforeCreatePullRequestCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePullRequest.afterCreatePullRequestCounter, 1)
+
+	mmCreatePullRequest.t.Helper()
+
+	if mmCreatePullRequest.inspectFuncCreatePullRequest != nil {
+		mmCreatePullRequest.inspectFuncCreatePullRequest(ctx, pr)
+	}
+
+	mm_params := CreatorMockCreatePullRequestParams{ctx, pr}
+
+	// Record call args
+	mmCreatePullRequest.CreatePullRequestMock.mutex.Lock()
+	mmCreatePullRequest.CreatePullRequestMock.callArgs = append(mmCreatePullRequest.CreatePullRequestMock.callArgs, &mm_params)
+	mmCreatePullRequest.CreatePullRequestMock.mutex.Unlock()
+
+	for _, e := range mmCreatePullRequest.CreatePullRequestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmCreatePullRequest.CreatePullRequestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePullRequest.CreatePullRequestMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePullRequest.CreatePullRequestMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePullRequest.CreatePullRequestMock.defaultExpectation.paramPtrs
+
+		mm_got := CreatorMockCreatePullRequestParams{ctx, pr}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreatePullRequest.t.Errorf("CreatorMock.CreatePullRequest got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePullRequest.CreatePullRequestMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pr != nil && !minimock.Equal(*mm_want_ptrs.pr, mm_got.pr) {
+				mmCreatePullRequest.t.Errorf("CreatorMock.CreatePullRequest got unexpected parameter pr, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePullRequest.CreatePullRequestMock.defaultExpectation.expectationOrigins.originPr, *mm_want_ptrs.pr, mm_got.pr, minimock.Diff(*mm_want_ptrs.pr, mm_got.pr))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePullRequest.t.Errorf("CreatorMock.CreatePullRequest got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreatePullRequest.CreatePullRequestMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePullRequest.CreatePullRequestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePullRequest.t.Fatal("No results are set for the CreatorMock.CreatePullRequest")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmCreatePullRequest.funcCreatePullRequest != nil {
+		return mmCreatePullRequest.funcCreatePullRequest(ctx, pr)
+	}
+	mmCreatePullRequest.t.Fatalf("Unexpected call to CreatorMock.CreatePullRequest. %v %v", ctx, pr)
+	return
+}
+
+// CreatePullRequestAfterCounter returns a count of finished CreatorMock.CreatePullRequest invocations
+func (mmCreatePullRequest *CreatorMock) CreatePullRequestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePullRequest.afterCreatePullRequestCounter)
+}
+
+// CreatePullRequestBeforeCounter returns a count of CreatorMock.CreatePullRequest invocations
+func (mmCreatePullRequest *CreatorMock) CreatePullRequestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePullRequest.beforeCreatePullRequestCounter)
+}
+
+// Calls returns a list of arguments used in each call to CreatorMock.CreatePullRequest.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePullRequest *mCreatorMockCreatePullRequest) Calls() []*CreatorMockCreatePullRequestParams {
+	mmCreatePullRequest.mutex.RLock()
+
+	argCopy := make([]*CreatorMockCreatePullRequestParams, len(mmCreatePullRequest.callArgs))
+	copy(argCopy, mmCreatePullRequest.callArgs)
+
+	mmCreatePullRequest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePullRequestDone returns true if the count of the CreatePullRequest invocations corresponds
+// the number of defined expectations
+func (m *CreatorMock) MinimockCreatePullRequestDone() bool {
+	if m.CreatePullRequestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePullRequestMock.invocationsDone()
+}
+
+// MinimockCreatePullRequestInspect logs each unmet expectation
+func (m *CreatorMock) MinimockCreatePullRequestInspect() {
+	for _, e := range m.CreatePullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CreatorMock.CreatePullRequest at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreatePullRequestCounter := mm_atomic.LoadUint64(&m.afterCreatePullRequestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePullRequestMock.defaultExpectation != nil && afterCreatePullRequestCounter < 1 {
+		if m.CreatePullRequestMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CreatorMock.CreatePullRequest at\n%s", m.CreatePullRequestMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CreatorMock.CreatePullRequest at\n%s with params: %#v", m.CreatePullRequestMock.defaultExpectation.expectationOrigins.origin, *m.CreatePullRequestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePullRequest != nil && afterCreatePullRequestCounter < 1 {
+		m.t.Errorf("Expected call to CreatorMock.CreatePullRequest at\n%s", m.funcCreatePullRequestOrigin)
+	}
+
+	if !m.CreatePullRequestMock.invocationsDone() && afterCreatePullRequestCounter > 0 {
+		m.t.Errorf("Expected %d calls to CreatorMock.CreatePullRequest at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePullRequestMock.expectedInvocations), m.CreatePullRequestMock.expectedInvocationsOrigin, afterCreatePullRequestCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CreatorMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreatePullRequestInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *CreatorMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *CreatorMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreatePullRequestDone()
+}
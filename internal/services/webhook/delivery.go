@@ -0,0 +1,95 @@
+// Package webhook holds what the code host receivers share. The receivers verify and decode the
+// deliveries of their code host into an Event, the Router applies it: every delivery is applied
+// once, code host accounts are resolved to users through the identity mapping and the actions are
+// routed to the pull request services.
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// ErrUnsupportedAction is returned by a receiver for event actions it does not handle.
+var ErrUnsupportedAction = errors.New("unsupported action")
+
+type (
+	deliveryRepository interface {
+		WebhookDeliveryExists(ctx context.Context, delivery domain.WebhookDelivery) (bool, error)
+		RecordWebhookDelivery(ctx context.Context, delivery domain.WebhookDelivery) error
+	}
+	logger interface {
+		Info(msg string, fields ...zap.Field)
+		Error(msg string, fields ...zap.Field)
+		With(fields ...zap.Field) *zap.Logger
+	}
+
+	// Deliveries applies every delivery of a code host once.
+	Deliveries struct {
+		repo    deliveryRepository
+		ignored []error
+	}
+)
+
+// NewDeliveries returns Deliveries that acknowledge the events which do not apply to the service
+// state as ignored. Besides the common ones, such as an opened PR that already exists, a receiver
+// may pass the errors of its own that mean so. An unmapped author is not one of them: the delivery
+// fails, so it can be redelivered once the account is mapped.
+func NewDeliveries(repo deliveryRepository, ignored ...error) *Deliveries {
+	return &Deliveries{
+		repo: repo,
+		ignored: append([]error{ErrUnsupportedAction, domain.ErrPRExists, domain.ErrPRNotFound,
+			domain.ErrInvalidTransition, domain.ErrPRMerged}, ignored...),
+	}
+}
+
+// Apply runs apply for a delivery that was not processed yet and completes the result. A delivery
+// is recorded only once apply succeeded or the event turned out not to apply, so a failed delivery
+// is processed again when the code host retries it. Two attempts of one delivery running at once
+// may both get to apply, the second one then does not apply, for example as an existing PR.
+func (d *Deliveries) Apply(ctx context.Context, logger logger, delivery domain.WebhookDelivery,
+	result domain.WebhookResult, apply func(ctx context.Context) error,
+) (domain.WebhookResult, error) {
+	seen, err := d.repo.WebhookDeliveryExists(ctx, delivery)
+	if err != nil {
+		logger.Error("repo.WebhookDeliveryExists", zap.Error(err))
+		return domain.WebhookResult{}, fmt.Errorf("repo.WebhookDeliveryExists: %w", err)
+	}
+	if seen {
+		result.Duplicate = true
+		return result, nil
+	}
+
+	if err = apply(ctx); err != nil {
+		reason, ignored := d.ignoredReason(err)
+		if !ignored {
+			logger.Error("apply", zap.Error(err), zap.String("action", result.Action),
+				zap.String("pull_request_id", result.PullRequestID))
+			return domain.WebhookResult{}, err
+		}
+		result.Ignored, result.Reason = true, reason
+	} else {
+		logger.Info("webhook applied", zap.String("action", result.Action),
+			zap.String("pull_request_id", result.PullRequestID))
+	}
+
+	// The event is already applied, a redelivery after a failed record does not apply again.
+	if err = d.repo.RecordWebhookDelivery(ctx, delivery); err != nil {
+		logger.Error("repo.RecordWebhookDelivery", zap.Error(err))
+	}
+	return result, nil
+}
+
+// ignoredReason tells whether err means the event does not apply to the service state.
+func (d *Deliveries) ignoredReason(err error) (string, bool) {
+	for _, target := range d.ignored {
+		if errors.Is(err, target) {
+			return target.Error(), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,773 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package webhook
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/webhook.deliveryRepository -o delivery_repository_mock_test.go -n DeliveryRepositoryMock -p webhook
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// DeliveryRepositoryMock implements deliveryRepository
+type DeliveryRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcRecordWebhookDelivery          func(ctx context.Context, delivery domain.WebhookDelivery) (err error)
+	funcRecordWebhookDeliveryOrigin    string
+	inspectFuncRecordWebhookDelivery   func(ctx context.Context, delivery domain.WebhookDelivery)
+	afterRecordWebhookDeliveryCounter  uint64
+	beforeRecordWebhookDeliveryCounter uint64
+	RecordWebhookDeliveryMock          mDeliveryRepositoryMockRecordWebhookDelivery
+
+	funcWebhookDeliveryExists          func(ctx context.Context, delivery domain.WebhookDelivery) (b1 bool, err error)
+	funcWebhookDeliveryExistsOrigin    string
+	inspectFuncWebhookDeliveryExists   func(ctx context.Context, delivery domain.WebhookDelivery)
+	afterWebhookDeliveryExistsCounter  uint64
+	beforeWebhookDeliveryExistsCounter uint64
+	WebhookDeliveryExistsMock          mDeliveryRepositoryMockWebhookDeliveryExists
+}
+
+// NewDeliveryRepositoryMock returns a mock for deliveryRepository
+func NewDeliveryRepositoryMock(t minimock.Tester) *DeliveryRepositoryMock {
+	m := &DeliveryRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.RecordWebhookDeliveryMock = mDeliveryRepositoryMockRecordWebhookDelivery{mock: m}
+	m.RecordWebhookDeliveryMock.callArgs = []*DeliveryRepositoryMockRecordWebhookDeliveryParams{}
+
+	m.WebhookDeliveryExistsMock = mDeliveryRepositoryMockWebhookDeliveryExists{mock: m}
+	m.WebhookDeliveryExistsMock.callArgs = []*DeliveryRepositoryMockWebhookDeliveryExistsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mDeliveryRepositoryMockRecordWebhookDelivery struct {
+	optional           bool
+	mock               *DeliveryRepositoryMock
+	defaultExpectation *DeliveryRepositoryMockRecordWebhookDeliveryExpectation
+	expectations       []*DeliveryRepositoryMockRecordWebhookDeliveryExpectation
+
+	callArgs []*DeliveryRepositoryMockRecordWebhookDeliveryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// DeliveryRepositoryMockRecordWebhookDeliveryExpectation specifies expectation struct of the deliveryRepository.RecordWebhookDelivery
+type DeliveryRepositoryMockRecordWebhookDeliveryExpectation struct {
+	mock               *DeliveryRepositoryMock
+	params             *DeliveryRepositoryMockRecordWebhookDeliveryParams
+	paramPtrs          *DeliveryRepositoryMockRecordWebhookDeliveryParamPtrs
+	expectationOrigins DeliveryRepositoryMockRecordWebhookDeliveryExpectationOrigins
+	results            *DeliveryRepositoryMockRecordWebhookDeliveryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// DeliveryRepositoryMockRecordWebhookDeliveryParams contains parameters of the deliveryRepository.RecordWebhookDelivery
+type DeliveryRepositoryMockRecordWebhookDeliveryParams struct {
+	ctx      context.Context
+	delivery domain.WebhookDelivery
+}
+
+// DeliveryRepositoryMockRecordWebhookDeliveryParamPtrs contains pointers to parameters of the deliveryRepository.RecordWebhookDelivery
+type DeliveryRepositoryMockRecordWebhookDeliveryParamPtrs struct {
+	ctx      *context.Context
+	delivery *domain.WebhookDelivery
+}
+
+// DeliveryRepositoryMockRecordWebhookDeliveryResults contains results of the deliveryRepository.RecordWebhookDelivery
+type DeliveryRepositoryMockRecordWebhookDeliveryResults struct {
+	err error
+}
+
+// DeliveryRepositoryMockRecordWebhookDeliveryOrigins contains origins of expectations of the deliveryRepository.RecordWebhookDelivery
+type DeliveryRepositoryMockRecordWebhookDeliveryExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originDelivery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecordWebhookDelivery *mDeliveryRepositoryMockRecordWebhookDelivery) Optional() *mDeliveryRepositoryMockRecordWebhookDelivery {
+	mmRecordWebhookDelivery.optional = true
+	return mmRecordWebhookDelivery
+}
+
+// Expect sets up expected params for deliveryRepository.RecordWebhookDelivery
+func (mmRecordWebhookDelivery *mDeliveryRepositoryMockRecordWebhookDelivery) Expect(ctx context.Context, delivery domain.WebhookDelivery) *mDeliveryRepositoryMockRecordWebhookDelivery {
+	if mmRecordWebhookDelivery.mock.funcRecordWebhookDelivery != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("DeliveryRepositoryMock.RecordWebhookDelivery mock is already set by Set")
+	}
+
+	if mmRecordWebhookDelivery.defaultExpectation == nil {
+		mmRecordWebhookDelivery.defaultExpectation = &DeliveryRepositoryMockRecordWebhookDeliveryExpectation{}
+	}
+
+	if mmRecordWebhookDelivery.defaultExpectation.paramPtrs != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("DeliveryRepositoryMock.RecordWebhookDelivery mock is already set by ExpectParams functions")
+	}
+
+	mmRecordWebhookDelivery.defaultExpectation.params = &DeliveryRepositoryMockRecordWebhookDeliveryParams{ctx, delivery}
+	mmRecordWebhookDelivery.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRecordWebhookDelivery.expectations {
+		if minimock.Equal(e.params, mmRecordWebhookDelivery.defaultExpectation.params) {
+			mmRecordWebhookDelivery.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecordWebhookDelivery.defaultExpectation.params)
+		}
+	}
+
+	return mmRecordWebhookDelivery
+}
+
+// ExpectCtxParam1 sets up expected param ctx for deliveryRepository.RecordWebhookDelivery
+func (mmRecordWebhookDelivery *mDeliveryRepositoryMockRecordWebhookDelivery) ExpectCtxParam1(ctx context.Context) *mDeliveryRepositoryMockRecordWebhookDelivery {
+	if mmRecordWebhookDelivery.mock.funcRecordWebhookDelivery != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("DeliveryRepositoryMock.RecordWebhookDelivery mock is already set by Set")
+	}
+
+	if mmRecordWebhookDelivery.defaultExpectation == nil {
+		mmRecordWebhookDelivery.defaultExpectation = &DeliveryRepositoryMockRecordWebhookDeliveryExpectation{}
+	}
+
+	if mmRecordWebhookDelivery.defaultExpectation.params != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("DeliveryRepositoryMock.RecordWebhookDelivery mock is already set by Expect")
+	}
+
+	if mmRecordWebhookDelivery.defaultExpectation.paramPtrs == nil {
+		mmRecordWebhookDelivery.defaultExpectation.paramPtrs = &DeliveryRepositoryMockRecordWebhookDeliveryParamPtrs{}
+	}
+	mmRecordWebhookDelivery.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRecordWebhookDelivery.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRecordWebhookDelivery
+}
+
+// ExpectDeliveryParam2 sets up expected param delivery for deliveryRepository.RecordWebhookDelivery
+func (mmRecordWebhookDelivery *mDeliveryRepositoryMockRecordWebhookDelivery) ExpectDeliveryParam2(delivery domain.WebhookDelivery) *mDeliveryRepositoryMockRecordWebhookDelivery {
+	if mmRecordWebhookDelivery.mock.funcRecordWebhookDelivery != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("DeliveryRepositoryMock.RecordWebhookDelivery mock is already set by Set")
+	}
+
+	if mmRecordWebhookDelivery.defaultExpectation == nil {
+		mmRecordWebhookDelivery.defaultExpectation = &DeliveryRepositoryMockRecordWebhookDeliveryExpectation{}
+	}
+
+	if mmRecordWebhookDelivery.defaultExpectation.params != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("DeliveryRepositoryMock.RecordWebhookDelivery mock is already set by Expect")
+	}
+
+	if mmRecordWebhookDelivery.defaultExpectation.paramPtrs == nil {
+		mmRecordWebhookDelivery.defaultExpectation.paramPtrs = &DeliveryRepositoryMockRecordWebhookDeliveryParamPtrs{}
+	}
+	mmRecordWebhookDelivery.defaultExpectation.paramPtrs.delivery = &delivery
+	mmRecordWebhookDelivery.defaultExpectation.expectationOrigins.originDelivery = minimock.CallerInfo(1)
+
+	return mmRecordWebhookDelivery
+}
+
+// Inspect accepts an inspector function that has same arguments as the deliveryRepository.RecordWebhookDelivery
+func (mmRecordWebhookDelivery *mDeliveryRepositoryMockRecordWebhookDelivery) Inspect(f func(ctx context.Context, delivery domain.WebhookDelivery)) *mDeliveryRepositoryMockRecordWebhookDelivery {
+	if mmRecordWebhookDelivery.mock.inspectFuncRecordWebhookDelivery != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("Inspect function is already set for DeliveryRepositoryMock.RecordWebhookDelivery")
+	}
+
+	mmRecordWebhookDelivery.mock.inspectFuncRecordWebhookDelivery = f
+
+	return mmRecordWebhookDelivery
+}
+
+// Return sets up results that will be returned by deliveryRepository.RecordWebhookDelivery
+func (mmRecordWebhookDelivery *mDeliveryRepositoryMockRecordWebhookDelivery) Return(err error) *DeliveryRepositoryMock {
+	if mmRecordWebhookDelivery.mock.funcRecordWebhookDelivery != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("DeliveryRepositoryMock.RecordWebhookDelivery mock is already set by Set")
+	}
+
+	if mmRecordWebhookDelivery.defaultExpectation == nil {
+		mmRecordWebhookDelivery.defaultExpectation = &DeliveryRepositoryMockRecordWebhookDeliveryExpectation{mock: mmRecordWebhookDelivery.mock}
+	}
+	mmRecordWebhookDelivery.defaultExpectation.results = &DeliveryRepositoryMockRecordWebhookDeliveryResults{err}
+	mmRecordWebhookDelivery.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRecordWebhookDelivery.mock
+}
+
+// Set uses given function f to mock the deliveryRepository.RecordWebhookDelivery method
+func (mmRecordWebhookDelivery *mDeliveryRepositoryMockRecordWebhookDelivery) Set(f func(ctx context.Context, delivery domain.WebhookDelivery) (err error)) *DeliveryRepositoryMock {
+	if mmRecordWebhookDelivery.defaultExpectation != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("Default expectation is already set for the deliveryRepository.RecordWebhookDelivery method")
+	}
+
+	if len(mmRecordWebhookDelivery.expectations) > 0 {
+		mmRecordWebhookDelivery.mock.t.Fatalf("Some expectations are already set for the deliveryRepository.RecordWebhookDelivery method")
+	}
+
+	mmRecordWebhookDelivery.mock.funcRecordWebhookDelivery = f
+	mmRecordWebhookDelivery.mock.funcRecordWebhookDeliveryOrigin = minimock.CallerInfo(1)
+	return mmRecordWebhookDelivery.mock
+}
+
+// When sets expectation for the deliveryRepository.RecordWebhookDelivery which will trigger the result defined by the following
+// Then helper
+func (mmRecordWebhookDelivery *mDeliveryRepositoryMockRecordWebhookDelivery) When(ctx context.Context, delivery domain.WebhookDelivery) *DeliveryRepositoryMockRecordWebhookDeliveryExpectation {
+	if mmRecordWebhookDelivery.mock.funcRecordWebhookDelivery != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("DeliveryRepositoryMock.RecordWebhookDelivery mock is already set by Set")
+	}
+
+	expectation := &DeliveryRepositoryMockRecordWebhookDeliveryExpectation{
+		mock:               mmRecordWebhookDelivery.mock,
+		params:             &DeliveryRepositoryMockRecordWebhookDeliveryParams{ctx, delivery},
+		expectationOrigins: DeliveryRepositoryMockRecordWebhookDeliveryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRecordWebhookDelivery.expectations = append(mmRecordWebhookDelivery.expectations, expectation)
+	return expectation
+}
+
+// Then sets up deliveryRepository.RecordWebhookDelivery return parameters for the expectation previously defined by the When method
+func (e *DeliveryRepositoryMockRecordWebhookDeliveryExpectation) Then(err error) *DeliveryRepositoryMock {
+	e.results = &DeliveryRepositoryMockRecordWebhookDeliveryResults{err}
+	return e.mock
+}
+
+// Times sets number of times deliveryRepository.RecordWebhookDelivery should be invoked
+func (mmRecordWebhookDelivery *mDeliveryRepositoryMockRecordWebhookDelivery) Times(n uint64) *mDeliveryRepositoryMockRecordWebhookDelivery {
+	if n == 0 {
+		mmRecordWebhookDelivery.mock.t.Fatalf("Times of DeliveryRepositoryMock.RecordWebhookDelivery mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecordWebhookDelivery.expectedInvocations, n)
+	mmRecordWebhookDelivery.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRecordWebhookDelivery
+}
+
+func (mmRecordWebhookDelivery *mDeliveryRepositoryMockRecordWebhookDelivery) invocationsDone() bool {
+	if len(mmRecordWebhookDelivery.expectations) == 0 && mmRecordWebhookDelivery.defaultExpectation == nil && mmRecordWebhookDelivery.mock.funcRecordWebhookDelivery == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecordWebhookDelivery.mock.afterRecordWebhookDeliveryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecordWebhookDelivery.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RecordWebhookDelivery implements deliveryRepository
+func (mmRecordWebhookDelivery *DeliveryRepositoryMock) RecordWebhookDelivery(ctx context.Context, delivery domain.WebhookDelivery) (err error) {
+	mm_atomic.AddUint64(&mmRecordWebhookDelivery.beforeRecordWebhookDeliveryCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecordWebhookDelivery.afterRecordWebhookDeliveryCounter, 1)
+
+	mmRecordWebhookDelivery.t.Helper()
+
+	if mmRecordWebhookDelivery.inspectFuncRecordWebhookDelivery != nil {
+		mmRecordWebhookDelivery.inspectFuncRecordWebhookDelivery(ctx, delivery)
+	}
+
+	mm_params := DeliveryRepositoryMockRecordWebhookDeliveryParams{ctx, delivery}
+
+	// Record call args
+	mmRecordWebhookDelivery.RecordWebhookDeliveryMock.mutex.Lock()
+	mmRecordWebhookDelivery.RecordWebhookDeliveryMock.callArgs = append(mmRecordWebhookDelivery.RecordWebhookDeliveryMock.callArgs, &mm_params)
+	mmRecordWebhookDelivery.RecordWebhookDeliveryMock.mutex.Unlock()
+
+	for _, e := range mmRecordWebhookDelivery.RecordWebhookDeliveryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRecordWebhookDelivery.RecordWebhookDeliveryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecordWebhookDelivery.RecordWebhookDeliveryMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecordWebhookDelivery.RecordWebhookDeliveryMock.defaultExpectation.params
+		mm_want_ptrs := mmRecordWebhookDelivery.RecordWebhookDeliveryMock.defaultExpectation.paramPtrs
+
+		mm_got := DeliveryRepositoryMockRecordWebhookDeliveryParams{ctx, delivery}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecordWebhookDelivery.t.Errorf("DeliveryRepositoryMock.RecordWebhookDelivery got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordWebhookDelivery.RecordWebhookDeliveryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.delivery != nil && !minimock.Equal(*mm_want_ptrs.delivery, mm_got.delivery) {
+				mmRecordWebhookDelivery.t.Errorf("DeliveryRepositoryMock.RecordWebhookDelivery got unexpected parameter delivery, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordWebhookDelivery.RecordWebhookDeliveryMock.defaultExpectation.expectationOrigins.originDelivery, *mm_want_ptrs.delivery, mm_got.delivery, minimock.Diff(*mm_want_ptrs.delivery, mm_got.delivery))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecordWebhookDelivery.t.Errorf("DeliveryRepositoryMock.RecordWebhookDelivery got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRecordWebhookDelivery.RecordWebhookDeliveryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRecordWebhookDelivery.RecordWebhookDeliveryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecordWebhookDelivery.t.Fatal("No results are set for the DeliveryRepositoryMock.RecordWebhookDelivery")
+		}
+		return (*mm_results).err
+	}
+	if mmRecordWebhookDelivery.funcRecordWebhookDelivery != nil {
+		return mmRecordWebhookDelivery.funcRecordWebhookDelivery(ctx, delivery)
+	}
+	mmRecordWebhookDelivery.t.Fatalf("Unexpected call to DeliveryRepositoryMock.RecordWebhookDelivery. %v %v", ctx, delivery)
+	return
+}
+
+// RecordWebhookDeliveryAfterCounter returns a count of finished DeliveryRepositoryMock.RecordWebhookDelivery invocations
+func (mmRecordWebhookDelivery *DeliveryRepositoryMock) RecordWebhookDeliveryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordWebhookDelivery.afterRecordWebhookDeliveryCounter)
+}
+
+// RecordWebhookDeliveryBeforeCounter returns a count of DeliveryRepositoryMock.RecordWebhookDelivery invocations
+func (mmRecordWebhookDelivery *DeliveryRepositoryMock) RecordWebhookDeliveryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordWebhookDelivery.beforeRecordWebhookDeliveryCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeliveryRepositoryMock.RecordWebhookDelivery.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecordWebhookDelivery *mDeliveryRepositoryMockRecordWebhookDelivery) Calls() []*DeliveryRepositoryMockRecordWebhookDeliveryParams {
+	mmRecordWebhookDelivery.mutex.RLock()
+
+	argCopy := make([]*DeliveryRepositoryMockRecordWebhookDeliveryParams, len(mmRecordWebhookDelivery.callArgs))
+	copy(argCopy, mmRecordWebhookDelivery.callArgs)
+
+	mmRecordWebhookDelivery.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordWebhookDeliveryDone returns true if the count of the RecordWebhookDelivery invocations corresponds
+// the number of defined expectations
+func (m *DeliveryRepositoryMock) MinimockRecordWebhookDeliveryDone() bool {
+	if m.RecordWebhookDeliveryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordWebhookDeliveryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordWebhookDeliveryMock.invocationsDone()
+}
+
+// MinimockRecordWebhookDeliveryInspect logs each unmet expectation
+func (m *DeliveryRepositoryMock) MinimockRecordWebhookDeliveryInspect() {
+	for _, e := range m.RecordWebhookDeliveryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeliveryRepositoryMock.RecordWebhookDelivery at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRecordWebhookDeliveryCounter := mm_atomic.LoadUint64(&m.afterRecordWebhookDeliveryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordWebhookDeliveryMock.defaultExpectation != nil && afterRecordWebhookDeliveryCounter < 1 {
+		if m.RecordWebhookDeliveryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to DeliveryRepositoryMock.RecordWebhookDelivery at\n%s", m.RecordWebhookDeliveryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to DeliveryRepositoryMock.RecordWebhookDelivery at\n%s with params: %#v", m.RecordWebhookDeliveryMock.defaultExpectation.expectationOrigins.origin, *m.RecordWebhookDeliveryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecordWebhookDelivery != nil && afterRecordWebhookDeliveryCounter < 1 {
+		m.t.Errorf("Expected call to DeliveryRepositoryMock.RecordWebhookDelivery at\n%s", m.funcRecordWebhookDeliveryOrigin)
+	}
+
+	if !m.RecordWebhookDeliveryMock.invocationsDone() && afterRecordWebhookDeliveryCounter > 0 {
+		m.t.Errorf("Expected %d calls to DeliveryRepositoryMock.RecordWebhookDelivery at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordWebhookDeliveryMock.expectedInvocations), m.RecordWebhookDeliveryMock.expectedInvocationsOrigin, afterRecordWebhookDeliveryCounter)
+	}
+}
+
+type mDeliveryRepositoryMockWebhookDeliveryExists struct {
+	optional           bool
+	mock               *DeliveryRepositoryMock
+	defaultExpectation *DeliveryRepositoryMockWebhookDeliveryExistsExpectation
+	expectations       []*DeliveryRepositoryMockWebhookDeliveryExistsExpectation
+
+	callArgs []*DeliveryRepositoryMockWebhookDeliveryExistsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// DeliveryRepositoryMockWebhookDeliveryExistsExpectation specifies expectation struct of the deliveryRepository.WebhookDeliveryExists
+type DeliveryRepositoryMockWebhookDeliveryExistsExpectation struct {
+	mock               *DeliveryRepositoryMock
+	params             *DeliveryRepositoryMockWebhookDeliveryExistsParams
+	paramPtrs          *DeliveryRepositoryMockWebhookDeliveryExistsParamPtrs
+	expectationOrigins DeliveryRepositoryMockWebhookDeliveryExistsExpectationOrigins
+	results            *DeliveryRepositoryMockWebhookDeliveryExistsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// DeliveryRepositoryMockWebhookDeliveryExistsParams contains parameters of the deliveryRepository.WebhookDeliveryExists
+type DeliveryRepositoryMockWebhookDeliveryExistsParams struct {
+	ctx      context.Context
+	delivery domain.WebhookDelivery
+}
+
+// DeliveryRepositoryMockWebhookDeliveryExistsParamPtrs contains pointers to parameters of the deliveryRepository.WebhookDeliveryExists
+type DeliveryRepositoryMockWebhookDeliveryExistsParamPtrs struct {
+	ctx      *context.Context
+	delivery *domain.WebhookDelivery
+}
+
+// DeliveryRepositoryMockWebhookDeliveryExistsResults contains results of the deliveryRepository.WebhookDeliveryExists
+type DeliveryRepositoryMockWebhookDeliveryExistsResults struct {
+	b1  bool
+	err error
+}
+
+// DeliveryRepositoryMockWebhookDeliveryExistsOrigins contains origins of expectations of the deliveryRepository.WebhookDeliveryExists
+type DeliveryRepositoryMockWebhookDeliveryExistsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originDelivery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmWebhookDeliveryExists *mDeliveryRepositoryMockWebhookDeliveryExists) Optional() *mDeliveryRepositoryMockWebhookDeliveryExists {
+	mmWebhookDeliveryExists.optional = true
+	return mmWebhookDeliveryExists
+}
+
+// Expect sets up expected params for deliveryRepository.WebhookDeliveryExists
+func (mmWebhookDeliveryExists *mDeliveryRepositoryMockWebhookDeliveryExists) Expect(ctx context.Context, delivery domain.WebhookDelivery) *mDeliveryRepositoryMockWebhookDeliveryExists {
+	if mmWebhookDeliveryExists.mock.funcWebhookDeliveryExists != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("DeliveryRepositoryMock.WebhookDeliveryExists mock is already set by Set")
+	}
+
+	if mmWebhookDeliveryExists.defaultExpectation == nil {
+		mmWebhookDeliveryExists.defaultExpectation = &DeliveryRepositoryMockWebhookDeliveryExistsExpectation{}
+	}
+
+	if mmWebhookDeliveryExists.defaultExpectation.paramPtrs != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("DeliveryRepositoryMock.WebhookDeliveryExists mock is already set by ExpectParams functions")
+	}
+
+	mmWebhookDeliveryExists.defaultExpectation.params = &DeliveryRepositoryMockWebhookDeliveryExistsParams{ctx, delivery}
+	mmWebhookDeliveryExists.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmWebhookDeliveryExists.expectations {
+		if minimock.Equal(e.params, mmWebhookDeliveryExists.defaultExpectation.params) {
+			mmWebhookDeliveryExists.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWebhookDeliveryExists.defaultExpectation.params)
+		}
+	}
+
+	return mmWebhookDeliveryExists
+}
+
+// ExpectCtxParam1 sets up expected param ctx for deliveryRepository.WebhookDeliveryExists
+func (mmWebhookDeliveryExists *mDeliveryRepositoryMockWebhookDeliveryExists) ExpectCtxParam1(ctx context.Context) *mDeliveryRepositoryMockWebhookDeliveryExists {
+	if mmWebhookDeliveryExists.mock.funcWebhookDeliveryExists != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("DeliveryRepositoryMock.WebhookDeliveryExists mock is already set by Set")
+	}
+
+	if mmWebhookDeliveryExists.defaultExpectation == nil {
+		mmWebhookDeliveryExists.defaultExpectation = &DeliveryRepositoryMockWebhookDeliveryExistsExpectation{}
+	}
+
+	if mmWebhookDeliveryExists.defaultExpectation.params != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("DeliveryRepositoryMock.WebhookDeliveryExists mock is already set by Expect")
+	}
+
+	if mmWebhookDeliveryExists.defaultExpectation.paramPtrs == nil {
+		mmWebhookDeliveryExists.defaultExpectation.paramPtrs = &DeliveryRepositoryMockWebhookDeliveryExistsParamPtrs{}
+	}
+	mmWebhookDeliveryExists.defaultExpectation.paramPtrs.ctx = &ctx
+	mmWebhookDeliveryExists.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmWebhookDeliveryExists
+}
+
+// ExpectDeliveryParam2 sets up expected param delivery for deliveryRepository.WebhookDeliveryExists
+func (mmWebhookDeliveryExists *mDeliveryRepositoryMockWebhookDeliveryExists) ExpectDeliveryParam2(delivery domain.WebhookDelivery) *mDeliveryRepositoryMockWebhookDeliveryExists {
+	if mmWebhookDeliveryExists.mock.funcWebhookDeliveryExists != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("DeliveryRepositoryMock.WebhookDeliveryExists mock is already set by Set")
+	}
+
+	if mmWebhookDeliveryExists.defaultExpectation == nil {
+		mmWebhookDeliveryExists.defaultExpectation = &DeliveryRepositoryMockWebhookDeliveryExistsExpectation{}
+	}
+
+	if mmWebhookDeliveryExists.defaultExpectation.params != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("DeliveryRepositoryMock.WebhookDeliveryExists mock is already set by Expect")
+	}
+
+	if mmWebhookDeliveryExists.defaultExpectation.paramPtrs == nil {
+		mmWebhookDeliveryExists.defaultExpectation.paramPtrs = &DeliveryRepositoryMockWebhookDeliveryExistsParamPtrs{}
+	}
+	mmWebhookDeliveryExists.defaultExpectation.paramPtrs.delivery = &delivery
+	mmWebhookDeliveryExists.defaultExpectation.expectationOrigins.originDelivery = minimock.CallerInfo(1)
+
+	return mmWebhookDeliveryExists
+}
+
+// Inspect accepts an inspector function that has same arguments as the deliveryRepository.WebhookDeliveryExists
+func (mmWebhookDeliveryExists *mDeliveryRepositoryMockWebhookDeliveryExists) Inspect(f func(ctx context.Context, delivery domain.WebhookDelivery)) *mDeliveryRepositoryMockWebhookDeliveryExists {
+	if mmWebhookDeliveryExists.mock.inspectFuncWebhookDeliveryExists != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("Inspect function is already set for DeliveryRepositoryMock.WebhookDeliveryExists")
+	}
+
+	mmWebhookDeliveryExists.mock.inspectFuncWebhookDeliveryExists = f
+
+	return mmWebhookDeliveryExists
+}
+
+// Return sets up results that will be returned by deliveryRepository.WebhookDeliveryExists
+func (mmWebhookDeliveryExists *mDeliveryRepositoryMockWebhookDeliveryExists) Return(b1 bool, err error) *DeliveryRepositoryMock {
+	if mmWebhookDeliveryExists.mock.funcWebhookDeliveryExists != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("DeliveryRepositoryMock.WebhookDeliveryExists mock is already set by Set")
+	}
+
+	if mmWebhookDeliveryExists.defaultExpectation == nil {
+		mmWebhookDeliveryExists.defaultExpectation = &DeliveryRepositoryMockWebhookDeliveryExistsExpectation{mock: mmWebhookDeliveryExists.mock}
+	}
+	mmWebhookDeliveryExists.defaultExpectation.results = &DeliveryRepositoryMockWebhookDeliveryExistsResults{b1, err}
+	mmWebhookDeliveryExists.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmWebhookDeliveryExists.mock
+}
+
+// Set uses given function f to mock the deliveryRepository.WebhookDeliveryExists method
+func (mmWebhookDeliveryExists *mDeliveryRepositoryMockWebhookDeliveryExists) Set(f func(ctx context.Context, delivery domain.WebhookDelivery) (b1 bool, err error)) *DeliveryRepositoryMock {
+	if mmWebhookDeliveryExists.defaultExpectation != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("Default expectation is already set for the deliveryRepository.WebhookDeliveryExists method")
+	}
+
+	if len(mmWebhookDeliveryExists.expectations) > 0 {
+		mmWebhookDeliveryExists.mock.t.Fatalf("Some expectations are already set for the deliveryRepository.WebhookDeliveryExists method")
+	}
+
+	mmWebhookDeliveryExists.mock.funcWebhookDeliveryExists = f
+	mmWebhookDeliveryExists.mock.funcWebhookDeliveryExistsOrigin = minimock.CallerInfo(1)
+	return mmWebhookDeliveryExists.mock
+}
+
+// When sets expectation for the deliveryRepository.WebhookDeliveryExists which will trigger the result defined by the following
+// Then helper
+func (mmWebhookDeliveryExists *mDeliveryRepositoryMockWebhookDeliveryExists) When(ctx context.Context, delivery domain.WebhookDelivery) *DeliveryRepositoryMockWebhookDeliveryExistsExpectation {
+	if mmWebhookDeliveryExists.mock.funcWebhookDeliveryExists != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("DeliveryRepositoryMock.WebhookDeliveryExists mock is already set by Set")
+	}
+
+	expectation := &DeliveryRepositoryMockWebhookDeliveryExistsExpectation{
+		mock:               mmWebhookDeliveryExists.mock,
+		params:             &DeliveryRepositoryMockWebhookDeliveryExistsParams{ctx, delivery},
+		expectationOrigins: DeliveryRepositoryMockWebhookDeliveryExistsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmWebhookDeliveryExists.expectations = append(mmWebhookDeliveryExists.expectations, expectation)
+	return expectation
+}
+
+// Then sets up deliveryRepository.WebhookDeliveryExists return parameters for the expectation previously defined by the When method
+func (e *DeliveryRepositoryMockWebhookDeliveryExistsExpectation) Then(b1 bool, err error) *DeliveryRepositoryMock {
+	e.results = &DeliveryRepositoryMockWebhookDeliveryExistsResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times deliveryRepository.WebhookDeliveryExists should be invoked
+func (mmWebhookDeliveryExists *mDeliveryRepositoryMockWebhookDeliveryExists) Times(n uint64) *mDeliveryRepositoryMockWebhookDeliveryExists {
+	if n == 0 {
+		mmWebhookDeliveryExists.mock.t.Fatalf("Times of DeliveryRepositoryMock.WebhookDeliveryExists mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmWebhookDeliveryExists.expectedInvocations, n)
+	mmWebhookDeliveryExists.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmWebhookDeliveryExists
+}
+
+func (mmWebhookDeliveryExists *mDeliveryRepositoryMockWebhookDeliveryExists) invocationsDone() bool {
+	if len(mmWebhookDeliveryExists.expectations) == 0 && mmWebhookDeliveryExists.defaultExpectation == nil && mmWebhookDeliveryExists.mock.funcWebhookDeliveryExists == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmWebhookDeliveryExists.mock.afterWebhookDeliveryExistsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWebhookDeliveryExists.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// WebhookDeliveryExists implements deliveryRepository
+func (mmWebhookDeliveryExists *DeliveryRepositoryMock) WebhookDeliveryExists(ctx context.Context, delivery domain.WebhookDelivery) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmWebhookDeliveryExists.beforeWebhookDeliveryExistsCounter, 1)
+	defer mm_atomic.AddUint64(&mmWebhookDeliveryExists.afterWebhookDeliveryExistsCounter, 1)
+
+	mmWebhookDeliveryExists.t.Helper()
+
+	if mmWebhookDeliveryExists.inspectFuncWebhookDeliveryExists != nil {
+		mmWebhookDeliveryExists.inspectFuncWebhookDeliveryExists(ctx, delivery)
+	}
+
+	mm_params := DeliveryRepositoryMockWebhookDeliveryExistsParams{ctx, delivery}
+
+	// Record call args
+	mmWebhookDeliveryExists.WebhookDeliveryExistsMock.mutex.Lock()
+	mmWebhookDeliveryExists.WebhookDeliveryExistsMock.callArgs = append(mmWebhookDeliveryExists.WebhookDeliveryExistsMock.callArgs, &mm_params)
+	mmWebhookDeliveryExists.WebhookDeliveryExistsMock.mutex.Unlock()
+
+	for _, e := range mmWebhookDeliveryExists.WebhookDeliveryExistsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmWebhookDeliveryExists.WebhookDeliveryExistsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWebhookDeliveryExists.WebhookDeliveryExistsMock.defaultExpectation.Counter, 1)
+		mm_want := mmWebhookDeliveryExists.WebhookDeliveryExistsMock.defaultExpectation.params
+		mm_want_ptrs := mmWebhookDeliveryExists.WebhookDeliveryExistsMock.defaultExpectation.paramPtrs
+
+		mm_got := DeliveryRepositoryMockWebhookDeliveryExistsParams{ctx, delivery}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmWebhookDeliveryExists.t.Errorf("DeliveryRepositoryMock.WebhookDeliveryExists got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWebhookDeliveryExists.WebhookDeliveryExistsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.delivery != nil && !minimock.Equal(*mm_want_ptrs.delivery, mm_got.delivery) {
+				mmWebhookDeliveryExists.t.Errorf("DeliveryRepositoryMock.WebhookDeliveryExists got unexpected parameter delivery, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWebhookDeliveryExists.WebhookDeliveryExistsMock.defaultExpectation.expectationOrigins.originDelivery, *mm_want_ptrs.delivery, mm_got.delivery, minimock.Diff(*mm_want_ptrs.delivery, mm_got.delivery))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWebhookDeliveryExists.t.Errorf("DeliveryRepositoryMock.WebhookDeliveryExists got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmWebhookDeliveryExists.WebhookDeliveryExistsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmWebhookDeliveryExists.WebhookDeliveryExistsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmWebhookDeliveryExists.t.Fatal("No results are set for the DeliveryRepositoryMock.WebhookDeliveryExists")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmWebhookDeliveryExists.funcWebhookDeliveryExists != nil {
+		return mmWebhookDeliveryExists.funcWebhookDeliveryExists(ctx, delivery)
+	}
+	mmWebhookDeliveryExists.t.Fatalf("Unexpected call to DeliveryRepositoryMock.WebhookDeliveryExists. %v %v", ctx, delivery)
+	return
+}
+
+// WebhookDeliveryExistsAfterCounter returns a count of finished DeliveryRepositoryMock.WebhookDeliveryExists invocations
+func (mmWebhookDeliveryExists *DeliveryRepositoryMock) WebhookDeliveryExistsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWebhookDeliveryExists.afterWebhookDeliveryExistsCounter)
+}
+
+// WebhookDeliveryExistsBeforeCounter returns a count of DeliveryRepositoryMock.WebhookDeliveryExists invocations
+func (mmWebhookDeliveryExists *DeliveryRepositoryMock) WebhookDeliveryExistsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWebhookDeliveryExists.beforeWebhookDeliveryExistsCounter)
+}
+
+// Calls returns a list of arguments used in each call to DeliveryRepositoryMock.WebhookDeliveryExists.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWebhookDeliveryExists *mDeliveryRepositoryMockWebhookDeliveryExists) Calls() []*DeliveryRepositoryMockWebhookDeliveryExistsParams {
+	mmWebhookDeliveryExists.mutex.RLock()
+
+	argCopy := make([]*DeliveryRepositoryMockWebhookDeliveryExistsParams, len(mmWebhookDeliveryExists.callArgs))
+	copy(argCopy, mmWebhookDeliveryExists.callArgs)
+
+	mmWebhookDeliveryExists.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWebhookDeliveryExistsDone returns true if the count of the WebhookDeliveryExists invocations corresponds
+// the number of defined expectations
+func (m *DeliveryRepositoryMock) MinimockWebhookDeliveryExistsDone() bool {
+	if m.WebhookDeliveryExistsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.WebhookDeliveryExistsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.WebhookDeliveryExistsMock.invocationsDone()
+}
+
+// MinimockWebhookDeliveryExistsInspect logs each unmet expectation
+func (m *DeliveryRepositoryMock) MinimockWebhookDeliveryExistsInspect() {
+	for _, e := range m.WebhookDeliveryExistsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DeliveryRepositoryMock.WebhookDeliveryExists at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterWebhookDeliveryExistsCounter := mm_atomic.LoadUint64(&m.afterWebhookDeliveryExistsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WebhookDeliveryExistsMock.defaultExpectation != nil && afterWebhookDeliveryExistsCounter < 1 {
+		if m.WebhookDeliveryExistsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to DeliveryRepositoryMock.WebhookDeliveryExists at\n%s", m.WebhookDeliveryExistsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to DeliveryRepositoryMock.WebhookDeliveryExists at\n%s with params: %#v", m.WebhookDeliveryExistsMock.defaultExpectation.expectationOrigins.origin, *m.WebhookDeliveryExistsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWebhookDeliveryExists != nil && afterWebhookDeliveryExistsCounter < 1 {
+		m.t.Errorf("Expected call to DeliveryRepositoryMock.WebhookDeliveryExists at\n%s", m.funcWebhookDeliveryExistsOrigin)
+	}
+
+	if !m.WebhookDeliveryExistsMock.invocationsDone() && afterWebhookDeliveryExistsCounter > 0 {
+		m.t.Errorf("Expected %d calls to DeliveryRepositoryMock.WebhookDeliveryExists at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.WebhookDeliveryExistsMock.expectedInvocations), m.WebhookDeliveryExistsMock.expectedInvocationsOrigin, afterWebhookDeliveryExistsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *DeliveryRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockRecordWebhookDeliveryInspect()
+
+			m.MinimockWebhookDeliveryExistsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *DeliveryRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *DeliveryRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockRecordWebhookDeliveryDone() &&
+		m.MinimockWebhookDeliveryExistsDone()
+}
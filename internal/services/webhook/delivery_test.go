@@ -0,0 +1,162 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func TestDeliveries_Apply(t *testing.T) {
+	t.Parallel()
+
+	delivery := domain.WebhookDelivery{
+		Provider:   domain.WebhookProviderGitHub,
+		DeliveryID: "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+		Event:      "pull_request",
+	}
+	result := domain.WebhookResult{
+		DeliveryID:    delivery.DeliveryID,
+		Event:         delivery.Event,
+		Action:        "opened",
+		PullRequestID: "acme/api#42",
+	}
+	errCustom := errors.New("update does not toggle draft")
+
+	tests := []struct {
+		name      string
+		repo      func(mc *minimock.Controller) deliveryRepository
+		applyErr  error
+		wantApply bool
+		want      domain.WebhookResult
+		wantErr   error
+	}{
+		{
+			name: "success: new delivery is applied and recorded",
+			repo: func(mc *minimock.Controller) deliveryRepository {
+				repo := NewDeliveryRepositoryMock(mc)
+				repo.WebhookDeliveryExistsMock.Expect(minimock.AnyContext, delivery).Return(false, nil)
+				repo.RecordWebhookDeliveryMock.Expect(minimock.AnyContext, delivery).Return(nil)
+				return repo
+			},
+			wantApply: true,
+			want:      result,
+		},
+		{
+			name: "success: processed delivery is a duplicate",
+			repo: func(mc *minimock.Controller) deliveryRepository {
+				repo := NewDeliveryRepositoryMock(mc)
+				repo.WebhookDeliveryExistsMock.Expect(minimock.AnyContext, delivery).Return(true, nil)
+				return repo
+			},
+			want: domain.WebhookResult{
+				DeliveryID: result.DeliveryID, Event: result.Event, Action: result.Action,
+				PullRequestID: result.PullRequestID, Duplicate: true,
+			},
+		},
+		{
+			name: "success: event that does not apply is ignored and recorded",
+			repo: func(mc *minimock.Controller) deliveryRepository {
+				repo := NewDeliveryRepositoryMock(mc)
+				repo.WebhookDeliveryExistsMock.Return(false, nil)
+				repo.RecordWebhookDeliveryMock.Expect(minimock.AnyContext, delivery).Return(nil)
+				return repo
+			},
+			applyErr:  domain.ErrPRExists,
+			wantApply: true,
+			want: domain.WebhookResult{
+				DeliveryID: result.DeliveryID, Event: result.Event, Action: result.Action,
+				PullRequestID: result.PullRequestID, Ignored: true, Reason: domain.ErrPRExists.Error(),
+			},
+		},
+		{
+			name: "success: receiver errors are ignored",
+			repo: func(mc *minimock.Controller) deliveryRepository {
+				repo := NewDeliveryRepositoryMock(mc)
+				repo.WebhookDeliveryExistsMock.Return(false, nil)
+				repo.RecordWebhookDeliveryMock.Return(nil)
+				return repo
+			},
+			applyErr:  errCustom,
+			wantApply: true,
+			want: domain.WebhookResult{
+				DeliveryID: result.DeliveryID, Event: result.Event, Action: result.Action,
+				PullRequestID: result.PullRequestID, Ignored: true, Reason: errCustom.Error(),
+			},
+		},
+		{
+			name: "success: failed record does not fail the applied delivery",
+			repo: func(mc *minimock.Controller) deliveryRepository {
+				repo := NewDeliveryRepositoryMock(mc)
+				repo.WebhookDeliveryExistsMock.Return(false, nil)
+				repo.RecordWebhookDeliveryMock.Return(errors.New("database connection failed"))
+				return repo
+			},
+			wantApply: true,
+			want:      result,
+		},
+		{
+			name: "error: failed delivery is not recorded, so a retry applies it",
+			repo: func(mc *minimock.Controller) deliveryRepository {
+				repo := NewDeliveryRepositoryMock(mc)
+				repo.WebhookDeliveryExistsMock.Return(false, nil)
+				return repo
+			},
+			applyErr:  domain.ErrNotEnoughReviewers,
+			wantApply: true,
+			wantErr:   domain.ErrNotEnoughReviewers,
+		},
+		{
+			name: "error: unmapped author is not recorded, so a redelivery after the mapping applies it",
+			repo: func(mc *minimock.Controller) deliveryRepository {
+				repo := NewDeliveryRepositoryMock(mc)
+				repo.WebhookDeliveryExistsMock.Return(false, nil)
+				return repo
+			},
+			applyErr:  domain.ErrAuthorNotFound,
+			wantApply: true,
+			wantErr:   domain.ErrAuthorNotFound,
+		},
+		{
+			name: "error: deliveries cannot be read",
+			repo: func(mc *minimock.Controller) deliveryRepository {
+				repo := NewDeliveryRepositoryMock(mc)
+				repo.WebhookDeliveryExistsMock.Return(false, errors.New("database connection failed"))
+				return repo
+			},
+			wantErr: errors.New("database connection failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			applied := false
+
+			got, err := NewDeliveries(tt.repo(mc), errCustom).Apply(context.Background(), zap.NewNop(), delivery,
+				result, func(context.Context) error {
+					applied = true
+					return tt.applyErr
+				})
+
+			assert.Equal(t, tt.wantApply, applied)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr.Error())
+				assert.Equal(t, domain.WebhookResult{}, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
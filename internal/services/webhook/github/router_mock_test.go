@@ -0,0 +1,481 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package github
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/webhook/github.router -o router_mock_test.go -n RouterMock -p github
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/AndrejDubinin/review-assigner/internal/services/webhook"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RouterMock implements router
+type RouterMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcProcess          func(ctx context.Context, delivery domain.WebhookDelivery, result domain.WebhookResult, event webhook.Event) (w1 domain.WebhookResult, err error)
+	funcProcessOrigin    string
+	inspectFuncProcess   func(ctx context.Context, delivery domain.WebhookDelivery, result domain.WebhookResult, event webhook.Event)
+	afterProcessCounter  uint64
+	beforeProcessCounter uint64
+	ProcessMock          mRouterMockProcess
+}
+
+// NewRouterMock returns a mock for router
+func NewRouterMock(t minimock.Tester) *RouterMock {
+	m := &RouterMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ProcessMock = mRouterMockProcess{mock: m}
+	m.ProcessMock.callArgs = []*RouterMockProcessParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRouterMockProcess struct {
+	optional           bool
+	mock               *RouterMock
+	defaultExpectation *RouterMockProcessExpectation
+	expectations       []*RouterMockProcessExpectation
+
+	callArgs []*RouterMockProcessParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RouterMockProcessExpectation specifies expectation struct of the router.Process
+type RouterMockProcessExpectation struct {
+	mock               *RouterMock
+	params             *RouterMockProcessParams
+	paramPtrs          *RouterMockProcessParamPtrs
+	expectationOrigins RouterMockProcessExpectationOrigins
+	results            *RouterMockProcessResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RouterMockProcessParams contains parameters of the router.Process
+type RouterMockProcessParams struct {
+	ctx      context.Context
+	delivery domain.WebhookDelivery
+	result   domain.WebhookResult
+	event    webhook.Event
+}
+
+// RouterMockProcessParamPtrs contains pointers to parameters of the router.Process
+type RouterMockProcessParamPtrs struct {
+	ctx      *context.Context
+	delivery *domain.WebhookDelivery
+	result   *domain.WebhookResult
+	event    *webhook.Event
+}
+
+// RouterMockProcessResults contains results of the router.Process
+type RouterMockProcessResults struct {
+	w1  domain.WebhookResult
+	err error
+}
+
+// RouterMockProcessOrigins contains origins of expectations of the router.Process
+type RouterMockProcessExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originDelivery string
+	originResult   string
+	originEvent    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmProcess *mRouterMockProcess) Optional() *mRouterMockProcess {
+	mmProcess.optional = true
+	return mmProcess
+}
+
+// Expect sets up expected params for router.Process
+func (mmProcess *mRouterMockProcess) Expect(ctx context.Context, delivery domain.WebhookDelivery, result domain.WebhookResult, event webhook.Event) *mRouterMockProcess {
+	if mmProcess.mock.funcProcess != nil {
+		mmProcess.mock.t.Fatalf("RouterMock.Process mock is already set by Set")
+	}
+
+	if mmProcess.defaultExpectation == nil {
+		mmProcess.defaultExpectation = &RouterMockProcessExpectation{}
+	}
+
+	if mmProcess.defaultExpectation.paramPtrs != nil {
+		mmProcess.mock.t.Fatalf("RouterMock.Process mock is already set by ExpectParams functions")
+	}
+
+	mmProcess.defaultExpectation.params = &RouterMockProcessParams{ctx, delivery, result, event}
+	mmProcess.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmProcess.expectations {
+		if minimock.Equal(e.params, mmProcess.defaultExpectation.params) {
+			mmProcess.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmProcess.defaultExpectation.params)
+		}
+	}
+
+	return mmProcess
+}
+
+// ExpectCtxParam1 sets up expected param ctx for router.Process
+func (mmProcess *mRouterMockProcess) ExpectCtxParam1(ctx context.Context) *mRouterMockProcess {
+	if mmProcess.mock.funcProcess != nil {
+		mmProcess.mock.t.Fatalf("RouterMock.Process mock is already set by Set")
+	}
+
+	if mmProcess.defaultExpectation == nil {
+		mmProcess.defaultExpectation = &RouterMockProcessExpectation{}
+	}
+
+	if mmProcess.defaultExpectation.params != nil {
+		mmProcess.mock.t.Fatalf("RouterMock.Process mock is already set by Expect")
+	}
+
+	if mmProcess.defaultExpectation.paramPtrs == nil {
+		mmProcess.defaultExpectation.paramPtrs = &RouterMockProcessParamPtrs{}
+	}
+	mmProcess.defaultExpectation.paramPtrs.ctx = &ctx
+	mmProcess.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmProcess
+}
+
+// ExpectDeliveryParam2 sets up expected param delivery for router.Process
+func (mmProcess *mRouterMockProcess) ExpectDeliveryParam2(delivery domain.WebhookDelivery) *mRouterMockProcess {
+	if mmProcess.mock.funcProcess != nil {
+		mmProcess.mock.t.Fatalf("RouterMock.Process mock is already set by Set")
+	}
+
+	if mmProcess.defaultExpectation == nil {
+		mmProcess.defaultExpectation = &RouterMockProcessExpectation{}
+	}
+
+	if mmProcess.defaultExpectation.params != nil {
+		mmProcess.mock.t.Fatalf("RouterMock.Process mock is already set by Expect")
+	}
+
+	if mmProcess.defaultExpectation.paramPtrs == nil {
+		mmProcess.defaultExpectation.paramPtrs = &RouterMockProcessParamPtrs{}
+	}
+	mmProcess.defaultExpectation.paramPtrs.delivery = &delivery
+	mmProcess.defaultExpectation.expectationOrigins.originDelivery = minimock.CallerInfo(1)
+
+	return mmProcess
+}
+
+// ExpectResultParam3 sets up expected param result for router.Process
+func (mmProcess *mRouterMockProcess) ExpectResultParam3(result domain.WebhookResult) *mRouterMockProcess {
+	if mmProcess.mock.funcProcess != nil {
+		mmProcess.mock.t.Fatalf("RouterMock.Process mock is already set by Set")
+	}
+
+	if mmProcess.defaultExpectation == nil {
+		mmProcess.defaultExpectation = &RouterMockProcessExpectation{}
+	}
+
+	if mmProcess.defaultExpectation.params != nil {
+		mmProcess.mock.t.Fatalf("RouterMock.Process mock is already set by Expect")
+	}
+
+	if mmProcess.defaultExpectation.paramPtrs == nil {
+		mmProcess.defaultExpectation.paramPtrs = &RouterMockProcessParamPtrs{}
+	}
+	mmProcess.defaultExpectation.paramPtrs.result = &result
+	mmProcess.defaultExpectation.expectationOrigins.originResult = minimock.CallerInfo(1)
+
+	return mmProcess
+}
+
+// ExpectEventParam4 sets up expected param event for router.Process
+func (mmProcess *mRouterMockProcess) ExpectEventParam4(event webhook.Event) *mRouterMockProcess {
+	if mmProcess.mock.funcProcess != nil {
+		mmProcess.mock.t.Fatalf("RouterMock.Process mock is already set by Set")
+	}
+
+	if mmProcess.defaultExpectation == nil {
+		mmProcess.defaultExpectation = &RouterMockProcessExpectation{}
+	}
+
+	if mmProcess.defaultExpectation.params != nil {
+		mmProcess.mock.t.Fatalf("RouterMock.Process mock is already set by Expect")
+	}
+
+	if mmProcess.defaultExpectation.paramPtrs == nil {
+		mmProcess.defaultExpectation.paramPtrs = &RouterMockProcessParamPtrs{}
+	}
+	mmProcess.defaultExpectation.paramPtrs.event = &event
+	mmProcess.defaultExpectation.expectationOrigins.originEvent = minimock.CallerInfo(1)
+
+	return mmProcess
+}
+
+// Inspect accepts an inspector function that has same arguments as the router.Process
+func (mmProcess *mRouterMockProcess) Inspect(f func(ctx context.Context, delivery domain.WebhookDelivery, result domain.WebhookResult, event webhook.Event)) *mRouterMockProcess {
+	if mmProcess.mock.inspectFuncProcess != nil {
+		mmProcess.mock.t.Fatalf("Inspect function is already set for RouterMock.Process")
+	}
+
+	mmProcess.mock.inspectFuncProcess = f
+
+	return mmProcess
+}
+
+// Return sets up results that will be returned by router.Process
+func (mmProcess *mRouterMockProcess) Return(w1 domain.WebhookResult, err error) *RouterMock {
+	if mmProcess.mock.funcProcess != nil {
+		mmProcess.mock.t.Fatalf("RouterMock.Process mock is already set by Set")
+	}
+
+	if mmProcess.defaultExpectation == nil {
+		mmProcess.defaultExpectation = &RouterMockProcessExpectation{mock: mmProcess.mock}
+	}
+	mmProcess.defaultExpectation.results = &RouterMockProcessResults{w1, err}
+	mmProcess.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmProcess.mock
+}
+
+// Set uses given function f to mock the router.Process method
+func (mmProcess *mRouterMockProcess) Set(f func(ctx context.Context, delivery domain.WebhookDelivery, result domain.WebhookResult, event webhook.Event) (w1 domain.WebhookResult, err error)) *RouterMock {
+	if mmProcess.defaultExpectation != nil {
+		mmProcess.mock.t.Fatalf("Default expectation is already set for the router.Process method")
+	}
+
+	if len(mmProcess.expectations) > 0 {
+		mmProcess.mock.t.Fatalf("Some expectations are already set for the router.Process method")
+	}
+
+	mmProcess.mock.funcProcess = f
+	mmProcess.mock.funcProcessOrigin = minimock.CallerInfo(1)
+	return mmProcess.mock
+}
+
+// When sets expectation for the router.Process which will trigger the result defined by the following
+// Then helper
+func (mmProcess *mRouterMockProcess) When(ctx context.Context, delivery domain.WebhookDelivery, result domain.WebhookResult, event webhook.Event) *RouterMockProcessExpectation {
+	if mmProcess.mock.funcProcess != nil {
+		mmProcess.mock.t.Fatalf("RouterMock.Process mock is already set by Set")
+	}
+
+	expectation := &RouterMockProcessExpectation{
+		mock:               mmProcess.mock,
+		params:             &RouterMockProcessParams{ctx, delivery, result, event},
+		expectationOrigins: RouterMockProcessExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmProcess.expectations = append(mmProcess.expectations, expectation)
+	return expectation
+}
+
+// Then sets up router.Process return parameters for the expectation previously defined by the When method
+func (e *RouterMockProcessExpectation) Then(w1 domain.WebhookResult, err error) *RouterMock {
+	e.results = &RouterMockProcessResults{w1, err}
+	return e.mock
+}
+
+// Times sets number of times router.Process should be invoked
+func (mmProcess *mRouterMockProcess) Times(n uint64) *mRouterMockProcess {
+	if n == 0 {
+		mmProcess.mock.t.Fatalf("Times of RouterMock.Process mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmProcess.expectedInvocations, n)
+	mmProcess.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmProcess
+}
+
+func (mmProcess *mRouterMockProcess) invocationsDone() bool {
+	if len(mmProcess.expectations) == 0 && mmProcess.defaultExpectation == nil && mmProcess.mock.funcProcess == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmProcess.mock.afterProcessCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmProcess.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Process implements router
+func (mmProcess *RouterMock) Process(ctx context.Context, delivery domain.WebhookDelivery, result domain.WebhookResult, event webhook.Event) (w1 domain.WebhookResult, err error) {
+	mm_atomic.AddUint64(&mmProcess.beforeProcessCounter, 1)
+	defer mm_atomic.AddUint64(&mmProcess.afterProcessCounter, 1)
+
+	mmProcess.t.Helper()
+
+	if mmProcess.inspectFuncProcess != nil {
+		mmProcess.inspectFuncProcess(ctx, delivery, result, event)
+	}
+
+	mm_params := RouterMockProcessParams{ctx, delivery, result, event}
+
+	// Record call args
+	mmProcess.ProcessMock.mutex.Lock()
+	mmProcess.ProcessMock.callArgs = append(mmProcess.ProcessMock.callArgs, &mm_params)
+	mmProcess.ProcessMock.mutex.Unlock()
+
+	for _, e := range mmProcess.ProcessMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.w1, e.results.err
+		}
+	}
+
+	if mmProcess.ProcessMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmProcess.ProcessMock.defaultExpectation.Counter, 1)
+		mm_want := mmProcess.ProcessMock.defaultExpectation.params
+		mm_want_ptrs := mmProcess.ProcessMock.defaultExpectation.paramPtrs
+
+		mm_got := RouterMockProcessParams{ctx, delivery, result, event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmProcess.t.Errorf("RouterMock.Process got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmProcess.ProcessMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.delivery != nil && !minimock.Equal(*mm_want_ptrs.delivery, mm_got.delivery) {
+				mmProcess.t.Errorf("RouterMock.Process got unexpected parameter delivery, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmProcess.ProcessMock.defaultExpectation.expectationOrigins.originDelivery, *mm_want_ptrs.delivery, mm_got.delivery, minimock.Diff(*mm_want_ptrs.delivery, mm_got.delivery))
+			}
+
+			if mm_want_ptrs.result != nil && !minimock.Equal(*mm_want_ptrs.result, mm_got.result) {
+				mmProcess.t.Errorf("RouterMock.Process got unexpected parameter result, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmProcess.ProcessMock.defaultExpectation.expectationOrigins.originResult, *mm_want_ptrs.result, mm_got.result, minimock.Diff(*mm_want_ptrs.result, mm_got.result))
+			}
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmProcess.t.Errorf("RouterMock.Process got unexpected parameter event, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmProcess.ProcessMock.defaultExpectation.expectationOrigins.originEvent, *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmProcess.t.Errorf("RouterMock.Process got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmProcess.ProcessMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmProcess.ProcessMock.defaultExpectation.results
+		if mm_results == nil {
+			mmProcess.t.Fatal("No results are set for the RouterMock.Process")
+		}
+		return (*mm_results).w1, (*mm_results).err
+	}
+	if mmProcess.funcProcess != nil {
+		return mmProcess.funcProcess(ctx, delivery, result, event)
+	}
+	mmProcess.t.Fatalf("Unexpected call to RouterMock.Process. %v %v %v %v", ctx, delivery, result, event)
+	return
+}
+
+// ProcessAfterCounter returns a count of finished RouterMock.Process invocations
+func (mmProcess *RouterMock) ProcessAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmProcess.afterProcessCounter)
+}
+
+// ProcessBeforeCounter returns a count of RouterMock.Process invocations
+func (mmProcess *RouterMock) ProcessBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmProcess.beforeProcessCounter)
+}
+
+// Calls returns a list of arguments used in each call to RouterMock.Process.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmProcess *mRouterMockProcess) Calls() []*RouterMockProcessParams {
+	mmProcess.mutex.RLock()
+
+	argCopy := make([]*RouterMockProcessParams, len(mmProcess.callArgs))
+	copy(argCopy, mmProcess.callArgs)
+
+	mmProcess.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockProcessDone returns true if the count of the Process invocations corresponds
+// the number of defined expectations
+func (m *RouterMock) MinimockProcessDone() bool {
+	if m.ProcessMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ProcessMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ProcessMock.invocationsDone()
+}
+
+// MinimockProcessInspect logs each unmet expectation
+func (m *RouterMock) MinimockProcessInspect() {
+	for _, e := range m.ProcessMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RouterMock.Process at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterProcessCounter := mm_atomic.LoadUint64(&m.afterProcessCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ProcessMock.defaultExpectation != nil && afterProcessCounter < 1 {
+		if m.ProcessMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RouterMock.Process at\n%s", m.ProcessMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RouterMock.Process at\n%s with params: %#v", m.ProcessMock.defaultExpectation.expectationOrigins.origin, *m.ProcessMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcProcess != nil && afterProcessCounter < 1 {
+		m.t.Errorf("Expected call to RouterMock.Process at\n%s", m.funcProcessOrigin)
+	}
+
+	if !m.ProcessMock.invocationsDone() && afterProcessCounter > 0 {
+		m.t.Errorf("Expected %d calls to RouterMock.Process at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ProcessMock.expectedInvocations), m.ProcessMock.expectedInvocationsOrigin, afterProcessCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RouterMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockProcessInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RouterMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RouterMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockProcessDone()
+}
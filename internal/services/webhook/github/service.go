@@ -0,0 +1,168 @@
+// Package github receives the GitHub pull_request webhook events: it verifies their signature and
+// decodes them for webhook.Router, so PRs opened on GitHub get reviewers the same way as PRs
+// created through the API.
+package github
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/AndrejDubinin/review-assigner/internal/services/webhook"
+)
+
+const (
+	eventPing        = "ping"
+	eventPullRequest = "pull_request"
+
+	signaturePrefix = "sha256="
+)
+
+// actions maps the pull_request actions to the webhook actions. A closed event is a merge when the
+// PR is merged, see decode.
+var actions = map[string]webhook.Action{
+	"opened":             webhook.ActionOpen,
+	"ready_for_review":   webhook.ActionReady,
+	"converted_to_draft": webhook.ActionDraft,
+	"closed":             webhook.ActionClose,
+	"reopened":           webhook.ActionReopen,
+}
+
+type (
+	router interface {
+		Process(ctx context.Context, delivery domain.WebhookDelivery, result domain.WebhookResult,
+			event webhook.Event) (domain.WebhookResult, error)
+	}
+
+	Handler struct {
+		router router
+		secret []byte
+	}
+
+	// pullRequestEvent holds the fields of the pull_request payload the service uses.
+	pullRequestEvent struct {
+		Action      string      `json:"action"`
+		Number      int         `json:"number"`
+		PullRequest pullRequest `json:"pull_request"`
+		Repository  struct {
+			FullName string `json:"full_name"`
+		} `json:"repository"`
+		Sender account `json:"sender"`
+	}
+	pullRequest struct {
+		Title   string  `json:"title"`
+		HTMLURL string  `json:"html_url"`
+		Draft   bool    `json:"draft"`
+		Merged  bool    `json:"merged"`
+		User    account `json:"user"`
+		Labels  []struct {
+			Name string `json:"name"`
+		} `json:"labels"`
+		Head     branch   `json:"head"`
+		Base     branch   `json:"base"`
+		MergedBy *account `json:"merged_by"`
+	}
+	branch struct {
+		Ref string `json:"ref"`
+		SHA string `json:"sha"`
+	}
+	account struct {
+		ID int64 `json:"id"`
+	}
+)
+
+func New(router router, secret string) *Handler {
+	return &Handler{
+		router: router,
+		secret: []byte(secret),
+	}
+}
+
+// VerifySignature checks the X-Hub-Signature-256 header, the hex HMAC-SHA256 of the body keyed
+// with the webhook secret.
+func (h *Handler) VerifySignature(body []byte, signature string) error {
+	if len(h.secret) == 0 || !strings.HasPrefix(signature, signaturePrefix) {
+		return domain.ErrInvalidWebhookSignature
+	}
+
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, signaturePrefix))
+	if err != nil {
+		return domain.ErrInvalidWebhookSignature
+	}
+
+	mac := hmac.New(sha256.New, h.secret)
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return domain.ErrInvalidWebhookSignature
+	}
+	return nil
+}
+
+// HandleDelivery applies a verified delivery, see webhook.Router. Events other than pull_request
+// are acknowledged as ignored.
+func (h *Handler) HandleDelivery(ctx context.Context, delivery domain.WebhookDelivery, payload []byte,
+) (domain.WebhookResult, error) {
+	result := domain.WebhookResult{
+		DeliveryID: delivery.DeliveryID,
+		Event:      delivery.Event,
+	}
+	if delivery.Event != eventPullRequest {
+		result.Ignored = true
+		if delivery.Event != eventPing {
+			result.Reason = "unsupported event"
+		}
+		return result, nil
+	}
+
+	var event pullRequestEvent
+	if err := json.Unmarshal(payload, &event); err != nil || event.Number <= 0 || event.Repository.FullName == "" {
+		return domain.WebhookResult{}, fmt.Errorf("%w: not a pull_request event", domain.ErrInvalidWebhookPayload)
+	}
+	result.Action = event.Action
+
+	return h.router.Process(ctx, delivery, result, decode(event))
+}
+
+// decode maps the pull_request event to the webhook event. The merge is attributed to the account
+// that merged the PR, or to the sender when the payload does not say.
+func decode(event pullRequestEvent) webhook.Event {
+	action := actions[event.Action]
+	actor := event.Sender
+	if action == webhook.ActionClose && event.PullRequest.Merged {
+		action = webhook.ActionMerge
+		if event.PullRequest.MergedBy != nil {
+			actor = *event.PullRequest.MergedBy
+		}
+	}
+
+	return webhook.Event{
+		Action:        action,
+		Repository:    event.Repository.FullName,
+		Number:        event.Number,
+		Title:         event.PullRequest.Title,
+		URL:           event.PullRequest.HTMLURL,
+		Draft:         event.PullRequest.Draft,
+		Labels:        labels(event),
+		SourceBranch:  event.PullRequest.Head.Ref,
+		TargetBranch:  event.PullRequest.Base.Ref,
+		HeadSHA:       event.PullRequest.Head.SHA,
+		AuthorAccount: event.PullRequest.User.ID,
+		ActorAccount:  actor.ID,
+	}
+}
+
+func labels(event pullRequestEvent) []string {
+	if len(event.PullRequest.Labels) == 0 {
+		return nil
+	}
+	names := make([]string, len(event.PullRequest.Labels))
+	for i, label := range event.PullRequest.Labels {
+		names[i] = label.Name
+	}
+	return names
+}
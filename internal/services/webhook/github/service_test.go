@@ -0,0 +1,226 @@
+package github
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/AndrejDubinin/review-assigner/internal/services/webhook"
+)
+
+const testSecret = "It's a Secret to Everybody"
+
+func sign(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestHandler_VerifySignature(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"zen":"Design for failure."}`)
+
+	tests := []struct {
+		name      string
+		secret    string
+		signature string
+		wantErr   bool
+	}{
+		{name: "success: valid signature", secret: testSecret, signature: sign(body, testSecret)},
+		{name: "error: other secret", secret: testSecret, signature: sign(body, "other"), wantErr: true},
+		{name: "error: sha1 signature", secret: testSecret, signature: "sha1=0123abcd", wantErr: true},
+		{name: "error: not hex", secret: testSecret, signature: "sha256=zz", wantErr: true},
+		{name: "error: missing signature", secret: testSecret, wantErr: true},
+		{name: "error: no secret configured", signature: sign(body, ""), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := New(nil, tt.secret).VerifySignature(body, tt.signature)
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, domain.ErrInvalidWebhookSignature)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestHandler_HandleDelivery(t *testing.T) {
+	t.Parallel()
+
+	delivery := domain.WebhookDelivery{
+		Provider:   domain.WebhookProviderGitHub,
+		DeliveryID: "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+		Event:      eventPullRequest,
+	}
+	opened := webhook.Event{
+		Action:        webhook.ActionOpen,
+		Repository:    "acme/api",
+		Number:        42,
+		Title:         "Add rate limiting to the public API",
+		URL:           "https://github.com/acme/api/pull/42",
+		Labels:        []string{"backend", "security"},
+		SourceBranch:  "feature/rate-limit",
+		TargetBranch:  "main",
+		HeadSHA:       "e5bd3914e2e596debea16f433f57875b5b90bcd6",
+		AuthorAccount: 101,
+		ActorAccount:  101,
+	}
+	changed := func(action webhook.Action, change func(event *webhook.Event)) webhook.Event {
+		event := opened
+		event.Action = action
+		event.Labels = nil
+		if change != nil {
+			change(&event)
+		}
+		return event
+	}
+	// processed is the result the router returns for the events it is passed.
+	processed := domain.WebhookResult{DeliveryID: delivery.DeliveryID, PullRequestID: "acme/api#42"}
+
+	noRouter := func(mc *minimock.Controller) router { return NewRouterMock(mc) }
+	routes := func(action string, event webhook.Event) func(mc *minimock.Controller) router {
+		return func(mc *minimock.Controller) router {
+			router := NewRouterMock(mc)
+			router.ProcessMock.Expect(minimock.AnyContext, delivery, domain.WebhookResult{
+				DeliveryID: delivery.DeliveryID,
+				Event:      eventPullRequest,
+				Action:     action,
+			}, event).Return(processed, nil)
+			return router
+		}
+	}
+
+	tests := []struct {
+		name    string
+		event   string
+		fixture string
+		router  func(mc *minimock.Controller) router
+		want    domain.WebhookResult
+		wantErr error
+	}{
+		{
+			name:    "success: opened is an open",
+			event:   eventPullRequest,
+			fixture: "pull_request_opened.json",
+			router:  routes("opened", opened),
+			want:    processed,
+		},
+		{
+			name:    "success: opened draft is an open of a draft",
+			event:   eventPullRequest,
+			fixture: "pull_request_opened_draft.json",
+			router: routes("opened", changed(webhook.ActionOpen, func(event *webhook.Event) {
+				event.Draft = true
+			})),
+			want: processed,
+		},
+		{
+			name:    "success: ready for review carries the labels",
+			event:   eventPullRequest,
+			fixture: "pull_request_ready_for_review.json",
+			router: routes("ready_for_review", changed(webhook.ActionReady, func(event *webhook.Event) {
+				event.Labels = []string{"backend"}
+			})),
+			want: processed,
+		},
+		{
+			name:    "success: closed and merged is a merge by the merging account",
+			event:   eventPullRequest,
+			fixture: "pull_request_closed_merged.json",
+			router: routes("closed", changed(webhook.ActionMerge, func(event *webhook.Event) {
+				event.ActorAccount = 102
+			})),
+			want: processed,
+		},
+		{
+			name:    "success: closed without merge is a close by the sender",
+			event:   eventPullRequest,
+			fixture: "pull_request_closed.json",
+			router: routes("closed", changed(webhook.ActionClose, func(event *webhook.Event) {
+				event.ActorAccount = 999
+			})),
+			want: processed,
+		},
+		{
+			name:    "success: reopened is a reopen",
+			event:   eventPullRequest,
+			fixture: "pull_request_reopened.json",
+			router:  routes("reopened", changed(webhook.ActionReopen, nil)),
+			want:    processed,
+		},
+		{
+			name:    "success: unsupported action has no webhook action",
+			event:   eventPullRequest,
+			fixture: "pull_request_labeled.json",
+			router: routes("labeled", changed("", func(event *webhook.Event) {
+				event.Labels = []string{"backend"}
+			})),
+			want: processed,
+		},
+		{
+			name:    "success: ping is acknowledged",
+			event:   eventPing,
+			fixture: "ping.json",
+			router:  noRouter,
+			want:    domain.WebhookResult{DeliveryID: delivery.DeliveryID, Event: eventPing, Ignored: true},
+		},
+		{
+			name:    "success: other events are ignored",
+			event:   "push",
+			fixture: "ping.json",
+			router:  noRouter,
+			want: domain.WebhookResult{
+				DeliveryID: delivery.DeliveryID, Event: "push", Ignored: true, Reason: "unsupported event",
+			},
+		},
+		{
+			name:    "error: payload is not a pull_request event",
+			event:   eventPullRequest,
+			fixture: "ping.json",
+			router:  noRouter,
+			wantErr: domain.ErrInvalidWebhookPayload,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			payload, err := os.ReadFile(filepath.Join("testdata", tt.fixture))
+			require.NoError(t, err)
+			handler := New(tt.router(mc), testSecret)
+
+			got, err := handler.HandleDelivery(context.Background(), domain.WebhookDelivery{
+				Provider:   delivery.Provider,
+				DeliveryID: delivery.DeliveryID,
+				Event:      tt.event,
+			}, payload)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, domain.WebhookResult{}, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// Package gitlab receives the GitLab Merge Request Hook events: it verifies their token and decodes
+// them for webhook.Router, so merge requests opened on GitLab get reviewers the same way as PRs
+// created through the API.
+package gitlab
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/AndrejDubinin/review-assigner/internal/services/webhook"
+)
+
+const (
+	eventMergeRequest = "Merge Request Hook"
+
+	actionUpdate = "update"
+)
+
+// actions maps the merge request actions to the webhook actions. An update is a draft change,
+// see decode.
+var actions = map[string]webhook.Action{
+	"open":   webhook.ActionOpen,
+	"merge":  webhook.ActionMerge,
+	"close":  webhook.ActionClose,
+	"reopen": webhook.ActionReopen,
+}
+
+// ErrNoDraftChange is an update that does not toggle draft, the service does not track other
+// merge request changes. The webhook.Router of GitLab must ignore it.
+var ErrNoDraftChange = errors.New("update does not toggle draft")
+
+type (
+	router interface {
+		Process(ctx context.Context, delivery domain.WebhookDelivery, result domain.WebhookResult,
+			event webhook.Event) (domain.WebhookResult, error)
+	}
+
+	Handler struct {
+		router router
+		token  []byte
+	}
+
+	// mergeRequestEvent holds the fields of the Merge Request Hook payload the service uses.
+	mergeRequestEvent struct {
+		ObjectKind string  `json:"object_kind"`
+		User       account `json:"user"`
+		Project    struct {
+			PathWithNamespace string `json:"path_with_namespace"`
+		} `json:"project"`
+		ObjectAttributes mergeRequest `json:"object_attributes"`
+		Labels           []label      `json:"labels"`
+		Changes          struct {
+			Draft *struct {
+				Previous bool `json:"previous"`
+				Current  bool `json:"current"`
+			} `json:"draft"`
+		} `json:"changes"`
+	}
+	mergeRequest struct {
+		IID          int    `json:"iid"`
+		Title        string `json:"title"`
+		URL          string `json:"url"`
+		Action       string `json:"action"`
+		Draft        bool   `json:"draft"`
+		AuthorID     int64  `json:"author_id"`
+		SourceBranch string `json:"source_branch"`
+		TargetBranch string `json:"target_branch"`
+		LastCommit   struct {
+			ID string `json:"id"`
+		} `json:"last_commit"`
+	}
+	account struct {
+		ID int64 `json:"id"`
+	}
+	label struct {
+		Title string `json:"title"`
+	}
+)
+
+func New(router router, token string) *Handler {
+	return &Handler{
+		router: router,
+		token:  []byte(token),
+	}
+}
+
+// VerifyToken checks the X-Gitlab-Token header, the secret token configured on the GitLab webhook.
+func (h *Handler) VerifyToken(token string) error {
+	if len(h.token) == 0 || subtle.ConstantTimeCompare([]byte(token), h.token) != 1 {
+		return domain.ErrInvalidWebhookToken
+	}
+	return nil
+}
+
+// HandleDelivery applies a verified delivery, see webhook.Router. Events other than merge request
+// events are acknowledged as ignored.
+func (h *Handler) HandleDelivery(ctx context.Context, delivery domain.WebhookDelivery, payload []byte,
+) (domain.WebhookResult, error) {
+	result := domain.WebhookResult{
+		DeliveryID: delivery.DeliveryID,
+		Event:      delivery.Event,
+	}
+	if delivery.Event != eventMergeRequest {
+		result.Ignored, result.Reason = true, "unsupported event"
+		return result, nil
+	}
+
+	var event mergeRequestEvent
+	if err := json.Unmarshal(payload, &event); err != nil || event.ObjectKind != "merge_request" ||
+		event.ObjectAttributes.IID <= 0 || event.Project.PathWithNamespace == "" {
+		return domain.WebhookResult{}, fmt.Errorf("%w: not a merge request event", domain.ErrInvalidWebhookPayload)
+	}
+	result.Action = event.ObjectAttributes.Action
+
+	return h.router.Process(ctx, delivery, result, decode(event))
+}
+
+// decode maps the merge request event to the webhook event. Updates apply only when they toggle
+// draft, other updates are skipped with ErrNoDraftChange.
+func decode(event mergeRequestEvent) webhook.Event {
+	action := actions[event.ObjectAttributes.Action]
+	var skip error
+	if event.ObjectAttributes.Action == actionUpdate {
+		switch draft := event.Changes.Draft; {
+		case draft == nil || draft.Previous == draft.Current:
+			skip = ErrNoDraftChange
+		case draft.Current:
+			action = webhook.ActionDraft
+		default:
+			action = webhook.ActionReady
+		}
+	}
+
+	return webhook.Event{
+		Action:        action,
+		Skip:          skip,
+		Repository:    event.Project.PathWithNamespace,
+		Number:        event.ObjectAttributes.IID,
+		Title:         event.ObjectAttributes.Title,
+		URL:           event.ObjectAttributes.URL,
+		Draft:         event.ObjectAttributes.Draft,
+		Labels:        labels(event),
+		SourceBranch:  event.ObjectAttributes.SourceBranch,
+		TargetBranch:  event.ObjectAttributes.TargetBranch,
+		HeadSHA:       event.ObjectAttributes.LastCommit.ID,
+		AuthorAccount: event.ObjectAttributes.AuthorID,
+		ActorAccount:  event.User.ID,
+	}
+}
+
+func labels(event mergeRequestEvent) []string {
+	if len(event.Labels) == 0 {
+		return nil
+	}
+	names := make([]string, len(event.Labels))
+	for i, label := range event.Labels {
+		names[i] = label.Title
+	}
+	return names
+}
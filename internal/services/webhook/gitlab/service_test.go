@@ -0,0 +1,227 @@
+package gitlab
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/AndrejDubinin/review-assigner/internal/services/webhook"
+)
+
+const testToken = "glwt-8c3f0b1e"
+
+func TestHandler_VerifyToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		secret  string
+		token   string
+		wantErr bool
+	}{
+		{name: "success: matching token", secret: testToken, token: testToken},
+		{name: "error: other token", secret: testToken, token: "glwt-00000000", wantErr: true},
+		{name: "error: token prefix", secret: testToken, token: testToken[:4], wantErr: true},
+		{name: "error: missing token", secret: testToken, wantErr: true},
+		{name: "error: no token configured", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := New(nil, tt.secret).VerifyToken(tt.token)
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, domain.ErrInvalidWebhookToken)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestHandler_HandleDelivery(t *testing.T) {
+	t.Parallel()
+
+	delivery := domain.WebhookDelivery{
+		Provider:   domain.WebhookProviderGitLab,
+		DeliveryID: "4d2b5a1c-6f1e-4b39-9d0e-2f8c1a7e5b90",
+		Event:      eventMergeRequest,
+	}
+	opened := webhook.Event{
+		Action:        webhook.ActionOpen,
+		Repository:    "acme/billing",
+		Number:        7,
+		Title:         "Retry failed payment provider calls",
+		URL:           "https://gitlab.acme.dev/acme/billing/-/merge_requests/7",
+		Labels:        []string{"backend", "payments"},
+		SourceBranch:  "feature/payment-retries",
+		TargetBranch:  "main",
+		HeadSHA:       "da1560886d4f094c3e6c9ef40349f7d38b5d27d7",
+		AuthorAccount: 201,
+		ActorAccount:  201,
+	}
+	changed := func(action webhook.Action, change func(event *webhook.Event)) webhook.Event {
+		event := opened
+		event.Action = action
+		if change != nil {
+			change(&event)
+		}
+		return event
+	}
+	// processed is the result the router returns for the events it is passed.
+	processed := domain.WebhookResult{DeliveryID: delivery.DeliveryID, PullRequestID: "acme/billing#7"}
+
+	noRouter := func(mc *minimock.Controller) router { return NewRouterMock(mc) }
+	routes := func(action string, event webhook.Event) func(mc *minimock.Controller) router {
+		return func(mc *minimock.Controller) router {
+			router := NewRouterMock(mc)
+			router.ProcessMock.Expect(minimock.AnyContext, delivery, domain.WebhookResult{
+				DeliveryID: delivery.DeliveryID,
+				Event:      eventMergeRequest,
+				Action:     action,
+			}, event).Return(processed, nil)
+			return router
+		}
+	}
+
+	tests := []struct {
+		name    string
+		event   string
+		fixture string
+		router  func(mc *minimock.Controller) router
+		want    domain.WebhookResult
+		wantErr error
+	}{
+		{
+			name:    "success: open is an open",
+			event:   eventMergeRequest,
+			fixture: "merge_request_open.json",
+			router:  routes("open", opened),
+			want:    processed,
+		},
+		{
+			name:    "success: open draft is an open of a draft",
+			event:   eventMergeRequest,
+			fixture: "merge_request_open_draft.json",
+			router: routes("open", changed(webhook.ActionOpen, func(event *webhook.Event) {
+				event.Title = "Draft: Retry failed payment provider calls"
+				event.Draft = true
+				event.Labels = nil
+			})),
+			want: processed,
+		},
+		{
+			name:    "success: update leaving draft is a ready for review",
+			event:   eventMergeRequest,
+			fixture: "merge_request_update_ready.json",
+			router: routes("update", changed(webhook.ActionReady, func(event *webhook.Event) {
+				event.Labels = nil
+			})),
+			want: processed,
+		},
+		{
+			name:    "success: update marking draft is a convert to draft",
+			event:   eventMergeRequest,
+			fixture: "merge_request_update_draft.json",
+			router: routes("update", changed(webhook.ActionDraft, func(event *webhook.Event) {
+				event.Title = "Draft: Retry failed payment provider calls"
+				event.Draft = true
+			})),
+			want: processed,
+		},
+		{
+			name:    "success: update without draft toggle is skipped",
+			event:   eventMergeRequest,
+			fixture: "merge_request_update_description.json",
+			router: routes("update", changed("", func(event *webhook.Event) {
+				event.Skip = ErrNoDraftChange
+			})),
+			want: processed,
+		},
+		{
+			name:    "success: merge is a merge by the user",
+			event:   eventMergeRequest,
+			fixture: "merge_request_merge.json",
+			router: routes("merge", changed(webhook.ActionMerge, func(event *webhook.Event) {
+				event.ActorAccount = 202
+			})),
+			want: processed,
+		},
+		{
+			name:    "success: close is a close by the user",
+			event:   eventMergeRequest,
+			fixture: "merge_request_close.json",
+			router: routes("close", changed(webhook.ActionClose, func(event *webhook.Event) {
+				event.ActorAccount = 999
+			})),
+			want: processed,
+		},
+		{
+			name:    "success: reopen is a reopen",
+			event:   eventMergeRequest,
+			fixture: "merge_request_reopen.json",
+			router:  routes("reopen", changed(webhook.ActionReopen, nil)),
+			want:    processed,
+		},
+		{
+			name:    "success: unsupported action has no webhook action",
+			event:   eventMergeRequest,
+			fixture: "merge_request_approved.json",
+			router: routes("approved", changed("", func(event *webhook.Event) {
+				event.ActorAccount = 202
+			})),
+			want: processed,
+		},
+		{
+			name:    "success: other events are acknowledged",
+			event:   "Push Hook",
+			fixture: "push.json",
+			router:  noRouter,
+			want: domain.WebhookResult{
+				DeliveryID: delivery.DeliveryID, Event: "Push Hook", Ignored: true, Reason: "unsupported event",
+			},
+		},
+		{
+			name:    "error: payload is not a merge request event",
+			event:   eventMergeRequest,
+			fixture: "push.json",
+			router:  noRouter,
+			wantErr: domain.ErrInvalidWebhookPayload,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			payload, err := os.ReadFile(filepath.Join("testdata", tt.fixture))
+			require.NoError(t, err)
+			handler := New(tt.router(mc), testToken)
+
+			got, err := handler.HandleDelivery(context.Background(), domain.WebhookDelivery{
+				Provider:   delivery.Provider,
+				DeliveryID: delivery.DeliveryID,
+				Event:      tt.event,
+			}, payload)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, domain.WebhookResult{}, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+type (
+	identityRepository interface {
+		GetUserIDByIdentity(ctx context.Context, provider domain.WebhookProvider, externalID string) (string, error)
+	}
+
+	// Identities resolves the numeric account ids of a code host to users through the identity
+	// mapping.
+	Identities struct {
+		repo     identityRepository
+		provider domain.WebhookProvider
+	}
+)
+
+func NewIdentities(repo identityRepository, provider domain.WebhookProvider) *Identities {
+	return &Identities{
+		repo:     repo,
+		provider: provider,
+	}
+}
+
+// Author returns the user the author account is mapped to, ErrAuthorNotFound when the account is
+// not mapped.
+func (i *Identities) Author(ctx context.Context, accountID int64) (string, error) {
+	userID, err := i.userID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return "", domain.ErrAuthorNotFound
+		}
+		return "", fmt.Errorf("repo.GetUserIDByIdentity: %w", err)
+	}
+	return userID, nil
+}
+
+// Actor returns the user the account is mapped to, or an empty id when it is not mapped, so the
+// event is recorded without an actor.
+func (i *Identities) Actor(ctx context.Context, accountID int64) string {
+	userID, err := i.userID(ctx, accountID)
+	if err != nil {
+		return ""
+	}
+	return userID
+}
+
+func (i *Identities) userID(ctx context.Context, accountID int64) (string, error) {
+	if accountID <= 0 {
+		return "", domain.ErrUserNotFound
+	}
+	return i.repo.GetUserIDByIdentity(ctx, i.provider, strconv.FormatInt(accountID, 10))
+}
@@ -0,0 +1,449 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package webhook
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/webhook.identityRepository -o identity_repository_mock_test.go -n IdentityRepositoryMock -p webhook
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// IdentityRepositoryMock implements identityRepository
+type IdentityRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetUserIDByIdentity          func(ctx context.Context, provider domain.WebhookProvider, externalID string) (s1 string, err error)
+	funcGetUserIDByIdentityOrigin    string
+	inspectFuncGetUserIDByIdentity   func(ctx context.Context, provider domain.WebhookProvider, externalID string)
+	afterGetUserIDByIdentityCounter  uint64
+	beforeGetUserIDByIdentityCounter uint64
+	GetUserIDByIdentityMock          mIdentityRepositoryMockGetUserIDByIdentity
+}
+
+// NewIdentityRepositoryMock returns a mock for identityRepository
+func NewIdentityRepositoryMock(t minimock.Tester) *IdentityRepositoryMock {
+	m := &IdentityRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetUserIDByIdentityMock = mIdentityRepositoryMockGetUserIDByIdentity{mock: m}
+	m.GetUserIDByIdentityMock.callArgs = []*IdentityRepositoryMockGetUserIDByIdentityParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mIdentityRepositoryMockGetUserIDByIdentity struct {
+	optional           bool
+	mock               *IdentityRepositoryMock
+	defaultExpectation *IdentityRepositoryMockGetUserIDByIdentityExpectation
+	expectations       []*IdentityRepositoryMockGetUserIDByIdentityExpectation
+
+	callArgs []*IdentityRepositoryMockGetUserIDByIdentityParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IdentityRepositoryMockGetUserIDByIdentityExpectation specifies expectation struct of the identityRepository.GetUserIDByIdentity
+type IdentityRepositoryMockGetUserIDByIdentityExpectation struct {
+	mock               *IdentityRepositoryMock
+	params             *IdentityRepositoryMockGetUserIDByIdentityParams
+	paramPtrs          *IdentityRepositoryMockGetUserIDByIdentityParamPtrs
+	expectationOrigins IdentityRepositoryMockGetUserIDByIdentityExpectationOrigins
+	results            *IdentityRepositoryMockGetUserIDByIdentityResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IdentityRepositoryMockGetUserIDByIdentityParams contains parameters of the identityRepository.GetUserIDByIdentity
+type IdentityRepositoryMockGetUserIDByIdentityParams struct {
+	ctx        context.Context
+	provider   domain.WebhookProvider
+	externalID string
+}
+
+// IdentityRepositoryMockGetUserIDByIdentityParamPtrs contains pointers to parameters of the identityRepository.GetUserIDByIdentity
+type IdentityRepositoryMockGetUserIDByIdentityParamPtrs struct {
+	ctx        *context.Context
+	provider   *domain.WebhookProvider
+	externalID *string
+}
+
+// IdentityRepositoryMockGetUserIDByIdentityResults contains results of the identityRepository.GetUserIDByIdentity
+type IdentityRepositoryMockGetUserIDByIdentityResults struct {
+	s1  string
+	err error
+}
+
+// IdentityRepositoryMockGetUserIDByIdentityOrigins contains origins of expectations of the identityRepository.GetUserIDByIdentity
+type IdentityRepositoryMockGetUserIDByIdentityExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originProvider   string
+	originExternalID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserIDByIdentity *mIdentityRepositoryMockGetUserIDByIdentity) Optional() *mIdentityRepositoryMockGetUserIDByIdentity {
+	mmGetUserIDByIdentity.optional = true
+	return mmGetUserIDByIdentity
+}
+
+// Expect sets up expected params for identityRepository.GetUserIDByIdentity
+func (mmGetUserIDByIdentity *mIdentityRepositoryMockGetUserIDByIdentity) Expect(ctx context.Context, provider domain.WebhookProvider, externalID string) *mIdentityRepositoryMockGetUserIDByIdentity {
+	if mmGetUserIDByIdentity.mock.funcGetUserIDByIdentity != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("IdentityRepositoryMock.GetUserIDByIdentity mock is already set by Set")
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation == nil {
+		mmGetUserIDByIdentity.defaultExpectation = &IdentityRepositoryMockGetUserIDByIdentityExpectation{}
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation.paramPtrs != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("IdentityRepositoryMock.GetUserIDByIdentity mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserIDByIdentity.defaultExpectation.params = &IdentityRepositoryMockGetUserIDByIdentityParams{ctx, provider, externalID}
+	mmGetUserIDByIdentity.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserIDByIdentity.expectations {
+		if minimock.Equal(e.params, mmGetUserIDByIdentity.defaultExpectation.params) {
+			mmGetUserIDByIdentity.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserIDByIdentity.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserIDByIdentity
+}
+
+// ExpectCtxParam1 sets up expected param ctx for identityRepository.GetUserIDByIdentity
+func (mmGetUserIDByIdentity *mIdentityRepositoryMockGetUserIDByIdentity) ExpectCtxParam1(ctx context.Context) *mIdentityRepositoryMockGetUserIDByIdentity {
+	if mmGetUserIDByIdentity.mock.funcGetUserIDByIdentity != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("IdentityRepositoryMock.GetUserIDByIdentity mock is already set by Set")
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation == nil {
+		mmGetUserIDByIdentity.defaultExpectation = &IdentityRepositoryMockGetUserIDByIdentityExpectation{}
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation.params != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("IdentityRepositoryMock.GetUserIDByIdentity mock is already set by Expect")
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation.paramPtrs == nil {
+		mmGetUserIDByIdentity.defaultExpectation.paramPtrs = &IdentityRepositoryMockGetUserIDByIdentityParamPtrs{}
+	}
+	mmGetUserIDByIdentity.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserIDByIdentity.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserIDByIdentity
+}
+
+// ExpectProviderParam2 sets up expected param provider for identityRepository.GetUserIDByIdentity
+func (mmGetUserIDByIdentity *mIdentityRepositoryMockGetUserIDByIdentity) ExpectProviderParam2(provider domain.WebhookProvider) *mIdentityRepositoryMockGetUserIDByIdentity {
+	if mmGetUserIDByIdentity.mock.funcGetUserIDByIdentity != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("IdentityRepositoryMock.GetUserIDByIdentity mock is already set by Set")
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation == nil {
+		mmGetUserIDByIdentity.defaultExpectation = &IdentityRepositoryMockGetUserIDByIdentityExpectation{}
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation.params != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("IdentityRepositoryMock.GetUserIDByIdentity mock is already set by Expect")
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation.paramPtrs == nil {
+		mmGetUserIDByIdentity.defaultExpectation.paramPtrs = &IdentityRepositoryMockGetUserIDByIdentityParamPtrs{}
+	}
+	mmGetUserIDByIdentity.defaultExpectation.paramPtrs.provider = &provider
+	mmGetUserIDByIdentity.defaultExpectation.expectationOrigins.originProvider = minimock.CallerInfo(1)
+
+	return mmGetUserIDByIdentity
+}
+
+// ExpectExternalIDParam3 sets up expected param externalID for identityRepository.GetUserIDByIdentity
+func (mmGetUserIDByIdentity *mIdentityRepositoryMockGetUserIDByIdentity) ExpectExternalIDParam3(externalID string) *mIdentityRepositoryMockGetUserIDByIdentity {
+	if mmGetUserIDByIdentity.mock.funcGetUserIDByIdentity != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("IdentityRepositoryMock.GetUserIDByIdentity mock is already set by Set")
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation == nil {
+		mmGetUserIDByIdentity.defaultExpectation = &IdentityRepositoryMockGetUserIDByIdentityExpectation{}
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation.params != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("IdentityRepositoryMock.GetUserIDByIdentity mock is already set by Expect")
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation.paramPtrs == nil {
+		mmGetUserIDByIdentity.defaultExpectation.paramPtrs = &IdentityRepositoryMockGetUserIDByIdentityParamPtrs{}
+	}
+	mmGetUserIDByIdentity.defaultExpectation.paramPtrs.externalID = &externalID
+	mmGetUserIDByIdentity.defaultExpectation.expectationOrigins.originExternalID = minimock.CallerInfo(1)
+
+	return mmGetUserIDByIdentity
+}
+
+// Inspect accepts an inspector function that has same arguments as the identityRepository.GetUserIDByIdentity
+func (mmGetUserIDByIdentity *mIdentityRepositoryMockGetUserIDByIdentity) Inspect(f func(ctx context.Context, provider domain.WebhookProvider, externalID string)) *mIdentityRepositoryMockGetUserIDByIdentity {
+	if mmGetUserIDByIdentity.mock.inspectFuncGetUserIDByIdentity != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("Inspect function is already set for IdentityRepositoryMock.GetUserIDByIdentity")
+	}
+
+	mmGetUserIDByIdentity.mock.inspectFuncGetUserIDByIdentity = f
+
+	return mmGetUserIDByIdentity
+}
+
+// Return sets up results that will be returned by identityRepository.GetUserIDByIdentity
+func (mmGetUserIDByIdentity *mIdentityRepositoryMockGetUserIDByIdentity) Return(s1 string, err error) *IdentityRepositoryMock {
+	if mmGetUserIDByIdentity.mock.funcGetUserIDByIdentity != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("IdentityRepositoryMock.GetUserIDByIdentity mock is already set by Set")
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation == nil {
+		mmGetUserIDByIdentity.defaultExpectation = &IdentityRepositoryMockGetUserIDByIdentityExpectation{mock: mmGetUserIDByIdentity.mock}
+	}
+	mmGetUserIDByIdentity.defaultExpectation.results = &IdentityRepositoryMockGetUserIDByIdentityResults{s1, err}
+	mmGetUserIDByIdentity.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserIDByIdentity.mock
+}
+
+// Set uses given function f to mock the identityRepository.GetUserIDByIdentity method
+func (mmGetUserIDByIdentity *mIdentityRepositoryMockGetUserIDByIdentity) Set(f func(ctx context.Context, provider domain.WebhookProvider, externalID string) (s1 string, err error)) *IdentityRepositoryMock {
+	if mmGetUserIDByIdentity.defaultExpectation != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("Default expectation is already set for the identityRepository.GetUserIDByIdentity method")
+	}
+
+	if len(mmGetUserIDByIdentity.expectations) > 0 {
+		mmGetUserIDByIdentity.mock.t.Fatalf("Some expectations are already set for the identityRepository.GetUserIDByIdentity method")
+	}
+
+	mmGetUserIDByIdentity.mock.funcGetUserIDByIdentity = f
+	mmGetUserIDByIdentity.mock.funcGetUserIDByIdentityOrigin = minimock.CallerInfo(1)
+	return mmGetUserIDByIdentity.mock
+}
+
+// When sets expectation for the identityRepository.GetUserIDByIdentity which will trigger the result defined by the following
+// Then helper
+func (mmGetUserIDByIdentity *mIdentityRepositoryMockGetUserIDByIdentity) When(ctx context.Context, provider domain.WebhookProvider, externalID string) *IdentityRepositoryMockGetUserIDByIdentityExpectation {
+	if mmGetUserIDByIdentity.mock.funcGetUserIDByIdentity != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("IdentityRepositoryMock.GetUserIDByIdentity mock is already set by Set")
+	}
+
+	expectation := &IdentityRepositoryMockGetUserIDByIdentityExpectation{
+		mock:               mmGetUserIDByIdentity.mock,
+		params:             &IdentityRepositoryMockGetUserIDByIdentityParams{ctx, provider, externalID},
+		expectationOrigins: IdentityRepositoryMockGetUserIDByIdentityExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserIDByIdentity.expectations = append(mmGetUserIDByIdentity.expectations, expectation)
+	return expectation
+}
+
+// Then sets up identityRepository.GetUserIDByIdentity return parameters for the expectation previously defined by the When method
+func (e *IdentityRepositoryMockGetUserIDByIdentityExpectation) Then(s1 string, err error) *IdentityRepositoryMock {
+	e.results = &IdentityRepositoryMockGetUserIDByIdentityResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times identityRepository.GetUserIDByIdentity should be invoked
+func (mmGetUserIDByIdentity *mIdentityRepositoryMockGetUserIDByIdentity) Times(n uint64) *mIdentityRepositoryMockGetUserIDByIdentity {
+	if n == 0 {
+		mmGetUserIDByIdentity.mock.t.Fatalf("Times of IdentityRepositoryMock.GetUserIDByIdentity mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserIDByIdentity.expectedInvocations, n)
+	mmGetUserIDByIdentity.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserIDByIdentity
+}
+
+func (mmGetUserIDByIdentity *mIdentityRepositoryMockGetUserIDByIdentity) invocationsDone() bool {
+	if len(mmGetUserIDByIdentity.expectations) == 0 && mmGetUserIDByIdentity.defaultExpectation == nil && mmGetUserIDByIdentity.mock.funcGetUserIDByIdentity == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserIDByIdentity.mock.afterGetUserIDByIdentityCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserIDByIdentity.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserIDByIdentity implements identityRepository
+func (mmGetUserIDByIdentity *IdentityRepositoryMock) GetUserIDByIdentity(ctx context.Context, provider domain.WebhookProvider, externalID string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGetUserIDByIdentity.beforeGetUserIDByIdentityCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserIDByIdentity.afterGetUserIDByIdentityCounter, 1)
+
+	mmGetUserIDByIdentity.t.Helper()
+
+	if mmGetUserIDByIdentity.inspectFuncGetUserIDByIdentity != nil {
+		mmGetUserIDByIdentity.inspectFuncGetUserIDByIdentity(ctx, provider, externalID)
+	}
+
+	mm_params := IdentityRepositoryMockGetUserIDByIdentityParams{ctx, provider, externalID}
+
+	// Record call args
+	mmGetUserIDByIdentity.GetUserIDByIdentityMock.mutex.Lock()
+	mmGetUserIDByIdentity.GetUserIDByIdentityMock.callArgs = append(mmGetUserIDByIdentity.GetUserIDByIdentityMock.callArgs, &mm_params)
+	mmGetUserIDByIdentity.GetUserIDByIdentityMock.mutex.Unlock()
+
+	for _, e := range mmGetUserIDByIdentity.GetUserIDByIdentityMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetUserIDByIdentity.GetUserIDByIdentityMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserIDByIdentity.GetUserIDByIdentityMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserIDByIdentity.GetUserIDByIdentityMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserIDByIdentity.GetUserIDByIdentityMock.defaultExpectation.paramPtrs
+
+		mm_got := IdentityRepositoryMockGetUserIDByIdentityParams{ctx, provider, externalID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserIDByIdentity.t.Errorf("IdentityRepositoryMock.GetUserIDByIdentity got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserIDByIdentity.GetUserIDByIdentityMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.provider != nil && !minimock.Equal(*mm_want_ptrs.provider, mm_got.provider) {
+				mmGetUserIDByIdentity.t.Errorf("IdentityRepositoryMock.GetUserIDByIdentity got unexpected parameter provider, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserIDByIdentity.GetUserIDByIdentityMock.defaultExpectation.expectationOrigins.originProvider, *mm_want_ptrs.provider, mm_got.provider, minimock.Diff(*mm_want_ptrs.provider, mm_got.provider))
+			}
+
+			if mm_want_ptrs.externalID != nil && !minimock.Equal(*mm_want_ptrs.externalID, mm_got.externalID) {
+				mmGetUserIDByIdentity.t.Errorf("IdentityRepositoryMock.GetUserIDByIdentity got unexpected parameter externalID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserIDByIdentity.GetUserIDByIdentityMock.defaultExpectation.expectationOrigins.originExternalID, *mm_want_ptrs.externalID, mm_got.externalID, minimock.Diff(*mm_want_ptrs.externalID, mm_got.externalID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserIDByIdentity.t.Errorf("IdentityRepositoryMock.GetUserIDByIdentity got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserIDByIdentity.GetUserIDByIdentityMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserIDByIdentity.GetUserIDByIdentityMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserIDByIdentity.t.Fatal("No results are set for the IdentityRepositoryMock.GetUserIDByIdentity")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetUserIDByIdentity.funcGetUserIDByIdentity != nil {
+		return mmGetUserIDByIdentity.funcGetUserIDByIdentity(ctx, provider, externalID)
+	}
+	mmGetUserIDByIdentity.t.Fatalf("Unexpected call to IdentityRepositoryMock.GetUserIDByIdentity. %v %v %v", ctx, provider, externalID)
+	return
+}
+
+// GetUserIDByIdentityAfterCounter returns a count of finished IdentityRepositoryMock.GetUserIDByIdentity invocations
+func (mmGetUserIDByIdentity *IdentityRepositoryMock) GetUserIDByIdentityAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserIDByIdentity.afterGetUserIDByIdentityCounter)
+}
+
+// GetUserIDByIdentityBeforeCounter returns a count of IdentityRepositoryMock.GetUserIDByIdentity invocations
+func (mmGetUserIDByIdentity *IdentityRepositoryMock) GetUserIDByIdentityBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserIDByIdentity.beforeGetUserIDByIdentityCounter)
+}
+
+// Calls returns a list of arguments used in each call to IdentityRepositoryMock.GetUserIDByIdentity.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserIDByIdentity *mIdentityRepositoryMockGetUserIDByIdentity) Calls() []*IdentityRepositoryMockGetUserIDByIdentityParams {
+	mmGetUserIDByIdentity.mutex.RLock()
+
+	argCopy := make([]*IdentityRepositoryMockGetUserIDByIdentityParams, len(mmGetUserIDByIdentity.callArgs))
+	copy(argCopy, mmGetUserIDByIdentity.callArgs)
+
+	mmGetUserIDByIdentity.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserIDByIdentityDone returns true if the count of the GetUserIDByIdentity invocations corresponds
+// the number of defined expectations
+func (m *IdentityRepositoryMock) MinimockGetUserIDByIdentityDone() bool {
+	if m.GetUserIDByIdentityMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserIDByIdentityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserIDByIdentityMock.invocationsDone()
+}
+
+// MinimockGetUserIDByIdentityInspect logs each unmet expectation
+func (m *IdentityRepositoryMock) MinimockGetUserIDByIdentityInspect() {
+	for _, e := range m.GetUserIDByIdentityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IdentityRepositoryMock.GetUserIDByIdentity at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserIDByIdentityCounter := mm_atomic.LoadUint64(&m.afterGetUserIDByIdentityCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserIDByIdentityMock.defaultExpectation != nil && afterGetUserIDByIdentityCounter < 1 {
+		if m.GetUserIDByIdentityMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IdentityRepositoryMock.GetUserIDByIdentity at\n%s", m.GetUserIDByIdentityMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IdentityRepositoryMock.GetUserIDByIdentity at\n%s with params: %#v", m.GetUserIDByIdentityMock.defaultExpectation.expectationOrigins.origin, *m.GetUserIDByIdentityMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserIDByIdentity != nil && afterGetUserIDByIdentityCounter < 1 {
+		m.t.Errorf("Expected call to IdentityRepositoryMock.GetUserIDByIdentity at\n%s", m.funcGetUserIDByIdentityOrigin)
+	}
+
+	if !m.GetUserIDByIdentityMock.invocationsDone() && afterGetUserIDByIdentityCounter > 0 {
+		m.t.Errorf("Expected %d calls to IdentityRepositoryMock.GetUserIDByIdentity at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserIDByIdentityMock.expectedInvocations), m.GetUserIDByIdentityMock.expectedInvocationsOrigin, afterGetUserIDByIdentityCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *IdentityRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetUserIDByIdentityInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *IdentityRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *IdentityRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetUserIDByIdentityDone()
+}
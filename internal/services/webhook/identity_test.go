@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func TestIdentities_Author(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		accountID int64
+		repo      func(mc *minimock.Controller) identityRepository
+		want      string
+		wantErr   error
+	}{
+		{
+			name:      "success: mapped account",
+			accountID: 201,
+			repo: func(mc *minimock.Controller) identityRepository {
+				repo := NewIdentityRepositoryMock(mc)
+				repo.GetUserIDByIdentityMock.Expect(minimock.AnyContext, domain.WebhookProviderGitLab, "201").
+					Return("u1", nil)
+				return repo
+			},
+			want: "u1",
+		},
+		{
+			name:      "error: unmapped account is not an author",
+			accountID: 201,
+			repo: func(mc *minimock.Controller) identityRepository {
+				repo := NewIdentityRepositoryMock(mc)
+				repo.GetUserIDByIdentityMock.Return("", domain.ErrUserNotFound)
+				return repo
+			},
+			wantErr: domain.ErrAuthorNotFound,
+		},
+		{
+			name: "error: payload without account",
+			repo: func(mc *minimock.Controller) identityRepository {
+				return NewIdentityRepositoryMock(mc)
+			},
+			wantErr: domain.ErrAuthorNotFound,
+		},
+		{
+			name:      "error: mapping cannot be read",
+			accountID: 201,
+			repo: func(mc *minimock.Controller) identityRepository {
+				repo := NewIdentityRepositoryMock(mc)
+				repo.GetUserIDByIdentityMock.Return("", errors.New("database connection failed"))
+				return repo
+			},
+			wantErr: errors.New("database connection failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			got, err := NewIdentities(tt.repo(mc), domain.WebhookProviderGitLab).Author(
+				context.Background(), tt.accountID)
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIdentities_Actor(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	repo := NewIdentityRepositoryMock(mc)
+	repo.GetUserIDByIdentityMock.When(minimock.AnyContext, domain.WebhookProviderGitHub, "101").Then("u1", nil)
+	repo.GetUserIDByIdentityMock.When(minimock.AnyContext, domain.WebhookProviderGitHub, "999").
+		Then("", domain.ErrUserNotFound)
+	identities := NewIdentities(repo, domain.WebhookProviderGitHub)
+
+	assert.Equal(t, "u1", identities.Actor(context.Background(), 101))
+	assert.Empty(t, identities.Actor(context.Background(), 999))
+	assert.Empty(t, identities.Actor(context.Background(), 0))
+}
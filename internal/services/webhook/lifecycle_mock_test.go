@@ -0,0 +1,1486 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package webhook
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/webhook.lifecycle -o lifecycle_mock_test.go -n LifecycleMock -p webhook
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// LifecycleMock implements lifecycle
+type LifecycleMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcClosePullRequest          func(ctx context.Context, action domain.PullRequestAction) (p1 domain.PullRequest, err error)
+	funcClosePullRequestOrigin    string
+	inspectFuncClosePullRequest   func(ctx context.Context, action domain.PullRequestAction)
+	afterClosePullRequestCounter  uint64
+	beforeClosePullRequestCounter uint64
+	ClosePullRequestMock          mLifecycleMockClosePullRequest
+
+	funcConvertToDraft          func(ctx context.Context, request domain.ConvertToDraft) (p1 domain.PullRequest, err error)
+	funcConvertToDraftOrigin    string
+	inspectFuncConvertToDraft   func(ctx context.Context, request domain.ConvertToDraft)
+	afterConvertToDraftCounter  uint64
+	beforeConvertToDraftCounter uint64
+	ConvertToDraftMock          mLifecycleMockConvertToDraft
+
+	funcReadyForReview          func(ctx context.Context, request domain.ReadyForReview) (p1 domain.PullRequest, err error)
+	funcReadyForReviewOrigin    string
+	inspectFuncReadyForReview   func(ctx context.Context, request domain.ReadyForReview)
+	afterReadyForReviewCounter  uint64
+	beforeReadyForReviewCounter uint64
+	ReadyForReviewMock          mLifecycleMockReadyForReview
+
+	funcReopenPullRequest          func(ctx context.Context, action domain.PullRequestAction) (p1 domain.PullRequest, err error)
+	funcReopenPullRequestOrigin    string
+	inspectFuncReopenPullRequest   func(ctx context.Context, action domain.PullRequestAction)
+	afterReopenPullRequestCounter  uint64
+	beforeReopenPullRequestCounter uint64
+	ReopenPullRequestMock          mLifecycleMockReopenPullRequest
+}
+
+// NewLifecycleMock returns a mock for lifecycle
+func NewLifecycleMock(t minimock.Tester) *LifecycleMock {
+	m := &LifecycleMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ClosePullRequestMock = mLifecycleMockClosePullRequest{mock: m}
+	m.ClosePullRequestMock.callArgs = []*LifecycleMockClosePullRequestParams{}
+
+	m.ConvertToDraftMock = mLifecycleMockConvertToDraft{mock: m}
+	m.ConvertToDraftMock.callArgs = []*LifecycleMockConvertToDraftParams{}
+
+	m.ReadyForReviewMock = mLifecycleMockReadyForReview{mock: m}
+	m.ReadyForReviewMock.callArgs = []*LifecycleMockReadyForReviewParams{}
+
+	m.ReopenPullRequestMock = mLifecycleMockReopenPullRequest{mock: m}
+	m.ReopenPullRequestMock.callArgs = []*LifecycleMockReopenPullRequestParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mLifecycleMockClosePullRequest struct {
+	optional           bool
+	mock               *LifecycleMock
+	defaultExpectation *LifecycleMockClosePullRequestExpectation
+	expectations       []*LifecycleMockClosePullRequestExpectation
+
+	callArgs []*LifecycleMockClosePullRequestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LifecycleMockClosePullRequestExpectation specifies expectation struct of the lifecycle.ClosePullRequest
+type LifecycleMockClosePullRequestExpectation struct {
+	mock               *LifecycleMock
+	params             *LifecycleMockClosePullRequestParams
+	paramPtrs          *LifecycleMockClosePullRequestParamPtrs
+	expectationOrigins LifecycleMockClosePullRequestExpectationOrigins
+	results            *LifecycleMockClosePullRequestResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LifecycleMockClosePullRequestParams contains parameters of the lifecycle.ClosePullRequest
+type LifecycleMockClosePullRequestParams struct {
+	ctx    context.Context
+	action domain.PullRequestAction
+}
+
+// LifecycleMockClosePullRequestParamPtrs contains pointers to parameters of the lifecycle.ClosePullRequest
+type LifecycleMockClosePullRequestParamPtrs struct {
+	ctx    *context.Context
+	action *domain.PullRequestAction
+}
+
+// LifecycleMockClosePullRequestResults contains results of the lifecycle.ClosePullRequest
+type LifecycleMockClosePullRequestResults struct {
+	p1  domain.PullRequest
+	err error
+}
+
+// LifecycleMockClosePullRequestOrigins contains origins of expectations of the lifecycle.ClosePullRequest
+type LifecycleMockClosePullRequestExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originAction string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClosePullRequest *mLifecycleMockClosePullRequest) Optional() *mLifecycleMockClosePullRequest {
+	mmClosePullRequest.optional = true
+	return mmClosePullRequest
+}
+
+// Expect sets up expected params for lifecycle.ClosePullRequest
+func (mmClosePullRequest *mLifecycleMockClosePullRequest) Expect(ctx context.Context, action domain.PullRequestAction) *mLifecycleMockClosePullRequest {
+	if mmClosePullRequest.mock.funcClosePullRequest != nil {
+		mmClosePullRequest.mock.t.Fatalf("LifecycleMock.ClosePullRequest mock is already set by Set")
+	}
+
+	if mmClosePullRequest.defaultExpectation == nil {
+		mmClosePullRequest.defaultExpectation = &LifecycleMockClosePullRequestExpectation{}
+	}
+
+	if mmClosePullRequest.defaultExpectation.paramPtrs != nil {
+		mmClosePullRequest.mock.t.Fatalf("LifecycleMock.ClosePullRequest mock is already set by ExpectParams functions")
+	}
+
+	mmClosePullRequest.defaultExpectation.params = &LifecycleMockClosePullRequestParams{ctx, action}
+	mmClosePullRequest.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmClosePullRequest.expectations {
+		if minimock.Equal(e.params, mmClosePullRequest.defaultExpectation.params) {
+			mmClosePullRequest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClosePullRequest.defaultExpectation.params)
+		}
+	}
+
+	return mmClosePullRequest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for lifecycle.ClosePullRequest
+func (mmClosePullRequest *mLifecycleMockClosePullRequest) ExpectCtxParam1(ctx context.Context) *mLifecycleMockClosePullRequest {
+	if mmClosePullRequest.mock.funcClosePullRequest != nil {
+		mmClosePullRequest.mock.t.Fatalf("LifecycleMock.ClosePullRequest mock is already set by Set")
+	}
+
+	if mmClosePullRequest.defaultExpectation == nil {
+		mmClosePullRequest.defaultExpectation = &LifecycleMockClosePullRequestExpectation{}
+	}
+
+	if mmClosePullRequest.defaultExpectation.params != nil {
+		mmClosePullRequest.mock.t.Fatalf("LifecycleMock.ClosePullRequest mock is already set by Expect")
+	}
+
+	if mmClosePullRequest.defaultExpectation.paramPtrs == nil {
+		mmClosePullRequest.defaultExpectation.paramPtrs = &LifecycleMockClosePullRequestParamPtrs{}
+	}
+	mmClosePullRequest.defaultExpectation.paramPtrs.ctx = &ctx
+	mmClosePullRequest.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmClosePullRequest
+}
+
+// ExpectActionParam2 sets up expected param action for lifecycle.ClosePullRequest
+func (mmClosePullRequest *mLifecycleMockClosePullRequest) ExpectActionParam2(action domain.PullRequestAction) *mLifecycleMockClosePullRequest {
+	if mmClosePullRequest.mock.funcClosePullRequest != nil {
+		mmClosePullRequest.mock.t.Fatalf("LifecycleMock.ClosePullRequest mock is already set by Set")
+	}
+
+	if mmClosePullRequest.defaultExpectation == nil {
+		mmClosePullRequest.defaultExpectation = &LifecycleMockClosePullRequestExpectation{}
+	}
+
+	if mmClosePullRequest.defaultExpectation.params != nil {
+		mmClosePullRequest.mock.t.Fatalf("LifecycleMock.ClosePullRequest mock is already set by Expect")
+	}
+
+	if mmClosePullRequest.defaultExpectation.paramPtrs == nil {
+		mmClosePullRequest.defaultExpectation.paramPtrs = &LifecycleMockClosePullRequestParamPtrs{}
+	}
+	mmClosePullRequest.defaultExpectation.paramPtrs.action = &action
+	mmClosePullRequest.defaultExpectation.expectationOrigins.originAction = minimock.CallerInfo(1)
+
+	return mmClosePullRequest
+}
+
+// Inspect accepts an inspector function that has same arguments as the lifecycle.ClosePullRequest
+func (mmClosePullRequest *mLifecycleMockClosePullRequest) Inspect(f func(ctx context.Context, action domain.PullRequestAction)) *mLifecycleMockClosePullRequest {
+	if mmClosePullRequest.mock.inspectFuncClosePullRequest != nil {
+		mmClosePullRequest.mock.t.Fatalf("Inspect function is already set for LifecycleMock.ClosePullRequest")
+	}
+
+	mmClosePullRequest.mock.inspectFuncClosePullRequest = f
+
+	return mmClosePullRequest
+}
+
+// Return sets up results that will be returned by lifecycle.ClosePullRequest
+func (mmClosePullRequest *mLifecycleMockClosePullRequest) Return(p1 domain.PullRequest, err error) *LifecycleMock {
+	if mmClosePullRequest.mock.funcClosePullRequest != nil {
+		mmClosePullRequest.mock.t.Fatalf("LifecycleMock.ClosePullRequest mock is already set by Set")
+	}
+
+	if mmClosePullRequest.defaultExpectation == nil {
+		mmClosePullRequest.defaultExpectation = &LifecycleMockClosePullRequestExpectation{mock: mmClosePullRequest.mock}
+	}
+	mmClosePullRequest.defaultExpectation.results = &LifecycleMockClosePullRequestResults{p1, err}
+	mmClosePullRequest.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClosePullRequest.mock
+}
+
+// Set uses given function f to mock the lifecycle.ClosePullRequest method
+func (mmClosePullRequest *mLifecycleMockClosePullRequest) Set(f func(ctx context.Context, action domain.PullRequestAction) (p1 domain.PullRequest, err error)) *LifecycleMock {
+	if mmClosePullRequest.defaultExpectation != nil {
+		mmClosePullRequest.mock.t.Fatalf("Default expectation is already set for the lifecycle.ClosePullRequest method")
+	}
+
+	if len(mmClosePullRequest.expectations) > 0 {
+		mmClosePullRequest.mock.t.Fatalf("Some expectations are already set for the lifecycle.ClosePullRequest method")
+	}
+
+	mmClosePullRequest.mock.funcClosePullRequest = f
+	mmClosePullRequest.mock.funcClosePullRequestOrigin = minimock.CallerInfo(1)
+	return mmClosePullRequest.mock
+}
+
+// When sets expectation for the lifecycle.ClosePullRequest which will trigger the result defined by the following
+// Then helper
+func (mmClosePullRequest *mLifecycleMockClosePullRequest) When(ctx context.Context, action domain.PullRequestAction) *LifecycleMockClosePullRequestExpectation {
+	if mmClosePullRequest.mock.funcClosePullRequest != nil {
+		mmClosePullRequest.mock.t.Fatalf("LifecycleMock.ClosePullRequest mock is already set by Set")
+	}
+
+	expectation := &LifecycleMockClosePullRequestExpectation{
+		mock:               mmClosePullRequest.mock,
+		params:             &LifecycleMockClosePullRequestParams{ctx, action},
+		expectationOrigins: LifecycleMockClosePullRequestExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmClosePullRequest.expectations = append(mmClosePullRequest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up lifecycle.ClosePullRequest return parameters for the expectation previously defined by the When method
+func (e *LifecycleMockClosePullRequestExpectation) Then(p1 domain.PullRequest, err error) *LifecycleMock {
+	e.results = &LifecycleMockClosePullRequestResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times lifecycle.ClosePullRequest should be invoked
+func (mmClosePullRequest *mLifecycleMockClosePullRequest) Times(n uint64) *mLifecycleMockClosePullRequest {
+	if n == 0 {
+		mmClosePullRequest.mock.t.Fatalf("Times of LifecycleMock.ClosePullRequest mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClosePullRequest.expectedInvocations, n)
+	mmClosePullRequest.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClosePullRequest
+}
+
+func (mmClosePullRequest *mLifecycleMockClosePullRequest) invocationsDone() bool {
+	if len(mmClosePullRequest.expectations) == 0 && mmClosePullRequest.defaultExpectation == nil && mmClosePullRequest.mock.funcClosePullRequest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClosePullRequest.mock.afterClosePullRequestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClosePullRequest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClosePullRequest implements lifecycle
+func (mmClosePullRequest *LifecycleMock) ClosePullRequest(ctx context.Context, action domain.PullRequestAction) (p1 domain.PullRequest, err error) {
+	mm_atomic.AddUint64(&mmClosePullRequest.beforeClosePullRequestCounter, 1)
+	defer mm_atomic.AddUint64(&mmClosePullRequest.afterClosePullRequestCounter, 1)
+
+	mmClosePullRequest.t.Helper()
+
+	if mmClosePullRequest.inspectFuncClosePullRequest != nil {
+		mmClosePullRequest.inspectFuncClosePullRequest(ctx, action)
+	}
+
+	mm_params := LifecycleMockClosePullRequestParams{ctx, action}
+
+	// Record call args
+	mmClosePullRequest.ClosePullRequestMock.mutex.Lock()
+	mmClosePullRequest.ClosePullRequestMock.callArgs = append(mmClosePullRequest.ClosePullRequestMock.callArgs, &mm_params)
+	mmClosePullRequest.ClosePullRequestMock.mutex.Unlock()
+
+	for _, e := range mmClosePullRequest.ClosePullRequestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmClosePullRequest.ClosePullRequestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClosePullRequest.ClosePullRequestMock.defaultExpectation.Counter, 1)
+		mm_want := mmClosePullRequest.ClosePullRequestMock.defaultExpectation.params
+		mm_want_ptrs := mmClosePullRequest.ClosePullRequestMock.defaultExpectation.paramPtrs
+
+		mm_got := LifecycleMockClosePullRequestParams{ctx, action}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClosePullRequest.t.Errorf("LifecycleMock.ClosePullRequest got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClosePullRequest.ClosePullRequestMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.action != nil && !minimock.Equal(*mm_want_ptrs.action, mm_got.action) {
+				mmClosePullRequest.t.Errorf("LifecycleMock.ClosePullRequest got unexpected parameter action, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClosePullRequest.ClosePullRequestMock.defaultExpectation.expectationOrigins.originAction, *mm_want_ptrs.action, mm_got.action, minimock.Diff(*mm_want_ptrs.action, mm_got.action))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClosePullRequest.t.Errorf("LifecycleMock.ClosePullRequest got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmClosePullRequest.ClosePullRequestMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClosePullRequest.ClosePullRequestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClosePullRequest.t.Fatal("No results are set for the LifecycleMock.ClosePullRequest")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmClosePullRequest.funcClosePullRequest != nil {
+		return mmClosePullRequest.funcClosePullRequest(ctx, action)
+	}
+	mmClosePullRequest.t.Fatalf("Unexpected call to LifecycleMock.ClosePullRequest. %v %v", ctx, action)
+	return
+}
+
+// ClosePullRequestAfterCounter returns a count of finished LifecycleMock.ClosePullRequest invocations
+func (mmClosePullRequest *LifecycleMock) ClosePullRequestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClosePullRequest.afterClosePullRequestCounter)
+}
+
+// ClosePullRequestBeforeCounter returns a count of LifecycleMock.ClosePullRequest invocations
+func (mmClosePullRequest *LifecycleMock) ClosePullRequestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClosePullRequest.beforeClosePullRequestCounter)
+}
+
+// Calls returns a list of arguments used in each call to LifecycleMock.ClosePullRequest.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClosePullRequest *mLifecycleMockClosePullRequest) Calls() []*LifecycleMockClosePullRequestParams {
+	mmClosePullRequest.mutex.RLock()
+
+	argCopy := make([]*LifecycleMockClosePullRequestParams, len(mmClosePullRequest.callArgs))
+	copy(argCopy, mmClosePullRequest.callArgs)
+
+	mmClosePullRequest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClosePullRequestDone returns true if the count of the ClosePullRequest invocations corresponds
+// the number of defined expectations
+func (m *LifecycleMock) MinimockClosePullRequestDone() bool {
+	if m.ClosePullRequestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClosePullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClosePullRequestMock.invocationsDone()
+}
+
+// MinimockClosePullRequestInspect logs each unmet expectation
+func (m *LifecycleMock) MinimockClosePullRequestInspect() {
+	for _, e := range m.ClosePullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LifecycleMock.ClosePullRequest at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterClosePullRequestCounter := mm_atomic.LoadUint64(&m.afterClosePullRequestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClosePullRequestMock.defaultExpectation != nil && afterClosePullRequestCounter < 1 {
+		if m.ClosePullRequestMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LifecycleMock.ClosePullRequest at\n%s", m.ClosePullRequestMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LifecycleMock.ClosePullRequest at\n%s with params: %#v", m.ClosePullRequestMock.defaultExpectation.expectationOrigins.origin, *m.ClosePullRequestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClosePullRequest != nil && afterClosePullRequestCounter < 1 {
+		m.t.Errorf("Expected call to LifecycleMock.ClosePullRequest at\n%s", m.funcClosePullRequestOrigin)
+	}
+
+	if !m.ClosePullRequestMock.invocationsDone() && afterClosePullRequestCounter > 0 {
+		m.t.Errorf("Expected %d calls to LifecycleMock.ClosePullRequest at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ClosePullRequestMock.expectedInvocations), m.ClosePullRequestMock.expectedInvocationsOrigin, afterClosePullRequestCounter)
+	}
+}
+
+type mLifecycleMockConvertToDraft struct {
+	optional           bool
+	mock               *LifecycleMock
+	defaultExpectation *LifecycleMockConvertToDraftExpectation
+	expectations       []*LifecycleMockConvertToDraftExpectation
+
+	callArgs []*LifecycleMockConvertToDraftParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LifecycleMockConvertToDraftExpectation specifies expectation struct of the lifecycle.ConvertToDraft
+type LifecycleMockConvertToDraftExpectation struct {
+	mock               *LifecycleMock
+	params             *LifecycleMockConvertToDraftParams
+	paramPtrs          *LifecycleMockConvertToDraftParamPtrs
+	expectationOrigins LifecycleMockConvertToDraftExpectationOrigins
+	results            *LifecycleMockConvertToDraftResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LifecycleMockConvertToDraftParams contains parameters of the lifecycle.ConvertToDraft
+type LifecycleMockConvertToDraftParams struct {
+	ctx     context.Context
+	request domain.ConvertToDraft
+}
+
+// LifecycleMockConvertToDraftParamPtrs contains pointers to parameters of the lifecycle.ConvertToDraft
+type LifecycleMockConvertToDraftParamPtrs struct {
+	ctx     *context.Context
+	request *domain.ConvertToDraft
+}
+
+// LifecycleMockConvertToDraftResults contains results of the lifecycle.ConvertToDraft
+type LifecycleMockConvertToDraftResults struct {
+	p1  domain.PullRequest
+	err error
+}
+
+// LifecycleMockConvertToDraftOrigins contains origins of expectations of the lifecycle.ConvertToDraft
+type LifecycleMockConvertToDraftExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originRequest string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmConvertToDraft *mLifecycleMockConvertToDraft) Optional() *mLifecycleMockConvertToDraft {
+	mmConvertToDraft.optional = true
+	return mmConvertToDraft
+}
+
+// Expect sets up expected params for lifecycle.ConvertToDraft
+func (mmConvertToDraft *mLifecycleMockConvertToDraft) Expect(ctx context.Context, request domain.ConvertToDraft) *mLifecycleMockConvertToDraft {
+	if mmConvertToDraft.mock.funcConvertToDraft != nil {
+		mmConvertToDraft.mock.t.Fatalf("LifecycleMock.ConvertToDraft mock is already set by Set")
+	}
+
+	if mmConvertToDraft.defaultExpectation == nil {
+		mmConvertToDraft.defaultExpectation = &LifecycleMockConvertToDraftExpectation{}
+	}
+
+	if mmConvertToDraft.defaultExpectation.paramPtrs != nil {
+		mmConvertToDraft.mock.t.Fatalf("LifecycleMock.ConvertToDraft mock is already set by ExpectParams functions")
+	}
+
+	mmConvertToDraft.defaultExpectation.params = &LifecycleMockConvertToDraftParams{ctx, request}
+	mmConvertToDraft.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmConvertToDraft.expectations {
+		if minimock.Equal(e.params, mmConvertToDraft.defaultExpectation.params) {
+			mmConvertToDraft.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConvertToDraft.defaultExpectation.params)
+		}
+	}
+
+	return mmConvertToDraft
+}
+
+// ExpectCtxParam1 sets up expected param ctx for lifecycle.ConvertToDraft
+func (mmConvertToDraft *mLifecycleMockConvertToDraft) ExpectCtxParam1(ctx context.Context) *mLifecycleMockConvertToDraft {
+	if mmConvertToDraft.mock.funcConvertToDraft != nil {
+		mmConvertToDraft.mock.t.Fatalf("LifecycleMock.ConvertToDraft mock is already set by Set")
+	}
+
+	if mmConvertToDraft.defaultExpectation == nil {
+		mmConvertToDraft.defaultExpectation = &LifecycleMockConvertToDraftExpectation{}
+	}
+
+	if mmConvertToDraft.defaultExpectation.params != nil {
+		mmConvertToDraft.mock.t.Fatalf("LifecycleMock.ConvertToDraft mock is already set by Expect")
+	}
+
+	if mmConvertToDraft.defaultExpectation.paramPtrs == nil {
+		mmConvertToDraft.defaultExpectation.paramPtrs = &LifecycleMockConvertToDraftParamPtrs{}
+	}
+	mmConvertToDraft.defaultExpectation.paramPtrs.ctx = &ctx
+	mmConvertToDraft.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmConvertToDraft
+}
+
+// ExpectRequestParam2 sets up expected param request for lifecycle.ConvertToDraft
+func (mmConvertToDraft *mLifecycleMockConvertToDraft) ExpectRequestParam2(request domain.ConvertToDraft) *mLifecycleMockConvertToDraft {
+	if mmConvertToDraft.mock.funcConvertToDraft != nil {
+		mmConvertToDraft.mock.t.Fatalf("LifecycleMock.ConvertToDraft mock is already set by Set")
+	}
+
+	if mmConvertToDraft.defaultExpectation == nil {
+		mmConvertToDraft.defaultExpectation = &LifecycleMockConvertToDraftExpectation{}
+	}
+
+	if mmConvertToDraft.defaultExpectation.params != nil {
+		mmConvertToDraft.mock.t.Fatalf("LifecycleMock.ConvertToDraft mock is already set by Expect")
+	}
+
+	if mmConvertToDraft.defaultExpectation.paramPtrs == nil {
+		mmConvertToDraft.defaultExpectation.paramPtrs = &LifecycleMockConvertToDraftParamPtrs{}
+	}
+	mmConvertToDraft.defaultExpectation.paramPtrs.request = &request
+	mmConvertToDraft.defaultExpectation.expectationOrigins.originRequest = minimock.CallerInfo(1)
+
+	return mmConvertToDraft
+}
+
+// Inspect accepts an inspector function that has same arguments as the lifecycle.ConvertToDraft
+func (mmConvertToDraft *mLifecycleMockConvertToDraft) Inspect(f func(ctx context.Context, request domain.ConvertToDraft)) *mLifecycleMockConvertToDraft {
+	if mmConvertToDraft.mock.inspectFuncConvertToDraft != nil {
+		mmConvertToDraft.mock.t.Fatalf("Inspect function is already set for LifecycleMock.ConvertToDraft")
+	}
+
+	mmConvertToDraft.mock.inspectFuncConvertToDraft = f
+
+	return mmConvertToDraft
+}
+
+// Return sets up results that will be returned by lifecycle.ConvertToDraft
+func (mmConvertToDraft *mLifecycleMockConvertToDraft) Return(p1 domain.PullRequest, err error) *LifecycleMock {
+	if mmConvertToDraft.mock.funcConvertToDraft != nil {
+		mmConvertToDraft.mock.t.Fatalf("LifecycleMock.ConvertToDraft mock is already set by Set")
+	}
+
+	if mmConvertToDraft.defaultExpectation == nil {
+		mmConvertToDraft.defaultExpectation = &LifecycleMockConvertToDraftExpectation{mock: mmConvertToDraft.mock}
+	}
+	mmConvertToDraft.defaultExpectation.results = &LifecycleMockConvertToDraftResults{p1, err}
+	mmConvertToDraft.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmConvertToDraft.mock
+}
+
+// Set uses given function f to mock the lifecycle.ConvertToDraft method
+func (mmConvertToDraft *mLifecycleMockConvertToDraft) Set(f func(ctx context.Context, request domain.ConvertToDraft) (p1 domain.PullRequest, err error)) *LifecycleMock {
+	if mmConvertToDraft.defaultExpectation != nil {
+		mmConvertToDraft.mock.t.Fatalf("Default expectation is already set for the lifecycle.ConvertToDraft method")
+	}
+
+	if len(mmConvertToDraft.expectations) > 0 {
+		mmConvertToDraft.mock.t.Fatalf("Some expectations are already set for the lifecycle.ConvertToDraft method")
+	}
+
+	mmConvertToDraft.mock.funcConvertToDraft = f
+	mmConvertToDraft.mock.funcConvertToDraftOrigin = minimock.CallerInfo(1)
+	return mmConvertToDraft.mock
+}
+
+// When sets expectation for the lifecycle.ConvertToDraft which will trigger the result defined by the following
+// Then helper
+func (mmConvertToDraft *mLifecycleMockConvertToDraft) When(ctx context.Context, request domain.ConvertToDraft) *LifecycleMockConvertToDraftExpectation {
+	if mmConvertToDraft.mock.funcConvertToDraft != nil {
+		mmConvertToDraft.mock.t.Fatalf("LifecycleMock.ConvertToDraft mock is already set by Set")
+	}
+
+	expectation := &LifecycleMockConvertToDraftExpectation{
+		mock:               mmConvertToDraft.mock,
+		params:             &LifecycleMockConvertToDraftParams{ctx, request},
+		expectationOrigins: LifecycleMockConvertToDraftExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmConvertToDraft.expectations = append(mmConvertToDraft.expectations, expectation)
+	return expectation
+}
+
+// Then sets up lifecycle.ConvertToDraft return parameters for the expectation previously defined by the When method
+func (e *LifecycleMockConvertToDraftExpectation) Then(p1 domain.PullRequest, err error) *LifecycleMock {
+	e.results = &LifecycleMockConvertToDraftResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times lifecycle.ConvertToDraft should be invoked
+func (mmConvertToDraft *mLifecycleMockConvertToDraft) Times(n uint64) *mLifecycleMockConvertToDraft {
+	if n == 0 {
+		mmConvertToDraft.mock.t.Fatalf("Times of LifecycleMock.ConvertToDraft mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConvertToDraft.expectedInvocations, n)
+	mmConvertToDraft.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmConvertToDraft
+}
+
+func (mmConvertToDraft *mLifecycleMockConvertToDraft) invocationsDone() bool {
+	if len(mmConvertToDraft.expectations) == 0 && mmConvertToDraft.defaultExpectation == nil && mmConvertToDraft.mock.funcConvertToDraft == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConvertToDraft.mock.afterConvertToDraftCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConvertToDraft.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ConvertToDraft implements lifecycle
+func (mmConvertToDraft *LifecycleMock) ConvertToDraft(ctx context.Context, request domain.ConvertToDraft) (p1 domain.PullRequest, err error) {
+	mm_atomic.AddUint64(&mmConvertToDraft.beforeConvertToDraftCounter, 1)
+	defer mm_atomic.AddUint64(&mmConvertToDraft.afterConvertToDraftCounter, 1)
+
+	mmConvertToDraft.t.Helper()
+
+	if mmConvertToDraft.inspectFuncConvertToDraft != nil {
+		mmConvertToDraft.inspectFuncConvertToDraft(ctx, request)
+	}
+
+	mm_params := LifecycleMockConvertToDraftParams{ctx, request}
+
+	// Record call args
+	mmConvertToDraft.ConvertToDraftMock.mutex.Lock()
+	mmConvertToDraft.ConvertToDraftMock.callArgs = append(mmConvertToDraft.ConvertToDraftMock.callArgs, &mm_params)
+	mmConvertToDraft.ConvertToDraftMock.mutex.Unlock()
+
+	for _, e := range mmConvertToDraft.ConvertToDraftMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmConvertToDraft.ConvertToDraftMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConvertToDraft.ConvertToDraftMock.defaultExpectation.Counter, 1)
+		mm_want := mmConvertToDraft.ConvertToDraftMock.defaultExpectation.params
+		mm_want_ptrs := mmConvertToDraft.ConvertToDraftMock.defaultExpectation.paramPtrs
+
+		mm_got := LifecycleMockConvertToDraftParams{ctx, request}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConvertToDraft.t.Errorf("LifecycleMock.ConvertToDraft got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConvertToDraft.ConvertToDraftMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmConvertToDraft.t.Errorf("LifecycleMock.ConvertToDraft got unexpected parameter request, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConvertToDraft.ConvertToDraftMock.defaultExpectation.expectationOrigins.originRequest, *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConvertToDraft.t.Errorf("LifecycleMock.ConvertToDraft got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmConvertToDraft.ConvertToDraftMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConvertToDraft.ConvertToDraftMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConvertToDraft.t.Fatal("No results are set for the LifecycleMock.ConvertToDraft")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmConvertToDraft.funcConvertToDraft != nil {
+		return mmConvertToDraft.funcConvertToDraft(ctx, request)
+	}
+	mmConvertToDraft.t.Fatalf("Unexpected call to LifecycleMock.ConvertToDraft. %v %v", ctx, request)
+	return
+}
+
+// ConvertToDraftAfterCounter returns a count of finished LifecycleMock.ConvertToDraft invocations
+func (mmConvertToDraft *LifecycleMock) ConvertToDraftAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConvertToDraft.afterConvertToDraftCounter)
+}
+
+// ConvertToDraftBeforeCounter returns a count of LifecycleMock.ConvertToDraft invocations
+func (mmConvertToDraft *LifecycleMock) ConvertToDraftBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConvertToDraft.beforeConvertToDraftCounter)
+}
+
+// Calls returns a list of arguments used in each call to LifecycleMock.ConvertToDraft.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConvertToDraft *mLifecycleMockConvertToDraft) Calls() []*LifecycleMockConvertToDraftParams {
+	mmConvertToDraft.mutex.RLock()
+
+	argCopy := make([]*LifecycleMockConvertToDraftParams, len(mmConvertToDraft.callArgs))
+	copy(argCopy, mmConvertToDraft.callArgs)
+
+	mmConvertToDraft.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConvertToDraftDone returns true if the count of the ConvertToDraft invocations corresponds
+// the number of defined expectations
+func (m *LifecycleMock) MinimockConvertToDraftDone() bool {
+	if m.ConvertToDraftMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConvertToDraftMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConvertToDraftMock.invocationsDone()
+}
+
+// MinimockConvertToDraftInspect logs each unmet expectation
+func (m *LifecycleMock) MinimockConvertToDraftInspect() {
+	for _, e := range m.ConvertToDraftMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LifecycleMock.ConvertToDraft at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterConvertToDraftCounter := mm_atomic.LoadUint64(&m.afterConvertToDraftCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConvertToDraftMock.defaultExpectation != nil && afterConvertToDraftCounter < 1 {
+		if m.ConvertToDraftMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LifecycleMock.ConvertToDraft at\n%s", m.ConvertToDraftMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LifecycleMock.ConvertToDraft at\n%s with params: %#v", m.ConvertToDraftMock.defaultExpectation.expectationOrigins.origin, *m.ConvertToDraftMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConvertToDraft != nil && afterConvertToDraftCounter < 1 {
+		m.t.Errorf("Expected call to LifecycleMock.ConvertToDraft at\n%s", m.funcConvertToDraftOrigin)
+	}
+
+	if !m.ConvertToDraftMock.invocationsDone() && afterConvertToDraftCounter > 0 {
+		m.t.Errorf("Expected %d calls to LifecycleMock.ConvertToDraft at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ConvertToDraftMock.expectedInvocations), m.ConvertToDraftMock.expectedInvocationsOrigin, afterConvertToDraftCounter)
+	}
+}
+
+type mLifecycleMockReadyForReview struct {
+	optional           bool
+	mock               *LifecycleMock
+	defaultExpectation *LifecycleMockReadyForReviewExpectation
+	expectations       []*LifecycleMockReadyForReviewExpectation
+
+	callArgs []*LifecycleMockReadyForReviewParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LifecycleMockReadyForReviewExpectation specifies expectation struct of the lifecycle.ReadyForReview
+type LifecycleMockReadyForReviewExpectation struct {
+	mock               *LifecycleMock
+	params             *LifecycleMockReadyForReviewParams
+	paramPtrs          *LifecycleMockReadyForReviewParamPtrs
+	expectationOrigins LifecycleMockReadyForReviewExpectationOrigins
+	results            *LifecycleMockReadyForReviewResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LifecycleMockReadyForReviewParams contains parameters of the lifecycle.ReadyForReview
+type LifecycleMockReadyForReviewParams struct {
+	ctx     context.Context
+	request domain.ReadyForReview
+}
+
+// LifecycleMockReadyForReviewParamPtrs contains pointers to parameters of the lifecycle.ReadyForReview
+type LifecycleMockReadyForReviewParamPtrs struct {
+	ctx     *context.Context
+	request *domain.ReadyForReview
+}
+
+// LifecycleMockReadyForReviewResults contains results of the lifecycle.ReadyForReview
+type LifecycleMockReadyForReviewResults struct {
+	p1  domain.PullRequest
+	err error
+}
+
+// LifecycleMockReadyForReviewOrigins contains origins of expectations of the lifecycle.ReadyForReview
+type LifecycleMockReadyForReviewExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originRequest string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReadyForReview *mLifecycleMockReadyForReview) Optional() *mLifecycleMockReadyForReview {
+	mmReadyForReview.optional = true
+	return mmReadyForReview
+}
+
+// Expect sets up expected params for lifecycle.ReadyForReview
+func (mmReadyForReview *mLifecycleMockReadyForReview) Expect(ctx context.Context, request domain.ReadyForReview) *mLifecycleMockReadyForReview {
+	if mmReadyForReview.mock.funcReadyForReview != nil {
+		mmReadyForReview.mock.t.Fatalf("LifecycleMock.ReadyForReview mock is already set by Set")
+	}
+
+	if mmReadyForReview.defaultExpectation == nil {
+		mmReadyForReview.defaultExpectation = &LifecycleMockReadyForReviewExpectation{}
+	}
+
+	if mmReadyForReview.defaultExpectation.paramPtrs != nil {
+		mmReadyForReview.mock.t.Fatalf("LifecycleMock.ReadyForReview mock is already set by ExpectParams functions")
+	}
+
+	mmReadyForReview.defaultExpectation.params = &LifecycleMockReadyForReviewParams{ctx, request}
+	mmReadyForReview.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReadyForReview.expectations {
+		if minimock.Equal(e.params, mmReadyForReview.defaultExpectation.params) {
+			mmReadyForReview.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReadyForReview.defaultExpectation.params)
+		}
+	}
+
+	return mmReadyForReview
+}
+
+// ExpectCtxParam1 sets up expected param ctx for lifecycle.ReadyForReview
+func (mmReadyForReview *mLifecycleMockReadyForReview) ExpectCtxParam1(ctx context.Context) *mLifecycleMockReadyForReview {
+	if mmReadyForReview.mock.funcReadyForReview != nil {
+		mmReadyForReview.mock.t.Fatalf("LifecycleMock.ReadyForReview mock is already set by Set")
+	}
+
+	if mmReadyForReview.defaultExpectation == nil {
+		mmReadyForReview.defaultExpectation = &LifecycleMockReadyForReviewExpectation{}
+	}
+
+	if mmReadyForReview.defaultExpectation.params != nil {
+		mmReadyForReview.mock.t.Fatalf("LifecycleMock.ReadyForReview mock is already set by Expect")
+	}
+
+	if mmReadyForReview.defaultExpectation.paramPtrs == nil {
+		mmReadyForReview.defaultExpectation.paramPtrs = &LifecycleMockReadyForReviewParamPtrs{}
+	}
+	mmReadyForReview.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReadyForReview.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReadyForReview
+}
+
+// ExpectRequestParam2 sets up expected param request for lifecycle.ReadyForReview
+func (mmReadyForReview *mLifecycleMockReadyForReview) ExpectRequestParam2(request domain.ReadyForReview) *mLifecycleMockReadyForReview {
+	if mmReadyForReview.mock.funcReadyForReview != nil {
+		mmReadyForReview.mock.t.Fatalf("LifecycleMock.ReadyForReview mock is already set by Set")
+	}
+
+	if mmReadyForReview.defaultExpectation == nil {
+		mmReadyForReview.defaultExpectation = &LifecycleMockReadyForReviewExpectation{}
+	}
+
+	if mmReadyForReview.defaultExpectation.params != nil {
+		mmReadyForReview.mock.t.Fatalf("LifecycleMock.ReadyForReview mock is already set by Expect")
+	}
+
+	if mmReadyForReview.defaultExpectation.paramPtrs == nil {
+		mmReadyForReview.defaultExpectation.paramPtrs = &LifecycleMockReadyForReviewParamPtrs{}
+	}
+	mmReadyForReview.defaultExpectation.paramPtrs.request = &request
+	mmReadyForReview.defaultExpectation.expectationOrigins.originRequest = minimock.CallerInfo(1)
+
+	return mmReadyForReview
+}
+
+// Inspect accepts an inspector function that has same arguments as the lifecycle.ReadyForReview
+func (mmReadyForReview *mLifecycleMockReadyForReview) Inspect(f func(ctx context.Context, request domain.ReadyForReview)) *mLifecycleMockReadyForReview {
+	if mmReadyForReview.mock.inspectFuncReadyForReview != nil {
+		mmReadyForReview.mock.t.Fatalf("Inspect function is already set for LifecycleMock.ReadyForReview")
+	}
+
+	mmReadyForReview.mock.inspectFuncReadyForReview = f
+
+	return mmReadyForReview
+}
+
+// Return sets up results that will be returned by lifecycle.ReadyForReview
+func (mmReadyForReview *mLifecycleMockReadyForReview) Return(p1 domain.PullRequest, err error) *LifecycleMock {
+	if mmReadyForReview.mock.funcReadyForReview != nil {
+		mmReadyForReview.mock.t.Fatalf("LifecycleMock.ReadyForReview mock is already set by Set")
+	}
+
+	if mmReadyForReview.defaultExpectation == nil {
+		mmReadyForReview.defaultExpectation = &LifecycleMockReadyForReviewExpectation{mock: mmReadyForReview.mock}
+	}
+	mmReadyForReview.defaultExpectation.results = &LifecycleMockReadyForReviewResults{p1, err}
+	mmReadyForReview.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReadyForReview.mock
+}
+
+// Set uses given function f to mock the lifecycle.ReadyForReview method
+func (mmReadyForReview *mLifecycleMockReadyForReview) Set(f func(ctx context.Context, request domain.ReadyForReview) (p1 domain.PullRequest, err error)) *LifecycleMock {
+	if mmReadyForReview.defaultExpectation != nil {
+		mmReadyForReview.mock.t.Fatalf("Default expectation is already set for the lifecycle.ReadyForReview method")
+	}
+
+	if len(mmReadyForReview.expectations) > 0 {
+		mmReadyForReview.mock.t.Fatalf("Some expectations are already set for the lifecycle.ReadyForReview method")
+	}
+
+	mmReadyForReview.mock.funcReadyForReview = f
+	mmReadyForReview.mock.funcReadyForReviewOrigin = minimock.CallerInfo(1)
+	return mmReadyForReview.mock
+}
+
+// When sets expectation for the lifecycle.ReadyForReview which will trigger the result defined by the following
+// Then helper
+func (mmReadyForReview *mLifecycleMockReadyForReview) When(ctx context.Context, request domain.ReadyForReview) *LifecycleMockReadyForReviewExpectation {
+	if mmReadyForReview.mock.funcReadyForReview != nil {
+		mmReadyForReview.mock.t.Fatalf("LifecycleMock.ReadyForReview mock is already set by Set")
+	}
+
+	expectation := &LifecycleMockReadyForReviewExpectation{
+		mock:               mmReadyForReview.mock,
+		params:             &LifecycleMockReadyForReviewParams{ctx, request},
+		expectationOrigins: LifecycleMockReadyForReviewExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReadyForReview.expectations = append(mmReadyForReview.expectations, expectation)
+	return expectation
+}
+
+// Then sets up lifecycle.ReadyForReview return parameters for the expectation previously defined by the When method
+func (e *LifecycleMockReadyForReviewExpectation) Then(p1 domain.PullRequest, err error) *LifecycleMock {
+	e.results = &LifecycleMockReadyForReviewResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times lifecycle.ReadyForReview should be invoked
+func (mmReadyForReview *mLifecycleMockReadyForReview) Times(n uint64) *mLifecycleMockReadyForReview {
+	if n == 0 {
+		mmReadyForReview.mock.t.Fatalf("Times of LifecycleMock.ReadyForReview mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReadyForReview.expectedInvocations, n)
+	mmReadyForReview.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReadyForReview
+}
+
+func (mmReadyForReview *mLifecycleMockReadyForReview) invocationsDone() bool {
+	if len(mmReadyForReview.expectations) == 0 && mmReadyForReview.defaultExpectation == nil && mmReadyForReview.mock.funcReadyForReview == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReadyForReview.mock.afterReadyForReviewCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReadyForReview.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReadyForReview implements lifecycle
+func (mmReadyForReview *LifecycleMock) ReadyForReview(ctx context.Context, request domain.ReadyForReview) (p1 domain.PullRequest, err error) {
+	mm_atomic.AddUint64(&mmReadyForReview.beforeReadyForReviewCounter, 1)
+	defer mm_atomic.AddUint64(&mmReadyForReview.afterReadyForReviewCounter, 1)
+
+	mmReadyForReview.t.Helper()
+
+	if mmReadyForReview.inspectFuncReadyForReview != nil {
+		mmReadyForReview.inspectFuncReadyForReview(ctx, request)
+	}
+
+	mm_params := LifecycleMockReadyForReviewParams{ctx, request}
+
+	// Record call args
+	mmReadyForReview.ReadyForReviewMock.mutex.Lock()
+	mmReadyForReview.ReadyForReviewMock.callArgs = append(mmReadyForReview.ReadyForReviewMock.callArgs, &mm_params)
+	mmReadyForReview.ReadyForReviewMock.mutex.Unlock()
+
+	for _, e := range mmReadyForReview.ReadyForReviewMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmReadyForReview.ReadyForReviewMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReadyForReview.ReadyForReviewMock.defaultExpectation.Counter, 1)
+		mm_want := mmReadyForReview.ReadyForReviewMock.defaultExpectation.params
+		mm_want_ptrs := mmReadyForReview.ReadyForReviewMock.defaultExpectation.paramPtrs
+
+		mm_got := LifecycleMockReadyForReviewParams{ctx, request}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReadyForReview.t.Errorf("LifecycleMock.ReadyForReview got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReadyForReview.ReadyForReviewMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmReadyForReview.t.Errorf("LifecycleMock.ReadyForReview got unexpected parameter request, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReadyForReview.ReadyForReviewMock.defaultExpectation.expectationOrigins.originRequest, *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReadyForReview.t.Errorf("LifecycleMock.ReadyForReview got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReadyForReview.ReadyForReviewMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReadyForReview.ReadyForReviewMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReadyForReview.t.Fatal("No results are set for the LifecycleMock.ReadyForReview")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmReadyForReview.funcReadyForReview != nil {
+		return mmReadyForReview.funcReadyForReview(ctx, request)
+	}
+	mmReadyForReview.t.Fatalf("Unexpected call to LifecycleMock.ReadyForReview. %v %v", ctx, request)
+	return
+}
+
+// ReadyForReviewAfterCounter returns a count of finished LifecycleMock.ReadyForReview invocations
+func (mmReadyForReview *LifecycleMock) ReadyForReviewAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReadyForReview.afterReadyForReviewCounter)
+}
+
+// ReadyForReviewBeforeCounter returns a count of LifecycleMock.ReadyForReview invocations
+func (mmReadyForReview *LifecycleMock) ReadyForReviewBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReadyForReview.beforeReadyForReviewCounter)
+}
+
+// Calls returns a list of arguments used in each call to LifecycleMock.ReadyForReview.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReadyForReview *mLifecycleMockReadyForReview) Calls() []*LifecycleMockReadyForReviewParams {
+	mmReadyForReview.mutex.RLock()
+
+	argCopy := make([]*LifecycleMockReadyForReviewParams, len(mmReadyForReview.callArgs))
+	copy(argCopy, mmReadyForReview.callArgs)
+
+	mmReadyForReview.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReadyForReviewDone returns true if the count of the ReadyForReview invocations corresponds
+// the number of defined expectations
+func (m *LifecycleMock) MinimockReadyForReviewDone() bool {
+	if m.ReadyForReviewMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReadyForReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReadyForReviewMock.invocationsDone()
+}
+
+// MinimockReadyForReviewInspect logs each unmet expectation
+func (m *LifecycleMock) MinimockReadyForReviewInspect() {
+	for _, e := range m.ReadyForReviewMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LifecycleMock.ReadyForReview at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReadyForReviewCounter := mm_atomic.LoadUint64(&m.afterReadyForReviewCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReadyForReviewMock.defaultExpectation != nil && afterReadyForReviewCounter < 1 {
+		if m.ReadyForReviewMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LifecycleMock.ReadyForReview at\n%s", m.ReadyForReviewMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LifecycleMock.ReadyForReview at\n%s with params: %#v", m.ReadyForReviewMock.defaultExpectation.expectationOrigins.origin, *m.ReadyForReviewMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReadyForReview != nil && afterReadyForReviewCounter < 1 {
+		m.t.Errorf("Expected call to LifecycleMock.ReadyForReview at\n%s", m.funcReadyForReviewOrigin)
+	}
+
+	if !m.ReadyForReviewMock.invocationsDone() && afterReadyForReviewCounter > 0 {
+		m.t.Errorf("Expected %d calls to LifecycleMock.ReadyForReview at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReadyForReviewMock.expectedInvocations), m.ReadyForReviewMock.expectedInvocationsOrigin, afterReadyForReviewCounter)
+	}
+}
+
+type mLifecycleMockReopenPullRequest struct {
+	optional           bool
+	mock               *LifecycleMock
+	defaultExpectation *LifecycleMockReopenPullRequestExpectation
+	expectations       []*LifecycleMockReopenPullRequestExpectation
+
+	callArgs []*LifecycleMockReopenPullRequestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LifecycleMockReopenPullRequestExpectation specifies expectation struct of the lifecycle.ReopenPullRequest
+type LifecycleMockReopenPullRequestExpectation struct {
+	mock               *LifecycleMock
+	params             *LifecycleMockReopenPullRequestParams
+	paramPtrs          *LifecycleMockReopenPullRequestParamPtrs
+	expectationOrigins LifecycleMockReopenPullRequestExpectationOrigins
+	results            *LifecycleMockReopenPullRequestResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LifecycleMockReopenPullRequestParams contains parameters of the lifecycle.ReopenPullRequest
+type LifecycleMockReopenPullRequestParams struct {
+	ctx    context.Context
+	action domain.PullRequestAction
+}
+
+// LifecycleMockReopenPullRequestParamPtrs contains pointers to parameters of the lifecycle.ReopenPullRequest
+type LifecycleMockReopenPullRequestParamPtrs struct {
+	ctx    *context.Context
+	action *domain.PullRequestAction
+}
+
+// LifecycleMockReopenPullRequestResults contains results of the lifecycle.ReopenPullRequest
+type LifecycleMockReopenPullRequestResults struct {
+	p1  domain.PullRequest
+	err error
+}
+
+// LifecycleMockReopenPullRequestOrigins contains origins of expectations of the lifecycle.ReopenPullRequest
+type LifecycleMockReopenPullRequestExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originAction string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReopenPullRequest *mLifecycleMockReopenPullRequest) Optional() *mLifecycleMockReopenPullRequest {
+	mmReopenPullRequest.optional = true
+	return mmReopenPullRequest
+}
+
+// Expect sets up expected params for lifecycle.ReopenPullRequest
+func (mmReopenPullRequest *mLifecycleMockReopenPullRequest) Expect(ctx context.Context, action domain.PullRequestAction) *mLifecycleMockReopenPullRequest {
+	if mmReopenPullRequest.mock.funcReopenPullRequest != nil {
+		mmReopenPullRequest.mock.t.Fatalf("LifecycleMock.ReopenPullRequest mock is already set by Set")
+	}
+
+	if mmReopenPullRequest.defaultExpectation == nil {
+		mmReopenPullRequest.defaultExpectation = &LifecycleMockReopenPullRequestExpectation{}
+	}
+
+	if mmReopenPullRequest.defaultExpectation.paramPtrs != nil {
+		mmReopenPullRequest.mock.t.Fatalf("LifecycleMock.ReopenPullRequest mock is already set by ExpectParams functions")
+	}
+
+	mmReopenPullRequest.defaultExpectation.params = &LifecycleMockReopenPullRequestParams{ctx, action}
+	mmReopenPullRequest.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReopenPullRequest.expectations {
+		if minimock.Equal(e.params, mmReopenPullRequest.defaultExpectation.params) {
+			mmReopenPullRequest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReopenPullRequest.defaultExpectation.params)
+		}
+	}
+
+	return mmReopenPullRequest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for lifecycle.ReopenPullRequest
+func (mmReopenPullRequest *mLifecycleMockReopenPullRequest) ExpectCtxParam1(ctx context.Context) *mLifecycleMockReopenPullRequest {
+	if mmReopenPullRequest.mock.funcReopenPullRequest != nil {
+		mmReopenPullRequest.mock.t.Fatalf("LifecycleMock.ReopenPullRequest mock is already set by Set")
+	}
+
+	if mmReopenPullRequest.defaultExpectation == nil {
+		mmReopenPullRequest.defaultExpectation = &LifecycleMockReopenPullRequestExpectation{}
+	}
+
+	if mmReopenPullRequest.defaultExpectation.params != nil {
+		mmReopenPullRequest.mock.t.Fatalf("LifecycleMock.ReopenPullRequest mock is already set by Expect")
+	}
+
+	if mmReopenPullRequest.defaultExpectation.paramPtrs == nil {
+		mmReopenPullRequest.defaultExpectation.paramPtrs = &LifecycleMockReopenPullRequestParamPtrs{}
+	}
+	mmReopenPullRequest.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReopenPullRequest.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReopenPullRequest
+}
+
+// ExpectActionParam2 sets up expected param action for lifecycle.ReopenPullRequest
+func (mmReopenPullRequest *mLifecycleMockReopenPullRequest) ExpectActionParam2(action domain.PullRequestAction) *mLifecycleMockReopenPullRequest {
+	if mmReopenPullRequest.mock.funcReopenPullRequest != nil {
+		mmReopenPullRequest.mock.t.Fatalf("LifecycleMock.ReopenPullRequest mock is already set by Set")
+	}
+
+	if mmReopenPullRequest.defaultExpectation == nil {
+		mmReopenPullRequest.defaultExpectation = &LifecycleMockReopenPullRequestExpectation{}
+	}
+
+	if mmReopenPullRequest.defaultExpectation.params != nil {
+		mmReopenPullRequest.mock.t.Fatalf("LifecycleMock.ReopenPullRequest mock is already set by Expect")
+	}
+
+	if mmReopenPullRequest.defaultExpectation.paramPtrs == nil {
+		mmReopenPullRequest.defaultExpectation.paramPtrs = &LifecycleMockReopenPullRequestParamPtrs{}
+	}
+	mmReopenPullRequest.defaultExpectation.paramPtrs.action = &action
+	mmReopenPullRequest.defaultExpectation.expectationOrigins.originAction = minimock.CallerInfo(1)
+
+	return mmReopenPullRequest
+}
+
+// Inspect accepts an inspector function that has same arguments as the lifecycle.ReopenPullRequest
+func (mmReopenPullRequest *mLifecycleMockReopenPullRequest) Inspect(f func(ctx context.Context, action domain.PullRequestAction)) *mLifecycleMockReopenPullRequest {
+	if mmReopenPullRequest.mock.inspectFuncReopenPullRequest != nil {
+		mmReopenPullRequest.mock.t.Fatalf("Inspect function is already set for LifecycleMock.ReopenPullRequest")
+	}
+
+	mmReopenPullRequest.mock.inspectFuncReopenPullRequest = f
+
+	return mmReopenPullRequest
+}
+
+// Return sets up results that will be returned by lifecycle.ReopenPullRequest
+func (mmReopenPullRequest *mLifecycleMockReopenPullRequest) Return(p1 domain.PullRequest, err error) *LifecycleMock {
+	if mmReopenPullRequest.mock.funcReopenPullRequest != nil {
+		mmReopenPullRequest.mock.t.Fatalf("LifecycleMock.ReopenPullRequest mock is already set by Set")
+	}
+
+	if mmReopenPullRequest.defaultExpectation == nil {
+		mmReopenPullRequest.defaultExpectation = &LifecycleMockReopenPullRequestExpectation{mock: mmReopenPullRequest.mock}
+	}
+	mmReopenPullRequest.defaultExpectation.results = &LifecycleMockReopenPullRequestResults{p1, err}
+	mmReopenPullRequest.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReopenPullRequest.mock
+}
+
+// Set uses given function f to mock the lifecycle.ReopenPullRequest method
+func (mmReopenPullRequest *mLifecycleMockReopenPullRequest) Set(f func(ctx context.Context, action domain.PullRequestAction) (p1 domain.PullRequest, err error)) *LifecycleMock {
+	if mmReopenPullRequest.defaultExpectation != nil {
+		mmReopenPullRequest.mock.t.Fatalf("Default expectation is already set for the lifecycle.ReopenPullRequest method")
+	}
+
+	if len(mmReopenPullRequest.expectations) > 0 {
+		mmReopenPullRequest.mock.t.Fatalf("Some expectations are already set for the lifecycle.ReopenPullRequest method")
+	}
+
+	mmReopenPullRequest.mock.funcReopenPullRequest = f
+	mmReopenPullRequest.mock.funcReopenPullRequestOrigin = minimock.CallerInfo(1)
+	return mmReopenPullRequest.mock
+}
+
+// When sets expectation for the lifecycle.ReopenPullRequest which will trigger the result defined by the following
+// Then helper
+func (mmReopenPullRequest *mLifecycleMockReopenPullRequest) When(ctx context.Context, action domain.PullRequestAction) *LifecycleMockReopenPullRequestExpectation {
+	if mmReopenPullRequest.mock.funcReopenPullRequest != nil {
+		mmReopenPullRequest.mock.t.Fatalf("LifecycleMock.ReopenPullRequest mock is already set by Set")
+	}
+
+	expectation := &LifecycleMockReopenPullRequestExpectation{
+		mock:               mmReopenPullRequest.mock,
+		params:             &LifecycleMockReopenPullRequestParams{ctx, action},
+		expectationOrigins: LifecycleMockReopenPullRequestExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReopenPullRequest.expectations = append(mmReopenPullRequest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up lifecycle.ReopenPullRequest return parameters for the expectation previously defined by the When method
+func (e *LifecycleMockReopenPullRequestExpectation) Then(p1 domain.PullRequest, err error) *LifecycleMock {
+	e.results = &LifecycleMockReopenPullRequestResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times lifecycle.ReopenPullRequest should be invoked
+func (mmReopenPullRequest *mLifecycleMockReopenPullRequest) Times(n uint64) *mLifecycleMockReopenPullRequest {
+	if n == 0 {
+		mmReopenPullRequest.mock.t.Fatalf("Times of LifecycleMock.ReopenPullRequest mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReopenPullRequest.expectedInvocations, n)
+	mmReopenPullRequest.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReopenPullRequest
+}
+
+func (mmReopenPullRequest *mLifecycleMockReopenPullRequest) invocationsDone() bool {
+	if len(mmReopenPullRequest.expectations) == 0 && mmReopenPullRequest.defaultExpectation == nil && mmReopenPullRequest.mock.funcReopenPullRequest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReopenPullRequest.mock.afterReopenPullRequestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReopenPullRequest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReopenPullRequest implements lifecycle
+func (mmReopenPullRequest *LifecycleMock) ReopenPullRequest(ctx context.Context, action domain.PullRequestAction) (p1 domain.PullRequest, err error) {
+	mm_atomic.AddUint64(&mmReopenPullRequest.beforeReopenPullRequestCounter, 1)
+	defer mm_atomic.AddUint64(&mmReopenPullRequest.afterReopenPullRequestCounter, 1)
+
+	mmReopenPullRequest.t.Helper()
+
+	if mmReopenPullRequest.inspectFuncReopenPullRequest != nil {
+		mmReopenPullRequest.inspectFuncReopenPullRequest(ctx, action)
+	}
+
+	mm_params := LifecycleMockReopenPullRequestParams{ctx, action}
+
+	// Record call args
+	mmReopenPullRequest.ReopenPullRequestMock.mutex.Lock()
+	mmReopenPullRequest.ReopenPullRequestMock.callArgs = append(mmReopenPullRequest.ReopenPullRequestMock.callArgs, &mm_params)
+	mmReopenPullRequest.ReopenPullRequestMock.mutex.Unlock()
+
+	for _, e := range mmReopenPullRequest.ReopenPullRequestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmReopenPullRequest.ReopenPullRequestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReopenPullRequest.ReopenPullRequestMock.defaultExpectation.Counter, 1)
+		mm_want := mmReopenPullRequest.ReopenPullRequestMock.defaultExpectation.params
+		mm_want_ptrs := mmReopenPullRequest.ReopenPullRequestMock.defaultExpectation.paramPtrs
+
+		mm_got := LifecycleMockReopenPullRequestParams{ctx, action}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReopenPullRequest.t.Errorf("LifecycleMock.ReopenPullRequest got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReopenPullRequest.ReopenPullRequestMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.action != nil && !minimock.Equal(*mm_want_ptrs.action, mm_got.action) {
+				mmReopenPullRequest.t.Errorf("LifecycleMock.ReopenPullRequest got unexpected parameter action, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReopenPullRequest.ReopenPullRequestMock.defaultExpectation.expectationOrigins.originAction, *mm_want_ptrs.action, mm_got.action, minimock.Diff(*mm_want_ptrs.action, mm_got.action))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReopenPullRequest.t.Errorf("LifecycleMock.ReopenPullRequest got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReopenPullRequest.ReopenPullRequestMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReopenPullRequest.ReopenPullRequestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReopenPullRequest.t.Fatal("No results are set for the LifecycleMock.ReopenPullRequest")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmReopenPullRequest.funcReopenPullRequest != nil {
+		return mmReopenPullRequest.funcReopenPullRequest(ctx, action)
+	}
+	mmReopenPullRequest.t.Fatalf("Unexpected call to LifecycleMock.ReopenPullRequest. %v %v", ctx, action)
+	return
+}
+
+// ReopenPullRequestAfterCounter returns a count of finished LifecycleMock.ReopenPullRequest invocations
+func (mmReopenPullRequest *LifecycleMock) ReopenPullRequestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReopenPullRequest.afterReopenPullRequestCounter)
+}
+
+// ReopenPullRequestBeforeCounter returns a count of LifecycleMock.ReopenPullRequest invocations
+func (mmReopenPullRequest *LifecycleMock) ReopenPullRequestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReopenPullRequest.beforeReopenPullRequestCounter)
+}
+
+// Calls returns a list of arguments used in each call to LifecycleMock.ReopenPullRequest.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReopenPullRequest *mLifecycleMockReopenPullRequest) Calls() []*LifecycleMockReopenPullRequestParams {
+	mmReopenPullRequest.mutex.RLock()
+
+	argCopy := make([]*LifecycleMockReopenPullRequestParams, len(mmReopenPullRequest.callArgs))
+	copy(argCopy, mmReopenPullRequest.callArgs)
+
+	mmReopenPullRequest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReopenPullRequestDone returns true if the count of the ReopenPullRequest invocations corresponds
+// the number of defined expectations
+func (m *LifecycleMock) MinimockReopenPullRequestDone() bool {
+	if m.ReopenPullRequestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReopenPullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReopenPullRequestMock.invocationsDone()
+}
+
+// MinimockReopenPullRequestInspect logs each unmet expectation
+func (m *LifecycleMock) MinimockReopenPullRequestInspect() {
+	for _, e := range m.ReopenPullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LifecycleMock.ReopenPullRequest at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReopenPullRequestCounter := mm_atomic.LoadUint64(&m.afterReopenPullRequestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReopenPullRequestMock.defaultExpectation != nil && afterReopenPullRequestCounter < 1 {
+		if m.ReopenPullRequestMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LifecycleMock.ReopenPullRequest at\n%s", m.ReopenPullRequestMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LifecycleMock.ReopenPullRequest at\n%s with params: %#v", m.ReopenPullRequestMock.defaultExpectation.expectationOrigins.origin, *m.ReopenPullRequestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReopenPullRequest != nil && afterReopenPullRequestCounter < 1 {
+		m.t.Errorf("Expected call to LifecycleMock.ReopenPullRequest at\n%s", m.funcReopenPullRequestOrigin)
+	}
+
+	if !m.ReopenPullRequestMock.invocationsDone() && afterReopenPullRequestCounter > 0 {
+		m.t.Errorf("Expected %d calls to LifecycleMock.ReopenPullRequest at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReopenPullRequestMock.expectedInvocations), m.ReopenPullRequestMock.expectedInvocationsOrigin, afterReopenPullRequestCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *LifecycleMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockClosePullRequestInspect()
+
+			m.MinimockConvertToDraftInspect()
+
+			m.MinimockReadyForReviewInspect()
+
+			m.MinimockReopenPullRequestInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *LifecycleMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *LifecycleMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockClosePullRequestDone() &&
+		m.MinimockConvertToDraftDone() &&
+		m.MinimockReadyForReviewDone() &&
+		m.MinimockReopenPullRequestDone()
+}
@@ -0,0 +1,418 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package webhook
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/webhook.merger -o merger_mock_test.go -n MergerMock -p webhook
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// MergerMock implements merger
+type MergerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcMergePullRequest          func(ctx context.Context, request domain.MergePullRequest) (p1 domain.PullRequest, err error)
+	funcMergePullRequestOrigin    string
+	inspectFuncMergePullRequest   func(ctx context.Context, request domain.MergePullRequest)
+	afterMergePullRequestCounter  uint64
+	beforeMergePullRequestCounter uint64
+	MergePullRequestMock          mMergerMockMergePullRequest
+}
+
+// NewMergerMock returns a mock for merger
+func NewMergerMock(t minimock.Tester) *MergerMock {
+	m := &MergerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.MergePullRequestMock = mMergerMockMergePullRequest{mock: m}
+	m.MergePullRequestMock.callArgs = []*MergerMockMergePullRequestParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mMergerMockMergePullRequest struct {
+	optional           bool
+	mock               *MergerMock
+	defaultExpectation *MergerMockMergePullRequestExpectation
+	expectations       []*MergerMockMergePullRequestExpectation
+
+	callArgs []*MergerMockMergePullRequestParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MergerMockMergePullRequestExpectation specifies expectation struct of the merger.MergePullRequest
+type MergerMockMergePullRequestExpectation struct {
+	mock               *MergerMock
+	params             *MergerMockMergePullRequestParams
+	paramPtrs          *MergerMockMergePullRequestParamPtrs
+	expectationOrigins MergerMockMergePullRequestExpectationOrigins
+	results            *MergerMockMergePullRequestResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MergerMockMergePullRequestParams contains parameters of the merger.MergePullRequest
+type MergerMockMergePullRequestParams struct {
+	ctx     context.Context
+	request domain.MergePullRequest
+}
+
+// MergerMockMergePullRequestParamPtrs contains pointers to parameters of the merger.MergePullRequest
+type MergerMockMergePullRequestParamPtrs struct {
+	ctx     *context.Context
+	request *domain.MergePullRequest
+}
+
+// MergerMockMergePullRequestResults contains results of the merger.MergePullRequest
+type MergerMockMergePullRequestResults struct {
+	p1  domain.PullRequest
+	err error
+}
+
+// MergerMockMergePullRequestOrigins contains origins of expectations of the merger.MergePullRequest
+type MergerMockMergePullRequestExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originRequest string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMergePullRequest *mMergerMockMergePullRequest) Optional() *mMergerMockMergePullRequest {
+	mmMergePullRequest.optional = true
+	return mmMergePullRequest
+}
+
+// Expect sets up expected params for merger.MergePullRequest
+func (mmMergePullRequest *mMergerMockMergePullRequest) Expect(ctx context.Context, request domain.MergePullRequest) *mMergerMockMergePullRequest {
+	if mmMergePullRequest.mock.funcMergePullRequest != nil {
+		mmMergePullRequest.mock.t.Fatalf("MergerMock.MergePullRequest mock is already set by Set")
+	}
+
+	if mmMergePullRequest.defaultExpectation == nil {
+		mmMergePullRequest.defaultExpectation = &MergerMockMergePullRequestExpectation{}
+	}
+
+	if mmMergePullRequest.defaultExpectation.paramPtrs != nil {
+		mmMergePullRequest.mock.t.Fatalf("MergerMock.MergePullRequest mock is already set by ExpectParams functions")
+	}
+
+	mmMergePullRequest.defaultExpectation.params = &MergerMockMergePullRequestParams{ctx, request}
+	mmMergePullRequest.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMergePullRequest.expectations {
+		if minimock.Equal(e.params, mmMergePullRequest.defaultExpectation.params) {
+			mmMergePullRequest.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMergePullRequest.defaultExpectation.params)
+		}
+	}
+
+	return mmMergePullRequest
+}
+
+// ExpectCtxParam1 sets up expected param ctx for merger.MergePullRequest
+func (mmMergePullRequest *mMergerMockMergePullRequest) ExpectCtxParam1(ctx context.Context) *mMergerMockMergePullRequest {
+	if mmMergePullRequest.mock.funcMergePullRequest != nil {
+		mmMergePullRequest.mock.t.Fatalf("MergerMock.MergePullRequest mock is already set by Set")
+	}
+
+	if mmMergePullRequest.defaultExpectation == nil {
+		mmMergePullRequest.defaultExpectation = &MergerMockMergePullRequestExpectation{}
+	}
+
+	if mmMergePullRequest.defaultExpectation.params != nil {
+		mmMergePullRequest.mock.t.Fatalf("MergerMock.MergePullRequest mock is already set by Expect")
+	}
+
+	if mmMergePullRequest.defaultExpectation.paramPtrs == nil {
+		mmMergePullRequest.defaultExpectation.paramPtrs = &MergerMockMergePullRequestParamPtrs{}
+	}
+	mmMergePullRequest.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMergePullRequest.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMergePullRequest
+}
+
+// ExpectRequestParam2 sets up expected param request for merger.MergePullRequest
+func (mmMergePullRequest *mMergerMockMergePullRequest) ExpectRequestParam2(request domain.MergePullRequest) *mMergerMockMergePullRequest {
+	if mmMergePullRequest.mock.funcMergePullRequest != nil {
+		mmMergePullRequest.mock.t.Fatalf("MergerMock.MergePullRequest mock is already set by Set")
+	}
+
+	if mmMergePullRequest.defaultExpectation == nil {
+		mmMergePullRequest.defaultExpectation = &MergerMockMergePullRequestExpectation{}
+	}
+
+	if mmMergePullRequest.defaultExpectation.params != nil {
+		mmMergePullRequest.mock.t.Fatalf("MergerMock.MergePullRequest mock is already set by Expect")
+	}
+
+	if mmMergePullRequest.defaultExpectation.paramPtrs == nil {
+		mmMergePullRequest.defaultExpectation.paramPtrs = &MergerMockMergePullRequestParamPtrs{}
+	}
+	mmMergePullRequest.defaultExpectation.paramPtrs.request = &request
+	mmMergePullRequest.defaultExpectation.expectationOrigins.originRequest = minimock.CallerInfo(1)
+
+	return mmMergePullRequest
+}
+
+// Inspect accepts an inspector function that has same arguments as the merger.MergePullRequest
+func (mmMergePullRequest *mMergerMockMergePullRequest) Inspect(f func(ctx context.Context, request domain.MergePullRequest)) *mMergerMockMergePullRequest {
+	if mmMergePullRequest.mock.inspectFuncMergePullRequest != nil {
+		mmMergePullRequest.mock.t.Fatalf("Inspect function is already set for MergerMock.MergePullRequest")
+	}
+
+	mmMergePullRequest.mock.inspectFuncMergePullRequest = f
+
+	return mmMergePullRequest
+}
+
+// Return sets up results that will be returned by merger.MergePullRequest
+func (mmMergePullRequest *mMergerMockMergePullRequest) Return(p1 domain.PullRequest, err error) *MergerMock {
+	if mmMergePullRequest.mock.funcMergePullRequest != nil {
+		mmMergePullRequest.mock.t.Fatalf("MergerMock.MergePullRequest mock is already set by Set")
+	}
+
+	if mmMergePullRequest.defaultExpectation == nil {
+		mmMergePullRequest.defaultExpectation = &MergerMockMergePullRequestExpectation{mock: mmMergePullRequest.mock}
+	}
+	mmMergePullRequest.defaultExpectation.results = &MergerMockMergePullRequestResults{p1, err}
+	mmMergePullRequest.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMergePullRequest.mock
+}
+
+// Set uses given function f to mock the merger.MergePullRequest method
+func (mmMergePullRequest *mMergerMockMergePullRequest) Set(f func(ctx context.Context, request domain.MergePullRequest) (p1 domain.PullRequest, err error)) *MergerMock {
+	if mmMergePullRequest.defaultExpectation != nil {
+		mmMergePullRequest.mock.t.Fatalf("Default expectation is already set for the merger.MergePullRequest method")
+	}
+
+	if len(mmMergePullRequest.expectations) > 0 {
+		mmMergePullRequest.mock.t.Fatalf("Some expectations are already set for the merger.MergePullRequest method")
+	}
+
+	mmMergePullRequest.mock.funcMergePullRequest = f
+	mmMergePullRequest.mock.funcMergePullRequestOrigin = minimock.CallerInfo(1)
+	return mmMergePullRequest.mock
+}
+
+// When sets expectation for the merger.MergePullRequest which will trigger the result defined by the following
+// Then helper
+func (mmMergePullRequest *mMergerMockMergePullRequest) When(ctx context.Context, request domain.MergePullRequest) *MergerMockMergePullRequestExpectation {
+	if mmMergePullRequest.mock.funcMergePullRequest != nil {
+		mmMergePullRequest.mock.t.Fatalf("MergerMock.MergePullRequest mock is already set by Set")
+	}
+
+	expectation := &MergerMockMergePullRequestExpectation{
+		mock:               mmMergePullRequest.mock,
+		params:             &MergerMockMergePullRequestParams{ctx, request},
+		expectationOrigins: MergerMockMergePullRequestExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMergePullRequest.expectations = append(mmMergePullRequest.expectations, expectation)
+	return expectation
+}
+
+// Then sets up merger.MergePullRequest return parameters for the expectation previously defined by the When method
+func (e *MergerMockMergePullRequestExpectation) Then(p1 domain.PullRequest, err error) *MergerMock {
+	e.results = &MergerMockMergePullRequestResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times merger.MergePullRequest should be invoked
+func (mmMergePullRequest *mMergerMockMergePullRequest) Times(n uint64) *mMergerMockMergePullRequest {
+	if n == 0 {
+		mmMergePullRequest.mock.t.Fatalf("Times of MergerMock.MergePullRequest mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMergePullRequest.expectedInvocations, n)
+	mmMergePullRequest.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMergePullRequest
+}
+
+func (mmMergePullRequest *mMergerMockMergePullRequest) invocationsDone() bool {
+	if len(mmMergePullRequest.expectations) == 0 && mmMergePullRequest.defaultExpectation == nil && mmMergePullRequest.mock.funcMergePullRequest == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMergePullRequest.mock.afterMergePullRequestCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMergePullRequest.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MergePullRequest implements merger
+func (mmMergePullRequest *MergerMock) MergePullRequest(ctx context.Context, request domain.MergePullRequest) (p1 domain.PullRequest, err error) {
+	mm_atomic.AddUint64(&mmMergePullRequest.beforeMergePullRequestCounter, 1)
+	defer mm_atomic.AddUint64(&mmMergePullRequest.afterMergePullRequestCounter, 1)
+
+	mmMergePullRequest.t.Helper()
+
+	if mmMergePullRequest.inspectFuncMergePullRequest != nil {
+		mmMergePullRequest.inspectFuncMergePullRequest(ctx, request)
+	}
+
+	mm_params := MergerMockMergePullRequestParams{ctx, request}
+
+	// Record call args
+	mmMergePullRequest.MergePullRequestMock.mutex.Lock()
+	mmMergePullRequest.MergePullRequestMock.callArgs = append(mmMergePullRequest.MergePullRequestMock.callArgs, &mm_params)
+	mmMergePullRequest.MergePullRequestMock.mutex.Unlock()
+
+	for _, e := range mmMergePullRequest.MergePullRequestMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmMergePullRequest.MergePullRequestMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMergePullRequest.MergePullRequestMock.defaultExpectation.Counter, 1)
+		mm_want := mmMergePullRequest.MergePullRequestMock.defaultExpectation.params
+		mm_want_ptrs := mmMergePullRequest.MergePullRequestMock.defaultExpectation.paramPtrs
+
+		mm_got := MergerMockMergePullRequestParams{ctx, request}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMergePullRequest.t.Errorf("MergerMock.MergePullRequest got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergePullRequest.MergePullRequestMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.request != nil && !minimock.Equal(*mm_want_ptrs.request, mm_got.request) {
+				mmMergePullRequest.t.Errorf("MergerMock.MergePullRequest got unexpected parameter request, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergePullRequest.MergePullRequestMock.defaultExpectation.expectationOrigins.originRequest, *mm_want_ptrs.request, mm_got.request, minimock.Diff(*mm_want_ptrs.request, mm_got.request))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMergePullRequest.t.Errorf("MergerMock.MergePullRequest got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMergePullRequest.MergePullRequestMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMergePullRequest.MergePullRequestMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMergePullRequest.t.Fatal("No results are set for the MergerMock.MergePullRequest")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmMergePullRequest.funcMergePullRequest != nil {
+		return mmMergePullRequest.funcMergePullRequest(ctx, request)
+	}
+	mmMergePullRequest.t.Fatalf("Unexpected call to MergerMock.MergePullRequest. %v %v", ctx, request)
+	return
+}
+
+// MergePullRequestAfterCounter returns a count of finished MergerMock.MergePullRequest invocations
+func (mmMergePullRequest *MergerMock) MergePullRequestAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMergePullRequest.afterMergePullRequestCounter)
+}
+
+// MergePullRequestBeforeCounter returns a count of MergerMock.MergePullRequest invocations
+func (mmMergePullRequest *MergerMock) MergePullRequestBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMergePullRequest.beforeMergePullRequestCounter)
+}
+
+// Calls returns a list of arguments used in each call to MergerMock.MergePullRequest.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMergePullRequest *mMergerMockMergePullRequest) Calls() []*MergerMockMergePullRequestParams {
+	mmMergePullRequest.mutex.RLock()
+
+	argCopy := make([]*MergerMockMergePullRequestParams, len(mmMergePullRequest.callArgs))
+	copy(argCopy, mmMergePullRequest.callArgs)
+
+	mmMergePullRequest.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMergePullRequestDone returns true if the count of the MergePullRequest invocations corresponds
+// the number of defined expectations
+func (m *MergerMock) MinimockMergePullRequestDone() bool {
+	if m.MergePullRequestMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MergePullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MergePullRequestMock.invocationsDone()
+}
+
+// MinimockMergePullRequestInspect logs each unmet expectation
+func (m *MergerMock) MinimockMergePullRequestInspect() {
+	for _, e := range m.MergePullRequestMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MergerMock.MergePullRequest at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMergePullRequestCounter := mm_atomic.LoadUint64(&m.afterMergePullRequestCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MergePullRequestMock.defaultExpectation != nil && afterMergePullRequestCounter < 1 {
+		if m.MergePullRequestMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MergerMock.MergePullRequest at\n%s", m.MergePullRequestMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MergerMock.MergePullRequest at\n%s with params: %#v", m.MergePullRequestMock.defaultExpectation.expectationOrigins.origin, *m.MergePullRequestMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMergePullRequest != nil && afterMergePullRequestCounter < 1 {
+		m.t.Errorf("Expected call to MergerMock.MergePullRequest at\n%s", m.funcMergePullRequestOrigin)
+	}
+
+	if !m.MergePullRequestMock.invocationsDone() && afterMergePullRequestCounter > 0 {
+		m.t.Errorf("Expected %d calls to MergerMock.MergePullRequest at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MergePullRequestMock.expectedInvocations), m.MergePullRequestMock.expectedInvocationsOrigin, afterMergePullRequestCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *MergerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockMergePullRequestInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *MergerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *MergerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockMergePullRequestDone()
+}
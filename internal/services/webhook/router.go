@@ -0,0 +1,236 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+// Action is what a PR event of a code host does with the PR, independent of how the code host
+// names it.
+type Action string
+
+const (
+	// ActionOpen creates the PR.
+	ActionOpen Action = "open"
+	// ActionReady marks a known draft ready for review. A PR the service does not know yet, for
+	// example a draft opened before the webhook was set up, is created instead.
+	ActionReady Action = "ready_for_review"
+	// ActionDraft converts the PR back to draft.
+	ActionDraft Action = "convert_to_draft"
+	// ActionMerge records the merge done on the code host.
+	ActionMerge Action = "merge"
+	// ActionClose closes the PR without merging.
+	ActionClose Action = "close"
+	// ActionReopen reopens a closed PR.
+	ActionReopen Action = "reopen"
+)
+
+// mergeReasons are recorded on the PRs merged on a code host, where the quorum was not checked.
+var mergeReasons = map[domain.WebhookProvider]string{
+	domain.WebhookProviderGitHub: "merged on GitHub",
+	domain.WebhookProviderGitLab: "merged on GitLab",
+}
+
+type (
+	routerRepository interface {
+		deliveryRepository
+		identityRepository
+		PullRequestExists(ctx context.Context, pullRequestID string) (bool, error)
+	}
+	creator interface {
+		CreatePullRequest(ctx context.Context, pr domain.CreatePullRequest) (domain.CreatePullRequestResult, error)
+	}
+	lifecycle interface {
+		ClosePullRequest(ctx context.Context, action domain.PullRequestAction) (domain.PullRequest, error)
+		ReopenPullRequest(ctx context.Context, action domain.PullRequestAction) (domain.PullRequest, error)
+		ReadyForReview(ctx context.Context, request domain.ReadyForReview) (domain.PullRequest, error)
+		ConvertToDraft(ctx context.Context, request domain.ConvertToDraft) (domain.PullRequest, error)
+	}
+	merger interface {
+		MergePullRequest(ctx context.Context, request domain.MergePullRequest) (domain.PullRequest, error)
+	}
+	validator interface {
+		Struct(s any) error
+	}
+
+	// Event is a PR event decoded by the receiver of a code host. Accounts are the numeric account
+	// ids on the code host.
+	Event struct {
+		// Action is empty for the actions the service does not handle.
+		Action Action
+		// Skip, when set, is why the event does not apply although the action is handled. The
+		// error must be one the router was created to ignore.
+		Skip error
+
+		Repository    string
+		Number        int
+		Title         string
+		URL           string
+		Draft         bool
+		Labels        []string
+		SourceBranch  string
+		TargetBranch  string
+		HeadSHA       string
+		AuthorAccount int64
+		ActorAccount  int64
+	}
+
+	// Router applies the PR events of a code host to the pull request services, every delivery
+	// once.
+	Router struct {
+		repo        routerRepository
+		deliveries  *Deliveries
+		identities  *Identities
+		creator     creator
+		lifecycle   lifecycle
+		merger      merger
+		provider    domain.WebhookProvider
+		mergeReason string
+		logger      logger
+		validator   validator
+	}
+)
+
+// PullRequestID returns the id the PR of the event is stored under.
+func (e Event) PullRequestID() string {
+	return domain.PullRequestKey(e.Repository, strconv.Itoa(e.Number))
+}
+
+// NewRouter returns the Router of the code host. The validator is the one the HTTP handlers use,
+// so PRs created from webhooks are held to the same rules. The errors a receiver sets as
+// Event.Skip are passed as ignored, see NewDeliveries.
+func NewRouter(repo routerRepository, provider domain.WebhookProvider, creator creator, lifecycle lifecycle,
+	merger merger, logger logger, validator validator, ignored ...error,
+) *Router {
+	return &Router{
+		repo:        repo,
+		deliveries:  NewDeliveries(repo, ignored...),
+		identities:  NewIdentities(repo, provider),
+		creator:     creator,
+		lifecycle:   lifecycle,
+		merger:      merger,
+		provider:    provider,
+		mergeReason: mergeReasons[provider],
+		logger:      logger,
+		validator:   validator,
+	}
+}
+
+// Process applies the event of a verified delivery, see Deliveries.Apply. The result the receiver
+// started is completed with the PR id and the outcome.
+func (r *Router) Process(ctx context.Context, delivery domain.WebhookDelivery, result domain.WebhookResult,
+	event Event,
+) (domain.WebhookResult, error) {
+	logger := r.logger.With(
+		zap.String("service", "webhook."+string(r.provider)),
+		zap.String("requestID", domain.GetRequestID(ctx)),
+		zap.String("delivery_id", delivery.DeliveryID),
+	)
+
+	result.PullRequestID = event.PullRequestID()
+
+	return r.deliveries.Apply(ctx, logger, delivery, result, func(ctx context.Context) error {
+		return r.apply(ctx, event)
+	})
+}
+
+// apply routes the event to the service handling the action. Actions the service does not handle
+// are ErrUnsupportedAction.
+func (r *Router) apply(ctx context.Context, event Event) error {
+	if event.Skip != nil {
+		return event.Skip
+	}
+	pullRequestID := event.PullRequestID()
+
+	switch event.Action {
+	case ActionOpen:
+		return r.create(ctx, event)
+
+	case ActionReady:
+		exists, err := r.repo.PullRequestExists(ctx, pullRequestID)
+		if err != nil {
+			return fmt.Errorf("repo.PullRequestExists: %w", err)
+		}
+		if !exists {
+			return r.create(ctx, event)
+		}
+		_, err = r.lifecycle.ReadyForReview(ctx, domain.ReadyForReview{
+			PullRequestID: pullRequestID,
+			ActorID:       r.identities.Actor(ctx, event.ActorAccount),
+			Labels:        event.Labels,
+		})
+		return err
+
+	case ActionDraft:
+		_, err := r.lifecycle.ConvertToDraft(ctx, domain.ConvertToDraft{
+			PullRequestID: pullRequestID,
+			ActorID:       r.identities.Actor(ctx, event.ActorAccount),
+		})
+		return err
+
+	case ActionMerge:
+		// The PR is already merged on the code host, so the merge is recorded even if the quorum
+		// is not met.
+		_, err := r.merger.MergePullRequest(ctx, domain.MergePullRequest{
+			PullRequestID: pullRequestID,
+			Force:         true,
+			ActorID:       r.identities.Actor(ctx, event.ActorAccount),
+			Reason:        r.mergeReason,
+		})
+		return err
+
+	case ActionClose:
+		_, err := r.lifecycle.ClosePullRequest(ctx, domain.PullRequestAction{
+			PullRequestID: pullRequestID,
+			ActorID:       r.identities.Actor(ctx, event.ActorAccount),
+		})
+		return err
+
+	case ActionReopen:
+		_, err := r.lifecycle.ReopenPullRequest(ctx, domain.PullRequestAction{
+			PullRequestID: pullRequestID,
+			ActorID:       r.identities.Actor(ctx, event.ActorAccount),
+		})
+		return err
+
+	default:
+		return ErrUnsupportedAction
+	}
+}
+
+// create creates the PR of the event with the author it is mapped to. A PR the HTTP handler would
+// reject, such as one with an overlong title, is domain.ErrInvalidWebhookPayload and is not
+// recorded.
+func (r *Router) create(ctx context.Context, event Event) error {
+	authorID, err := r.identities.Author(ctx, event.AuthorAccount)
+	if err != nil {
+		return err
+	}
+
+	pr := domain.CreatePullRequest{
+		PullRequestID:   strconv.Itoa(event.Number),
+		PullRequestName: event.Title,
+		AuthorID:        authorID,
+		Labels:          event.Labels,
+		Draft:           event.Draft,
+		Repository:      event.Repository,
+		SourceBranch:    event.SourceBranch,
+		TargetBranch:    event.TargetBranch,
+		URL:             event.URL,
+		HeadSHA:         event.HeadSHA,
+	}
+	if err = r.validator.Struct(pr); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidWebhookPayload, err)
+	}
+	if err = pr.ValidateKeys(); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidWebhookPayload, err)
+	}
+
+	_, err = r.creator.CreatePullRequest(ctx, pr)
+	return err
+}
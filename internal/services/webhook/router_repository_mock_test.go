@@ -0,0 +1,1516 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package webhook
+
+//go:generate minimock -i github.com/AndrejDubinin/review-assigner/internal/services/webhook.routerRepository -o router_repository_mock_test.go -n RouterRepositoryMock -p webhook
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RouterRepositoryMock implements routerRepository
+type RouterRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetUserIDByIdentity          func(ctx context.Context, provider domain.WebhookProvider, externalID string) (s1 string, err error)
+	funcGetUserIDByIdentityOrigin    string
+	inspectFuncGetUserIDByIdentity   func(ctx context.Context, provider domain.WebhookProvider, externalID string)
+	afterGetUserIDByIdentityCounter  uint64
+	beforeGetUserIDByIdentityCounter uint64
+	GetUserIDByIdentityMock          mRouterRepositoryMockGetUserIDByIdentity
+
+	funcPullRequestExists          func(ctx context.Context, pullRequestID string) (b1 bool, err error)
+	funcPullRequestExistsOrigin    string
+	inspectFuncPullRequestExists   func(ctx context.Context, pullRequestID string)
+	afterPullRequestExistsCounter  uint64
+	beforePullRequestExistsCounter uint64
+	PullRequestExistsMock          mRouterRepositoryMockPullRequestExists
+
+	funcRecordWebhookDelivery          func(ctx context.Context, delivery domain.WebhookDelivery) (err error)
+	funcRecordWebhookDeliveryOrigin    string
+	inspectFuncRecordWebhookDelivery   func(ctx context.Context, delivery domain.WebhookDelivery)
+	afterRecordWebhookDeliveryCounter  uint64
+	beforeRecordWebhookDeliveryCounter uint64
+	RecordWebhookDeliveryMock          mRouterRepositoryMockRecordWebhookDelivery
+
+	funcWebhookDeliveryExists          func(ctx context.Context, delivery domain.WebhookDelivery) (b1 bool, err error)
+	funcWebhookDeliveryExistsOrigin    string
+	inspectFuncWebhookDeliveryExists   func(ctx context.Context, delivery domain.WebhookDelivery)
+	afterWebhookDeliveryExistsCounter  uint64
+	beforeWebhookDeliveryExistsCounter uint64
+	WebhookDeliveryExistsMock          mRouterRepositoryMockWebhookDeliveryExists
+}
+
+// NewRouterRepositoryMock returns a mock for routerRepository
+func NewRouterRepositoryMock(t minimock.Tester) *RouterRepositoryMock {
+	m := &RouterRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetUserIDByIdentityMock = mRouterRepositoryMockGetUserIDByIdentity{mock: m}
+	m.GetUserIDByIdentityMock.callArgs = []*RouterRepositoryMockGetUserIDByIdentityParams{}
+
+	m.PullRequestExistsMock = mRouterRepositoryMockPullRequestExists{mock: m}
+	m.PullRequestExistsMock.callArgs = []*RouterRepositoryMockPullRequestExistsParams{}
+
+	m.RecordWebhookDeliveryMock = mRouterRepositoryMockRecordWebhookDelivery{mock: m}
+	m.RecordWebhookDeliveryMock.callArgs = []*RouterRepositoryMockRecordWebhookDeliveryParams{}
+
+	m.WebhookDeliveryExistsMock = mRouterRepositoryMockWebhookDeliveryExists{mock: m}
+	m.WebhookDeliveryExistsMock.callArgs = []*RouterRepositoryMockWebhookDeliveryExistsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRouterRepositoryMockGetUserIDByIdentity struct {
+	optional           bool
+	mock               *RouterRepositoryMock
+	defaultExpectation *RouterRepositoryMockGetUserIDByIdentityExpectation
+	expectations       []*RouterRepositoryMockGetUserIDByIdentityExpectation
+
+	callArgs []*RouterRepositoryMockGetUserIDByIdentityParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RouterRepositoryMockGetUserIDByIdentityExpectation specifies expectation struct of the routerRepository.GetUserIDByIdentity
+type RouterRepositoryMockGetUserIDByIdentityExpectation struct {
+	mock               *RouterRepositoryMock
+	params             *RouterRepositoryMockGetUserIDByIdentityParams
+	paramPtrs          *RouterRepositoryMockGetUserIDByIdentityParamPtrs
+	expectationOrigins RouterRepositoryMockGetUserIDByIdentityExpectationOrigins
+	results            *RouterRepositoryMockGetUserIDByIdentityResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RouterRepositoryMockGetUserIDByIdentityParams contains parameters of the routerRepository.GetUserIDByIdentity
+type RouterRepositoryMockGetUserIDByIdentityParams struct {
+	ctx        context.Context
+	provider   domain.WebhookProvider
+	externalID string
+}
+
+// RouterRepositoryMockGetUserIDByIdentityParamPtrs contains pointers to parameters of the routerRepository.GetUserIDByIdentity
+type RouterRepositoryMockGetUserIDByIdentityParamPtrs struct {
+	ctx        *context.Context
+	provider   *domain.WebhookProvider
+	externalID *string
+}
+
+// RouterRepositoryMockGetUserIDByIdentityResults contains results of the routerRepository.GetUserIDByIdentity
+type RouterRepositoryMockGetUserIDByIdentityResults struct {
+	s1  string
+	err error
+}
+
+// RouterRepositoryMockGetUserIDByIdentityOrigins contains origins of expectations of the routerRepository.GetUserIDByIdentity
+type RouterRepositoryMockGetUserIDByIdentityExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originProvider   string
+	originExternalID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserIDByIdentity *mRouterRepositoryMockGetUserIDByIdentity) Optional() *mRouterRepositoryMockGetUserIDByIdentity {
+	mmGetUserIDByIdentity.optional = true
+	return mmGetUserIDByIdentity
+}
+
+// Expect sets up expected params for routerRepository.GetUserIDByIdentity
+func (mmGetUserIDByIdentity *mRouterRepositoryMockGetUserIDByIdentity) Expect(ctx context.Context, provider domain.WebhookProvider, externalID string) *mRouterRepositoryMockGetUserIDByIdentity {
+	if mmGetUserIDByIdentity.mock.funcGetUserIDByIdentity != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("RouterRepositoryMock.GetUserIDByIdentity mock is already set by Set")
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation == nil {
+		mmGetUserIDByIdentity.defaultExpectation = &RouterRepositoryMockGetUserIDByIdentityExpectation{}
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation.paramPtrs != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("RouterRepositoryMock.GetUserIDByIdentity mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserIDByIdentity.defaultExpectation.params = &RouterRepositoryMockGetUserIDByIdentityParams{ctx, provider, externalID}
+	mmGetUserIDByIdentity.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserIDByIdentity.expectations {
+		if minimock.Equal(e.params, mmGetUserIDByIdentity.defaultExpectation.params) {
+			mmGetUserIDByIdentity.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserIDByIdentity.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserIDByIdentity
+}
+
+// ExpectCtxParam1 sets up expected param ctx for routerRepository.GetUserIDByIdentity
+func (mmGetUserIDByIdentity *mRouterRepositoryMockGetUserIDByIdentity) ExpectCtxParam1(ctx context.Context) *mRouterRepositoryMockGetUserIDByIdentity {
+	if mmGetUserIDByIdentity.mock.funcGetUserIDByIdentity != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("RouterRepositoryMock.GetUserIDByIdentity mock is already set by Set")
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation == nil {
+		mmGetUserIDByIdentity.defaultExpectation = &RouterRepositoryMockGetUserIDByIdentityExpectation{}
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation.params != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("RouterRepositoryMock.GetUserIDByIdentity mock is already set by Expect")
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation.paramPtrs == nil {
+		mmGetUserIDByIdentity.defaultExpectation.paramPtrs = &RouterRepositoryMockGetUserIDByIdentityParamPtrs{}
+	}
+	mmGetUserIDByIdentity.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserIDByIdentity.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserIDByIdentity
+}
+
+// ExpectProviderParam2 sets up expected param provider for routerRepository.GetUserIDByIdentity
+func (mmGetUserIDByIdentity *mRouterRepositoryMockGetUserIDByIdentity) ExpectProviderParam2(provider domain.WebhookProvider) *mRouterRepositoryMockGetUserIDByIdentity {
+	if mmGetUserIDByIdentity.mock.funcGetUserIDByIdentity != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("RouterRepositoryMock.GetUserIDByIdentity mock is already set by Set")
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation == nil {
+		mmGetUserIDByIdentity.defaultExpectation = &RouterRepositoryMockGetUserIDByIdentityExpectation{}
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation.params != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("RouterRepositoryMock.GetUserIDByIdentity mock is already set by Expect")
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation.paramPtrs == nil {
+		mmGetUserIDByIdentity.defaultExpectation.paramPtrs = &RouterRepositoryMockGetUserIDByIdentityParamPtrs{}
+	}
+	mmGetUserIDByIdentity.defaultExpectation.paramPtrs.provider = &provider
+	mmGetUserIDByIdentity.defaultExpectation.expectationOrigins.originProvider = minimock.CallerInfo(1)
+
+	return mmGetUserIDByIdentity
+}
+
+// ExpectExternalIDParam3 sets up expected param externalID for routerRepository.GetUserIDByIdentity
+func (mmGetUserIDByIdentity *mRouterRepositoryMockGetUserIDByIdentity) ExpectExternalIDParam3(externalID string) *mRouterRepositoryMockGetUserIDByIdentity {
+	if mmGetUserIDByIdentity.mock.funcGetUserIDByIdentity != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("RouterRepositoryMock.GetUserIDByIdentity mock is already set by Set")
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation == nil {
+		mmGetUserIDByIdentity.defaultExpectation = &RouterRepositoryMockGetUserIDByIdentityExpectation{}
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation.params != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("RouterRepositoryMock.GetUserIDByIdentity mock is already set by Expect")
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation.paramPtrs == nil {
+		mmGetUserIDByIdentity.defaultExpectation.paramPtrs = &RouterRepositoryMockGetUserIDByIdentityParamPtrs{}
+	}
+	mmGetUserIDByIdentity.defaultExpectation.paramPtrs.externalID = &externalID
+	mmGetUserIDByIdentity.defaultExpectation.expectationOrigins.originExternalID = minimock.CallerInfo(1)
+
+	return mmGetUserIDByIdentity
+}
+
+// Inspect accepts an inspector function that has same arguments as the routerRepository.GetUserIDByIdentity
+func (mmGetUserIDByIdentity *mRouterRepositoryMockGetUserIDByIdentity) Inspect(f func(ctx context.Context, provider domain.WebhookProvider, externalID string)) *mRouterRepositoryMockGetUserIDByIdentity {
+	if mmGetUserIDByIdentity.mock.inspectFuncGetUserIDByIdentity != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("Inspect function is already set for RouterRepositoryMock.GetUserIDByIdentity")
+	}
+
+	mmGetUserIDByIdentity.mock.inspectFuncGetUserIDByIdentity = f
+
+	return mmGetUserIDByIdentity
+}
+
+// Return sets up results that will be returned by routerRepository.GetUserIDByIdentity
+func (mmGetUserIDByIdentity *mRouterRepositoryMockGetUserIDByIdentity) Return(s1 string, err error) *RouterRepositoryMock {
+	if mmGetUserIDByIdentity.mock.funcGetUserIDByIdentity != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("RouterRepositoryMock.GetUserIDByIdentity mock is already set by Set")
+	}
+
+	if mmGetUserIDByIdentity.defaultExpectation == nil {
+		mmGetUserIDByIdentity.defaultExpectation = &RouterRepositoryMockGetUserIDByIdentityExpectation{mock: mmGetUserIDByIdentity.mock}
+	}
+	mmGetUserIDByIdentity.defaultExpectation.results = &RouterRepositoryMockGetUserIDByIdentityResults{s1, err}
+	mmGetUserIDByIdentity.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserIDByIdentity.mock
+}
+
+// Set uses given function f to mock the routerRepository.GetUserIDByIdentity method
+func (mmGetUserIDByIdentity *mRouterRepositoryMockGetUserIDByIdentity) Set(f func(ctx context.Context, provider domain.WebhookProvider, externalID string) (s1 string, err error)) *RouterRepositoryMock {
+	if mmGetUserIDByIdentity.defaultExpectation != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("Default expectation is already set for the routerRepository.GetUserIDByIdentity method")
+	}
+
+	if len(mmGetUserIDByIdentity.expectations) > 0 {
+		mmGetUserIDByIdentity.mock.t.Fatalf("Some expectations are already set for the routerRepository.GetUserIDByIdentity method")
+	}
+
+	mmGetUserIDByIdentity.mock.funcGetUserIDByIdentity = f
+	mmGetUserIDByIdentity.mock.funcGetUserIDByIdentityOrigin = minimock.CallerInfo(1)
+	return mmGetUserIDByIdentity.mock
+}
+
+// When sets expectation for the routerRepository.GetUserIDByIdentity which will trigger the result defined by the following
+// Then helper
+func (mmGetUserIDByIdentity *mRouterRepositoryMockGetUserIDByIdentity) When(ctx context.Context, provider domain.WebhookProvider, externalID string) *RouterRepositoryMockGetUserIDByIdentityExpectation {
+	if mmGetUserIDByIdentity.mock.funcGetUserIDByIdentity != nil {
+		mmGetUserIDByIdentity.mock.t.Fatalf("RouterRepositoryMock.GetUserIDByIdentity mock is already set by Set")
+	}
+
+	expectation := &RouterRepositoryMockGetUserIDByIdentityExpectation{
+		mock:               mmGetUserIDByIdentity.mock,
+		params:             &RouterRepositoryMockGetUserIDByIdentityParams{ctx, provider, externalID},
+		expectationOrigins: RouterRepositoryMockGetUserIDByIdentityExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserIDByIdentity.expectations = append(mmGetUserIDByIdentity.expectations, expectation)
+	return expectation
+}
+
+// Then sets up routerRepository.GetUserIDByIdentity return parameters for the expectation previously defined by the When method
+func (e *RouterRepositoryMockGetUserIDByIdentityExpectation) Then(s1 string, err error) *RouterRepositoryMock {
+	e.results = &RouterRepositoryMockGetUserIDByIdentityResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times routerRepository.GetUserIDByIdentity should be invoked
+func (mmGetUserIDByIdentity *mRouterRepositoryMockGetUserIDByIdentity) Times(n uint64) *mRouterRepositoryMockGetUserIDByIdentity {
+	if n == 0 {
+		mmGetUserIDByIdentity.mock.t.Fatalf("Times of RouterRepositoryMock.GetUserIDByIdentity mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserIDByIdentity.expectedInvocations, n)
+	mmGetUserIDByIdentity.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserIDByIdentity
+}
+
+func (mmGetUserIDByIdentity *mRouterRepositoryMockGetUserIDByIdentity) invocationsDone() bool {
+	if len(mmGetUserIDByIdentity.expectations) == 0 && mmGetUserIDByIdentity.defaultExpectation == nil && mmGetUserIDByIdentity.mock.funcGetUserIDByIdentity == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserIDByIdentity.mock.afterGetUserIDByIdentityCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserIDByIdentity.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserIDByIdentity implements routerRepository
+func (mmGetUserIDByIdentity *RouterRepositoryMock) GetUserIDByIdentity(ctx context.Context, provider domain.WebhookProvider, externalID string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGetUserIDByIdentity.beforeGetUserIDByIdentityCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserIDByIdentity.afterGetUserIDByIdentityCounter, 1)
+
+	mmGetUserIDByIdentity.t.Helper()
+
+	if mmGetUserIDByIdentity.inspectFuncGetUserIDByIdentity != nil {
+		mmGetUserIDByIdentity.inspectFuncGetUserIDByIdentity(ctx, provider, externalID)
+	}
+
+	mm_params := RouterRepositoryMockGetUserIDByIdentityParams{ctx, provider, externalID}
+
+	// Record call args
+	mmGetUserIDByIdentity.GetUserIDByIdentityMock.mutex.Lock()
+	mmGetUserIDByIdentity.GetUserIDByIdentityMock.callArgs = append(mmGetUserIDByIdentity.GetUserIDByIdentityMock.callArgs, &mm_params)
+	mmGetUserIDByIdentity.GetUserIDByIdentityMock.mutex.Unlock()
+
+	for _, e := range mmGetUserIDByIdentity.GetUserIDByIdentityMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetUserIDByIdentity.GetUserIDByIdentityMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserIDByIdentity.GetUserIDByIdentityMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserIDByIdentity.GetUserIDByIdentityMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserIDByIdentity.GetUserIDByIdentityMock.defaultExpectation.paramPtrs
+
+		mm_got := RouterRepositoryMockGetUserIDByIdentityParams{ctx, provider, externalID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserIDByIdentity.t.Errorf("RouterRepositoryMock.GetUserIDByIdentity got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserIDByIdentity.GetUserIDByIdentityMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.provider != nil && !minimock.Equal(*mm_want_ptrs.provider, mm_got.provider) {
+				mmGetUserIDByIdentity.t.Errorf("RouterRepositoryMock.GetUserIDByIdentity got unexpected parameter provider, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserIDByIdentity.GetUserIDByIdentityMock.defaultExpectation.expectationOrigins.originProvider, *mm_want_ptrs.provider, mm_got.provider, minimock.Diff(*mm_want_ptrs.provider, mm_got.provider))
+			}
+
+			if mm_want_ptrs.externalID != nil && !minimock.Equal(*mm_want_ptrs.externalID, mm_got.externalID) {
+				mmGetUserIDByIdentity.t.Errorf("RouterRepositoryMock.GetUserIDByIdentity got unexpected parameter externalID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserIDByIdentity.GetUserIDByIdentityMock.defaultExpectation.expectationOrigins.originExternalID, *mm_want_ptrs.externalID, mm_got.externalID, minimock.Diff(*mm_want_ptrs.externalID, mm_got.externalID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserIDByIdentity.t.Errorf("RouterRepositoryMock.GetUserIDByIdentity got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserIDByIdentity.GetUserIDByIdentityMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserIDByIdentity.GetUserIDByIdentityMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserIDByIdentity.t.Fatal("No results are set for the RouterRepositoryMock.GetUserIDByIdentity")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetUserIDByIdentity.funcGetUserIDByIdentity != nil {
+		return mmGetUserIDByIdentity.funcGetUserIDByIdentity(ctx, provider, externalID)
+	}
+	mmGetUserIDByIdentity.t.Fatalf("Unexpected call to RouterRepositoryMock.GetUserIDByIdentity. %v %v %v", ctx, provider, externalID)
+	return
+}
+
+// GetUserIDByIdentityAfterCounter returns a count of finished RouterRepositoryMock.GetUserIDByIdentity invocations
+func (mmGetUserIDByIdentity *RouterRepositoryMock) GetUserIDByIdentityAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserIDByIdentity.afterGetUserIDByIdentityCounter)
+}
+
+// GetUserIDByIdentityBeforeCounter returns a count of RouterRepositoryMock.GetUserIDByIdentity invocations
+func (mmGetUserIDByIdentity *RouterRepositoryMock) GetUserIDByIdentityBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserIDByIdentity.beforeGetUserIDByIdentityCounter)
+}
+
+// Calls returns a list of arguments used in each call to RouterRepositoryMock.GetUserIDByIdentity.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserIDByIdentity *mRouterRepositoryMockGetUserIDByIdentity) Calls() []*RouterRepositoryMockGetUserIDByIdentityParams {
+	mmGetUserIDByIdentity.mutex.RLock()
+
+	argCopy := make([]*RouterRepositoryMockGetUserIDByIdentityParams, len(mmGetUserIDByIdentity.callArgs))
+	copy(argCopy, mmGetUserIDByIdentity.callArgs)
+
+	mmGetUserIDByIdentity.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserIDByIdentityDone returns true if the count of the GetUserIDByIdentity invocations corresponds
+// the number of defined expectations
+func (m *RouterRepositoryMock) MinimockGetUserIDByIdentityDone() bool {
+	if m.GetUserIDByIdentityMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserIDByIdentityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserIDByIdentityMock.invocationsDone()
+}
+
+// MinimockGetUserIDByIdentityInspect logs each unmet expectation
+func (m *RouterRepositoryMock) MinimockGetUserIDByIdentityInspect() {
+	for _, e := range m.GetUserIDByIdentityMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RouterRepositoryMock.GetUserIDByIdentity at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserIDByIdentityCounter := mm_atomic.LoadUint64(&m.afterGetUserIDByIdentityCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserIDByIdentityMock.defaultExpectation != nil && afterGetUserIDByIdentityCounter < 1 {
+		if m.GetUserIDByIdentityMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RouterRepositoryMock.GetUserIDByIdentity at\n%s", m.GetUserIDByIdentityMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RouterRepositoryMock.GetUserIDByIdentity at\n%s with params: %#v", m.GetUserIDByIdentityMock.defaultExpectation.expectationOrigins.origin, *m.GetUserIDByIdentityMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserIDByIdentity != nil && afterGetUserIDByIdentityCounter < 1 {
+		m.t.Errorf("Expected call to RouterRepositoryMock.GetUserIDByIdentity at\n%s", m.funcGetUserIDByIdentityOrigin)
+	}
+
+	if !m.GetUserIDByIdentityMock.invocationsDone() && afterGetUserIDByIdentityCounter > 0 {
+		m.t.Errorf("Expected %d calls to RouterRepositoryMock.GetUserIDByIdentity at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserIDByIdentityMock.expectedInvocations), m.GetUserIDByIdentityMock.expectedInvocationsOrigin, afterGetUserIDByIdentityCounter)
+	}
+}
+
+type mRouterRepositoryMockPullRequestExists struct {
+	optional           bool
+	mock               *RouterRepositoryMock
+	defaultExpectation *RouterRepositoryMockPullRequestExistsExpectation
+	expectations       []*RouterRepositoryMockPullRequestExistsExpectation
+
+	callArgs []*RouterRepositoryMockPullRequestExistsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RouterRepositoryMockPullRequestExistsExpectation specifies expectation struct of the routerRepository.PullRequestExists
+type RouterRepositoryMockPullRequestExistsExpectation struct {
+	mock               *RouterRepositoryMock
+	params             *RouterRepositoryMockPullRequestExistsParams
+	paramPtrs          *RouterRepositoryMockPullRequestExistsParamPtrs
+	expectationOrigins RouterRepositoryMockPullRequestExistsExpectationOrigins
+	results            *RouterRepositoryMockPullRequestExistsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RouterRepositoryMockPullRequestExistsParams contains parameters of the routerRepository.PullRequestExists
+type RouterRepositoryMockPullRequestExistsParams struct {
+	ctx           context.Context
+	pullRequestID string
+}
+
+// RouterRepositoryMockPullRequestExistsParamPtrs contains pointers to parameters of the routerRepository.PullRequestExists
+type RouterRepositoryMockPullRequestExistsParamPtrs struct {
+	ctx           *context.Context
+	pullRequestID *string
+}
+
+// RouterRepositoryMockPullRequestExistsResults contains results of the routerRepository.PullRequestExists
+type RouterRepositoryMockPullRequestExistsResults struct {
+	b1  bool
+	err error
+}
+
+// RouterRepositoryMockPullRequestExistsOrigins contains origins of expectations of the routerRepository.PullRequestExists
+type RouterRepositoryMockPullRequestExistsExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originPullRequestID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPullRequestExists *mRouterRepositoryMockPullRequestExists) Optional() *mRouterRepositoryMockPullRequestExists {
+	mmPullRequestExists.optional = true
+	return mmPullRequestExists
+}
+
+// Expect sets up expected params for routerRepository.PullRequestExists
+func (mmPullRequestExists *mRouterRepositoryMockPullRequestExists) Expect(ctx context.Context, pullRequestID string) *mRouterRepositoryMockPullRequestExists {
+	if mmPullRequestExists.mock.funcPullRequestExists != nil {
+		mmPullRequestExists.mock.t.Fatalf("RouterRepositoryMock.PullRequestExists mock is already set by Set")
+	}
+
+	if mmPullRequestExists.defaultExpectation == nil {
+		mmPullRequestExists.defaultExpectation = &RouterRepositoryMockPullRequestExistsExpectation{}
+	}
+
+	if mmPullRequestExists.defaultExpectation.paramPtrs != nil {
+		mmPullRequestExists.mock.t.Fatalf("RouterRepositoryMock.PullRequestExists mock is already set by ExpectParams functions")
+	}
+
+	mmPullRequestExists.defaultExpectation.params = &RouterRepositoryMockPullRequestExistsParams{ctx, pullRequestID}
+	mmPullRequestExists.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPullRequestExists.expectations {
+		if minimock.Equal(e.params, mmPullRequestExists.defaultExpectation.params) {
+			mmPullRequestExists.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPullRequestExists.defaultExpectation.params)
+		}
+	}
+
+	return mmPullRequestExists
+}
+
+// ExpectCtxParam1 sets up expected param ctx for routerRepository.PullRequestExists
+func (mmPullRequestExists *mRouterRepositoryMockPullRequestExists) ExpectCtxParam1(ctx context.Context) *mRouterRepositoryMockPullRequestExists {
+	if mmPullRequestExists.mock.funcPullRequestExists != nil {
+		mmPullRequestExists.mock.t.Fatalf("RouterRepositoryMock.PullRequestExists mock is already set by Set")
+	}
+
+	if mmPullRequestExists.defaultExpectation == nil {
+		mmPullRequestExists.defaultExpectation = &RouterRepositoryMockPullRequestExistsExpectation{}
+	}
+
+	if mmPullRequestExists.defaultExpectation.params != nil {
+		mmPullRequestExists.mock.t.Fatalf("RouterRepositoryMock.PullRequestExists mock is already set by Expect")
+	}
+
+	if mmPullRequestExists.defaultExpectation.paramPtrs == nil {
+		mmPullRequestExists.defaultExpectation.paramPtrs = &RouterRepositoryMockPullRequestExistsParamPtrs{}
+	}
+	mmPullRequestExists.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPullRequestExists.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPullRequestExists
+}
+
+// ExpectPullRequestIDParam2 sets up expected param pullRequestID for routerRepository.PullRequestExists
+func (mmPullRequestExists *mRouterRepositoryMockPullRequestExists) ExpectPullRequestIDParam2(pullRequestID string) *mRouterRepositoryMockPullRequestExists {
+	if mmPullRequestExists.mock.funcPullRequestExists != nil {
+		mmPullRequestExists.mock.t.Fatalf("RouterRepositoryMock.PullRequestExists mock is already set by Set")
+	}
+
+	if mmPullRequestExists.defaultExpectation == nil {
+		mmPullRequestExists.defaultExpectation = &RouterRepositoryMockPullRequestExistsExpectation{}
+	}
+
+	if mmPullRequestExists.defaultExpectation.params != nil {
+		mmPullRequestExists.mock.t.Fatalf("RouterRepositoryMock.PullRequestExists mock is already set by Expect")
+	}
+
+	if mmPullRequestExists.defaultExpectation.paramPtrs == nil {
+		mmPullRequestExists.defaultExpectation.paramPtrs = &RouterRepositoryMockPullRequestExistsParamPtrs{}
+	}
+	mmPullRequestExists.defaultExpectation.paramPtrs.pullRequestID = &pullRequestID
+	mmPullRequestExists.defaultExpectation.expectationOrigins.originPullRequestID = minimock.CallerInfo(1)
+
+	return mmPullRequestExists
+}
+
+// Inspect accepts an inspector function that has same arguments as the routerRepository.PullRequestExists
+func (mmPullRequestExists *mRouterRepositoryMockPullRequestExists) Inspect(f func(ctx context.Context, pullRequestID string)) *mRouterRepositoryMockPullRequestExists {
+	if mmPullRequestExists.mock.inspectFuncPullRequestExists != nil {
+		mmPullRequestExists.mock.t.Fatalf("Inspect function is already set for RouterRepositoryMock.PullRequestExists")
+	}
+
+	mmPullRequestExists.mock.inspectFuncPullRequestExists = f
+
+	return mmPullRequestExists
+}
+
+// Return sets up results that will be returned by routerRepository.PullRequestExists
+func (mmPullRequestExists *mRouterRepositoryMockPullRequestExists) Return(b1 bool, err error) *RouterRepositoryMock {
+	if mmPullRequestExists.mock.funcPullRequestExists != nil {
+		mmPullRequestExists.mock.t.Fatalf("RouterRepositoryMock.PullRequestExists mock is already set by Set")
+	}
+
+	if mmPullRequestExists.defaultExpectation == nil {
+		mmPullRequestExists.defaultExpectation = &RouterRepositoryMockPullRequestExistsExpectation{mock: mmPullRequestExists.mock}
+	}
+	mmPullRequestExists.defaultExpectation.results = &RouterRepositoryMockPullRequestExistsResults{b1, err}
+	mmPullRequestExists.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPullRequestExists.mock
+}
+
+// Set uses given function f to mock the routerRepository.PullRequestExists method
+func (mmPullRequestExists *mRouterRepositoryMockPullRequestExists) Set(f func(ctx context.Context, pullRequestID string) (b1 bool, err error)) *RouterRepositoryMock {
+	if mmPullRequestExists.defaultExpectation != nil {
+		mmPullRequestExists.mock.t.Fatalf("Default expectation is already set for the routerRepository.PullRequestExists method")
+	}
+
+	if len(mmPullRequestExists.expectations) > 0 {
+		mmPullRequestExists.mock.t.Fatalf("Some expectations are already set for the routerRepository.PullRequestExists method")
+	}
+
+	mmPullRequestExists.mock.funcPullRequestExists = f
+	mmPullRequestExists.mock.funcPullRequestExistsOrigin = minimock.CallerInfo(1)
+	return mmPullRequestExists.mock
+}
+
+// When sets expectation for the routerRepository.PullRequestExists which will trigger the result defined by the following
+// Then helper
+func (mmPullRequestExists *mRouterRepositoryMockPullRequestExists) When(ctx context.Context, pullRequestID string) *RouterRepositoryMockPullRequestExistsExpectation {
+	if mmPullRequestExists.mock.funcPullRequestExists != nil {
+		mmPullRequestExists.mock.t.Fatalf("RouterRepositoryMock.PullRequestExists mock is already set by Set")
+	}
+
+	expectation := &RouterRepositoryMockPullRequestExistsExpectation{
+		mock:               mmPullRequestExists.mock,
+		params:             &RouterRepositoryMockPullRequestExistsParams{ctx, pullRequestID},
+		expectationOrigins: RouterRepositoryMockPullRequestExistsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPullRequestExists.expectations = append(mmPullRequestExists.expectations, expectation)
+	return expectation
+}
+
+// Then sets up routerRepository.PullRequestExists return parameters for the expectation previously defined by the When method
+func (e *RouterRepositoryMockPullRequestExistsExpectation) Then(b1 bool, err error) *RouterRepositoryMock {
+	e.results = &RouterRepositoryMockPullRequestExistsResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times routerRepository.PullRequestExists should be invoked
+func (mmPullRequestExists *mRouterRepositoryMockPullRequestExists) Times(n uint64) *mRouterRepositoryMockPullRequestExists {
+	if n == 0 {
+		mmPullRequestExists.mock.t.Fatalf("Times of RouterRepositoryMock.PullRequestExists mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPullRequestExists.expectedInvocations, n)
+	mmPullRequestExists.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPullRequestExists
+}
+
+func (mmPullRequestExists *mRouterRepositoryMockPullRequestExists) invocationsDone() bool {
+	if len(mmPullRequestExists.expectations) == 0 && mmPullRequestExists.defaultExpectation == nil && mmPullRequestExists.mock.funcPullRequestExists == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPullRequestExists.mock.afterPullRequestExistsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPullRequestExists.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PullRequestExists implements routerRepository
+func (mmPullRequestExists *RouterRepositoryMock) PullRequestExists(ctx context.Context, pullRequestID string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmPullRequestExists.beforePullRequestExistsCounter, 1)
+	defer mm_atomic.AddUint64(&mmPullRequestExists.afterPullRequestExistsCounter, 1)
+
+	mmPullRequestExists.t.Helper()
+
+	if mmPullRequestExists.inspectFuncPullRequestExists != nil {
+		mmPullRequestExists.inspectFuncPullRequestExists(ctx, pullRequestID)
+	}
+
+	mm_params := RouterRepositoryMockPullRequestExistsParams{ctx, pullRequestID}
+
+	// Record call args
+	mmPullRequestExists.PullRequestExistsMock.mutex.Lock()
+	mmPullRequestExists.PullRequestExistsMock.callArgs = append(mmPullRequestExists.PullRequestExistsMock.callArgs, &mm_params)
+	mmPullRequestExists.PullRequestExistsMock.mutex.Unlock()
+
+	for _, e := range mmPullRequestExists.PullRequestExistsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmPullRequestExists.PullRequestExistsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPullRequestExists.PullRequestExistsMock.defaultExpectation.Counter, 1)
+		mm_want := mmPullRequestExists.PullRequestExistsMock.defaultExpectation.params
+		mm_want_ptrs := mmPullRequestExists.PullRequestExistsMock.defaultExpectation.paramPtrs
+
+		mm_got := RouterRepositoryMockPullRequestExistsParams{ctx, pullRequestID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPullRequestExists.t.Errorf("RouterRepositoryMock.PullRequestExists got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPullRequestExists.PullRequestExistsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pullRequestID != nil && !minimock.Equal(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID) {
+				mmPullRequestExists.t.Errorf("RouterRepositoryMock.PullRequestExists got unexpected parameter pullRequestID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPullRequestExists.PullRequestExistsMock.defaultExpectation.expectationOrigins.originPullRequestID, *mm_want_ptrs.pullRequestID, mm_got.pullRequestID, minimock.Diff(*mm_want_ptrs.pullRequestID, mm_got.pullRequestID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPullRequestExists.t.Errorf("RouterRepositoryMock.PullRequestExists got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPullRequestExists.PullRequestExistsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPullRequestExists.PullRequestExistsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPullRequestExists.t.Fatal("No results are set for the RouterRepositoryMock.PullRequestExists")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmPullRequestExists.funcPullRequestExists != nil {
+		return mmPullRequestExists.funcPullRequestExists(ctx, pullRequestID)
+	}
+	mmPullRequestExists.t.Fatalf("Unexpected call to RouterRepositoryMock.PullRequestExists. %v %v", ctx, pullRequestID)
+	return
+}
+
+// PullRequestExistsAfterCounter returns a count of finished RouterRepositoryMock.PullRequestExists invocations
+func (mmPullRequestExists *RouterRepositoryMock) PullRequestExistsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPullRequestExists.afterPullRequestExistsCounter)
+}
+
+// PullRequestExistsBeforeCounter returns a count of RouterRepositoryMock.PullRequestExists invocations
+func (mmPullRequestExists *RouterRepositoryMock) PullRequestExistsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPullRequestExists.beforePullRequestExistsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RouterRepositoryMock.PullRequestExists.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPullRequestExists *mRouterRepositoryMockPullRequestExists) Calls() []*RouterRepositoryMockPullRequestExistsParams {
+	mmPullRequestExists.mutex.RLock()
+
+	argCopy := make([]*RouterRepositoryMockPullRequestExistsParams, len(mmPullRequestExists.callArgs))
+	copy(argCopy, mmPullRequestExists.callArgs)
+
+	mmPullRequestExists.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPullRequestExistsDone returns true if the count of the PullRequestExists invocations corresponds
+// the number of defined expectations
+func (m *RouterRepositoryMock) MinimockPullRequestExistsDone() bool {
+	if m.PullRequestExistsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PullRequestExistsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PullRequestExistsMock.invocationsDone()
+}
+
+// MinimockPullRequestExistsInspect logs each unmet expectation
+func (m *RouterRepositoryMock) MinimockPullRequestExistsInspect() {
+	for _, e := range m.PullRequestExistsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RouterRepositoryMock.PullRequestExists at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPullRequestExistsCounter := mm_atomic.LoadUint64(&m.afterPullRequestExistsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PullRequestExistsMock.defaultExpectation != nil && afterPullRequestExistsCounter < 1 {
+		if m.PullRequestExistsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RouterRepositoryMock.PullRequestExists at\n%s", m.PullRequestExistsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RouterRepositoryMock.PullRequestExists at\n%s with params: %#v", m.PullRequestExistsMock.defaultExpectation.expectationOrigins.origin, *m.PullRequestExistsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPullRequestExists != nil && afterPullRequestExistsCounter < 1 {
+		m.t.Errorf("Expected call to RouterRepositoryMock.PullRequestExists at\n%s", m.funcPullRequestExistsOrigin)
+	}
+
+	if !m.PullRequestExistsMock.invocationsDone() && afterPullRequestExistsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RouterRepositoryMock.PullRequestExists at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PullRequestExistsMock.expectedInvocations), m.PullRequestExistsMock.expectedInvocationsOrigin, afterPullRequestExistsCounter)
+	}
+}
+
+type mRouterRepositoryMockRecordWebhookDelivery struct {
+	optional           bool
+	mock               *RouterRepositoryMock
+	defaultExpectation *RouterRepositoryMockRecordWebhookDeliveryExpectation
+	expectations       []*RouterRepositoryMockRecordWebhookDeliveryExpectation
+
+	callArgs []*RouterRepositoryMockRecordWebhookDeliveryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RouterRepositoryMockRecordWebhookDeliveryExpectation specifies expectation struct of the routerRepository.RecordWebhookDelivery
+type RouterRepositoryMockRecordWebhookDeliveryExpectation struct {
+	mock               *RouterRepositoryMock
+	params             *RouterRepositoryMockRecordWebhookDeliveryParams
+	paramPtrs          *RouterRepositoryMockRecordWebhookDeliveryParamPtrs
+	expectationOrigins RouterRepositoryMockRecordWebhookDeliveryExpectationOrigins
+	results            *RouterRepositoryMockRecordWebhookDeliveryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RouterRepositoryMockRecordWebhookDeliveryParams contains parameters of the routerRepository.RecordWebhookDelivery
+type RouterRepositoryMockRecordWebhookDeliveryParams struct {
+	ctx      context.Context
+	delivery domain.WebhookDelivery
+}
+
+// RouterRepositoryMockRecordWebhookDeliveryParamPtrs contains pointers to parameters of the routerRepository.RecordWebhookDelivery
+type RouterRepositoryMockRecordWebhookDeliveryParamPtrs struct {
+	ctx      *context.Context
+	delivery *domain.WebhookDelivery
+}
+
+// RouterRepositoryMockRecordWebhookDeliveryResults contains results of the routerRepository.RecordWebhookDelivery
+type RouterRepositoryMockRecordWebhookDeliveryResults struct {
+	err error
+}
+
+// RouterRepositoryMockRecordWebhookDeliveryOrigins contains origins of expectations of the routerRepository.RecordWebhookDelivery
+type RouterRepositoryMockRecordWebhookDeliveryExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originDelivery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecordWebhookDelivery *mRouterRepositoryMockRecordWebhookDelivery) Optional() *mRouterRepositoryMockRecordWebhookDelivery {
+	mmRecordWebhookDelivery.optional = true
+	return mmRecordWebhookDelivery
+}
+
+// Expect sets up expected params for routerRepository.RecordWebhookDelivery
+func (mmRecordWebhookDelivery *mRouterRepositoryMockRecordWebhookDelivery) Expect(ctx context.Context, delivery domain.WebhookDelivery) *mRouterRepositoryMockRecordWebhookDelivery {
+	if mmRecordWebhookDelivery.mock.funcRecordWebhookDelivery != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("RouterRepositoryMock.RecordWebhookDelivery mock is already set by Set")
+	}
+
+	if mmRecordWebhookDelivery.defaultExpectation == nil {
+		mmRecordWebhookDelivery.defaultExpectation = &RouterRepositoryMockRecordWebhookDeliveryExpectation{}
+	}
+
+	if mmRecordWebhookDelivery.defaultExpectation.paramPtrs != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("RouterRepositoryMock.RecordWebhookDelivery mock is already set by ExpectParams functions")
+	}
+
+	mmRecordWebhookDelivery.defaultExpectation.params = &RouterRepositoryMockRecordWebhookDeliveryParams{ctx, delivery}
+	mmRecordWebhookDelivery.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRecordWebhookDelivery.expectations {
+		if minimock.Equal(e.params, mmRecordWebhookDelivery.defaultExpectation.params) {
+			mmRecordWebhookDelivery.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecordWebhookDelivery.defaultExpectation.params)
+		}
+	}
+
+	return mmRecordWebhookDelivery
+}
+
+// ExpectCtxParam1 sets up expected param ctx for routerRepository.RecordWebhookDelivery
+func (mmRecordWebhookDelivery *mRouterRepositoryMockRecordWebhookDelivery) ExpectCtxParam1(ctx context.Context) *mRouterRepositoryMockRecordWebhookDelivery {
+	if mmRecordWebhookDelivery.mock.funcRecordWebhookDelivery != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("RouterRepositoryMock.RecordWebhookDelivery mock is already set by Set")
+	}
+
+	if mmRecordWebhookDelivery.defaultExpectation == nil {
+		mmRecordWebhookDelivery.defaultExpectation = &RouterRepositoryMockRecordWebhookDeliveryExpectation{}
+	}
+
+	if mmRecordWebhookDelivery.defaultExpectation.params != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("RouterRepositoryMock.RecordWebhookDelivery mock is already set by Expect")
+	}
+
+	if mmRecordWebhookDelivery.defaultExpectation.paramPtrs == nil {
+		mmRecordWebhookDelivery.defaultExpectation.paramPtrs = &RouterRepositoryMockRecordWebhookDeliveryParamPtrs{}
+	}
+	mmRecordWebhookDelivery.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRecordWebhookDelivery.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRecordWebhookDelivery
+}
+
+// ExpectDeliveryParam2 sets up expected param delivery for routerRepository.RecordWebhookDelivery
+func (mmRecordWebhookDelivery *mRouterRepositoryMockRecordWebhookDelivery) ExpectDeliveryParam2(delivery domain.WebhookDelivery) *mRouterRepositoryMockRecordWebhookDelivery {
+	if mmRecordWebhookDelivery.mock.funcRecordWebhookDelivery != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("RouterRepositoryMock.RecordWebhookDelivery mock is already set by Set")
+	}
+
+	if mmRecordWebhookDelivery.defaultExpectation == nil {
+		mmRecordWebhookDelivery.defaultExpectation = &RouterRepositoryMockRecordWebhookDeliveryExpectation{}
+	}
+
+	if mmRecordWebhookDelivery.defaultExpectation.params != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("RouterRepositoryMock.RecordWebhookDelivery mock is already set by Expect")
+	}
+
+	if mmRecordWebhookDelivery.defaultExpectation.paramPtrs == nil {
+		mmRecordWebhookDelivery.defaultExpectation.paramPtrs = &RouterRepositoryMockRecordWebhookDeliveryParamPtrs{}
+	}
+	mmRecordWebhookDelivery.defaultExpectation.paramPtrs.delivery = &delivery
+	mmRecordWebhookDelivery.defaultExpectation.expectationOrigins.originDelivery = minimock.CallerInfo(1)
+
+	return mmRecordWebhookDelivery
+}
+
+// Inspect accepts an inspector function that has same arguments as the routerRepository.RecordWebhookDelivery
+func (mmRecordWebhookDelivery *mRouterRepositoryMockRecordWebhookDelivery) Inspect(f func(ctx context.Context, delivery domain.WebhookDelivery)) *mRouterRepositoryMockRecordWebhookDelivery {
+	if mmRecordWebhookDelivery.mock.inspectFuncRecordWebhookDelivery != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("Inspect function is already set for RouterRepositoryMock.RecordWebhookDelivery")
+	}
+
+	mmRecordWebhookDelivery.mock.inspectFuncRecordWebhookDelivery = f
+
+	return mmRecordWebhookDelivery
+}
+
+// Return sets up results that will be returned by routerRepository.RecordWebhookDelivery
+func (mmRecordWebhookDelivery *mRouterRepositoryMockRecordWebhookDelivery) Return(err error) *RouterRepositoryMock {
+	if mmRecordWebhookDelivery.mock.funcRecordWebhookDelivery != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("RouterRepositoryMock.RecordWebhookDelivery mock is already set by Set")
+	}
+
+	if mmRecordWebhookDelivery.defaultExpectation == nil {
+		mmRecordWebhookDelivery.defaultExpectation = &RouterRepositoryMockRecordWebhookDeliveryExpectation{mock: mmRecordWebhookDelivery.mock}
+	}
+	mmRecordWebhookDelivery.defaultExpectation.results = &RouterRepositoryMockRecordWebhookDeliveryResults{err}
+	mmRecordWebhookDelivery.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRecordWebhookDelivery.mock
+}
+
+// Set uses given function f to mock the routerRepository.RecordWebhookDelivery method
+func (mmRecordWebhookDelivery *mRouterRepositoryMockRecordWebhookDelivery) Set(f func(ctx context.Context, delivery domain.WebhookDelivery) (err error)) *RouterRepositoryMock {
+	if mmRecordWebhookDelivery.defaultExpectation != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("Default expectation is already set for the routerRepository.RecordWebhookDelivery method")
+	}
+
+	if len(mmRecordWebhookDelivery.expectations) > 0 {
+		mmRecordWebhookDelivery.mock.t.Fatalf("Some expectations are already set for the routerRepository.RecordWebhookDelivery method")
+	}
+
+	mmRecordWebhookDelivery.mock.funcRecordWebhookDelivery = f
+	mmRecordWebhookDelivery.mock.funcRecordWebhookDeliveryOrigin = minimock.CallerInfo(1)
+	return mmRecordWebhookDelivery.mock
+}
+
+// When sets expectation for the routerRepository.RecordWebhookDelivery which will trigger the result defined by the following
+// Then helper
+func (mmRecordWebhookDelivery *mRouterRepositoryMockRecordWebhookDelivery) When(ctx context.Context, delivery domain.WebhookDelivery) *RouterRepositoryMockRecordWebhookDeliveryExpectation {
+	if mmRecordWebhookDelivery.mock.funcRecordWebhookDelivery != nil {
+		mmRecordWebhookDelivery.mock.t.Fatalf("RouterRepositoryMock.RecordWebhookDelivery mock is already set by Set")
+	}
+
+	expectation := &RouterRepositoryMockRecordWebhookDeliveryExpectation{
+		mock:               mmRecordWebhookDelivery.mock,
+		params:             &RouterRepositoryMockRecordWebhookDeliveryParams{ctx, delivery},
+		expectationOrigins: RouterRepositoryMockRecordWebhookDeliveryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRecordWebhookDelivery.expectations = append(mmRecordWebhookDelivery.expectations, expectation)
+	return expectation
+}
+
+// Then sets up routerRepository.RecordWebhookDelivery return parameters for the expectation previously defined by the When method
+func (e *RouterRepositoryMockRecordWebhookDeliveryExpectation) Then(err error) *RouterRepositoryMock {
+	e.results = &RouterRepositoryMockRecordWebhookDeliveryResults{err}
+	return e.mock
+}
+
+// Times sets number of times routerRepository.RecordWebhookDelivery should be invoked
+func (mmRecordWebhookDelivery *mRouterRepositoryMockRecordWebhookDelivery) Times(n uint64) *mRouterRepositoryMockRecordWebhookDelivery {
+	if n == 0 {
+		mmRecordWebhookDelivery.mock.t.Fatalf("Times of RouterRepositoryMock.RecordWebhookDelivery mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecordWebhookDelivery.expectedInvocations, n)
+	mmRecordWebhookDelivery.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRecordWebhookDelivery
+}
+
+func (mmRecordWebhookDelivery *mRouterRepositoryMockRecordWebhookDelivery) invocationsDone() bool {
+	if len(mmRecordWebhookDelivery.expectations) == 0 && mmRecordWebhookDelivery.defaultExpectation == nil && mmRecordWebhookDelivery.mock.funcRecordWebhookDelivery == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecordWebhookDelivery.mock.afterRecordWebhookDeliveryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecordWebhookDelivery.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RecordWebhookDelivery implements routerRepository
+func (mmRecordWebhookDelivery *RouterRepositoryMock) RecordWebhookDelivery(ctx context.Context, delivery domain.WebhookDelivery) (err error) {
+	mm_atomic.AddUint64(&mmRecordWebhookDelivery.beforeRecordWebhookDeliveryCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecordWebhookDelivery.afterRecordWebhookDeliveryCounter, 1)
+
+	mmRecordWebhookDelivery.t.Helper()
+
+	if mmRecordWebhookDelivery.inspectFuncRecordWebhookDelivery != nil {
+		mmRecordWebhookDelivery.inspectFuncRecordWebhookDelivery(ctx, delivery)
+	}
+
+	mm_params := RouterRepositoryMockRecordWebhookDeliveryParams{ctx, delivery}
+
+	// Record call args
+	mmRecordWebhookDelivery.RecordWebhookDeliveryMock.mutex.Lock()
+	mmRecordWebhookDelivery.RecordWebhookDeliveryMock.callArgs = append(mmRecordWebhookDelivery.RecordWebhookDeliveryMock.callArgs, &mm_params)
+	mmRecordWebhookDelivery.RecordWebhookDeliveryMock.mutex.Unlock()
+
+	for _, e := range mmRecordWebhookDelivery.RecordWebhookDeliveryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRecordWebhookDelivery.RecordWebhookDeliveryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecordWebhookDelivery.RecordWebhookDeliveryMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecordWebhookDelivery.RecordWebhookDeliveryMock.defaultExpectation.params
+		mm_want_ptrs := mmRecordWebhookDelivery.RecordWebhookDeliveryMock.defaultExpectation.paramPtrs
+
+		mm_got := RouterRepositoryMockRecordWebhookDeliveryParams{ctx, delivery}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecordWebhookDelivery.t.Errorf("RouterRepositoryMock.RecordWebhookDelivery got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordWebhookDelivery.RecordWebhookDeliveryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.delivery != nil && !minimock.Equal(*mm_want_ptrs.delivery, mm_got.delivery) {
+				mmRecordWebhookDelivery.t.Errorf("RouterRepositoryMock.RecordWebhookDelivery got unexpected parameter delivery, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordWebhookDelivery.RecordWebhookDeliveryMock.defaultExpectation.expectationOrigins.originDelivery, *mm_want_ptrs.delivery, mm_got.delivery, minimock.Diff(*mm_want_ptrs.delivery, mm_got.delivery))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecordWebhookDelivery.t.Errorf("RouterRepositoryMock.RecordWebhookDelivery got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRecordWebhookDelivery.RecordWebhookDeliveryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRecordWebhookDelivery.RecordWebhookDeliveryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecordWebhookDelivery.t.Fatal("No results are set for the RouterRepositoryMock.RecordWebhookDelivery")
+		}
+		return (*mm_results).err
+	}
+	if mmRecordWebhookDelivery.funcRecordWebhookDelivery != nil {
+		return mmRecordWebhookDelivery.funcRecordWebhookDelivery(ctx, delivery)
+	}
+	mmRecordWebhookDelivery.t.Fatalf("Unexpected call to RouterRepositoryMock.RecordWebhookDelivery. %v %v", ctx, delivery)
+	return
+}
+
+// RecordWebhookDeliveryAfterCounter returns a count of finished RouterRepositoryMock.RecordWebhookDelivery invocations
+func (mmRecordWebhookDelivery *RouterRepositoryMock) RecordWebhookDeliveryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordWebhookDelivery.afterRecordWebhookDeliveryCounter)
+}
+
+// RecordWebhookDeliveryBeforeCounter returns a count of RouterRepositoryMock.RecordWebhookDelivery invocations
+func (mmRecordWebhookDelivery *RouterRepositoryMock) RecordWebhookDeliveryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordWebhookDelivery.beforeRecordWebhookDeliveryCounter)
+}
+
+// Calls returns a list of arguments used in each call to RouterRepositoryMock.RecordWebhookDelivery.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecordWebhookDelivery *mRouterRepositoryMockRecordWebhookDelivery) Calls() []*RouterRepositoryMockRecordWebhookDeliveryParams {
+	mmRecordWebhookDelivery.mutex.RLock()
+
+	argCopy := make([]*RouterRepositoryMockRecordWebhookDeliveryParams, len(mmRecordWebhookDelivery.callArgs))
+	copy(argCopy, mmRecordWebhookDelivery.callArgs)
+
+	mmRecordWebhookDelivery.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordWebhookDeliveryDone returns true if the count of the RecordWebhookDelivery invocations corresponds
+// the number of defined expectations
+func (m *RouterRepositoryMock) MinimockRecordWebhookDeliveryDone() bool {
+	if m.RecordWebhookDeliveryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordWebhookDeliveryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordWebhookDeliveryMock.invocationsDone()
+}
+
+// MinimockRecordWebhookDeliveryInspect logs each unmet expectation
+func (m *RouterRepositoryMock) MinimockRecordWebhookDeliveryInspect() {
+	for _, e := range m.RecordWebhookDeliveryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RouterRepositoryMock.RecordWebhookDelivery at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRecordWebhookDeliveryCounter := mm_atomic.LoadUint64(&m.afterRecordWebhookDeliveryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordWebhookDeliveryMock.defaultExpectation != nil && afterRecordWebhookDeliveryCounter < 1 {
+		if m.RecordWebhookDeliveryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RouterRepositoryMock.RecordWebhookDelivery at\n%s", m.RecordWebhookDeliveryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RouterRepositoryMock.RecordWebhookDelivery at\n%s with params: %#v", m.RecordWebhookDeliveryMock.defaultExpectation.expectationOrigins.origin, *m.RecordWebhookDeliveryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecordWebhookDelivery != nil && afterRecordWebhookDeliveryCounter < 1 {
+		m.t.Errorf("Expected call to RouterRepositoryMock.RecordWebhookDelivery at\n%s", m.funcRecordWebhookDeliveryOrigin)
+	}
+
+	if !m.RecordWebhookDeliveryMock.invocationsDone() && afterRecordWebhookDeliveryCounter > 0 {
+		m.t.Errorf("Expected %d calls to RouterRepositoryMock.RecordWebhookDelivery at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordWebhookDeliveryMock.expectedInvocations), m.RecordWebhookDeliveryMock.expectedInvocationsOrigin, afterRecordWebhookDeliveryCounter)
+	}
+}
+
+type mRouterRepositoryMockWebhookDeliveryExists struct {
+	optional           bool
+	mock               *RouterRepositoryMock
+	defaultExpectation *RouterRepositoryMockWebhookDeliveryExistsExpectation
+	expectations       []*RouterRepositoryMockWebhookDeliveryExistsExpectation
+
+	callArgs []*RouterRepositoryMockWebhookDeliveryExistsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RouterRepositoryMockWebhookDeliveryExistsExpectation specifies expectation struct of the routerRepository.WebhookDeliveryExists
+type RouterRepositoryMockWebhookDeliveryExistsExpectation struct {
+	mock               *RouterRepositoryMock
+	params             *RouterRepositoryMockWebhookDeliveryExistsParams
+	paramPtrs          *RouterRepositoryMockWebhookDeliveryExistsParamPtrs
+	expectationOrigins RouterRepositoryMockWebhookDeliveryExistsExpectationOrigins
+	results            *RouterRepositoryMockWebhookDeliveryExistsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RouterRepositoryMockWebhookDeliveryExistsParams contains parameters of the routerRepository.WebhookDeliveryExists
+type RouterRepositoryMockWebhookDeliveryExistsParams struct {
+	ctx      context.Context
+	delivery domain.WebhookDelivery
+}
+
+// RouterRepositoryMockWebhookDeliveryExistsParamPtrs contains pointers to parameters of the routerRepository.WebhookDeliveryExists
+type RouterRepositoryMockWebhookDeliveryExistsParamPtrs struct {
+	ctx      *context.Context
+	delivery *domain.WebhookDelivery
+}
+
+// RouterRepositoryMockWebhookDeliveryExistsResults contains results of the routerRepository.WebhookDeliveryExists
+type RouterRepositoryMockWebhookDeliveryExistsResults struct {
+	b1  bool
+	err error
+}
+
+// RouterRepositoryMockWebhookDeliveryExistsOrigins contains origins of expectations of the routerRepository.WebhookDeliveryExists
+type RouterRepositoryMockWebhookDeliveryExistsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originDelivery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmWebhookDeliveryExists *mRouterRepositoryMockWebhookDeliveryExists) Optional() *mRouterRepositoryMockWebhookDeliveryExists {
+	mmWebhookDeliveryExists.optional = true
+	return mmWebhookDeliveryExists
+}
+
+// Expect sets up expected params for routerRepository.WebhookDeliveryExists
+func (mmWebhookDeliveryExists *mRouterRepositoryMockWebhookDeliveryExists) Expect(ctx context.Context, delivery domain.WebhookDelivery) *mRouterRepositoryMockWebhookDeliveryExists {
+	if mmWebhookDeliveryExists.mock.funcWebhookDeliveryExists != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("RouterRepositoryMock.WebhookDeliveryExists mock is already set by Set")
+	}
+
+	if mmWebhookDeliveryExists.defaultExpectation == nil {
+		mmWebhookDeliveryExists.defaultExpectation = &RouterRepositoryMockWebhookDeliveryExistsExpectation{}
+	}
+
+	if mmWebhookDeliveryExists.defaultExpectation.paramPtrs != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("RouterRepositoryMock.WebhookDeliveryExists mock is already set by ExpectParams functions")
+	}
+
+	mmWebhookDeliveryExists.defaultExpectation.params = &RouterRepositoryMockWebhookDeliveryExistsParams{ctx, delivery}
+	mmWebhookDeliveryExists.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmWebhookDeliveryExists.expectations {
+		if minimock.Equal(e.params, mmWebhookDeliveryExists.defaultExpectation.params) {
+			mmWebhookDeliveryExists.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWebhookDeliveryExists.defaultExpectation.params)
+		}
+	}
+
+	return mmWebhookDeliveryExists
+}
+
+// ExpectCtxParam1 sets up expected param ctx for routerRepository.WebhookDeliveryExists
+func (mmWebhookDeliveryExists *mRouterRepositoryMockWebhookDeliveryExists) ExpectCtxParam1(ctx context.Context) *mRouterRepositoryMockWebhookDeliveryExists {
+	if mmWebhookDeliveryExists.mock.funcWebhookDeliveryExists != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("RouterRepositoryMock.WebhookDeliveryExists mock is already set by Set")
+	}
+
+	if mmWebhookDeliveryExists.defaultExpectation == nil {
+		mmWebhookDeliveryExists.defaultExpectation = &RouterRepositoryMockWebhookDeliveryExistsExpectation{}
+	}
+
+	if mmWebhookDeliveryExists.defaultExpectation.params != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("RouterRepositoryMock.WebhookDeliveryExists mock is already set by Expect")
+	}
+
+	if mmWebhookDeliveryExists.defaultExpectation.paramPtrs == nil {
+		mmWebhookDeliveryExists.defaultExpectation.paramPtrs = &RouterRepositoryMockWebhookDeliveryExistsParamPtrs{}
+	}
+	mmWebhookDeliveryExists.defaultExpectation.paramPtrs.ctx = &ctx
+	mmWebhookDeliveryExists.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmWebhookDeliveryExists
+}
+
+// ExpectDeliveryParam2 sets up expected param delivery for routerRepository.WebhookDeliveryExists
+func (mmWebhookDeliveryExists *mRouterRepositoryMockWebhookDeliveryExists) ExpectDeliveryParam2(delivery domain.WebhookDelivery) *mRouterRepositoryMockWebhookDeliveryExists {
+	if mmWebhookDeliveryExists.mock.funcWebhookDeliveryExists != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("RouterRepositoryMock.WebhookDeliveryExists mock is already set by Set")
+	}
+
+	if mmWebhookDeliveryExists.defaultExpectation == nil {
+		mmWebhookDeliveryExists.defaultExpectation = &RouterRepositoryMockWebhookDeliveryExistsExpectation{}
+	}
+
+	if mmWebhookDeliveryExists.defaultExpectation.params != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("RouterRepositoryMock.WebhookDeliveryExists mock is already set by Expect")
+	}
+
+	if mmWebhookDeliveryExists.defaultExpectation.paramPtrs == nil {
+		mmWebhookDeliveryExists.defaultExpectation.paramPtrs = &RouterRepositoryMockWebhookDeliveryExistsParamPtrs{}
+	}
+	mmWebhookDeliveryExists.defaultExpectation.paramPtrs.delivery = &delivery
+	mmWebhookDeliveryExists.defaultExpectation.expectationOrigins.originDelivery = minimock.CallerInfo(1)
+
+	return mmWebhookDeliveryExists
+}
+
+// Inspect accepts an inspector function that has same arguments as the routerRepository.WebhookDeliveryExists
+func (mmWebhookDeliveryExists *mRouterRepositoryMockWebhookDeliveryExists) Inspect(f func(ctx context.Context, delivery domain.WebhookDelivery)) *mRouterRepositoryMockWebhookDeliveryExists {
+	if mmWebhookDeliveryExists.mock.inspectFuncWebhookDeliveryExists != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("Inspect function is already set for RouterRepositoryMock.WebhookDeliveryExists")
+	}
+
+	mmWebhookDeliveryExists.mock.inspectFuncWebhookDeliveryExists = f
+
+	return mmWebhookDeliveryExists
+}
+
+// Return sets up results that will be returned by routerRepository.WebhookDeliveryExists
+func (mmWebhookDeliveryExists *mRouterRepositoryMockWebhookDeliveryExists) Return(b1 bool, err error) *RouterRepositoryMock {
+	if mmWebhookDeliveryExists.mock.funcWebhookDeliveryExists != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("RouterRepositoryMock.WebhookDeliveryExists mock is already set by Set")
+	}
+
+	if mmWebhookDeliveryExists.defaultExpectation == nil {
+		mmWebhookDeliveryExists.defaultExpectation = &RouterRepositoryMockWebhookDeliveryExistsExpectation{mock: mmWebhookDeliveryExists.mock}
+	}
+	mmWebhookDeliveryExists.defaultExpectation.results = &RouterRepositoryMockWebhookDeliveryExistsResults{b1, err}
+	mmWebhookDeliveryExists.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmWebhookDeliveryExists.mock
+}
+
+// Set uses given function f to mock the routerRepository.WebhookDeliveryExists method
+func (mmWebhookDeliveryExists *mRouterRepositoryMockWebhookDeliveryExists) Set(f func(ctx context.Context, delivery domain.WebhookDelivery) (b1 bool, err error)) *RouterRepositoryMock {
+	if mmWebhookDeliveryExists.defaultExpectation != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("Default expectation is already set for the routerRepository.WebhookDeliveryExists method")
+	}
+
+	if len(mmWebhookDeliveryExists.expectations) > 0 {
+		mmWebhookDeliveryExists.mock.t.Fatalf("Some expectations are already set for the routerRepository.WebhookDeliveryExists method")
+	}
+
+	mmWebhookDeliveryExists.mock.funcWebhookDeliveryExists = f
+	mmWebhookDeliveryExists.mock.funcWebhookDeliveryExistsOrigin = minimock.CallerInfo(1)
+	return mmWebhookDeliveryExists.mock
+}
+
+// When sets expectation for the routerRepository.WebhookDeliveryExists which will trigger the result defined by the following
+// Then helper
+func (mmWebhookDeliveryExists *mRouterRepositoryMockWebhookDeliveryExists) When(ctx context.Context, delivery domain.WebhookDelivery) *RouterRepositoryMockWebhookDeliveryExistsExpectation {
+	if mmWebhookDeliveryExists.mock.funcWebhookDeliveryExists != nil {
+		mmWebhookDeliveryExists.mock.t.Fatalf("RouterRepositoryMock.WebhookDeliveryExists mock is already set by Set")
+	}
+
+	expectation := &RouterRepositoryMockWebhookDeliveryExistsExpectation{
+		mock:               mmWebhookDeliveryExists.mock,
+		params:             &RouterRepositoryMockWebhookDeliveryExistsParams{ctx, delivery},
+		expectationOrigins: RouterRepositoryMockWebhookDeliveryExistsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmWebhookDeliveryExists.expectations = append(mmWebhookDeliveryExists.expectations, expectation)
+	return expectation
+}
+
+// Then sets up routerRepository.WebhookDeliveryExists return parameters for the expectation previously defined by the When method
+func (e *RouterRepositoryMockWebhookDeliveryExistsExpectation) Then(b1 bool, err error) *RouterRepositoryMock {
+	e.results = &RouterRepositoryMockWebhookDeliveryExistsResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times routerRepository.WebhookDeliveryExists should be invoked
+func (mmWebhookDeliveryExists *mRouterRepositoryMockWebhookDeliveryExists) Times(n uint64) *mRouterRepositoryMockWebhookDeliveryExists {
+	if n == 0 {
+		mmWebhookDeliveryExists.mock.t.Fatalf("Times of RouterRepositoryMock.WebhookDeliveryExists mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmWebhookDeliveryExists.expectedInvocations, n)
+	mmWebhookDeliveryExists.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmWebhookDeliveryExists
+}
+
+func (mmWebhookDeliveryExists *mRouterRepositoryMockWebhookDeliveryExists) invocationsDone() bool {
+	if len(mmWebhookDeliveryExists.expectations) == 0 && mmWebhookDeliveryExists.defaultExpectation == nil && mmWebhookDeliveryExists.mock.funcWebhookDeliveryExists == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmWebhookDeliveryExists.mock.afterWebhookDeliveryExistsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWebhookDeliveryExists.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// WebhookDeliveryExists implements routerRepository
+func (mmWebhookDeliveryExists *RouterRepositoryMock) WebhookDeliveryExists(ctx context.Context, delivery domain.WebhookDelivery) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmWebhookDeliveryExists.beforeWebhookDeliveryExistsCounter, 1)
+	defer mm_atomic.AddUint64(&mmWebhookDeliveryExists.afterWebhookDeliveryExistsCounter, 1)
+
+	mmWebhookDeliveryExists.t.Helper()
+
+	if mmWebhookDeliveryExists.inspectFuncWebhookDeliveryExists != nil {
+		mmWebhookDeliveryExists.inspectFuncWebhookDeliveryExists(ctx, delivery)
+	}
+
+	mm_params := RouterRepositoryMockWebhookDeliveryExistsParams{ctx, delivery}
+
+	// Record call args
+	mmWebhookDeliveryExists.WebhookDeliveryExistsMock.mutex.Lock()
+	mmWebhookDeliveryExists.WebhookDeliveryExistsMock.callArgs = append(mmWebhookDeliveryExists.WebhookDeliveryExistsMock.callArgs, &mm_params)
+	mmWebhookDeliveryExists.WebhookDeliveryExistsMock.mutex.Unlock()
+
+	for _, e := range mmWebhookDeliveryExists.WebhookDeliveryExistsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmWebhookDeliveryExists.WebhookDeliveryExistsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWebhookDeliveryExists.WebhookDeliveryExistsMock.defaultExpectation.Counter, 1)
+		mm_want := mmWebhookDeliveryExists.WebhookDeliveryExistsMock.defaultExpectation.params
+		mm_want_ptrs := mmWebhookDeliveryExists.WebhookDeliveryExistsMock.defaultExpectation.paramPtrs
+
+		mm_got := RouterRepositoryMockWebhookDeliveryExistsParams{ctx, delivery}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmWebhookDeliveryExists.t.Errorf("RouterRepositoryMock.WebhookDeliveryExists got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWebhookDeliveryExists.WebhookDeliveryExistsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.delivery != nil && !minimock.Equal(*mm_want_ptrs.delivery, mm_got.delivery) {
+				mmWebhookDeliveryExists.t.Errorf("RouterRepositoryMock.WebhookDeliveryExists got unexpected parameter delivery, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWebhookDeliveryExists.WebhookDeliveryExistsMock.defaultExpectation.expectationOrigins.originDelivery, *mm_want_ptrs.delivery, mm_got.delivery, minimock.Diff(*mm_want_ptrs.delivery, mm_got.delivery))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWebhookDeliveryExists.t.Errorf("RouterRepositoryMock.WebhookDeliveryExists got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmWebhookDeliveryExists.WebhookDeliveryExistsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmWebhookDeliveryExists.WebhookDeliveryExistsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmWebhookDeliveryExists.t.Fatal("No results are set for the RouterRepositoryMock.WebhookDeliveryExists")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmWebhookDeliveryExists.funcWebhookDeliveryExists != nil {
+		return mmWebhookDeliveryExists.funcWebhookDeliveryExists(ctx, delivery)
+	}
+	mmWebhookDeliveryExists.t.Fatalf("Unexpected call to RouterRepositoryMock.WebhookDeliveryExists. %v %v", ctx, delivery)
+	return
+}
+
+// WebhookDeliveryExistsAfterCounter returns a count of finished RouterRepositoryMock.WebhookDeliveryExists invocations
+func (mmWebhookDeliveryExists *RouterRepositoryMock) WebhookDeliveryExistsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWebhookDeliveryExists.afterWebhookDeliveryExistsCounter)
+}
+
+// WebhookDeliveryExistsBeforeCounter returns a count of RouterRepositoryMock.WebhookDeliveryExists invocations
+func (mmWebhookDeliveryExists *RouterRepositoryMock) WebhookDeliveryExistsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWebhookDeliveryExists.beforeWebhookDeliveryExistsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RouterRepositoryMock.WebhookDeliveryExists.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWebhookDeliveryExists *mRouterRepositoryMockWebhookDeliveryExists) Calls() []*RouterRepositoryMockWebhookDeliveryExistsParams {
+	mmWebhookDeliveryExists.mutex.RLock()
+
+	argCopy := make([]*RouterRepositoryMockWebhookDeliveryExistsParams, len(mmWebhookDeliveryExists.callArgs))
+	copy(argCopy, mmWebhookDeliveryExists.callArgs)
+
+	mmWebhookDeliveryExists.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWebhookDeliveryExistsDone returns true if the count of the WebhookDeliveryExists invocations corresponds
+// the number of defined expectations
+func (m *RouterRepositoryMock) MinimockWebhookDeliveryExistsDone() bool {
+	if m.WebhookDeliveryExistsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.WebhookDeliveryExistsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.WebhookDeliveryExistsMock.invocationsDone()
+}
+
+// MinimockWebhookDeliveryExistsInspect logs each unmet expectation
+func (m *RouterRepositoryMock) MinimockWebhookDeliveryExistsInspect() {
+	for _, e := range m.WebhookDeliveryExistsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RouterRepositoryMock.WebhookDeliveryExists at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterWebhookDeliveryExistsCounter := mm_atomic.LoadUint64(&m.afterWebhookDeliveryExistsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WebhookDeliveryExistsMock.defaultExpectation != nil && afterWebhookDeliveryExistsCounter < 1 {
+		if m.WebhookDeliveryExistsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RouterRepositoryMock.WebhookDeliveryExists at\n%s", m.WebhookDeliveryExistsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RouterRepositoryMock.WebhookDeliveryExists at\n%s with params: %#v", m.WebhookDeliveryExistsMock.defaultExpectation.expectationOrigins.origin, *m.WebhookDeliveryExistsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWebhookDeliveryExists != nil && afterWebhookDeliveryExistsCounter < 1 {
+		m.t.Errorf("Expected call to RouterRepositoryMock.WebhookDeliveryExists at\n%s", m.funcWebhookDeliveryExistsOrigin)
+	}
+
+	if !m.WebhookDeliveryExistsMock.invocationsDone() && afterWebhookDeliveryExistsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RouterRepositoryMock.WebhookDeliveryExists at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.WebhookDeliveryExistsMock.expectedInvocations), m.WebhookDeliveryExistsMock.expectedInvocationsOrigin, afterWebhookDeliveryExistsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RouterRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetUserIDByIdentityInspect()
+
+			m.MinimockPullRequestExistsInspect()
+
+			m.MinimockRecordWebhookDeliveryInspect()
+
+			m.MinimockWebhookDeliveryExistsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RouterRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RouterRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetUserIDByIdentityDone() &&
+		m.MinimockPullRequestExistsDone() &&
+		m.MinimockRecordWebhookDeliveryDone() &&
+		m.MinimockWebhookDeliveryExistsDone()
+}
@@ -0,0 +1,411 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	validatorV10 "github.com/go-playground/validator/v10"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/AndrejDubinin/review-assigner/internal/domain"
+)
+
+func TestRouter_Process(t *testing.T) {
+	t.Parallel()
+
+	const pullRequestID = "acme/api#42"
+
+	delivery := domain.WebhookDelivery{
+		Provider:   domain.WebhookProviderGitHub,
+		DeliveryID: "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+		Event:      "pull_request",
+	}
+	result := domain.WebhookResult{
+		DeliveryID: delivery.DeliveryID,
+		Event:      delivery.Event,
+		Action:     "opened",
+	}
+	opened := Event{
+		Action:        ActionOpen,
+		Repository:    "acme/api",
+		Number:        42,
+		Title:         "Add rate limiting to the public API",
+		URL:           "https://github.com/acme/api/pull/42",
+		Labels:        []string{"backend", "security"},
+		SourceBranch:  "feature/rate-limit",
+		TargetBranch:  "main",
+		HeadSHA:       "e5bd3914e2e596debea16f433f57875b5b90bcd6",
+		AuthorAccount: 101,
+		ActorAccount:  101,
+	}
+	created := domain.CreatePullRequest{
+		PullRequestID:   "42",
+		PullRequestName: "Add rate limiting to the public API",
+		AuthorID:        "u1",
+		Labels:          []string{"backend", "security"},
+		Repository:      "acme/api",
+		SourceBranch:    "feature/rate-limit",
+		TargetBranch:    "main",
+		URL:             "https://github.com/acme/api/pull/42",
+		HeadSHA:         "e5bd3914e2e596debea16f433f57875b5b90bcd6",
+	}
+	changed := func(action Action, change func(event *Event)) Event {
+		event := opened
+		event.Action = action
+		if change != nil {
+			change(&event)
+		}
+		return event
+	}
+	errSkip := errors.New("update does not toggle draft")
+	validator := validatorV10.New(validatorV10.WithRequiredStructEnabled())
+
+	type fields struct {
+		repo      func(mc *minimock.Controller) routerRepository
+		creator   func(mc *minimock.Controller) creator
+		lifecycle func(mc *minimock.Controller) lifecycle
+		merger    func(mc *minimock.Controller) merger
+	}
+	// fresh expects a delivery seen for the first time that is recorded once it is processed.
+	fresh := func(mc *minimock.Controller) *RouterRepositoryMock {
+		repo := NewRouterRepositoryMock(mc)
+		repo.WebhookDeliveryExistsMock.Expect(minimock.AnyContext, delivery).Return(false, nil)
+		repo.RecordWebhookDeliveryMock.Expect(minimock.AnyContext, delivery).Return(nil)
+		return repo
+	}
+	mapped := func(repo *RouterRepositoryMock, provider domain.WebhookProvider, accountID, userID string) {
+		repo.GetUserIDByIdentityMock.When(minimock.AnyContext, provider, accountID).Then(userID, nil)
+	}
+	freshBy := func(accountID, userID string) func(mc *minimock.Controller) routerRepository {
+		return func(mc *minimock.Controller) routerRepository {
+			repo := fresh(mc)
+			mapped(repo, domain.WebhookProviderGitHub, accountID, userID)
+			return repo
+		}
+	}
+	noCreator := func(mc *minimock.Controller) creator { return NewCreatorMock(mc) }
+	noLifecycle := func(mc *minimock.Controller) lifecycle { return NewLifecycleMock(mc) }
+	noMerger := func(mc *minimock.Controller) merger { return NewMergerMock(mc) }
+	creates := func(pr domain.CreatePullRequest, err error) func(mc *minimock.Controller) creator {
+		return func(mc *minimock.Controller) creator {
+			creator := NewCreatorMock(mc)
+			creator.CreatePullRequestMock.Expect(minimock.AnyContext, pr).Return(domain.CreatePullRequestResult{}, err)
+			return creator
+		}
+	}
+	// unrecorded expects a delivery that fails once the author is resolved.
+	unrecorded := func(mc *minimock.Controller) routerRepository {
+		repo := NewRouterRepositoryMock(mc)
+		repo.WebhookDeliveryExistsMock.Return(false, nil)
+		mapped(repo, domain.WebhookProviderGitHub, "101", "u1")
+		return repo
+	}
+	applied := domain.WebhookResult{
+		DeliveryID: result.DeliveryID, Event: result.Event, Action: result.Action, PullRequestID: pullRequestID,
+	}
+	ignored := func(reason error) domain.WebhookResult {
+		result := applied
+		result.Ignored, result.Reason = true, reason.Error()
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		provider domain.WebhookProvider
+		event    Event
+		fields   fields
+		want     domain.WebhookResult
+		wantErr  error
+	}{
+		{
+			name:     "success: open creates the PR with the mapped author",
+			provider: domain.WebhookProviderGitHub,
+			event:    opened,
+			fields: fields{
+				repo:      freshBy("101", "u1"),
+				creator:   creates(created, nil),
+				lifecycle: noLifecycle,
+				merger:    noMerger,
+			},
+			want: applied,
+		},
+		{
+			name:     "success: ready for review assigns reviewers to the known draft",
+			provider: domain.WebhookProviderGitHub,
+			event:    changed(ActionReady, nil),
+			fields: fields{
+				repo: func(mc *minimock.Controller) routerRepository {
+					repo := fresh(mc)
+					mapped(repo, domain.WebhookProviderGitHub, "101", "u1")
+					repo.PullRequestExistsMock.Expect(minimock.AnyContext, pullRequestID).Return(true, nil)
+					return repo
+				},
+				creator: noCreator,
+				lifecycle: func(mc *minimock.Controller) lifecycle {
+					lifecycle := NewLifecycleMock(mc)
+					lifecycle.ReadyForReviewMock.Expect(minimock.AnyContext, domain.ReadyForReview{
+						PullRequestID: pullRequestID,
+						ActorID:       "u1",
+						Labels:        []string{"backend", "security"},
+					}).Return(domain.PullRequest{}, nil)
+					return lifecycle
+				},
+				merger: noMerger,
+			},
+			want: applied,
+		},
+		{
+			name:     "success: ready for review creates an unknown PR",
+			provider: domain.WebhookProviderGitHub,
+			event:    changed(ActionReady, nil),
+			fields: fields{
+				repo: func(mc *minimock.Controller) routerRepository {
+					repo := fresh(mc)
+					mapped(repo, domain.WebhookProviderGitHub, "101", "u1")
+					repo.PullRequestExistsMock.Expect(minimock.AnyContext, pullRequestID).Return(false, nil)
+					return repo
+				},
+				creator:   creates(created, nil),
+				lifecycle: noLifecycle,
+				merger:    noMerger,
+			},
+			want: applied,
+		},
+		{
+			name:     "success: draft converts to draft",
+			provider: domain.WebhookProviderGitHub,
+			event:    changed(ActionDraft, nil),
+			fields: fields{
+				repo:    freshBy("101", "u1"),
+				creator: noCreator,
+				lifecycle: func(mc *minimock.Controller) lifecycle {
+					lifecycle := NewLifecycleMock(mc)
+					lifecycle.ConvertToDraftMock.Expect(minimock.AnyContext, domain.ConvertToDraft{
+						PullRequestID: pullRequestID,
+						ActorID:       "u1",
+					}).Return(domain.PullRequest{}, nil)
+					return lifecycle
+				},
+				merger: noMerger,
+			},
+			want: applied,
+		},
+		{
+			name:     "success: merge records the merge with the reason of the code host",
+			provider: domain.WebhookProviderGitLab,
+			event: changed(ActionMerge, func(event *Event) {
+				event.ActorAccount = 202
+			}),
+			fields: fields{
+				repo: func(mc *minimock.Controller) routerRepository {
+					repo := fresh(mc)
+					mapped(repo, domain.WebhookProviderGitLab, "202", "u2")
+					return repo
+				},
+				creator:   noCreator,
+				lifecycle: noLifecycle,
+				merger: func(mc *minimock.Controller) merger {
+					merger := NewMergerMock(mc)
+					merger.MergePullRequestMock.Expect(minimock.AnyContext, domain.MergePullRequest{
+						PullRequestID: pullRequestID,
+						Force:         true,
+						ActorID:       "u2",
+						Reason:        "merged on GitLab",
+					}).Return(domain.PullRequest{}, nil)
+					return merger
+				},
+			},
+			want: applied,
+		},
+		{
+			name:     "success: close closes, unmapped actor is no actor",
+			provider: domain.WebhookProviderGitHub,
+			event: changed(ActionClose, func(event *Event) {
+				event.ActorAccount = 999
+			}),
+			fields: fields{
+				repo: func(mc *minimock.Controller) routerRepository {
+					repo := fresh(mc)
+					repo.GetUserIDByIdentityMock.Expect(minimock.AnyContext, domain.WebhookProviderGitHub, "999").
+						Return("", domain.ErrUserNotFound)
+					return repo
+				},
+				creator: noCreator,
+				lifecycle: func(mc *minimock.Controller) lifecycle {
+					lifecycle := NewLifecycleMock(mc)
+					lifecycle.ClosePullRequestMock.Expect(minimock.AnyContext, domain.PullRequestAction{
+						PullRequestID: pullRequestID,
+					}).Return(domain.PullRequest{}, nil)
+					return lifecycle
+				},
+				merger: noMerger,
+			},
+			want: applied,
+		},
+		{
+			name:     "success: reopen reopens",
+			provider: domain.WebhookProviderGitHub,
+			event:    changed(ActionReopen, nil),
+			fields: fields{
+				repo:    freshBy("101", "u1"),
+				creator: noCreator,
+				lifecycle: func(mc *minimock.Controller) lifecycle {
+					lifecycle := NewLifecycleMock(mc)
+					lifecycle.ReopenPullRequestMock.Expect(minimock.AnyContext, domain.PullRequestAction{
+						PullRequestID: pullRequestID,
+						ActorID:       "u1",
+					}).Return(domain.PullRequest{}, nil)
+					return lifecycle
+				},
+				merger: noMerger,
+			},
+			want: applied,
+		},
+		{
+			name:     "success: unsupported action is ignored",
+			provider: domain.WebhookProviderGitHub,
+			event:    changed("", nil),
+			fields: fields{
+				repo:      func(mc *minimock.Controller) routerRepository { return fresh(mc) },
+				creator:   noCreator,
+				lifecycle: noLifecycle,
+				merger:    noMerger,
+			},
+			want: ignored(ErrUnsupportedAction),
+		},
+		{
+			name:     "success: skipped event is ignored",
+			provider: domain.WebhookProviderGitLab,
+			event: changed("", func(event *Event) {
+				event.Skip = errSkip
+			}),
+			fields: fields{
+				repo:      func(mc *minimock.Controller) routerRepository { return fresh(mc) },
+				creator:   noCreator,
+				lifecycle: noLifecycle,
+				merger:    noMerger,
+			},
+			want: ignored(errSkip),
+		},
+		{
+			name:     "success: open of a PR that already exists is ignored",
+			provider: domain.WebhookProviderGitHub,
+			event:    opened,
+			fields: fields{
+				repo:      freshBy("101", "u1"),
+				creator:   creates(created, domain.ErrPRExists),
+				lifecycle: noLifecycle,
+				merger:    noMerger,
+			},
+			want: ignored(domain.ErrPRExists),
+		},
+		{
+			name:     "success: redelivery is a duplicate",
+			provider: domain.WebhookProviderGitHub,
+			event:    opened,
+			fields: fields{
+				repo: func(mc *minimock.Controller) routerRepository {
+					repo := NewRouterRepositoryMock(mc)
+					repo.WebhookDeliveryExistsMock.Expect(minimock.AnyContext, delivery).Return(true, nil)
+					return repo
+				},
+				creator:   noCreator,
+				lifecycle: noLifecycle,
+				merger:    noMerger,
+			},
+			want: domain.WebhookResult{
+				DeliveryID: result.DeliveryID, Event: result.Event, Action: result.Action,
+				PullRequestID: pullRequestID, Duplicate: true,
+			},
+		},
+		{
+			name:     "error: failed assignment is not recorded, so a redelivery is applied",
+			provider: domain.WebhookProviderGitHub,
+			event:    opened,
+			fields: fields{
+				repo:      unrecorded,
+				creator:   creates(created, domain.ErrNotEnoughReviewers),
+				lifecycle: noLifecycle,
+				merger:    noMerger,
+			},
+			wantErr: domain.ErrNotEnoughReviewers,
+		},
+		{
+			name:     "error: unmapped author is not recorded, so a redelivery after the mapping applies",
+			provider: domain.WebhookProviderGitHub,
+			event:    opened,
+			fields: fields{
+				repo: func(mc *minimock.Controller) routerRepository {
+					repo := NewRouterRepositoryMock(mc)
+					repo.WebhookDeliveryExistsMock.Return(false, nil)
+					repo.GetUserIDByIdentityMock.Return("", domain.ErrUserNotFound)
+					return repo
+				},
+				creator:   noCreator,
+				lifecycle: noLifecycle,
+				merger:    noMerger,
+			},
+			wantErr: domain.ErrAuthorNotFound,
+		},
+		{
+			name:     "error: title the HTTP API rejects is not created",
+			provider: domain.WebhookProviderGitHub,
+			event: changed(ActionOpen, func(event *Event) {
+				event.Title = strings.Repeat("a", 501)
+			}),
+			fields: fields{
+				repo:      unrecorded,
+				creator:   noCreator,
+				lifecycle: noLifecycle,
+				merger:    noMerger,
+			},
+			wantErr: domain.ErrInvalidWebhookPayload,
+		},
+		{
+			name:     "error: repository the HTTP API rejects is not created on ready for review",
+			provider: domain.WebhookProviderGitHub,
+			event: changed(ActionReady, func(event *Event) {
+				event.Repository = strings.Repeat("a", 201)
+			}),
+			fields: fields{
+				repo: func(mc *minimock.Controller) routerRepository {
+					repo := NewRouterRepositoryMock(mc)
+					repo.WebhookDeliveryExistsMock.Return(false, nil)
+					repo.PullRequestExistsMock.Return(false, nil)
+					mapped(repo, domain.WebhookProviderGitHub, "101", "u1")
+					return repo
+				},
+				creator:   noCreator,
+				lifecycle: noLifecycle,
+				merger:    noMerger,
+			},
+			wantErr: domain.ErrInvalidWebhookPayload,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			router := NewRouter(tt.fields.repo(mc), tt.provider, tt.fields.creator(mc), tt.fields.lifecycle(mc),
+				tt.fields.merger(mc), zap.NewNop(), validator, errSkip)
+
+			got, err := router.Process(context.Background(), delivery, result, tt.event)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, domain.WebhookResult{}, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS team_policies (
+  id SERIAL PRIMARY KEY,
+  team_id INT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  version INT NOT NULL,
+  format VARCHAR(8) NOT NULL,
+  document TEXT NOT NULL,
+  policy JSONB NOT NULL,
+  created_at TIMESTAMPTZ DEFAULT NOW(),
+
+  CONSTRAINT unique_team_policy_version
+    UNIQUE (team_id, version),
+
+  CONSTRAINT chk_team_policy_format
+    CHECK (format IN ('json', 'yaml'))
+);
+
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS policy_version INT NULL;
+
+-- Comments
+COMMENT ON TABLE team_policies IS 'Versioned assignment policy documents of teams';
+COMMENT ON COLUMN team_policies.id IS 'Auto-incrementing record identifier';
+COMMENT ON COLUMN team_policies.team_id IS 'Reference to team the policy belongs to';
+COMMENT ON COLUMN team_policies.version IS 'Policy version, sequential within a team';
+COMMENT ON COLUMN team_policies.format IS 'Format of the uploaded document: json or yaml';
+COMMENT ON COLUMN team_policies.document IS 'Document exactly as it was uploaded';
+COMMENT ON COLUMN team_policies.policy IS 'Parsed policy with defaults applied';
+COMMENT ON COLUMN team_policies.created_at IS 'Timestamp when the version was uploaded';
+COMMENT ON COLUMN teams.policy_version IS 'Active policy version (NULL - default assignment behaviour)';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE teams DROP COLUMN IF EXISTS policy_version;
+DROP TABLE IF EXISTS team_policies;
+-- +goose StatementEnd
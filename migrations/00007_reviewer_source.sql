@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reviewers
+  ADD COLUMN IF NOT EXISTS source VARCHAR(16) NOT NULL DEFAULT 'AUTO',
+  ADD COLUMN IF NOT EXISTS deputy_for VARCHAR(255) NULL REFERENCES users(id) ON DELETE SET NULL;
+
+ALTER TABLE reviewers
+  ADD CONSTRAINT chk_reviewer_source
+    CHECK (source IN ('AUTO', 'MANDATORY', 'DEPUTY'));
+
+-- Comments
+COMMENT ON COLUMN reviewers.source IS 'Why the reviewer was assigned: AUTO (strategy), MANDATORY (policy rule) or DEPUTY';
+COMMENT ON COLUMN reviewers.deputy_for IS 'Mandatory reviewer replaced by this deputy (NULL unless source is DEPUTY)';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reviewers DROP CONSTRAINT IF EXISTS chk_reviewer_source;
+ALTER TABLE reviewers DROP COLUMN IF EXISTS deputy_for;
+ALTER TABLE reviewers DROP COLUMN IF EXISTS source;
+-- +goose StatementEnd
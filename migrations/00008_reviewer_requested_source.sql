@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reviewers DROP CONSTRAINT IF EXISTS chk_reviewer_source;
+ALTER TABLE reviewers
+  ADD CONSTRAINT chk_reviewer_source
+    CHECK (source IN ('AUTO', 'MANDATORY', 'DEPUTY', 'REQUESTED'));
+
+-- Comments
+COMMENT ON COLUMN reviewers.source IS 'Why the reviewer was assigned: AUTO (strategy), MANDATORY (policy rule), DEPUTY or REQUESTED (by the author)';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE reviewers SET source = 'AUTO' WHERE source = 'REQUESTED';
+ALTER TABLE reviewers DROP CONSTRAINT IF EXISTS chk_reviewer_source;
+ALTER TABLE reviewers
+  ADD CONSTRAINT chk_reviewer_source
+    CHECK (source IN ('AUTO', 'MANDATORY', 'DEPUTY'));
+-- +goose StatementEnd
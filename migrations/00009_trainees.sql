@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN IF NOT EXISTS is_trainee BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE reviewers ADD COLUMN IF NOT EXISTS role VARCHAR(16) NOT NULL DEFAULT 'REVIEWER';
+ALTER TABLE reviewers
+  ADD CONSTRAINT chk_reviewer_role
+    CHECK (role IN ('REVIEWER', 'SHADOW'));
+
+-- Comments
+COMMENT ON COLUMN users.is_trainee IS 'Trainee flag (trainees are only assigned as non-blocking shadow reviewers)';
+COMMENT ON COLUMN reviewers.role IS 'REVIEWER - counts toward quorum and load, SHADOW - non-blocking trainee reviewer';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reviewers DROP CONSTRAINT IF EXISTS chk_reviewer_role;
+ALTER TABLE reviewers DROP COLUMN IF EXISTS role;
+ALTER TABLE users DROP COLUMN IF EXISTS is_trainee;
+-- +goose StatementEnd
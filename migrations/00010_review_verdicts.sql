@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reviewers
+  ADD COLUMN IF NOT EXISTS verdict VARCHAR(32) NULL,
+  ADD COLUMN IF NOT EXISTS verdict_at TIMESTAMPTZ NULL;
+
+ALTER TABLE reviewers
+  ADD CONSTRAINT chk_reviewer_verdict
+    CHECK (
+      (verdict IS NULL AND verdict_at IS NULL) OR
+      (verdict IN ('APPROVED', 'CHANGES_REQUESTED', 'COMMENTED') AND verdict_at IS NOT NULL)
+    );
+
+CREATE TABLE IF NOT EXISTS review_verdicts (
+  id BIGSERIAL PRIMARY KEY,
+  pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+  user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  verdict VARCHAR(32) NOT NULL,
+  message TEXT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT chk_review_verdict
+    CHECK (verdict IN ('APPROVED', 'CHANGES_REQUESTED', 'COMMENTED'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_verdicts_pull_request ON review_verdicts (pull_request_id, created_at);
+
+-- Comments
+COMMENT ON COLUMN reviewers.verdict IS 'Latest verdict of the reviewer (NULL while the review is pending)';
+COMMENT ON COLUMN reviewers.verdict_at IS 'Timestamp when the latest verdict was given';
+COMMENT ON TABLE review_verdicts IS 'History of every verdict given on a pull request';
+COMMENT ON COLUMN review_verdicts.id IS 'Auto-incrementing record identifier';
+COMMENT ON COLUMN review_verdicts.pull_request_id IS 'Reference to reviewed pull request';
+COMMENT ON COLUMN review_verdicts.user_id IS 'Reference to reviewer who gave the verdict';
+COMMENT ON COLUMN review_verdicts.verdict IS 'APPROVED, CHANGES_REQUESTED or COMMENTED';
+COMMENT ON COLUMN review_verdicts.message IS 'Optional review message';
+COMMENT ON COLUMN review_verdicts.created_at IS 'Timestamp when the verdict was given';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS review_verdicts;
+ALTER TABLE reviewers DROP CONSTRAINT IF EXISTS chk_reviewer_verdict;
+ALTER TABLE reviewers DROP COLUMN IF EXISTS verdict_at;
+ALTER TABLE reviewers DROP COLUMN IF EXISTS verdict;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS pull_request_events (
+  id BIGSERIAL PRIMARY KEY,
+  pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+  event_type VARCHAR(32) NOT NULL,
+  actor_id VARCHAR(255) NULL REFERENCES users(id) ON DELETE SET NULL,
+  details JSONB NOT NULL DEFAULT '{}',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_pull_request_events_pull_request ON pull_request_events (pull_request_id, created_at);
+
+-- Comments
+COMMENT ON TABLE pull_request_events IS 'Audit trail of notable pull request events (force merges, escalations, ...)';
+COMMENT ON COLUMN pull_request_events.id IS 'Auto-incrementing record identifier';
+COMMENT ON COLUMN pull_request_events.pull_request_id IS 'Reference to pull request';
+COMMENT ON COLUMN pull_request_events.event_type IS 'Event type, e.g. FORCE_MERGED';
+COMMENT ON COLUMN pull_request_events.actor_id IS 'User who triggered the event (NULL for the service itself)';
+COMMENT ON COLUMN pull_request_events.details IS 'Event specific details';
+COMMENT ON COLUMN pull_request_events.created_at IS 'Timestamp when the event happened';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pull_request_events;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose NO TRANSACTION
+-- New enum values cannot be used in the transaction that adds them,
+-- so the constraints depending on them live in the next migration.
+
+-- +goose Up
+ALTER TYPE pr_status ADD VALUE IF NOT EXISTS 'DRAFT' BEFORE 'OPEN';
+ALTER TYPE pr_status ADD VALUE IF NOT EXISTS 'CLOSED';
+
+-- +goose Down
+-- Enum values cannot be dropped, the type is rebuilt instead.
+-- +goose StatementBegin
+BEGIN;
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS chk_pr_merged_at;
+ALTER TABLE pull_requests ALTER COLUMN status DROP DEFAULT;
+ALTER TYPE pr_status RENAME TO pr_status_old;
+CREATE TYPE pr_status AS ENUM ('OPEN', 'MERGED');
+ALTER TABLE pull_requests ALTER COLUMN status TYPE pr_status USING status::text::pr_status;
+ALTER TABLE pull_requests ALTER COLUMN status SET DEFAULT 'OPEN';
+DROP TYPE pr_status_old;
+ALTER TABLE pull_requests
+  ADD CONSTRAINT chk_pr_merged_at
+    CHECK (
+      (status = 'MERGED' AND merged_at IS NOT NULL) OR
+      (status = 'OPEN' AND merged_at IS NULL)
+    );
+COMMIT;
+-- +goose StatementEnd
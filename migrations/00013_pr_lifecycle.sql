@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS closed_at TIMESTAMPTZ DEFAULT NULL;
+
+-- Existing rows are OPEN or MERGED and satisfy both constraints
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS chk_pr_merged_at;
+ALTER TABLE pull_requests
+  ADD CONSTRAINT chk_pr_merged_at
+    CHECK (
+      (status = 'MERGED' AND merged_at IS NOT NULL) OR
+      (status <> 'MERGED' AND merged_at IS NULL)
+    );
+ALTER TABLE pull_requests
+  ADD CONSTRAINT chk_pr_closed_at
+    CHECK (
+      (status = 'CLOSED' AND closed_at IS NOT NULL) OR
+      (status <> 'CLOSED' AND closed_at IS NULL)
+    );
+
+-- Comments
+COMMENT ON COLUMN pull_requests.status IS 'PR status: DRAFT, OPEN (active), MERGED or CLOSED (abandoned without merge)';
+COMMENT ON COLUMN pull_requests.merged_at IS 'Timestamp when PR was merged (NULL unless MERGED)';
+COMMENT ON COLUMN pull_requests.closed_at IS 'Timestamp when PR was closed (NULL unless CLOSED)';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE pull_requests SET status = 'OPEN', closed_at = NULL WHERE status IN ('DRAFT', 'CLOSED');
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS chk_pr_closed_at;
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS chk_pr_merged_at;
+ALTER TABLE pull_requests
+  ADD CONSTRAINT chk_pr_merged_at
+    CHECK (
+      (status = 'MERGED' AND merged_at IS NOT NULL) OR
+      (status = 'OPEN' AND merged_at IS NULL)
+    );
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS closed_at;
+COMMENT ON COLUMN pull_requests.status IS 'PR status: OPEN (active) or MERGED';
+COMMENT ON COLUMN pull_requests.merged_at IS 'Timestamp when PR was merged (NULL for OPEN)';
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Released and replaced reviewers are kept as history, so the same user may have several
+-- non-current rows on a PR. Only current assignments must be unique.
+ALTER TABLE reviewers DROP CONSTRAINT IF EXISTS unique_current_reviewer;
+CREATE UNIQUE INDEX IF NOT EXISTS unique_current_reviewer
+  ON reviewers (pull_request_id, user_id)
+  WHERE is_current;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS unique_current_reviewer;
+ALTER TABLE reviewers
+  ADD CONSTRAINT unique_current_reviewer
+    UNIQUE (pull_request_id, user_id, is_current)
+    DEFERRABLE INITIALLY DEFERRED;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reviewers ADD COLUMN IF NOT EXISTS escalated_at TIMESTAMPTZ NULL;
+
+-- Pending reviews are scanned by the SLA worker and the reminder scheduler
+CREATE INDEX IF NOT EXISTS idx_reviewers_pending
+  ON reviewers (assigned_at)
+  WHERE is_current AND verdict IS NULL;
+
+-- Comments
+COMMENT ON COLUMN reviewers.escalated_at IS 'Timestamp when the review breached the SLA and was escalated (NULL if not escalated)';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_reviewers_pending;
+ALTER TABLE reviewers DROP COLUMN IF EXISTS escalated_at;
+-- +goose StatementEnd
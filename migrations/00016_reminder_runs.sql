@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS reminder_runs (
+  id BIGSERIAL PRIMARY KEY,
+  team_id INT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+  scheduled_for TIMESTAMPTZ NOT NULL,
+  started_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  finished_at TIMESTAMPTZ NULL,
+
+  CONSTRAINT unique_reminder_run
+    UNIQUE (team_id, scheduled_for)
+);
+
+CREATE TABLE IF NOT EXISTS reminder_deliveries (
+  id BIGSERIAL PRIMARY KEY,
+  run_id BIGINT NOT NULL REFERENCES reminder_runs(id) ON DELETE CASCADE,
+  user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  pull_request_ids TEXT[] NOT NULL,
+  status VARCHAR(16) NOT NULL,
+  error TEXT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT unique_reminder_delivery
+    UNIQUE (run_id, user_id),
+
+  CONSTRAINT chk_reminder_delivery_status
+    CHECK (status IN ('SENT', 'FAILED'))
+);
+
+-- Comments
+COMMENT ON TABLE reminder_runs IS 'Scheduled reminder runs, one per team and scheduled moment';
+COMMENT ON COLUMN reminder_runs.id IS 'Auto-incrementing record identifier';
+COMMENT ON COLUMN reminder_runs.team_id IS 'Reference to team the reminders were sent for';
+COMMENT ON COLUMN reminder_runs.scheduled_for IS 'Scheduled moment the run belongs to';
+COMMENT ON COLUMN reminder_runs.started_at IS 'Timestamp when a replica claimed the run';
+COMMENT ON COLUMN reminder_runs.finished_at IS 'Timestamp when all reminders were sent (NULL if interrupted)';
+COMMENT ON TABLE reminder_deliveries IS 'Reminders sent to reviewers within a run';
+COMMENT ON COLUMN reminder_deliveries.id IS 'Auto-incrementing record identifier';
+COMMENT ON COLUMN reminder_deliveries.run_id IS 'Reference to reminder run';
+COMMENT ON COLUMN reminder_deliveries.user_id IS 'Reviewer the reminder was sent to';
+COMMENT ON COLUMN reminder_deliveries.pull_request_ids IS 'Pull requests listed in the reminder';
+COMMENT ON COLUMN reminder_deliveries.status IS 'Delivery status: SENT or FAILED';
+COMMENT ON COLUMN reminder_deliveries.error IS 'Sink error of a failed delivery';
+COMMENT ON COLUMN reminder_deliveries.created_at IS 'Timestamp when the delivery was attempted';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS reminder_deliveries;
+DROP TABLE IF EXISTS reminder_runs;
+-- +goose StatementEnd
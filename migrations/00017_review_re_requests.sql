@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reviewers
+  ADD COLUMN IF NOT EXISTS re_request_count INT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS re_requested_at TIMESTAMPTZ NULL;
+
+-- A re-requested review is pending since the re-request, not since the assignment
+DROP INDEX IF EXISTS idx_reviewers_pending;
+CREATE INDEX IF NOT EXISTS idx_reviewers_pending
+  ON reviewers ((COALESCE(re_requested_at, assigned_at)))
+  WHERE is_current AND verdict IS NULL;
+
+-- Comments
+COMMENT ON COLUMN reviewers.re_request_count IS 'Number of times the author requested the review again';
+COMMENT ON COLUMN reviewers.re_requested_at IS 'Timestamp when the review was last requested again (NULL if never)';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_reviewers_pending;
+CREATE INDEX IF NOT EXISTS idx_reviewers_pending
+  ON reviewers (assigned_at)
+  WHERE is_current AND verdict IS NULL;
+ALTER TABLE reviewers DROP COLUMN IF EXISTS re_requested_at;
+ALTER TABLE reviewers DROP COLUMN IF EXISTS re_request_count;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS review_declines (
+  id BIGSERIAL PRIMARY KEY,
+  pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+  user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  reason VARCHAR(32) NOT NULL,
+  comment TEXT NULL,
+  replacement_id VARCHAR(255) NULL REFERENCES users(id) ON DELETE SET NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT chk_review_decline_reason
+    CHECK (reason IN ('NO_CONTEXT', 'NO_TIME', 'CONFLICT_OF_INTEREST', 'OUT_OF_OFFICE', 'OTHER'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_declines_pull_request ON review_declines (pull_request_id);
+CREATE INDEX IF NOT EXISTS idx_review_declines_created_at ON review_declines (created_at);
+
+-- Comments
+COMMENT ON TABLE review_declines IS 'Review assignments declined by the reviewer';
+COMMENT ON COLUMN review_declines.id IS 'Auto-incrementing record identifier';
+COMMENT ON COLUMN review_declines.pull_request_id IS 'Reference to pull request';
+COMMENT ON COLUMN review_declines.user_id IS 'Reviewer who declined';
+COMMENT ON COLUMN review_declines.reason IS 'Reason code of the decline';
+COMMENT ON COLUMN review_declines.comment IS 'Optional free-form explanation';
+COMMENT ON COLUMN review_declines.replacement_id IS 'Reviewer assigned instead (NULL if nobody was available)';
+COMMENT ON COLUMN review_declines.created_at IS 'Timestamp when the assignment was declined';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS review_declines;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pull_requests
+  ADD COLUMN IF NOT EXISTS repository VARCHAR(200) NULL,
+  ADD COLUMN IF NOT EXISTS source_branch VARCHAR(255) NULL,
+  ADD COLUMN IF NOT EXISTS target_branch VARCHAR(255) NULL,
+  ADD COLUMN IF NOT EXISTS url TEXT NULL,
+  ADD COLUMN IF NOT EXISTS labels TEXT[] NOT NULL DEFAULT '{}',
+  ADD COLUMN IF NOT EXISTS head_sha VARCHAR(64) NULL;
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_repository ON pull_requests (repository, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_pull_requests_labels ON pull_requests USING GIN (labels);
+
+-- Comments
+COMMENT ON COLUMN pull_requests.id IS 'Unique pull request identifier, namespaced as <repository>#<id> when the repository is known';
+COMMENT ON COLUMN pull_requests.repository IS 'Repository the PR belongs to, e.g. acme/api';
+COMMENT ON COLUMN pull_requests.source_branch IS 'Branch with the changes';
+COMMENT ON COLUMN pull_requests.target_branch IS 'Branch the PR is merged into';
+COMMENT ON COLUMN pull_requests.url IS 'Web URL of the PR in the code host';
+COMMENT ON COLUMN pull_requests.labels IS 'Labels of the PR';
+COMMENT ON COLUMN pull_requests.head_sha IS 'Commit SHA of the PR head';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_pull_requests_labels;
+DROP INDEX IF EXISTS idx_pull_requests_repository;
+ALTER TABLE pull_requests
+  DROP COLUMN IF EXISTS head_sha,
+  DROP COLUMN IF EXISTS labels,
+  DROP COLUMN IF EXISTS url,
+  DROP COLUMN IF EXISTS target_branch,
+  DROP COLUMN IF EXISTS source_branch,
+  DROP COLUMN IF EXISTS repository;
+COMMENT ON COLUMN pull_requests.id IS 'Unique pull request identifier';
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- The keyset order of GET /pullRequest/list needs created_at on every PR.
+
+-- +goose Up
+-- +goose StatementBegin
+UPDATE pull_requests SET created_at = COALESCE(updated_at, NOW()) WHERE created_at IS NULL;
+ALTER TABLE pull_requests ALTER COLUMN created_at SET NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pull_requests ALTER COLUMN created_at DROP NOT NULL;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose NO TRANSACTION
+-- Indexes for GET /pullRequest/list are built concurrently so large tables stay writable.
+-- Each of them matches a filter combined with the (created_at DESC, id DESC) keyset order.
+
+-- +goose Up
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_pull_requests_created ON pull_requests (created_at DESC, id DESC);
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_pull_requests_status ON pull_requests (status, created_at DESC, id DESC);
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_pull_requests_author ON pull_requests (author_id, created_at DESC, id DESC);
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_pull_requests_merged ON pull_requests (merged_at) WHERE merged_at IS NOT NULL;
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_reviewers_current_user ON reviewers (user_id, pull_request_id) WHERE is_current;
+
+-- +goose Down
+DROP INDEX CONCURRENTLY IF EXISTS idx_reviewers_current_user;
+DROP INDEX CONCURRENTLY IF EXISTS idx_pull_requests_merged;
+DROP INDEX CONCURRENTLY IF EXISTS idx_pull_requests_author;
+DROP INDEX CONCURRENTLY IF EXISTS idx_pull_requests_status;
+DROP INDEX CONCURRENTLY IF EXISTS idx_pull_requests_created;
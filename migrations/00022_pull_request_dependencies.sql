@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS pull_request_dependencies (
+  pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+  depends_on_id VARCHAR(255) NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  PRIMARY KEY (pull_request_id, depends_on_id),
+  CONSTRAINT chk_pull_request_dependency_self CHECK (pull_request_id <> depends_on_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_pull_request_dependencies_depends_on ON pull_request_dependencies (depends_on_id);
+
+ALTER TABLE reviewers DROP CONSTRAINT IF EXISTS chk_reviewer_source;
+ALTER TABLE reviewers
+  ADD CONSTRAINT chk_reviewer_source
+    CHECK (source IN ('AUTO', 'MANDATORY', 'DEPUTY', 'REQUESTED', 'STACK'));
+
+-- Comments
+COMMENT ON TABLE pull_request_dependencies IS 'Stacked pull requests: a PR cannot be merged while a PR it depends on is open';
+COMMENT ON COLUMN pull_request_dependencies.pull_request_id IS 'Dependent pull request';
+COMMENT ON COLUMN pull_request_dependencies.depends_on_id IS 'Pull request it is stacked on';
+COMMENT ON COLUMN pull_request_dependencies.created_at IS 'Timestamp when the dependency was added';
+COMMENT ON COLUMN reviewers.source IS 'Why the reviewer was assigned: AUTO (strategy), MANDATORY (policy rule), DEPUTY, REQUESTED (by the author) or STACK (reviewer of a base PR)';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE reviewers SET source = 'AUTO' WHERE source = 'STACK';
+ALTER TABLE reviewers DROP CONSTRAINT IF EXISTS chk_reviewer_source;
+ALTER TABLE reviewers
+  ADD CONSTRAINT chk_reviewer_source
+    CHECK (source IN ('AUTO', 'MANDATORY', 'DEPUTY', 'REQUESTED'));
+COMMENT ON COLUMN reviewers.source IS 'Why the reviewer was assigned: AUTO (strategy), MANDATORY (policy rule), DEPUTY or REQUESTED (by the author)';
+DROP TABLE IF EXISTS pull_request_dependencies;
+-- +goose StatementEnd
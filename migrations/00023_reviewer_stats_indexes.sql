@@ -0,0 +1,12 @@
+-- +goose NO TRANSACTION
+-- Indexes for the range aggregates of GET /stats, built concurrently so reviewers stays writable.
+
+-- +goose Up
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_reviewers_assigned_at ON reviewers (assigned_at);
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_reviewers_replaced_at ON reviewers (replaced_at) WHERE replaced_at IS NOT NULL;
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_reviewers_pull_request ON reviewers (pull_request_id);
+
+-- +goose Down
+DROP INDEX CONCURRENTLY IF EXISTS idx_reviewers_pull_request;
+DROP INDEX CONCURRENTLY IF EXISTS idx_reviewers_replaced_at;
+DROP INDEX CONCURRENTLY IF EXISTS idx_reviewers_assigned_at;
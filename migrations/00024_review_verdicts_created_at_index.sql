@@ -0,0 +1,8 @@
+-- +goose NO TRANSACTION
+-- Index for the range scan of the reviewer history export, built concurrently so review_verdicts stays writable.
+
+-- +goose Up
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_review_verdicts_created_at ON review_verdicts (created_at);
+
+-- +goose Down
+DROP INDEX CONCURRENTLY IF EXISTS idx_review_verdicts_created_at;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+  provider VARCHAR(16) NOT NULL,
+  delivery_id VARCHAR(255) NOT NULL,
+  event VARCHAR(64) NOT NULL,
+  received_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  PRIMARY KEY (provider, delivery_id)
+);
+
+-- Comments
+COMMENT ON TABLE webhook_deliveries IS 'Processed webhook deliveries, used to ignore redeliveries';
+COMMENT ON COLUMN webhook_deliveries.provider IS 'Code host that sent the delivery, e.g. github';
+COMMENT ON COLUMN webhook_deliveries.delivery_id IS 'Delivery id assigned by the code host';
+COMMENT ON COLUMN webhook_deliveries.event IS 'Event type of the delivery';
+COMMENT ON COLUMN webhook_deliveries.received_at IS 'Timestamp when the delivery was received';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS webhook_deliveries;
+-- +goose StatementEnd
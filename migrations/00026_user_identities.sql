@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS user_identities (
+  provider VARCHAR(16) NOT NULL,
+  external_id VARCHAR(255) NOT NULL,
+  user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  PRIMARY KEY (provider, external_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON user_identities (user_id);
+
+-- Comments
+COMMENT ON TABLE user_identities IS 'Code host accounts mapped to users';
+COMMENT ON COLUMN user_identities.provider IS 'Code host of the account, e.g. gitlab';
+COMMENT ON COLUMN user_identities.external_id IS 'Account id on the code host, the numeric user id for GitLab';
+COMMENT ON COLUMN user_identities.user_id IS 'User the account belongs to';
+COMMENT ON COLUMN user_identities.updated_at IS 'Timestamp when the mapping was last set';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_identities;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS escalated_at TIMESTAMPTZ NULL;
+
+-- PRs with an escalated current review are already escalated
+UPDATE pull_requests p SET escalated_at = r.escalated_at
+FROM (
+  SELECT pull_request_id, MAX(escalated_at) AS escalated_at
+  FROM reviewers
+  WHERE is_current AND escalated_at IS NOT NULL
+  GROUP BY pull_request_id
+) r
+WHERE p.id = r.pull_request_id;
+
+-- Comments
+COMMENT ON COLUMN pull_requests.escalated_at IS 'Timestamp when a review of the PR breached the SLA and the PR was escalated (NULL if not escalated since the last re-request)';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS escalated_at;
+-- +goose StatementEnd
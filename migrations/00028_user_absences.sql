@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS user_absences (
+  id BIGSERIAL PRIMARY KEY,
+  user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  starts_at TIMESTAMPTZ NOT NULL,
+  ends_at TIMESTAMPTZ NOT NULL,
+  comment TEXT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT chk_user_absence_period CHECK (ends_at > starts_at)
+);
+
+-- Candidates are checked for an absence covering the current time
+CREATE INDEX IF NOT EXISTS idx_user_absences_user_id ON user_absences (user_id, ends_at);
+
+-- Comments
+COMMENT ON TABLE user_absences IS 'Periods users are away, absent users are not assigned as reviewers';
+COMMENT ON COLUMN user_absences.id IS 'Auto-incrementing record identifier';
+COMMENT ON COLUMN user_absences.user_id IS 'User who is away';
+COMMENT ON COLUMN user_absences.starts_at IS 'Start of the absence, inclusive';
+COMMENT ON COLUMN user_absences.ends_at IS 'End of the absence, exclusive';
+COMMENT ON COLUMN user_absences.comment IS 'Optional free-form explanation, e.g. vacation';
+COMMENT ON COLUMN user_absences.created_at IS 'Timestamp when the absence was added';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS user_absences;
+-- +goose StatementEnd